@@ -0,0 +1,53 @@
+package attendance_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// AttendancePolicy holds the rules used to turn clock events into a daily attendance record.
+//
+// Fields:
+//   - lateTolerance: grace period after the scheduled start before a clock-in counts as late
+//   - earlyLeaveTolerance: grace period before the scheduled end before a clock-out counts as early leave
+//   - punchWindow: how far before the scheduled start and after the scheduled end punches still belong to the day
+//   - missingPunch: how a day with only a clock-in or only a clock-out is treated
+type AttendancePolicy struct {
+	lateTolerance       time.Duration
+	earlyLeaveTolerance time.Duration
+	punchWindow         time.Duration
+	missingPunch        enum.MissingPunchPolicy
+}
+
+// NewAttendancePolicy constructs an AttendancePolicy with validation.
+func NewAttendancePolicy(lateTolerance, earlyLeaveTolerance, punchWindow time.Duration, missingPunch enum.MissingPunchPolicy) (*AttendancePolicy, error) {
+	if lateTolerance < 0 || earlyLeaveTolerance < 0 {
+		return nil, errors.New("tolerance cannot be negative")
+	}
+	if punchWindow <= 0 {
+		return nil, errors.New("punch window must be positive")
+	}
+	if !missingPunch.Valid() {
+		return nil, fmt.Errorf("invalid MissingPunchPolicy: %q", missingPunch)
+	}
+	return &AttendancePolicy{
+		lateTolerance:       lateTolerance,
+		earlyLeaveTolerance: earlyLeaveTolerance,
+		punchWindow:         punchWindow,
+		missingPunch:        missingPunch,
+	}, nil
+}
+
+// LateTolerance returns the grace period for clock-in.
+func (p AttendancePolicy) LateTolerance() time.Duration { return p.lateTolerance }
+
+// EarlyLeaveTolerance returns the grace period for clock-out.
+func (p AttendancePolicy) EarlyLeaveTolerance() time.Duration { return p.earlyLeaveTolerance }
+
+// PunchWindow returns how far outside the schedule punches are still attributed to the day.
+func (p AttendancePolicy) PunchWindow() time.Duration { return p.punchWindow }
+
+// MissingPunch returns the rule applied to days with a single punch.
+func (p AttendancePolicy) MissingPunch() enum.MissingPunchPolicy { return p.missingPunch }
@@ -0,0 +1,60 @@
+package attendance_entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"time"
+)
+
+// ClockEvent represents a single clock-in or clock-out punch of an employee.
+type ClockEvent struct {
+	id         uuid.UUID
+	employeeID uuid.UUID
+	eventType  enum.ClockEventType
+	source     enum.ClockSource
+	occurredAt time.Time
+	location   *valueobject.GeoLocation
+	deviceID   string
+	note       string
+}
+
+// ID returns the unique identifier of the event.
+func (c *ClockEvent) ID() uuid.UUID {
+	return c.id
+}
+
+// EmployeeID returns the identifier of the employee who punched.
+func (c *ClockEvent) EmployeeID() uuid.UUID {
+	return c.employeeID
+}
+
+// Type returns whether the event is a clock-in or a clock-out.
+func (c *ClockEvent) Type() enum.ClockEventType {
+	return c.eventType
+}
+
+// Source returns where the punch was captured.
+func (c *ClockEvent) Source() enum.ClockSource {
+	return c.source
+}
+
+// OccurredAt returns the instant of the punch.
+func (c *ClockEvent) OccurredAt() time.Time {
+	return c.occurredAt
+}
+
+// Location returns the geolocation of the punch, or nil if none was captured.
+func (c *ClockEvent) Location() *valueobject.GeoLocation {
+	return c.location
+}
+
+// DeviceID returns the identifier of the device or terminal used, if any.
+func (c *ClockEvent) DeviceID() string {
+	return c.deviceID
+}
+
+// Note returns the free-text note, e.g. the reason for a manual punch.
+func (c *ClockEvent) Note() string {
+	return c.note
+}
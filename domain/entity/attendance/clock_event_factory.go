@@ -0,0 +1,70 @@
+package attendance_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"strings"
+	"time"
+)
+
+// ClockEventFactory is a factory type for creating ClockEvent instances with validated properties.
+type ClockEventFactory struct {
+	ID         string
+	EmployeeID string
+	Type       string
+	Source     string
+	OccurredAt time.Time
+	Location   *valueobject.GeoLocation
+	DeviceID   string
+	Note       string
+}
+
+// Create validates the factory data and returns a new ClockEvent.
+// Mobile punches must carry a location and manual punches must carry a note explaining them.
+func (f ClockEventFactory) Create() (*ClockEvent, error) {
+	newUUID, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	employeeID, err := uuid.Parse(f.EmployeeID)
+	if err != nil {
+		return nil, errors.New("invalid employee id")
+	}
+
+	eventType, err := enum.ParseClockEventType(f.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := enum.ParseClockSource(f.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.OccurredAt.IsZero() {
+		f.OccurredAt = time.Now()
+	}
+
+	if source == enum.ClockSourceMobile && f.Location == nil {
+		return nil, errors.New("mobile punch requires a location")
+	}
+
+	note := strings.TrimSpace(f.Note)
+	if source == enum.ClockSourceManual && note == "" {
+		return nil, errors.New("manual punch requires a note")
+	}
+
+	return &ClockEvent{
+		id:         newUUID,
+		employeeID: employeeID,
+		eventType:  eventType,
+		source:     source,
+		occurredAt: f.OccurredAt,
+		location:   f.Location,
+		deviceID:   strings.TrimSpace(f.DeviceID),
+		note:       note,
+	}, nil
+}
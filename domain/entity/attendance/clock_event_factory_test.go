@@ -0,0 +1,94 @@
+package attendance_entity_test
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	attendance_entity "github.com/rfanazhari/hris/domain/entity/attendance"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestClockEventFactory_Create(t *testing.T) {
+	location, _ := valueobject.NewGeoLocation(-6.2, 106.8, 10)
+
+	t.Run("ValidInput", func(t *testing.T) {
+		factory := attendance_entity.ClockEventFactory{
+			ID:         uuid.NewString(),
+			EmployeeID: uuid.NewString(),
+			Type:       "in",
+			Source:     "mobile",
+			OccurredAt: time.Date(2025, 3, 3, 1, 0, 0, 0, time.UTC),
+			Location:   location,
+			DeviceID:   " android-123 ",
+		}
+
+		event, err := factory.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, factory.EmployeeID, event.EmployeeID().String())
+		assert.Equal(t, enum.ClockIn, event.Type())
+		assert.Equal(t, enum.ClockSourceMobile, event.Source())
+		assert.Equal(t, factory.OccurredAt, event.OccurredAt())
+		assert.Equal(t, *location, *event.Location())
+		assert.Equal(t, "android-123", event.DeviceID())
+	})
+	t.Run("ZeroOccurredAtDefaultsToNow", func(t *testing.T) {
+		factory := attendance_entity.ClockEventFactory{ID: uuid.NewString(), EmployeeID: uuid.NewString(), Type: "out", Source: "fingerprint"}
+
+		event, err := factory.Create()
+
+		assert.Nil(t, err)
+		assert.False(t, event.OccurredAt().IsZero())
+	})
+	t.Run("InvalidID", func(t *testing.T) {
+		factory := attendance_entity.ClockEventFactory{ID: "uuid", EmployeeID: uuid.NewString(), Type: "in", Source: "web"}
+
+		event, err := factory.Create()
+
+		assert.Nil(t, event)
+		assert.EqualError(t, err, "invalid format uuid")
+	})
+	t.Run("InvalidEmployeeID", func(t *testing.T) {
+		factory := attendance_entity.ClockEventFactory{ID: uuid.NewString(), EmployeeID: "x", Type: "in", Source: "web"}
+
+		event, err := factory.Create()
+
+		assert.Nil(t, event)
+		assert.EqualError(t, err, "invalid employee id")
+	})
+	t.Run("InvalidType", func(t *testing.T) {
+		factory := attendance_entity.ClockEventFactory{ID: uuid.NewString(), EmployeeID: uuid.NewString(), Type: "break", Source: "web"}
+
+		event, err := factory.Create()
+
+		assert.Nil(t, event)
+		assert.EqualError(t, err, fmt.Errorf("invalid ClockEventType: %q", "break").Error())
+	})
+	t.Run("InvalidSource", func(t *testing.T) {
+		factory := attendance_entity.ClockEventFactory{ID: uuid.NewString(), EmployeeID: uuid.NewString(), Type: "in", Source: "sms"}
+
+		event, err := factory.Create()
+
+		assert.Nil(t, event)
+		assert.EqualError(t, err, fmt.Errorf("invalid ClockSource: %q", "sms").Error())
+	})
+	t.Run("MobileWithoutLocation", func(t *testing.T) {
+		factory := attendance_entity.ClockEventFactory{ID: uuid.NewString(), EmployeeID: uuid.NewString(), Type: "in", Source: "mobile"}
+
+		event, err := factory.Create()
+
+		assert.Nil(t, event)
+		assert.EqualError(t, err, "mobile punch requires a location")
+	})
+	t.Run("ManualWithoutNote", func(t *testing.T) {
+		factory := attendance_entity.ClockEventFactory{ID: uuid.NewString(), EmployeeID: uuid.NewString(), Type: "in", Source: "manual", Note: " "}
+
+		event, err := factory.Create()
+
+		assert.Nil(t, event)
+		assert.EqualError(t, err, "manual punch requires a note")
+	})
+}
@@ -0,0 +1,89 @@
+package attendance_entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// DailyAttendance is the attendance record of one employee for one working day,
+// computed from the clock events that fall into the day's schedule.
+type DailyAttendance struct {
+	employeeID     uuid.UUID
+	date           time.Time
+	scheduledStart time.Time
+	scheduledEnd   time.Time
+	clockIn        *time.Time
+	clockOut       *time.Time
+	imputed        bool
+	lateBy         time.Duration
+	earlyLeaveBy   time.Duration
+	worked         time.Duration
+	status         enum.AttendanceStatus
+}
+
+// EmployeeID returns the identifier of the employee.
+func (d *DailyAttendance) EmployeeID() uuid.UUID {
+	return d.employeeID
+}
+
+// Date returns midnight of the attendance day in the office time zone.
+func (d *DailyAttendance) Date() time.Time {
+	return d.date
+}
+
+// ScheduledStart returns the scheduled start instant of the day.
+func (d *DailyAttendance) ScheduledStart() time.Time {
+	return d.scheduledStart
+}
+
+// ScheduledEnd returns the scheduled end instant of the day.
+func (d *DailyAttendance) ScheduledEnd() time.Time {
+	return d.scheduledEnd
+}
+
+// ClockIn returns the first clock-in of the day, or nil if there is none.
+func (d *DailyAttendance) ClockIn() *time.Time {
+	return d.clockIn
+}
+
+// ClockOut returns the last clock-out of the day, or nil if there is none.
+func (d *DailyAttendance) ClockOut() *time.Time {
+	return d.clockOut
+}
+
+// Imputed reports whether a missing punch was filled in from the schedule.
+func (d *DailyAttendance) Imputed() bool {
+	return d.imputed
+}
+
+// LateBy returns how long after the scheduled start the employee clocked in (0 if within tolerance).
+func (d *DailyAttendance) LateBy() time.Duration {
+	return d.lateBy
+}
+
+// EarlyLeaveBy returns how long before the scheduled end the employee clocked out (0 if within tolerance).
+func (d *DailyAttendance) EarlyLeaveBy() time.Duration {
+	return d.earlyLeaveBy
+}
+
+// Worked returns the time between clock-in and clock-out.
+func (d *DailyAttendance) Worked() time.Duration {
+	return d.worked
+}
+
+// Status returns the outcome of the day. A day that is both late and early leave is reported as late;
+// use IsEarlyLeave to check the latter.
+func (d *DailyAttendance) Status() enum.AttendanceStatus {
+	return d.status
+}
+
+// IsLate reports whether the employee clocked in after the tolerance.
+func (d *DailyAttendance) IsLate() bool {
+	return d.lateBy > 0
+}
+
+// IsEarlyLeave reports whether the employee clocked out before the tolerance.
+func (d *DailyAttendance) IsEarlyLeave() bool {
+	return d.earlyLeaveBy > 0
+}
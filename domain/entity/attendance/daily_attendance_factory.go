@@ -0,0 +1,124 @@
+package attendance_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"sort"
+	"time"
+)
+
+// DailyAttendanceFactory computes a DailyAttendance from the clock events of a working day.
+//
+// Events of other employees and events outside the schedule window widened by the policy's
+// punch window are ignored. The first clock-in and the last clock-out after it are used;
+// without any clock-in the last clock-out is kept.
+// Missing punches are handled according to the policy:
+//   - flag:         status missing_punch, lateness is still evaluated for the existing punch
+//   - use_schedule: the missing punch is taken from the schedule and the day is evaluated normally
+//   - absent:       the day is treated as absent
+type DailyAttendanceFactory struct {
+	EmployeeID string
+	Date       time.Time
+	Schedule   WorkSchedule
+	Policy     AttendancePolicy
+	Events     []ClockEvent
+}
+
+// Create validates the factory data and returns the computed DailyAttendance.
+func (f DailyAttendanceFactory) Create() (*DailyAttendance, error) {
+	employeeID, err := uuid.Parse(f.EmployeeID)
+	if err != nil {
+		return nil, errors.New("invalid employee id")
+	}
+	if f.Date.IsZero() {
+		return nil, errors.New("date cannot be empty")
+	}
+	if !f.Schedule.timeZone.Valid() {
+		return nil, errors.New("invalid work schedule")
+	}
+	if !f.Policy.missingPunch.Valid() {
+		return nil, errors.New("invalid attendance policy")
+	}
+
+	start, end := f.Schedule.Window(f.Date)
+	from, to := start.Add(-f.Policy.punchWindow), end.Add(f.Policy.punchWindow)
+
+	events := make([]ClockEvent, 0, len(f.Events))
+	for _, e := range f.Events {
+		if e.employeeID != employeeID || e.occurredAt.Before(from) || e.occurredAt.After(to) {
+			continue
+		}
+		events = append(events, e)
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].occurredAt.Before(events[j].occurredAt) })
+
+	var clockIn, clockOut *time.Time
+	for _, e := range events {
+		if e.eventType == enum.ClockIn {
+			at := e.occurredAt.In(start.Location())
+			clockIn = &at
+			break
+		}
+	}
+	// A clock-out before the first clock-in is stray and leaves the day with a missing punch.
+	for _, e := range events {
+		at := e.occurredAt.In(start.Location())
+		if e.eventType == enum.ClockOut && (clockIn == nil || at.After(*clockIn)) {
+			clockOut = &at
+		}
+	}
+
+	d := &DailyAttendance{
+		employeeID:     employeeID,
+		date:           time.Date(f.Date.Year(), f.Date.Month(), f.Date.Day(), 0, 0, 0, 0, start.Location()),
+		scheduledStart: start,
+		scheduledEnd:   end,
+		clockIn:        clockIn,
+		clockOut:       clockOut,
+	}
+
+	if clockIn == nil && clockOut == nil {
+		d.status = enum.AttendanceAbsent
+		return d, nil
+	}
+
+	in, out := clockIn, clockOut
+	if in == nil || out == nil {
+		switch f.Policy.missingPunch {
+		case enum.MissingPunchAbsent:
+			d.status = enum.AttendanceAbsent
+			return d, nil
+		case enum.MissingPunchUseSchedule:
+			d.imputed = true
+			if in == nil {
+				in = &start
+			}
+			if out == nil {
+				out = &end
+			}
+		}
+	}
+
+	if in != nil && in.After(start.Add(f.Policy.lateTolerance)) {
+		d.lateBy = in.Sub(start)
+	}
+	if out != nil && out.Before(end.Add(-f.Policy.earlyLeaveTolerance)) {
+		d.earlyLeaveBy = end.Sub(*out)
+	}
+	if in != nil && out != nil {
+		d.worked = out.Sub(*in)
+	}
+
+	switch {
+	case in == nil || out == nil:
+		d.status = enum.AttendanceMissingPunch
+	case d.IsLate():
+		d.status = enum.AttendanceLate
+	case d.IsEarlyLeave():
+		d.status = enum.AttendanceEarlyLeave
+	default:
+		d.status = enum.AttendancePresent
+	}
+	return d, nil
+}
@@ -0,0 +1,207 @@
+package attendance_entity_test
+
+import (
+	"github.com/google/uuid"
+	attendance_entity "github.com/rfanazhari/hris/domain/entity/attendance"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func newClockEvent(t *testing.T, employeeID, eventType string, at time.Time) attendance_entity.ClockEvent {
+	event, err := attendance_entity.ClockEventFactory{
+		ID:         uuid.NewString(),
+		EmployeeID: employeeID,
+		Type:       eventType,
+		Source:     "fingerprint",
+		OccurredAt: at,
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return *event
+}
+
+func TestDailyAttendanceFactory_Create(t *testing.T) {
+	employeeID := uuid.NewString()
+	wib := enum.TimeZoneWIB.Location()
+	day := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	schedule, _ := attendance_entity.NewWorkSchedule("08:00", "17:00", enum.TimeZoneWIB)
+	at := func(hour, minute int) time.Time { return time.Date(2025, 3, 3, hour, minute, 0, 0, wib) }
+	policy := func(missing enum.MissingPunchPolicy) attendance_entity.AttendancePolicy {
+		p, _ := attendance_entity.NewAttendancePolicy(10*time.Minute, 5*time.Minute, 4*time.Hour, missing)
+		return *p
+	}
+
+	t.Run("Present", func(t *testing.T) {
+		record, err := attendance_entity.DailyAttendanceFactory{
+			EmployeeID: employeeID,
+			Date:       day,
+			Schedule:   *schedule,
+			Policy:     policy(enum.MissingPunchFlag),
+			Events: []attendance_entity.ClockEvent{
+				newClockEvent(t, employeeID, "out", at(17, 2)),
+				newClockEvent(t, employeeID, "in", at(8, 9)),
+				newClockEvent(t, employeeID, "in", at(12, 30)),
+			},
+		}.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, enum.AttendancePresent, record.Status())
+		assert.Equal(t, at(8, 9), *record.ClockIn())
+		assert.Equal(t, at(17, 2), *record.ClockOut())
+		assert.Equal(t, 8*time.Hour+53*time.Minute, record.Worked())
+		assert.False(t, record.IsLate())
+		assert.Equal(t, time.Date(2025, 3, 3, 0, 0, 0, 0, wib), record.Date())
+	})
+	t.Run("LateAndEarlyLeave", func(t *testing.T) {
+		record, _ := attendance_entity.DailyAttendanceFactory{
+			EmployeeID: employeeID,
+			Date:       day,
+			Schedule:   *schedule,
+			Policy:     policy(enum.MissingPunchFlag),
+			Events: []attendance_entity.ClockEvent{
+				newClockEvent(t, employeeID, "in", at(8, 30)),
+				newClockEvent(t, employeeID, "out", at(16, 0)),
+			},
+		}.Create()
+
+		assert.Equal(t, enum.AttendanceLate, record.Status())
+		assert.Equal(t, 30*time.Minute, record.LateBy())
+		assert.True(t, record.IsEarlyLeave())
+		assert.Equal(t, time.Hour, record.EarlyLeaveBy())
+	})
+	t.Run("EarlyLeave", func(t *testing.T) {
+		record, _ := attendance_entity.DailyAttendanceFactory{
+			EmployeeID: employeeID,
+			Date:       day,
+			Schedule:   *schedule,
+			Policy:     policy(enum.MissingPunchFlag),
+			Events: []attendance_entity.ClockEvent{
+				newClockEvent(t, employeeID, "in", at(7, 55)),
+				newClockEvent(t, employeeID, "out", at(16, 50)),
+			},
+		}.Create()
+
+		assert.Equal(t, enum.AttendanceEarlyLeave, record.Status())
+		assert.Equal(t, 10*time.Minute, record.EarlyLeaveBy())
+	})
+	t.Run("TimezoneAwarePunchesFromUTC", func(t *testing.T) {
+		// 01:05 UTC is 08:05 WIB, 10:00 UTC is 17:00 WIB
+		record, _ := attendance_entity.DailyAttendanceFactory{
+			EmployeeID: employeeID,
+			Date:       day,
+			Schedule:   *schedule,
+			Policy:     policy(enum.MissingPunchFlag),
+			Events: []attendance_entity.ClockEvent{
+				newClockEvent(t, employeeID, "in", time.Date(2025, 3, 3, 1, 5, 0, 0, time.UTC)),
+				newClockEvent(t, employeeID, "out", time.Date(2025, 3, 3, 10, 0, 0, 0, time.UTC)),
+			},
+		}.Create()
+
+		assert.Equal(t, enum.AttendancePresent, record.Status())
+		assert.Equal(t, wib.String(), record.ClockIn().Location().String())
+	})
+	t.Run("Absent", func(t *testing.T) {
+		record, _ := attendance_entity.DailyAttendanceFactory{
+			EmployeeID: employeeID,
+			Date:       day,
+			Schedule:   *schedule,
+			Policy:     policy(enum.MissingPunchFlag),
+			Events: []attendance_entity.ClockEvent{
+				newClockEvent(t, uuid.NewString(), "in", at(8, 0)),
+				newClockEvent(t, employeeID, "in", at(8, 0).AddDate(0, 0, -1)),
+			},
+		}.Create()
+
+		assert.Equal(t, enum.AttendanceAbsent, record.Status())
+		assert.Nil(t, record.ClockIn())
+	})
+	t.Run("MissingClockOutFlag", func(t *testing.T) {
+		record, _ := attendance_entity.DailyAttendanceFactory{
+			EmployeeID: employeeID,
+			Date:       day,
+			Schedule:   *schedule,
+			Policy:     policy(enum.MissingPunchFlag),
+			Events:     []attendance_entity.ClockEvent{newClockEvent(t, employeeID, "in", at(8, 20))},
+		}.Create()
+
+		assert.Equal(t, enum.AttendanceMissingPunch, record.Status())
+		assert.True(t, record.IsLate())
+		assert.Nil(t, record.ClockOut())
+		assert.Equal(t, time.Duration(0), record.Worked())
+	})
+	t.Run("StrayClockOutBeforeClockIn", func(t *testing.T) {
+		record, _ := attendance_entity.DailyAttendanceFactory{
+			EmployeeID: employeeID,
+			Date:       day,
+			Schedule:   *schedule,
+			Policy:     policy(enum.MissingPunchFlag),
+			Events: []attendance_entity.ClockEvent{
+				newClockEvent(t, employeeID, "out", at(7, 30)),
+				newClockEvent(t, employeeID, "in", at(7, 55)),
+			},
+		}.Create()
+
+		assert.Equal(t, enum.AttendanceMissingPunch, record.Status())
+		assert.Equal(t, at(7, 55), *record.ClockIn())
+		assert.Nil(t, record.ClockOut())
+		assert.Equal(t, time.Duration(0), record.Worked())
+		assert.False(t, record.IsEarlyLeave())
+	})
+	t.Run("MissingClockOutUseSchedule", func(t *testing.T) {
+		record, _ := attendance_entity.DailyAttendanceFactory{
+			EmployeeID: employeeID,
+			Date:       day,
+			Schedule:   *schedule,
+			Policy:     policy(enum.MissingPunchUseSchedule),
+			Events:     []attendance_entity.ClockEvent{newClockEvent(t, employeeID, "in", at(8, 0))},
+		}.Create()
+
+		assert.Equal(t, enum.AttendancePresent, record.Status())
+		assert.True(t, record.Imputed())
+		assert.Equal(t, 9*time.Hour, record.Worked())
+	})
+	t.Run("MissingClockInAbsent", func(t *testing.T) {
+		record, _ := attendance_entity.DailyAttendanceFactory{
+			EmployeeID: employeeID,
+			Date:       day,
+			Schedule:   *schedule,
+			Policy:     policy(enum.MissingPunchAbsent),
+			Events:     []attendance_entity.ClockEvent{newClockEvent(t, employeeID, "out", at(17, 0))},
+		}.Create()
+
+		assert.Equal(t, enum.AttendanceAbsent, record.Status())
+	})
+	t.Run("NightScheduleAcrossMidnight", func(t *testing.T) {
+		night, _ := attendance_entity.NewWorkSchedule("22:00", "06:00", enum.TimeZoneWIT)
+		wit := enum.TimeZoneWIT.Location()
+		record, _ := attendance_entity.DailyAttendanceFactory{
+			EmployeeID: employeeID,
+			Date:       day,
+			Schedule:   *night,
+			Policy:     policy(enum.MissingPunchFlag),
+			Events: []attendance_entity.ClockEvent{
+				newClockEvent(t, employeeID, "in", time.Date(2025, 3, 3, 21, 58, 0, 0, wit)),
+				newClockEvent(t, employeeID, "out", time.Date(2025, 3, 4, 6, 1, 0, 0, wit)),
+			},
+		}.Create()
+
+		assert.Equal(t, enum.AttendancePresent, record.Status())
+		assert.Equal(t, 8*time.Hour+3*time.Minute, record.Worked())
+	})
+	t.Run("InvalidInput", func(t *testing.T) {
+		_, err := attendance_entity.DailyAttendanceFactory{EmployeeID: "x"}.Create()
+		assert.EqualError(t, err, "invalid employee id")
+
+		_, err = attendance_entity.DailyAttendanceFactory{EmployeeID: employeeID}.Create()
+		assert.EqualError(t, err, "date cannot be empty")
+
+		_, err = attendance_entity.DailyAttendanceFactory{EmployeeID: employeeID, Date: day}.Create()
+		assert.EqualError(t, err, "invalid work schedule")
+
+		_, err = attendance_entity.DailyAttendanceFactory{EmployeeID: employeeID, Date: day, Schedule: *schedule}.Create()
+		assert.EqualError(t, err, "invalid attendance policy")
+	})
+}
@@ -0,0 +1,184 @@
+package attendance_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// RosterEntry assigns one employee to one shift on one calendar date.
+type RosterEntry struct {
+	employeeID uuid.UUID
+	date       time.Time
+	shiftID    uuid.UUID
+	start      time.Time
+	end        time.Time
+}
+
+// EmployeeID returns the assigned employee.
+func (r RosterEntry) EmployeeID() uuid.UUID { return r.employeeID }
+
+// Date returns the calendar date the shift starts on.
+func (r RosterEntry) Date() time.Time { return r.date }
+
+// ShiftID returns the assigned shift.
+func (r RosterEntry) ShiftID() uuid.UUID { return r.shiftID }
+
+// Start returns the start instant of the assigned shift.
+func (r RosterEntry) Start() time.Time { return r.start }
+
+// End returns the end instant of the assigned shift.
+func (r RosterEntry) End() time.Time { return r.end }
+
+// RosterConflict records a slot of the pattern that could not be assigned.
+type RosterConflict struct {
+	employeeID uuid.UUID
+	date       time.Time
+	shiftID    uuid.UUID
+	reason     enum.RosterConflictReason
+}
+
+// EmployeeID returns the employee of the conflicting slot.
+func (r RosterConflict) EmployeeID() uuid.UUID { return r.employeeID }
+
+// Date returns the calendar date of the conflicting slot.
+func (r RosterConflict) Date() time.Time { return r.date }
+
+// ShiftID returns the shift that could not be assigned.
+func (r RosterConflict) ShiftID() uuid.UUID { return r.shiftID }
+
+// Reason returns why the slot could not be assigned.
+func (r RosterConflict) Reason() enum.RosterConflictReason { return r.reason }
+
+// Roster is the shift plan of an organization unit over a period of calendar dates.
+type Roster struct {
+	id                 uuid.UUID
+	organizationUnitID uuid.UUID
+	periodStart        time.Time
+	periodEnd          time.Time
+	minRest            time.Duration
+	entries            []RosterEntry
+	conflicts          []RosterConflict
+	createdAt          time.Time
+}
+
+// ID returns the unique identifier of the roster.
+func (r *Roster) ID() uuid.UUID {
+	return r.id
+}
+
+// OrganizationUnitID returns the organization unit the roster is planned for.
+func (r *Roster) OrganizationUnitID() uuid.UUID {
+	return r.organizationUnitID
+}
+
+// PeriodStart returns the first calendar date of the roster.
+func (r *Roster) PeriodStart() time.Time {
+	return r.periodStart
+}
+
+// PeriodEnd returns the last calendar date of the roster.
+func (r *Roster) PeriodEnd() time.Time {
+	return r.periodEnd
+}
+
+// MinRest returns the minimum rest required between two shifts of the same employee.
+func (r *Roster) MinRest() time.Duration {
+	return r.minRest
+}
+
+// Entries returns a copy of all assignments.
+func (r *Roster) Entries() []RosterEntry {
+	out := make([]RosterEntry, len(r.entries))
+	copy(out, r.entries)
+	return out
+}
+
+// EntriesFor returns the assignments of one employee in insertion order.
+func (r *Roster) EntriesFor(employeeID uuid.UUID) []RosterEntry {
+	var out []RosterEntry
+	for _, e := range r.entries {
+		if e.employeeID == employeeID {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// Conflicts returns a copy of the recorded conflicts.
+func (r *Roster) Conflicts() []RosterConflict {
+	out := make([]RosterConflict, len(r.conflicts))
+	copy(out, r.conflicts)
+	return out
+}
+
+// CreatedAt returns the timestamp when the roster was created.
+func (r *Roster) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// Covers reports whether the calendar date of date lies within the roster period.
+func (r *Roster) Covers(date time.Time) bool {
+	d := daysBetween(r.periodStart, date)
+	return d >= 0 && d <= daysBetween(r.periodStart, r.periodEnd)
+}
+
+// HasSufficientRest reports whether a shift from start to end keeps at least the minimum
+// rest period to every other shift already assigned to the employee.
+func (r *Roster) HasSufficientRest(employeeID uuid.UUID, start, end time.Time) bool {
+	for _, e := range r.entries {
+		if e.employeeID != employeeID {
+			continue
+		}
+		if !e.end.Add(r.minRest).After(start) || !end.Add(r.minRest).After(e.start) {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// Assign puts the employee on the shift for the calendar date of date.
+func (r *Roster) Assign(employeeID uuid.UUID, date time.Time, shift Shift) error {
+	if employeeID == uuid.Nil {
+		return errors.New("invalid employee id")
+	}
+	if shift.id == uuid.Nil {
+		return errors.New("invalid shift")
+	}
+	if !r.Covers(date) {
+		return errors.New("date is outside the roster period")
+	}
+	day := calendarDate(date)
+	for _, e := range r.entries {
+		if e.employeeID == employeeID && e.date.Equal(day) {
+			return fmt.Errorf("employee is already assigned on %s", day.Format("2006-01-02"))
+		}
+	}
+	start, end := shift.Window(day)
+	if !r.HasSufficientRest(employeeID, start, end) {
+		return errors.New("insufficient rest between shifts")
+	}
+
+	r.entries = append(r.entries, RosterEntry{employeeID: employeeID, date: day, shiftID: shift.id, start: start, end: end})
+	return nil
+}
+
+// AddConflict records that the employee could not be assigned to the shift on the calendar date of date.
+func (r *Roster) AddConflict(employeeID uuid.UUID, date time.Time, shiftID uuid.UUID, reason enum.RosterConflictReason) error {
+	if !reason.Valid() {
+		return fmt.Errorf("invalid RosterConflictReason: %q", reason)
+	}
+	if !r.Covers(date) {
+		return errors.New("date is outside the roster period")
+	}
+	r.conflicts = append(r.conflicts, RosterConflict{employeeID: employeeID, date: calendarDate(date), shiftID: shiftID, reason: reason})
+	return nil
+}
+
+// calendarDate returns the calendar date of t as midnight UTC.
+func calendarDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,54 @@
+package attendance_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+// RosterFactory is a factory type for creating empty Roster instances.
+type RosterFactory struct {
+	ID                 string
+	OrganizationUnitID string
+	PeriodStart        time.Time
+	PeriodEnd          time.Time
+	MinRest            time.Duration
+	CreatedAt          time.Time
+}
+
+// Create validates the factory data and returns a new Roster without assignments.
+func (f RosterFactory) Create() (*Roster, error) {
+	newUUID, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	unitID, err := uuid.Parse(f.OrganizationUnitID)
+	if err != nil {
+		return nil, errors.New("invalid organization unit id")
+	}
+
+	if f.PeriodStart.IsZero() || f.PeriodEnd.IsZero() {
+		return nil, errors.New("period cannot be empty")
+	}
+	if daysBetween(f.PeriodStart, f.PeriodEnd) < 0 {
+		return nil, errors.New("period end cannot be before period start")
+	}
+
+	if f.MinRest < 0 {
+		return nil, errors.New("minimum rest cannot be negative")
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	return &Roster{
+		id:                 newUUID,
+		organizationUnitID: unitID,
+		periodStart:        calendarDate(f.PeriodStart),
+		periodEnd:          calendarDate(f.PeriodEnd),
+		minRest:            f.MinRest,
+		createdAt:          f.CreatedAt,
+	}, nil
+}
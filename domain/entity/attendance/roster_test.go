@@ -0,0 +1,96 @@
+package attendance_entity_test
+
+import (
+	"github.com/google/uuid"
+	attendance_entity "github.com/rfanazhari/hris/domain/entity/attendance"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func newShift(t *testing.T, code, start, end string) *attendance_entity.Shift {
+	shift, err := attendance_entity.ShiftFactory{ID: uuid.NewString(), Code: code, Name: code, Start: start, End: end, TimeZone: "wib"}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return shift
+}
+
+func TestRosterFactory_Create(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	roster, err := attendance_entity.RosterFactory{
+		ID:                 uuid.NewString(),
+		OrganizationUnitID: uuid.NewString(),
+		PeriodStart:        start,
+		PeriodEnd:          start.AddDate(0, 0, 6),
+		MinRest:            11 * time.Hour,
+	}.Create()
+	assert.Nil(t, err)
+	assert.Equal(t, 11*time.Hour, roster.MinRest())
+	assert.True(t, roster.Covers(start.AddDate(0, 0, 6)))
+	assert.False(t, roster.Covers(start.AddDate(0, 0, 7)))
+	assert.False(t, roster.CreatedAt().IsZero())
+
+	_, err = attendance_entity.RosterFactory{ID: "x"}.Create()
+	assert.EqualError(t, err, "invalid format uuid")
+
+	_, err = attendance_entity.RosterFactory{ID: uuid.NewString(), OrganizationUnitID: "x"}.Create()
+	assert.EqualError(t, err, "invalid organization unit id")
+
+	_, err = attendance_entity.RosterFactory{ID: uuid.NewString(), OrganizationUnitID: uuid.NewString()}.Create()
+	assert.EqualError(t, err, "period cannot be empty")
+
+	_, err = attendance_entity.RosterFactory{ID: uuid.NewString(), OrganizationUnitID: uuid.NewString(), PeriodStart: start, PeriodEnd: start.AddDate(0, 0, -1)}.Create()
+	assert.EqualError(t, err, "period end cannot be before period start")
+
+	_, err = attendance_entity.RosterFactory{ID: uuid.NewString(), OrganizationUnitID: uuid.NewString(), PeriodStart: start, PeriodEnd: start, MinRest: -time.Hour}.Create()
+	assert.EqualError(t, err, "minimum rest cannot be negative")
+}
+
+func TestRoster_Assign(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	employeeID := uuid.New()
+	night := newShift(t, "M", "23:00", "07:00")
+	morning := newShift(t, "P", "07:00", "15:00")
+	newRoster := func() *attendance_entity.Roster {
+		r, _ := attendance_entity.RosterFactory{
+			ID: uuid.NewString(), OrganizationUnitID: uuid.NewString(),
+			PeriodStart: start, PeriodEnd: start.AddDate(0, 0, 6), MinRest: 11 * time.Hour,
+		}.Create()
+		return r
+	}
+
+	t.Run("AssignsAndEnforcesRest", func(t *testing.T) {
+		roster := newRoster()
+
+		assert.Nil(t, roster.Assign(employeeID, start, *night))
+		assert.EqualError(t, roster.Assign(employeeID, start, *morning), "employee is already assigned on 2025-03-01")
+		// night ends 07:00 on 2 March, morning on 2 March starts right away
+		assert.EqualError(t, roster.Assign(employeeID, start.AddDate(0, 0, 1), *morning), "insufficient rest between shifts")
+		assert.Nil(t, roster.Assign(employeeID, start.AddDate(0, 0, 2), *morning))
+		assert.Nil(t, roster.Assign(uuid.New(), start.AddDate(0, 0, 1), *morning))
+
+		assert.Len(t, roster.Entries(), 3)
+		entries := roster.EntriesFor(employeeID)
+		assert.Len(t, entries, 2)
+		assert.Equal(t, night.ID(), entries[0].ShiftID())
+		assert.Equal(t, 8*time.Hour, entries[0].End().Sub(entries[0].Start()))
+	})
+	t.Run("Errors", func(t *testing.T) {
+		roster := newRoster()
+
+		assert.EqualError(t, roster.Assign(uuid.Nil, start, *morning), "invalid employee id")
+		assert.EqualError(t, roster.Assign(employeeID, start, attendance_entity.Shift{}), "invalid shift")
+		assert.EqualError(t, roster.Assign(employeeID, start.AddDate(0, 0, 7), *morning), "date is outside the roster period")
+	})
+	t.Run("AddConflict", func(t *testing.T) {
+		roster := newRoster()
+
+		assert.Nil(t, roster.AddConflict(employeeID, start, morning.ID(), enum.RosterConflictOnLeave))
+		assert.EqualError(t, roster.AddConflict(employeeID, start, morning.ID(), "sick"), `invalid RosterConflictReason: "sick"`)
+		assert.Len(t, roster.Conflicts(), 1)
+		assert.Equal(t, enum.RosterConflictOnLeave, roster.Conflicts()[0].Reason())
+	})
+}
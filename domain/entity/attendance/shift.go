@@ -0,0 +1,83 @@
+package attendance_entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// Shift defines a named working period such as "Pagi 07:00-15:00" or "Malam 23:00-07:00".
+// A shift whose end is not after its start crosses midnight.
+type Shift struct {
+	id       uuid.UUID
+	code     string
+	name     string
+	schedule WorkSchedule
+	breaks   []ShiftBreak
+}
+
+// ID returns the unique identifier of the shift.
+func (s *Shift) ID() uuid.UUID {
+	return s.id
+}
+
+// Code returns the short code of the shift, e.g. "P" or "M".
+func (s *Shift) Code() string {
+	return s.code
+}
+
+// Name returns the display name of the shift.
+func (s *Shift) Name() string {
+	return s.name
+}
+
+// Schedule returns the shift hours as a WorkSchedule, usable for daily attendance.
+func (s *Shift) Schedule() WorkSchedule {
+	return s.schedule
+}
+
+// TimeZone returns the office time zone of the shift.
+func (s *Shift) TimeZone() enum.OfficeTimeZone {
+	return s.schedule.timeZone
+}
+
+// Breaks returns a copy of the breaks of the shift.
+func (s *Shift) Breaks() []ShiftBreak {
+	out := make([]ShiftBreak, len(s.breaks))
+	copy(out, s.breaks)
+	return out
+}
+
+// CrossesMidnight reports whether the shift ends on the day after it starts.
+func (s *Shift) CrossesMidnight() bool {
+	return s.schedule.CrossesMidnight()
+}
+
+// Length returns the time between the start and the end of the shift.
+func (s *Shift) Length() time.Duration {
+	start, end := s.schedule.Window(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	return end.Sub(start)
+}
+
+// WorkingDuration returns the shift length minus its breaks.
+func (s *Shift) WorkingDuration() time.Duration {
+	d := s.Length()
+	for _, b := range s.breaks {
+		d -= b.duration
+	}
+	return d
+}
+
+// Window returns the start and end instants of the shift on the calendar date of date.
+func (s *Shift) Window(date time.Time) (time.Time, time.Time) {
+	return s.schedule.Window(date)
+}
+
+// offsetInShift returns how long after the shift start the given time of day occurs.
+func (s *Shift) offsetInShift(timeOfDay time.Duration) time.Duration {
+	offset := timeOfDay - s.schedule.start
+	if offset < 0 {
+		offset += 24 * time.Hour
+	}
+	return offset
+}
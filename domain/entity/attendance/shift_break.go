@@ -0,0 +1,31 @@
+package attendance_entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ShiftBreak is an unpaid break within a shift, starting at a time of day and lasting a duration.
+type ShiftBreak struct {
+	start    time.Duration
+	duration time.Duration
+}
+
+// NewShiftBreak constructs a ShiftBreak from an "HH:MM" start time and a positive duration.
+func NewShiftBreak(start string, duration time.Duration) (*ShiftBreak, error) {
+	s, err := ParseClockTime(start)
+	if err != nil {
+		return nil, fmt.Errorf("invalid break start: %w", err)
+	}
+	if duration <= 0 {
+		return nil, errors.New("break duration must be positive")
+	}
+	return &ShiftBreak{start: s, duration: duration}, nil
+}
+
+// Start returns the break start as offset since midnight.
+func (b ShiftBreak) Start() time.Duration { return b.start }
+
+// Duration returns the length of the break.
+func (b ShiftBreak) Duration() time.Duration { return b.duration }
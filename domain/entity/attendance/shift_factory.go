@@ -0,0 +1,75 @@
+package attendance_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ShiftFactory is a factory type for creating Shift instances with validated properties.
+type ShiftFactory struct {
+	ID       string
+	Code     string
+	Name     string
+	Start    string
+	End      string
+	TimeZone string
+	Breaks   []ShiftBreak
+}
+
+// Create validates the factory data and returns a new Shift.
+// Breaks must lie completely within the shift and must not overlap each other.
+func (f ShiftFactory) Create() (*Shift, error) {
+	newUUID, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(f.Code))
+	if code == "" {
+		return nil, errors.New("code cannot be empty")
+	}
+
+	name := strings.TrimSpace(f.Name)
+	if name == "" {
+		return nil, errors.New("name cannot be empty")
+	}
+
+	timeZone, err := enum.ParseOfficeTimeZone(f.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := NewWorkSchedule(f.Start, f.End, timeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	shift := &Shift{id: newUUID, code: code, name: name, schedule: *schedule}
+
+	breaks := make([]ShiftBreak, len(f.Breaks))
+	copy(breaks, f.Breaks)
+	sort.Slice(breaks, func(i, j int) bool {
+		return shift.offsetInShift(breaks[i].start) < shift.offsetInShift(breaks[j].start)
+	})
+	var previousEnd time.Duration
+	for _, b := range breaks {
+		if b.duration <= 0 {
+			return nil, errors.New("break duration must be positive")
+		}
+		offset := shift.offsetInShift(b.start)
+		if offset+b.duration > shift.Length() {
+			return nil, errors.New("break must be within the shift")
+		}
+		if offset < previousEnd {
+			return nil, errors.New("breaks cannot overlap")
+		}
+		previousEnd = offset + b.duration
+	}
+	shift.breaks = breaks
+
+	return shift, nil
+}
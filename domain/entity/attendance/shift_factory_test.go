@@ -0,0 +1,105 @@
+package attendance_entity_test
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	attendance_entity "github.com/rfanazhari/hris/domain/entity/attendance"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func newShiftBreak(t *testing.T, start string, duration time.Duration) attendance_entity.ShiftBreak {
+	b, err := attendance_entity.NewShiftBreak(start, duration)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return *b
+}
+
+func TestShiftFactory_Create(t *testing.T) {
+	t.Run("MorningShift", func(t *testing.T) {
+		factory := attendance_entity.ShiftFactory{
+			ID:       uuid.NewString(),
+			Code:     " p ",
+			Name:     "Pagi",
+			Start:    "07:00",
+			End:      "15:00",
+			TimeZone: "wib",
+			Breaks:   []attendance_entity.ShiftBreak{newShiftBreak(t, "12:00", 30*time.Minute)},
+		}
+
+		shift, err := factory.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "P", shift.Code())
+		assert.Equal(t, "Pagi", shift.Name())
+		assert.Equal(t, enum.TimeZoneWIB, shift.TimeZone())
+		assert.False(t, shift.CrossesMidnight())
+		assert.Equal(t, 8*time.Hour, shift.Length())
+		assert.Equal(t, 7*time.Hour+30*time.Minute, shift.WorkingDuration())
+		assert.Len(t, shift.Breaks(), 1)
+	})
+	t.Run("NightShiftWithBreakAfterMidnight", func(t *testing.T) {
+		factory := attendance_entity.ShiftFactory{
+			ID:       uuid.NewString(),
+			Code:     "M",
+			Name:     "Malam",
+			Start:    "23:00",
+			End:      "07:00",
+			TimeZone: "wita",
+			Breaks: []attendance_entity.ShiftBreak{
+				newShiftBreak(t, "03:00", time.Hour),
+				newShiftBreak(t, "23:30", 15*time.Minute),
+			},
+		}
+
+		shift, err := factory.Create()
+
+		assert.Nil(t, err)
+		assert.True(t, shift.CrossesMidnight())
+		assert.Equal(t, 8*time.Hour, shift.Length())
+		assert.Equal(t, 6*time.Hour+45*time.Minute, shift.WorkingDuration())
+		assert.Equal(t, 23*time.Hour+30*time.Minute, shift.Breaks()[0].Start())
+		start, end := shift.Window(time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC))
+		assert.Equal(t, time.Date(2025, 3, 31, 23, 0, 0, 0, enum.TimeZoneWITA.Location()), start)
+		assert.Equal(t, time.Date(2025, 4, 1, 7, 0, 0, 0, enum.TimeZoneWITA.Location()), end)
+	})
+	t.Run("InvalidID", func(t *testing.T) {
+		_, err := attendance_entity.ShiftFactory{ID: "x"}.Create()
+		assert.EqualError(t, err, "invalid format uuid")
+	})
+	t.Run("EmptyCodeAndName", func(t *testing.T) {
+		_, err := attendance_entity.ShiftFactory{ID: uuid.NewString(), Name: "Pagi"}.Create()
+		assert.EqualError(t, err, "code cannot be empty")
+
+		_, err = attendance_entity.ShiftFactory{ID: uuid.NewString(), Code: "P"}.Create()
+		assert.EqualError(t, err, "name cannot be empty")
+	})
+	t.Run("InvalidTimeZone", func(t *testing.T) {
+		_, err := attendance_entity.ShiftFactory{ID: uuid.NewString(), Code: "P", Name: "Pagi", Start: "07:00", End: "15:00", TimeZone: "gmt"}.Create()
+		assert.EqualError(t, err, fmt.Errorf("invalid OfficeTimeZone: %q", "gmt").Error())
+	})
+	t.Run("BreakOutsideShift", func(t *testing.T) {
+		_, err := attendance_entity.ShiftFactory{
+			ID: uuid.NewString(), Code: "P", Name: "Pagi", Start: "07:00", End: "15:00", TimeZone: "wib",
+			Breaks: []attendance_entity.ShiftBreak{newShiftBreak(t, "14:45", 30*time.Minute)},
+		}.Create()
+		assert.EqualError(t, err, "break must be within the shift")
+	})
+	t.Run("OverlappingBreaks", func(t *testing.T) {
+		_, err := attendance_entity.ShiftFactory{
+			ID: uuid.NewString(), Code: "P", Name: "Pagi", Start: "07:00", End: "15:00", TimeZone: "wib",
+			Breaks: []attendance_entity.ShiftBreak{newShiftBreak(t, "12:00", time.Hour), newShiftBreak(t, "12:30", 15*time.Minute)},
+		}.Create()
+		assert.EqualError(t, err, "breaks cannot overlap")
+	})
+	t.Run("InvalidBreak", func(t *testing.T) {
+		_, err := attendance_entity.NewShiftBreak("12:00", 0)
+		assert.EqualError(t, err, "break duration must be positive")
+
+		_, err = attendance_entity.NewShiftBreak("noon", time.Hour)
+		assert.EqualError(t, err, "invalid break start: time must be in HH:MM format")
+	})
+}
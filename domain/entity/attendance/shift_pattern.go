@@ -0,0 +1,54 @@
+package attendance_entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// ShiftPattern is a rotating sequence of shifts and days off, e.g. 2 morning, 2 night, 2 off.
+// Each slot holds a shift ID, uuid.Nil marks a day off.
+type ShiftPattern struct {
+	id       uuid.UUID
+	name     string
+	sequence []uuid.UUID
+}
+
+// ID returns the unique identifier of the pattern.
+func (p *ShiftPattern) ID() uuid.UUID {
+	return p.id
+}
+
+// Name returns the display name of the pattern.
+func (p *ShiftPattern) Name() string {
+	return p.name
+}
+
+// Sequence returns a copy of the slots of the pattern.
+func (p *ShiftPattern) Sequence() []uuid.UUID {
+	out := make([]uuid.UUID, len(p.sequence))
+	copy(out, p.sequence)
+	return out
+}
+
+// Length returns the number of days in one rotation.
+func (p *ShiftPattern) Length() int {
+	return len(p.sequence)
+}
+
+// ShiftOn returns the shift ID scheduled on date for a rotation that started on anchor
+// shifted by offset days. The second value is false on a day off.
+func (p *ShiftPattern) ShiftOn(anchor time.Time, offset int, date time.Time) (uuid.UUID, bool) {
+	index := (daysBetween(anchor, date) + offset) % len(p.sequence)
+	if index < 0 {
+		index += len(p.sequence)
+	}
+	id := p.sequence[index]
+	return id, id != uuid.Nil
+}
+
+// daysBetween returns the number of calendar days from a to b, ignoring time of day and location.
+func daysBetween(a, b time.Time) int {
+	da := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	db := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(db.Sub(da).Hours() / 24)
+}
@@ -0,0 +1,51 @@
+package attendance_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"strings"
+)
+
+// ShiftPatternFactory is a factory type for creating ShiftPattern instances.
+// Sequence holds one entry per day: a shift ID, or an empty string for a day off.
+type ShiftPatternFactory struct {
+	ID       string
+	Name     string
+	Sequence []string
+}
+
+// Create validates the factory data and returns a new ShiftPattern.
+func (f ShiftPatternFactory) Create() (*ShiftPattern, error) {
+	newUUID, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	name := strings.TrimSpace(f.Name)
+	if name == "" {
+		return nil, errors.New("name cannot be empty")
+	}
+
+	if len(f.Sequence) == 0 {
+		return nil, errors.New("sequence cannot be empty")
+	}
+
+	sequence := make([]uuid.UUID, len(f.Sequence))
+	working := 0
+	for i, s := range f.Sequence {
+		if strings.TrimSpace(s) == "" {
+			continue
+		}
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return nil, errors.New("invalid shift id in sequence")
+		}
+		sequence[i] = id
+		working++
+	}
+	if working == 0 {
+		return nil, errors.New("sequence must contain at least one shift")
+	}
+
+	return &ShiftPattern{id: newUUID, name: name, sequence: sequence}, nil
+}
@@ -0,0 +1,57 @@
+package attendance_entity_test
+
+import (
+	"github.com/google/uuid"
+	attendance_entity "github.com/rfanazhari/hris/domain/entity/attendance"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestShiftPatternFactory_Create(t *testing.T) {
+	morning, night := uuid.NewString(), uuid.NewString()
+
+	t.Run("TwoMorningTwoNightTwoOff", func(t *testing.T) {
+		pattern, err := attendance_entity.ShiftPatternFactory{
+			ID:       uuid.NewString(),
+			Name:     "2-2-2",
+			Sequence: []string{morning, morning, night, night, "", ""},
+		}.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 6, pattern.Length())
+
+		anchor := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+		id, working := pattern.ShiftOn(anchor, 0, anchor.AddDate(0, 0, 2))
+		assert.True(t, working)
+		assert.Equal(t, night, id.String())
+
+		_, working = pattern.ShiftOn(anchor, 0, anchor.AddDate(0, 0, 5))
+		assert.False(t, working)
+
+		id, _ = pattern.ShiftOn(anchor, 0, anchor.AddDate(0, 0, 6))
+		assert.Equal(t, morning, id.String())
+
+		id, _ = pattern.ShiftOn(anchor, 2, anchor)
+		assert.Equal(t, night, id.String())
+
+		id, _ = pattern.ShiftOn(anchor, 0, anchor.AddDate(0, 0, -4))
+		assert.Equal(t, night, id.String())
+	})
+	t.Run("InvalidInput", func(t *testing.T) {
+		_, err := attendance_entity.ShiftPatternFactory{ID: "x"}.Create()
+		assert.EqualError(t, err, "invalid format uuid")
+
+		_, err = attendance_entity.ShiftPatternFactory{ID: uuid.NewString()}.Create()
+		assert.EqualError(t, err, "name cannot be empty")
+
+		_, err = attendance_entity.ShiftPatternFactory{ID: uuid.NewString(), Name: "x"}.Create()
+		assert.EqualError(t, err, "sequence cannot be empty")
+
+		_, err = attendance_entity.ShiftPatternFactory{ID: uuid.NewString(), Name: "x", Sequence: []string{"morning"}}.Create()
+		assert.EqualError(t, err, "invalid shift id in sequence")
+
+		_, err = attendance_entity.ShiftPatternFactory{ID: uuid.NewString(), Name: "x", Sequence: []string{"", " "}}.Create()
+		assert.EqualError(t, err, "sequence must contain at least one shift")
+	})
+}
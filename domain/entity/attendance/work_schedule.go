@@ -0,0 +1,68 @@
+package attendance_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// WorkSchedule describes the expected working hours of a day in an office time zone.
+// When the end is not after the start, the schedule crosses midnight and ends on the next day.
+type WorkSchedule struct {
+	start    time.Duration
+	end      time.Duration
+	timeZone enum.OfficeTimeZone
+}
+
+// NewWorkSchedule constructs a WorkSchedule from "HH:MM" start and end times.
+func NewWorkSchedule(start, end string, timeZone enum.OfficeTimeZone) (*WorkSchedule, error) {
+	s, err := ParseClockTime(start)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start time: %w", err)
+	}
+	e, err := ParseClockTime(end)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end time: %w", err)
+	}
+	if s == e {
+		return nil, errors.New("start and end time cannot be equal")
+	}
+	if !timeZone.Valid() {
+		return nil, fmt.Errorf("invalid OfficeTimeZone: %q", timeZone)
+	}
+	return &WorkSchedule{start: s, end: e, timeZone: timeZone}, nil
+}
+
+// ParseClockTime parses an "HH:MM" time of day into the offset since midnight.
+func ParseClockTime(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, errors.New("time must be in HH:MM format")
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Start returns the start time as offset since midnight.
+func (w WorkSchedule) Start() time.Duration { return w.start }
+
+// End returns the end time as offset since midnight.
+func (w WorkSchedule) End() time.Duration { return w.end }
+
+// TimeZone returns the office time zone the schedule is expressed in.
+func (w WorkSchedule) TimeZone() enum.OfficeTimeZone { return w.timeZone }
+
+// CrossesMidnight reports whether the schedule ends on the day after it starts.
+func (w WorkSchedule) CrossesMidnight() bool { return w.end <= w.start }
+
+// Window returns the scheduled start and end instants for the calendar date of date
+// (its year, month and day are used as-is, regardless of its location).
+func (w WorkSchedule) Window(date time.Time) (time.Time, time.Time) {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, w.timeZone.Location())
+	start := day.Add(w.start)
+	end := day.Add(w.end)
+	if w.CrossesMidnight() {
+		end = end.AddDate(0, 0, 1)
+	}
+	return start, end
+}
@@ -0,0 +1,61 @@
+package attendance_entity_test
+
+import (
+	attendance_entity "github.com/rfanazhari/hris/domain/entity/attendance"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewWorkSchedule(t *testing.T) {
+	t.Run("DayScheduleInWITA", func(t *testing.T) {
+		schedule, err := attendance_entity.NewWorkSchedule("08:00", "17:00", enum.TimeZoneWITA)
+
+		assert.Nil(t, err)
+		assert.False(t, schedule.CrossesMidnight())
+		start, end := schedule.Window(time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC))
+		assert.Equal(t, time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), start.UTC())
+		assert.Equal(t, time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC), end.UTC())
+	})
+	t.Run("NightScheduleCrossesMidnight", func(t *testing.T) {
+		schedule, err := attendance_entity.NewWorkSchedule("22:00", "06:00", enum.TimeZoneWIB)
+
+		assert.Nil(t, err)
+		assert.True(t, schedule.CrossesMidnight())
+		start, end := schedule.Window(time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC))
+		assert.Equal(t, 8*time.Hour, end.Sub(start))
+		assert.Equal(t, 4, end.Day())
+	})
+	t.Run("InvalidTimes", func(t *testing.T) {
+		_, err := attendance_entity.NewWorkSchedule("8am", "17:00", enum.TimeZoneWIB)
+		assert.EqualError(t, err, "invalid start time: time must be in HH:MM format")
+
+		_, err = attendance_entity.NewWorkSchedule("08:00", "25:00", enum.TimeZoneWIB)
+		assert.EqualError(t, err, "invalid end time: time must be in HH:MM format")
+
+		_, err = attendance_entity.NewWorkSchedule("08:00", "08:00", enum.TimeZoneWIB)
+		assert.EqualError(t, err, "start and end time cannot be equal")
+	})
+	t.Run("InvalidTimeZone", func(t *testing.T) {
+		_, err := attendance_entity.NewWorkSchedule("08:00", "17:00", "utc")
+
+		assert.EqualError(t, err, `invalid OfficeTimeZone: "utc"`)
+	})
+}
+
+func TestNewAttendancePolicy(t *testing.T) {
+	policy, err := attendance_entity.NewAttendancePolicy(15*time.Minute, 0, 4*time.Hour, enum.MissingPunchFlag)
+	assert.Nil(t, err)
+	assert.Equal(t, 15*time.Minute, policy.LateTolerance())
+	assert.Equal(t, enum.MissingPunchFlag, policy.MissingPunch())
+
+	_, err = attendance_entity.NewAttendancePolicy(-time.Minute, 0, time.Hour, enum.MissingPunchFlag)
+	assert.EqualError(t, err, "tolerance cannot be negative")
+
+	_, err = attendance_entity.NewAttendancePolicy(0, 0, 0, enum.MissingPunchFlag)
+	assert.EqualError(t, err, "punch window must be positive")
+
+	_, err = attendance_entity.NewAttendancePolicy(0, 0, time.Hour, "ignore")
+	assert.EqualError(t, err, `invalid MissingPunchPolicy: "ignore"`)
+}
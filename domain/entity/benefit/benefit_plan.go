@@ -0,0 +1,78 @@
+package benefit_entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// BenefitPlan defines an allowance or insurance plan and who may be enrolled in it.
+// Allowances are paid as earnings; insurance premiums are shown as employer contributions
+// with the employee's share, if any, deducted.
+type BenefitPlan struct {
+	id                   uuid.UUID
+	code                 string
+	name                 string
+	benefitType          enum.BenefitType
+	basis                enum.BenefitAmountBasis
+	amount               int64
+	employeeContribution int64
+	taxable              bool
+	eligibility          EligibilityRule
+	createdAt            time.Time
+}
+
+// ID returns the identifier of the plan.
+func (p *BenefitPlan) ID() uuid.UUID {
+	return p.id
+}
+
+// Code returns the payslip line code of the plan, e.g. TRANSPORT.
+func (p *BenefitPlan) Code() string {
+	return p.code
+}
+
+// Name returns the name printed on payslips.
+func (p *BenefitPlan) Name() string {
+	return p.name
+}
+
+// Type returns whether the plan is an allowance or an insurance plan.
+func (p *BenefitPlan) Type() enum.BenefitType {
+	return p.benefitType
+}
+
+// Basis returns how the amount is determined.
+func (p *BenefitPlan) Basis() enum.BenefitAmountBasis {
+	return p.basis
+}
+
+// Amount returns the monthly amount, or the amount per attended day.
+func (p *BenefitPlan) Amount() int64 {
+	return p.amount
+}
+
+// EmployeeContribution returns the monthly premium share deducted from the employee.
+func (p *BenefitPlan) EmployeeContribution() int64 {
+	return p.employeeContribution
+}
+
+// Taxable reports whether the amount is part of the gross income for PPh 21.
+func (p *BenefitPlan) Taxable() bool {
+	return p.taxable
+}
+
+// Eligibility returns the rule restricting who may be enrolled.
+func (p *BenefitPlan) Eligibility() EligibilityRule {
+	return p.eligibility
+}
+
+// CreatedAt returns when the plan was created.
+func (p *BenefitPlan) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+// EmployeeContributionCode returns the payslip line code of the employee's premium share.
+func (p *BenefitPlan) EmployeeContributionCode() string {
+	return p.code + "_EE"
+}
@@ -0,0 +1,125 @@
+package benefit_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var planCode = regexp.MustCompile(`^[A-Z][A-Z0-9_]{1,19}$`)
+
+// BenefitPlanFactory creates BenefitPlans.
+//
+// Fields:
+//   - Code: payslip line code, letters, digits and underscores, e.g. "TRANSPORT"
+//   - Basis: "fixed" monthly or "attendance_day"; attendance based amounts apply to allowances only
+//   - EmployeeContribution: monthly premium share of the employee, insurance plans only
+//   - GradeLevels, ContractTypes, MaritalStatuses, MinTenureMonths: eligibility, empty for any
+type BenefitPlanFactory struct {
+	ID                   string
+	Code                 string
+	Name                 string
+	Type                 string
+	Basis                string
+	Amount               int64
+	EmployeeContribution int64
+	Taxable              bool
+	GradeLevels          []string
+	ContractTypes        []string
+	MaritalStatuses      []string
+	MinTenureMonths      int
+	CreatedAt            time.Time
+}
+
+// Create validates the factory input and returns the plan.
+func (f BenefitPlanFactory) Create() (*BenefitPlan, error) {
+	id, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(f.Code))
+	if !planCode.MatchString(code) {
+		return nil, fmt.Errorf("invalid plan code: %q", f.Code)
+	}
+	if payroll_entity.IsReservedCode(code) || payroll_entity.IsReservedCode(code+"_EE") {
+		return nil, errors.New("line code is reserved")
+	}
+	name := strings.TrimSpace(f.Name)
+	if name == "" {
+		return nil, errors.New("plan name cannot be empty")
+	}
+
+	benefitType, err := enum.ParseBenefitType(f.Type)
+	if err != nil {
+		return nil, err
+	}
+	if f.Basis == "" {
+		f.Basis = string(enum.BenefitFixed)
+	}
+	basis, err := enum.ParseBenefitAmountBasis(f.Basis)
+	if err != nil {
+		return nil, err
+	}
+	if basis == enum.BenefitPerAttendanceDay && benefitType != enum.BenefitAllowance {
+		return nil, errors.New("attendance based amounts apply to allowances only")
+	}
+
+	if f.Amount <= 0 {
+		return nil, errors.New("plan amount must be positive")
+	}
+	if f.EmployeeContribution < 0 {
+		return nil, errors.New("employee contribution cannot be negative")
+	}
+	if f.EmployeeContribution > 0 && benefitType != enum.BenefitInsurance {
+		return nil, errors.New("employee contribution applies to insurance plans only")
+	}
+	if f.MinTenureMonths < 0 {
+		return nil, errors.New("minimum tenure cannot be negative")
+	}
+
+	rule := EligibilityRule{minTenureMonths: f.MinTenureMonths}
+	for _, s := range f.GradeLevels {
+		v, err := enum.ParseJobGradeLevel(s)
+		if err != nil {
+			return nil, err
+		}
+		rule.gradeLevels = append(rule.gradeLevels, v)
+	}
+	for _, s := range f.ContractTypes {
+		v, err := enum.ParseContractType(s)
+		if err != nil {
+			return nil, err
+		}
+		rule.contractTypes = append(rule.contractTypes, v)
+	}
+	for _, s := range f.MaritalStatuses {
+		v, err := enum.ParseMaritalStatus(s)
+		if err != nil {
+			return nil, err
+		}
+		rule.maritalStatuses = append(rule.maritalStatuses, v)
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	return &BenefitPlan{
+		id:                   id,
+		code:                 code,
+		name:                 name,
+		benefitType:          benefitType,
+		basis:                basis,
+		amount:               f.Amount,
+		employeeContribution: f.EmployeeContribution,
+		taxable:              f.Taxable,
+		eligibility:          rule,
+		createdAt:            f.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package benefit_entity_test
+
+import (
+	"github.com/google/uuid"
+	benefit_entity "github.com/rfanazhari/hris/domain/entity/benefit"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestBenefitPlanFactory_Create(t *testing.T) {
+	family := benefit_entity.BenefitPlanFactory{
+		ID: uuid.NewString(), Code: "tunj_keluarga", Name: "Tunjangan Keluarga", Type: "allowance",
+		Amount: 500_000, Taxable: true,
+		ContractTypes: []string{"pkwtt"}, MaritalStatuses: []string{"married"}, MinTenureMonths: 3,
+	}
+
+	t.Run("ValidInput", func(t *testing.T) {
+		plan, err := family.Create()
+		assert.Nil(t, err)
+		assert.Equal(t, "TUNJ_KELUARGA", plan.Code())
+		assert.Equal(t, enum.BenefitFixed, plan.Basis())
+		assert.Equal(t, []enum.ContractType{enum.ContractPKWTT}, plan.Eligibility().ContractTypes())
+		assert.Empty(t, plan.Eligibility().GradeLevels())
+		assert.False(t, plan.CreatedAt().IsZero())
+	})
+	t.Run("InvalidInput", func(t *testing.T) {
+		cases := map[string]func(f *benefit_entity.BenefitPlanFactory){
+			"invalid format uuid":                                   func(f *benefit_entity.BenefitPlanFactory) { f.ID = "uuid" },
+			`invalid plan code: "tunj keluarga"`:                    func(f *benefit_entity.BenefitPlanFactory) { f.Code = "tunj keluarga" },
+			"line code is reserved":                                 func(f *benefit_entity.BenefitPlanFactory) { f.Code = "thr" },
+			"plan name cannot be empty":                             func(f *benefit_entity.BenefitPlanFactory) { f.Name = " " },
+			`invalid BenefitType: "bonus"`:                          func(f *benefit_entity.BenefitPlanFactory) { f.Type = "bonus" },
+			"plan amount must be positive":                          func(f *benefit_entity.BenefitPlanFactory) { f.Amount = 0 },
+			"employee contribution applies to insurance plans only": func(f *benefit_entity.BenefitPlanFactory) { f.EmployeeContribution = 1 },
+			"minimum tenure cannot be negative":                     func(f *benefit_entity.BenefitPlanFactory) { f.MinTenureMonths = -1 },
+			`invalid ContractType: "outsourcing"`:                   func(f *benefit_entity.BenefitPlanFactory) { f.ContractTypes = []string{"outsourcing"} },
+			"attendance based amounts apply to allowances only": func(f *benefit_entity.BenefitPlanFactory) {
+				f.Type = "insurance"
+				f.Basis = "attendance_day"
+			},
+		}
+		for msg, mutate := range cases {
+			f := family
+			mutate(&f)
+			_, err := f.Create()
+			assert.EqualError(t, err, msg)
+		}
+	})
+}
+
+func TestEligibilityRule_Check(t *testing.T) {
+	plan, _ := benefit_entity.BenefitPlanFactory{
+		ID: uuid.NewString(), Code: "CAR", Name: "Tunjangan Kendaraan", Type: "allowance", Amount: 2_000_000,
+		GradeLevels: []string{"manager", "director"}, ContractTypes: []string{"pkwtt"}, MinTenureMonths: 12,
+	}.Create()
+	rule := plan.Eligibility()
+	manager := benefit_entity.Candidate{
+		GradeLevel: enum.GradeManager, ContractType: enum.ContractPKWTT, MaritalStatus: enum.MaritalSingle,
+		HireDate: date(2024, 3, 15), At: date(2025, 3, 15),
+	}
+
+	assert.Equal(t, 12, manager.TenureMonths())
+	assert.Nil(t, rule.Check(manager))
+
+	early := manager
+	early.At = date(2025, 3, 14)
+	assert.EqualError(t, rule.Check(early), "requires 12 months of service")
+
+	junior := manager
+	junior.GradeLevel = enum.GradeJunior
+	assert.EqualError(t, rule.Check(junior), `grade level "junior" is not eligible`)
+
+	contract := manager
+	contract.ContractType = enum.ContractPKWT
+	assert.EqualError(t, rule.Check(contract), `contract type "pkwt" is not eligible`)
+}
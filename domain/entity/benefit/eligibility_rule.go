@@ -0,0 +1,84 @@
+package benefit_entity
+
+import (
+	"fmt"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// Candidate is what eligibility rules are evaluated against: the employee's situation on
+// a given date.
+type Candidate struct {
+	GradeLevel    enum.GradeLevel
+	ContractType  enum.ContractType
+	MaritalStatus enum.MaritalStatus
+	HireDate      time.Time
+	At            time.Time
+}
+
+// TenureMonths returns the number of full months between the hire date and At.
+func (c Candidate) TenureMonths() int {
+	if c.HireDate.IsZero() || c.At.Before(c.HireDate) {
+		return 0
+	}
+	months := (c.At.Year()-c.HireDate.Year())*12 + int(c.At.Month()-c.HireDate.Month())
+	if c.At.Day() < c.HireDate.Day() {
+		months--
+	}
+	return months
+}
+
+// EligibilityRule restricts a benefit plan to some employees. An empty list allows any
+// value, so the zero rule makes everyone eligible.
+type EligibilityRule struct {
+	gradeLevels     []enum.GradeLevel
+	contractTypes   []enum.ContractType
+	maritalStatuses []enum.MaritalStatus
+	minTenureMonths int
+}
+
+// GradeLevels returns the eligible grade levels, empty for all.
+func (r EligibilityRule) GradeLevels() []enum.GradeLevel {
+	return append([]enum.GradeLevel{}, r.gradeLevels...)
+}
+
+// ContractTypes returns the eligible contract types, empty for all.
+func (r EligibilityRule) ContractTypes() []enum.ContractType {
+	return append([]enum.ContractType{}, r.contractTypes...)
+}
+
+// MaritalStatuses returns the eligible marital statuses, empty for all.
+func (r EligibilityRule) MaritalStatuses() []enum.MaritalStatus {
+	return append([]enum.MaritalStatus{}, r.maritalStatuses...)
+}
+
+// MinTenureMonths returns the number of months of service required.
+func (r EligibilityRule) MinTenureMonths() int {
+	return r.minTenureMonths
+}
+
+// Check returns an error describing why the candidate is not eligible, or nil.
+func (r EligibilityRule) Check(c Candidate) error {
+	if len(r.gradeLevels) > 0 && !contains(r.gradeLevels, c.GradeLevel) {
+		return fmt.Errorf("grade level %q is not eligible", c.GradeLevel)
+	}
+	if len(r.contractTypes) > 0 && !contains(r.contractTypes, c.ContractType) {
+		return fmt.Errorf("contract type %q is not eligible", c.ContractType)
+	}
+	if len(r.maritalStatuses) > 0 && !contains(r.maritalStatuses, c.MaritalStatus) {
+		return fmt.Errorf("marital status %q is not eligible", c.MaritalStatus)
+	}
+	if c.TenureMonths() < r.minTenureMonths {
+		return fmt.Errorf("requires %d months of service", r.minTenureMonths)
+	}
+	return nil
+}
+
+func contains[T comparable](list []T, v T) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package benefit_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Enrollment places an employee in a benefit plan from an effective date until an
+// optional end date, both inclusive.
+type Enrollment struct {
+	id            uuid.UUID
+	employeeID    uuid.UUID
+	planID        uuid.UUID
+	effectiveFrom time.Time
+	effectiveTo   *time.Time
+	amount        *int64
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+// ID returns the identifier of the enrollment.
+func (e *Enrollment) ID() uuid.UUID {
+	return e.id
+}
+
+// EmployeeID returns the enrolled employee.
+func (e *Enrollment) EmployeeID() uuid.UUID {
+	return e.employeeID
+}
+
+// PlanID returns the plan the employee is enrolled in.
+func (e *Enrollment) PlanID() uuid.UUID {
+	return e.planID
+}
+
+// EffectiveFrom returns the first day of the enrollment.
+func (e *Enrollment) EffectiveFrom() time.Time {
+	return e.effectiveFrom
+}
+
+// EffectiveTo returns the last day of the enrollment, nil while open-ended.
+func (e *Enrollment) EffectiveTo() *time.Time {
+	return e.effectiveTo
+}
+
+// Amount returns the amount agreed for this employee, nil to use the plan amount.
+func (e *Enrollment) Amount() *int64 {
+	return e.amount
+}
+
+// CreatedAt returns when the enrollment was created.
+func (e *Enrollment) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+// UpdatedAt returns when the enrollment was last changed.
+func (e *Enrollment) UpdatedAt() time.Time {
+	return e.updatedAt
+}
+
+// AmountFor returns the enrollment amount, falling back to the plan amount.
+func (e *Enrollment) AmountFor(plan BenefitPlan) int64 {
+	if e.amount != nil {
+		return *e.amount
+	}
+	return plan.amount
+}
+
+// IsActive reports whether the enrollment covers the calendar date of at.
+func (e *Enrollment) IsActive(at time.Time) bool {
+	day := dateOf(at)
+	return !day.Before(e.effectiveFrom) && (e.effectiveTo == nil || !day.After(*e.effectiveTo))
+}
+
+// Overlaps reports whether the enrollment covers any day of [from, to].
+func (e *Enrollment) Overlaps(from time.Time, to *time.Time) bool {
+	if to != nil && dateOf(*to).Before(e.effectiveFrom) {
+		return false
+	}
+	return e.effectiveTo == nil || !e.effectiveTo.Before(dateOf(from))
+}
+
+// CoveredDays returns the number of days of [from, to] the enrollment covers.
+func (e *Enrollment) CoveredDays(from, to time.Time) int {
+	start, end := dateOf(from), dateOf(to)
+	if start.Before(e.effectiveFrom) {
+		start = e.effectiveFrom
+	}
+	if e.effectiveTo != nil && end.After(*e.effectiveTo) {
+		end = *e.effectiveTo
+	}
+	if end.Before(start) {
+		return 0
+	}
+	return int(end.Sub(start).Hours()/24) + 1
+}
+
+// End ends the enrollment on the calendar date of endDate.
+func (e *Enrollment) End(endDate time.Time, at time.Time) error {
+	day := dateOf(endDate)
+	if day.Before(e.effectiveFrom) {
+		return errors.New("end date cannot be before the effective date")
+	}
+	if e.effectiveTo != nil && !day.Before(*e.effectiveTo) {
+		return errors.New("enrollment already ends on or before the end date")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+	e.effectiveTo = &day
+	e.updatedAt = at
+	return nil
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,72 @@
+package benefit_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+// EnrollmentFactory creates Enrollments. Amount overrides the plan amount for this
+// employee, e.g. a position allowance agreed in the contract.
+type EnrollmentFactory struct {
+	ID            string
+	EmployeeID    string
+	PlanID        string
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+	Amount        *int64
+	CreatedAt     time.Time
+}
+
+// Create validates the factory input and returns the enrollment.
+func (f EnrollmentFactory) Create() (*Enrollment, error) {
+	id, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+	employeeID, err := uuid.Parse(f.EmployeeID)
+	if err != nil {
+		return nil, errors.New("invalid employee id")
+	}
+	planID, err := uuid.Parse(f.PlanID)
+	if err != nil {
+		return nil, errors.New("invalid plan id")
+	}
+
+	if f.EffectiveFrom.IsZero() {
+		return nil, errors.New("effective date cannot be empty")
+	}
+	from := dateOf(f.EffectiveFrom)
+	var to *time.Time
+	if f.EffectiveTo != nil {
+		end := dateOf(*f.EffectiveTo)
+		if end.Before(from) {
+			return nil, errors.New("end date cannot be before the effective date")
+		}
+		to = &end
+	}
+
+	var amount *int64
+	if f.Amount != nil {
+		if *f.Amount <= 0 {
+			return nil, errors.New("enrollment amount must be positive")
+		}
+		v := *f.Amount
+		amount = &v
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	return &Enrollment{
+		id:            id,
+		employeeID:    employeeID,
+		planID:        planID,
+		effectiveFrom: from,
+		effectiveTo:   to,
+		amount:        amount,
+		createdAt:     f.CreatedAt,
+		updatedAt:     f.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package benefit_entity_test
+
+import (
+	"github.com/google/uuid"
+	benefit_entity "github.com/rfanazhari/hris/domain/entity/benefit"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestEnrollmentFactory_Create(t *testing.T) {
+	valid := benefit_entity.EnrollmentFactory{
+		ID: uuid.NewString(), EmployeeID: uuid.NewString(), PlanID: uuid.NewString(),
+		EffectiveFrom: time.Date(2025, 4, 10, 8, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("ValidInput", func(t *testing.T) {
+		enrollment, err := valid.Create()
+		assert.Nil(t, err)
+		assert.Equal(t, date(2025, 4, 10), enrollment.EffectiveFrom())
+		assert.Nil(t, enrollment.EffectiveTo())
+		assert.True(t, enrollment.IsActive(date(2030, 1, 1)))
+		assert.False(t, enrollment.IsActive(date(2025, 4, 9)))
+	})
+	t.Run("InvalidInput", func(t *testing.T) {
+		before := date(2025, 4, 9)
+		zero := int64(0)
+		cases := map[string]func(f *benefit_entity.EnrollmentFactory){
+			"invalid format uuid":                          func(f *benefit_entity.EnrollmentFactory) { f.ID = "" },
+			"invalid employee id":                          func(f *benefit_entity.EnrollmentFactory) { f.EmployeeID = "x" },
+			"invalid plan id":                              func(f *benefit_entity.EnrollmentFactory) { f.PlanID = "x" },
+			"effective date cannot be empty":               func(f *benefit_entity.EnrollmentFactory) { f.EffectiveFrom = time.Time{} },
+			"end date cannot be before the effective date": func(f *benefit_entity.EnrollmentFactory) { f.EffectiveTo = &before },
+			"enrollment amount must be positive":           func(f *benefit_entity.EnrollmentFactory) { f.Amount = &zero },
+		}
+		for msg, mutate := range cases {
+			f := valid
+			mutate(&f)
+			_, err := f.Create()
+			assert.EqualError(t, err, msg)
+		}
+	})
+}
+
+func TestEnrollment_Coverage(t *testing.T) {
+	amount := int64(750_000)
+	enrollment, _ := benefit_entity.EnrollmentFactory{
+		ID: uuid.NewString(), EmployeeID: uuid.NewString(), PlanID: uuid.NewString(),
+		EffectiveFrom: date(2025, 4, 10), Amount: &amount,
+	}.Create()
+	plan, _ := benefit_entity.BenefitPlanFactory{ID: uuid.NewString(), Code: "POSITION", Name: "Tunjangan Jabatan", Type: "allowance", Amount: 500_000}.Create()
+
+	assert.Equal(t, int64(750_000), enrollment.AmountFor(*plan))
+	assert.Equal(t, 21, enrollment.CoveredDays(date(2025, 4, 1), date(2025, 4, 30)))
+	assert.Equal(t, 0, enrollment.CoveredDays(date(2025, 3, 1), date(2025, 3, 31)))
+
+	end := date(2025, 4, 30)
+	assert.True(t, enrollment.Overlaps(date(2025, 1, 1), &end))
+	assert.True(t, enrollment.Overlaps(date(2026, 1, 1), nil))
+
+	assert.EqualError(t, enrollment.End(date(2025, 4, 1), time.Time{}), "end date cannot be before the effective date")
+	assert.Nil(t, enrollment.End(date(2025, 5, 20), time.Time{}))
+	assert.Equal(t, 20, enrollment.CoveredDays(date(2025, 5, 1), date(2025, 5, 31)))
+	assert.False(t, enrollment.Overlaps(date(2025, 5, 21), nil))
+	assert.EqualError(t, enrollment.End(date(2025, 6, 1), time.Time{}), "enrollment already ends on or before the end date")
+}
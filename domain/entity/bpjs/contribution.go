@@ -0,0 +1,71 @@
+package bpjs_entity
+
+import "github.com/rfanazhari/hris/domain/enum"
+
+// Contribution is the amount due to one BPJS programme for one month, split between the
+// part paid by the employer and the part deducted from the employee's pay.
+type Contribution struct {
+	program  enum.BPJSProgram
+	employer int64
+	employee int64
+}
+
+// Program returns the BPJS programme.
+func (c Contribution) Program() enum.BPJSProgram {
+	return c.program
+}
+
+// Employer returns the part paid by the employer.
+func (c Contribution) Employer() int64 {
+	return c.employer
+}
+
+// Employee returns the part deducted from the employee's pay.
+func (c Contribution) Employee() int64 {
+	return c.employee
+}
+
+// Total returns the amount remitted to BPJS.
+func (c Contribution) Total() int64 {
+	return c.employer + c.employee
+}
+
+// Contributions are the BPJS contributions of an employee for one month.
+type Contributions struct {
+	items []Contribution
+}
+
+// Items returns the contribution of every programme.
+func (c Contributions) Items() []Contribution {
+	out := make([]Contribution, len(c.items))
+	copy(out, c.items)
+	return out
+}
+
+// Of returns the contribution to the given programme.
+func (c Contributions) Of(program enum.BPJSProgram) Contribution {
+	for _, item := range c.items {
+		if item.program == program {
+			return item
+		}
+	}
+	return Contribution{program: program}
+}
+
+// Employer returns the total paid by the employer.
+func (c Contributions) Employer() int64 {
+	var total int64
+	for _, item := range c.items {
+		total += item.employer
+	}
+	return total
+}
+
+// Employee returns the total deducted from the employee's pay.
+func (c Contributions) Employee() int64 {
+	var total int64
+	for _, item := range c.items {
+		total += item.employee
+	}
+	return total
+}
@@ -0,0 +1,69 @@
+package bpjs_entity
+
+import (
+	"errors"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// ContributionRates are the BPJS contribution rates and wage caps in effect from a date.
+// Rates are in basis points (hundredths of a percent) of the monthly wage (upah pokok plus
+// tunjangan tetap).
+type ContributionRates struct {
+	effectiveDate  time.Time
+	healthEmployer int64
+	healthEmployee int64
+	healthWageCap  int64
+	jhtEmployer    int64
+	jhtEmployee    int64
+	jpEmployer     int64
+	jpEmployee     int64
+	jpWageCap      int64
+	jkm            int64
+	jkk            map[enum.JKKRiskClass]int64
+}
+
+// EffectiveDate returns the first day the rates apply.
+func (r ContributionRates) EffectiveDate() time.Time {
+	return r.effectiveDate
+}
+
+// HealthWageCap returns the highest wage BPJS Kesehatan contributions are calculated on.
+func (r ContributionRates) HealthWageCap() int64 {
+	return r.healthWageCap
+}
+
+// JPWageCap returns the highest wage JP contributions are calculated on.
+func (r ContributionRates) JPWageCap() int64 {
+	return r.jpWageCap
+}
+
+// JKKRate returns the JKK rate of the risk class in basis points.
+func (r ContributionRates) JKKRate(class enum.JKKRiskClass) int64 {
+	return r.jkk[class]
+}
+
+// Calculate returns the contributions due on the monthly wage of an employee working at a
+// workplace of the given JKK risk class.
+func (r ContributionRates) Calculate(wage int64, class enum.JKKRiskClass) (*Contributions, error) {
+	if wage < 0 {
+		return nil, errors.New("wage cannot be negative")
+	}
+	if !class.Valid() {
+		return nil, errors.New("invalid jkk risk class")
+	}
+	healthWage := min(wage, r.healthWageCap)
+	jpWage := min(wage, r.jpWageCap)
+	return &Contributions{items: []Contribution{
+		{enum.BPJSKesehatan, percentOf(healthWage, r.healthEmployer), percentOf(healthWage, r.healthEmployee)},
+		{enum.BPJSJHT, percentOf(wage, r.jhtEmployer), percentOf(wage, r.jhtEmployee)},
+		{enum.BPJSJP, percentOf(jpWage, r.jpEmployer), percentOf(jpWage, r.jpEmployee)},
+		{enum.BPJSJKK, percentOf(wage, r.jkk[class]), 0},
+		{enum.BPJSJKM, percentOf(wage, r.jkm), 0},
+	}}, nil
+}
+
+// percentOf applies a rate in basis points, rounded to the nearest unit.
+func percentOf(amount, rate int64) int64 {
+	return (amount*rate + 5_000) / 10_000
+}
@@ -0,0 +1,70 @@
+package bpjs_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// ContributionRatesFactory creates ContributionRates. Rates are in basis points and JKK
+// must have a rate for every risk class.
+type ContributionRatesFactory struct {
+	EffectiveDate  time.Time
+	HealthEmployer int64
+	HealthEmployee int64
+	HealthWageCap  int64
+	JHTEmployer    int64
+	JHTEmployee    int64
+	JPEmployer     int64
+	JPEmployee     int64
+	JPWageCap      int64
+	JKM            int64
+	JKK            map[string]int64
+}
+
+// Create validates the factory input and returns the rates.
+func (f ContributionRatesFactory) Create() (*ContributionRates, error) {
+	if f.EffectiveDate.IsZero() {
+		return nil, errors.New("effective date cannot be empty")
+	}
+	for _, rate := range []int64{f.HealthEmployer, f.HealthEmployee, f.JHTEmployer, f.JHTEmployee, f.JPEmployer, f.JPEmployee, f.JKM} {
+		if rate < 0 || rate > 10_000 {
+			return nil, errors.New("contribution rate must be between 0 and 10000 basis points")
+		}
+	}
+	if f.HealthWageCap <= 0 || f.JPWageCap <= 0 {
+		return nil, errors.New("wage cap must be positive")
+	}
+
+	jkk := make(map[enum.JKKRiskClass]int64, len(f.JKK))
+	for s, rate := range f.JKK {
+		class, err := enum.ParseJKKRiskClass(s)
+		if err != nil {
+			return nil, err
+		}
+		if rate < 0 || rate > 10_000 {
+			return nil, errors.New("contribution rate must be between 0 and 10000 basis points")
+		}
+		jkk[class] = rate
+	}
+	for _, class := range []enum.JKKRiskClass{enum.JKKRiskVeryLow, enum.JKKRiskLow, enum.JKKRiskMedium, enum.JKKRiskHigh, enum.JKKRiskVeryHigh} {
+		if _, ok := jkk[class]; !ok {
+			return nil, fmt.Errorf("missing jkk rate for %s", class)
+		}
+	}
+
+	return &ContributionRates{
+		effectiveDate:  time.Date(f.EffectiveDate.Year(), f.EffectiveDate.Month(), f.EffectiveDate.Day(), 0, 0, 0, 0, time.UTC),
+		healthEmployer: f.HealthEmployer,
+		healthEmployee: f.HealthEmployee,
+		healthWageCap:  f.HealthWageCap,
+		jhtEmployer:    f.JHTEmployer,
+		jhtEmployee:    f.JHTEmployee,
+		jpEmployer:     f.JPEmployer,
+		jpEmployee:     f.JPEmployee,
+		jpWageCap:      f.JPWageCap,
+		jkm:            f.JKM,
+		jkk:            jkk,
+	}, nil
+}
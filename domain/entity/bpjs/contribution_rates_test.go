@@ -0,0 +1,126 @@
+package bpjs_entity_test
+
+import (
+	bpjs_entity "github.com/rfanazhari/hris/domain/entity/bpjs"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestContributionRates_Calculate(t *testing.T) {
+	schedule := bpjs_entity.DefaultRateSchedule()
+	june, _ := schedule.At(time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC))
+
+	t.Run("AboveCaps", func(t *testing.T) {
+		got, err := june.Calculate(15_000_000, enum.JKKRiskMedium)
+
+		assert.Nil(t, err)
+		tests := []struct {
+			program  enum.BPJSProgram
+			employer int64
+			employee int64
+		}{
+			{enum.BPJSKesehatan, 480_000, 120_000}, // capped at 12.000.000
+			{enum.BPJSJHT, 555_000, 300_000},
+			{enum.BPJSJP, 210_948, 105_474}, // capped at 10.547.400
+			{enum.BPJSJKK, 133_500, 0},
+			{enum.BPJSJKM, 45_000, 0},
+		}
+		for _, tt := range tests {
+			c := got.Of(tt.program)
+			assert.Equal(t, tt.employer, c.Employer(), tt.program)
+			assert.Equal(t, tt.employee, c.Employee(), tt.program)
+		}
+		assert.Len(t, got.Items(), 5)
+		assert.Equal(t, int64(1_424_448), got.Employer())
+		assert.Equal(t, int64(525_474), got.Employee())
+		assert.Equal(t, int64(600_000), got.Of(enum.BPJSKesehatan).Total())
+	})
+	t.Run("BelowCaps", func(t *testing.T) {
+		got, _ := june.Calculate(5_000_000, enum.JKKRiskVeryLow)
+
+		assert.Equal(t, int64(200_000), got.Of(enum.BPJSKesehatan).Employer())
+		assert.Equal(t, int64(50_000), got.Of(enum.BPJSJP).Employee())
+		assert.Equal(t, int64(12_000), got.Of(enum.BPJSJKK).Employer())
+		assert.Equal(t, int64(15_000), got.Of(enum.BPJSJKM).Employer())
+	})
+	t.Run("JPWageCapBeforeMarch", func(t *testing.T) {
+		february, _ := schedule.At(time.Date(2025, time.February, 28, 23, 0, 0, 0, time.UTC))
+		got, _ := february.Calculate(15_000_000, enum.JKKRiskMedium)
+
+		assert.Equal(t, int64(10_042_300), february.JPWageCap())
+		assert.Equal(t, int64(100_423), got.Of(enum.BPJSJP).Employee())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := june.Calculate(-1, enum.JKKRiskLow)
+		assert.EqualError(t, err, "wage cannot be negative")
+		_, err = june.Calculate(1, "extreme")
+		assert.EqualError(t, err, "invalid jkk risk class")
+	})
+}
+
+func TestContributionRatesFactory_Create(t *testing.T) {
+	valid := bpjs_entity.ContributionRatesFactory{
+		EffectiveDate:  time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+		HealthEmployer: 400,
+		HealthEmployee: 100,
+		HealthWageCap:  12_000_000,
+		JHTEmployer:    370,
+		JHTEmployee:    200,
+		JPEmployer:     200,
+		JPEmployee:     100,
+		JPWageCap:      11_000_000,
+		JKM:            30,
+		JKK:            map[string]int64{"very_low": 24, "low": 54, "medium": 89, "high": 127, "very_high": 174},
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		rates, err := valid.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(127), rates.JKKRate(enum.JKKRiskHigh))
+
+		schedule, err := bpjs_entity.NewRateSchedule(append(bpjs_entity.DefaultRateSchedule().Rates(), *rates)...)
+		assert.Nil(t, err)
+		current, _ := schedule.At(time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC))
+		assert.Equal(t, int64(11_000_000), current.JPWageCap())
+		_, ok := schedule.At(time.Date(2022, time.February, 28, 0, 0, 0, 0, time.UTC))
+		assert.False(t, ok)
+
+		_, err = bpjs_entity.NewRateSchedule(*rates, *rates)
+		assert.EqualError(t, err, "contribution rates already exist for the effective date")
+	})
+	t.Run("MissingJKKClass", func(t *testing.T) {
+		f := valid
+		f.JKK = map[string]int64{"low": 54}
+
+		_, err := f.Create()
+
+		assert.EqualError(t, err, "missing jkk rate for very_low")
+	})
+	t.Run("InvalidRate", func(t *testing.T) {
+		f := valid
+		f.JHTEmployee = -1
+
+		_, err := f.Create()
+
+		assert.EqualError(t, err, "contribution rate must be between 0 and 10000 basis points")
+	})
+	t.Run("InvalidCap", func(t *testing.T) {
+		f := valid
+		f.JPWageCap = 0
+
+		_, err := f.Create()
+
+		assert.EqualError(t, err, "wage cap must be positive")
+	})
+	t.Run("EmptyEffectiveDate", func(t *testing.T) {
+		f := valid
+		f.EffectiveDate = time.Time{}
+
+		_, err := f.Create()
+
+		assert.EqualError(t, err, "effective date cannot be empty")
+	})
+}
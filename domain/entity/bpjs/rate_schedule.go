@@ -0,0 +1,91 @@
+package bpjs_entity
+
+import (
+	"errors"
+	"github.com/rfanazhari/hris/domain/enum"
+	"sort"
+	"time"
+)
+
+// RateSchedule holds successive ContributionRates so that payroll for any period uses the
+// rates in effect at the time, e.g. the JP wage cap that BPJS Ketenagakerjaan adjusts every March.
+type RateSchedule struct {
+	rates []ContributionRates
+}
+
+// NewRateSchedule returns a schedule of the given rates. Two rates cannot take effect on
+// the same date.
+func NewRateSchedule(rates ...ContributionRates) (*RateSchedule, error) {
+	sorted := make([]ContributionRates, len(rates))
+	copy(sorted, rates)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].effectiveDate.Before(sorted[j].effectiveDate) })
+	for i := range sorted {
+		if sorted[i].effectiveDate.IsZero() {
+			return nil, errors.New("invalid contribution rates")
+		}
+		if i > 0 && sorted[i].effectiveDate.Equal(sorted[i-1].effectiveDate) {
+			return nil, errors.New("contribution rates already exist for the effective date")
+		}
+	}
+	return &RateSchedule{rates: sorted}, nil
+}
+
+// Rates returns the rates of the schedule ordered by effective date.
+func (s *RateSchedule) Rates() []ContributionRates {
+	out := make([]ContributionRates, len(s.rates))
+	copy(out, s.rates)
+	return out
+}
+
+// At returns the rates in effect on the calendar date of at.
+func (s *RateSchedule) At(at time.Time) (*ContributionRates, bool) {
+	day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+	for i := len(s.rates) - 1; i >= 0; i-- {
+		if !s.rates[i].effectiveDate.After(day) {
+			r := s.rates[i]
+			return &r, true
+		}
+	}
+	return nil, false
+}
+
+// DefaultRateSchedule returns the statutory rates:
+//   - BPJS Kesehatan 4% employer, 1% employee, wage cap 12.000.000 (Perpres No. 64/2020)
+//   - JHT 3,7% employer, 2% employee (PP No. 46/2015)
+//   - JP 2% employer, 1% employee, wage cap adjusted every March (PP No. 45/2015)
+//   - JKK by risk class and JKM 0,3% employer (PP No. 44/2015)
+func DefaultRateSchedule() *RateSchedule {
+	jpWageCaps := []struct {
+		from time.Time
+		cap  int64
+	}{
+		{time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC), 9_077_600},
+		{time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC), 9_559_600},
+		{time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), 10_042_300},
+		{time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC), 10_547_400},
+	}
+	jkk := map[enum.JKKRiskClass]int64{
+		enum.JKKRiskVeryLow:  24,
+		enum.JKKRiskLow:      54,
+		enum.JKKRiskMedium:   89,
+		enum.JKKRiskHigh:     127,
+		enum.JKKRiskVeryHigh: 174,
+	}
+	schedule := &RateSchedule{}
+	for _, c := range jpWageCaps {
+		schedule.rates = append(schedule.rates, ContributionRates{
+			effectiveDate:  c.from,
+			healthEmployer: 400,
+			healthEmployee: 100,
+			healthWageCap:  12_000_000,
+			jhtEmployer:    370,
+			jhtEmployee:    200,
+			jpEmployer:     200,
+			jpEmployee:     100,
+			jpWageCap:      c.cap,
+			jkm:            30,
+			jkk:            jkk,
+		})
+	}
+	return schedule
+}
@@ -0,0 +1,52 @@
+package calendar_entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// Holiday represents a single non-working calendar date. National holidays and cuti
+// bersama apply everywhere; regional holidays apply to one organization unit and company
+// holidays either to one organization unit or, without one, to the whole company.
+type Holiday struct {
+	date               time.Time
+	name               string
+	holidayType        enum.HolidayType
+	organizationUnitID *uuid.UUID
+}
+
+// Date returns the calendar date of the holiday at midnight UTC.
+func (h Holiday) Date() time.Time {
+	return h.date
+}
+
+// Name returns the name of the holiday.
+func (h Holiday) Name() string {
+	return h.name
+}
+
+// Type returns the origin of the holiday.
+func (h Holiday) Type() enum.HolidayType {
+	return h.holidayType
+}
+
+// OrganizationUnitID returns the organization unit the holiday is limited to, if any.
+func (h Holiday) OrganizationUnitID() *uuid.UUID {
+	return h.organizationUnitID
+}
+
+// AppliesTo reports whether the holiday applies to the given organization unit.
+// A nil unit only matches holidays that are not limited to a unit.
+func (h Holiday) AppliesTo(unitID *uuid.UUID) bool {
+	if h.organizationUnitID == nil {
+		return true
+	}
+	return unitID != nil && *unitID == *h.organizationUnitID
+}
+
+// dateKey normalizes t to midnight UTC of its calendar day so dates from different
+// locations compare by their calendar date.
+func dateKey(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,62 @@
+package calendar_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"strings"
+	"time"
+)
+
+// HolidayFactory is a factory type for creating Holiday values.
+type HolidayFactory struct {
+	Date               time.Time
+	Name               string
+	Type               string
+	OrganizationUnitID string
+}
+
+// Create validates the factory data and returns a new Holiday.
+// National holidays and cuti bersama cannot be limited to an organization unit, regional
+// holidays must be.
+func (f HolidayFactory) Create() (*Holiday, error) {
+	if f.Date.IsZero() {
+		return nil, errors.New("holiday date cannot be empty")
+	}
+
+	name := strings.TrimSpace(f.Name)
+	if name == "" {
+		return nil, errors.New("holiday name cannot be empty")
+	}
+
+	holidayType, err := enum.ParseHolidayType(f.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	var unitID *uuid.UUID
+	if f.OrganizationUnitID != "" {
+		id, err := uuid.Parse(f.OrganizationUnitID)
+		if err != nil {
+			return nil, errors.New("invalid organization unit id")
+		}
+		unitID = &id
+	}
+	switch holidayType {
+	case enum.HolidayNational, enum.HolidayCollectiveLeave:
+		if unitID != nil {
+			return nil, errors.New("national holiday cannot be limited to an organization unit")
+		}
+	case enum.HolidayRegional:
+		if unitID == nil {
+			return nil, errors.New("regional holiday requires an organization unit")
+		}
+	}
+
+	return &Holiday{
+		date:               dateKey(f.Date),
+		name:               name,
+		holidayType:        holidayType,
+		organizationUnitID: unitID,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package calendar_entity
+
+import (
+	"github.com/rfanazhari/hris/domain/enum"
+	"sort"
+	"time"
+)
+
+// WorkCalendar tells working days from non-working days for one organization unit.
+// A day is non-working when it is a weekly rest day of the work week or a holiday.
+// Cuti bersama counts as non-working unless the calendar works through collective leave.
+type WorkCalendar struct {
+	workWeek              enum.WorkWeek
+	holidays              map[time.Time]Holiday
+	workOnCollectiveLeave bool
+}
+
+// WorkWeek returns the working time arrangement of the calendar.
+func (c *WorkCalendar) WorkWeek() enum.WorkWeek {
+	return c.workWeek
+}
+
+// WorkOnCollectiveLeave reports whether cuti bersama days are working days.
+func (c *WorkCalendar) WorkOnCollectiveLeave() bool {
+	return c.workOnCollectiveLeave
+}
+
+// Holidays returns the holidays of the calendar ordered by date.
+func (c *WorkCalendar) Holidays() []Holiday {
+	out := make([]Holiday, 0, len(c.holidays))
+	for _, h := range c.holidays {
+		out = append(out, h)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].date.Before(out[j].date) })
+	return out
+}
+
+// Holiday returns the holiday on the calendar date of t, if any.
+func (c *WorkCalendar) Holiday(t time.Time) (Holiday, bool) {
+	h, ok := c.holidays[dateKey(t)]
+	return h, ok
+}
+
+// IsRestDay reports whether the calendar date of t is a weekly rest day.
+func (c *WorkCalendar) IsRestDay(t time.Time) bool {
+	return c.workWeek.IsRestDay(t.Weekday())
+}
+
+// IsWorkingDay reports whether the calendar date of t is a working day.
+func (c *WorkCalendar) IsWorkingDay(t time.Time) bool {
+	if c.IsRestDay(t) {
+		return false
+	}
+	h, ok := c.Holiday(t)
+	if !ok {
+		return true
+	}
+	return h.holidayType == enum.HolidayCollectiveLeave && c.workOnCollectiveLeave
+}
+
+// WorkingDaysBetween counts the working days from the calendar date of from up to and
+// including the calendar date of to. It returns 0 when to is before from.
+func (c *WorkCalendar) WorkingDaysBetween(from, to time.Time) int {
+	count := 0
+	for d := dateKey(from); !d.After(dateKey(to)); d = d.AddDate(0, 0, 1) {
+		if c.IsWorkingDay(d) {
+			count++
+		}
+	}
+	return count
+}
+
+// NextWorkingDay returns the first working day after the calendar date of t, at midnight UTC.
+func (c *WorkCalendar) NextWorkingDay(t time.Time) time.Time {
+	d := dateKey(t).AddDate(0, 0, 1)
+	for !c.IsWorkingDay(d) {
+		d = d.AddDate(0, 0, 1)
+	}
+	return d
+}
+
+// AddWorkingDays returns the working day that lies n working days after the calendar date of t.
+func (c *WorkCalendar) AddWorkingDays(t time.Time, n int) time.Time {
+	d := dateKey(t)
+	for i := 0; i < n; i++ {
+		d = c.NextWorkingDay(d)
+	}
+	return d
+}
@@ -0,0 +1,41 @@
+package calendar_entity
+
+import (
+	"fmt"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// WorkCalendarFactory is a factory type for creating WorkCalendar entities.
+// Holidays should already be filtered to the organization unit the calendar is for.
+type WorkCalendarFactory struct {
+	WorkWeek              string
+	Holidays              []Holiday
+	WorkOnCollectiveLeave bool
+}
+
+// Create validates the factory data and returns a new WorkCalendar.
+// When two holidays share a date, any other holiday takes precedence over cuti bersama,
+// otherwise the first one wins.
+func (f WorkCalendarFactory) Create() (*WorkCalendar, error) {
+	workWeek, err := enum.ParseWorkWeek(f.WorkWeek)
+	if err != nil {
+		return nil, err
+	}
+
+	holidays := make(map[time.Time]Holiday, len(f.Holidays))
+	for _, h := range f.Holidays {
+		if h.date.IsZero() || !h.holidayType.Valid() {
+			return nil, fmt.Errorf("invalid holiday %q", h.name)
+		}
+		if existing, ok := holidays[h.date]; !ok || (existing.holidayType == enum.HolidayCollectiveLeave && h.holidayType != enum.HolidayCollectiveLeave) {
+			holidays[h.date] = h
+		}
+	}
+
+	return &WorkCalendar{
+		workWeek:              workWeek,
+		holidays:              holidays,
+		workOnCollectiveLeave: f.WorkOnCollectiveLeave,
+	}, nil
+}
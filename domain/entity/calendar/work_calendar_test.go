@@ -0,0 +1,107 @@
+package calendar_entity_test
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	calendar_entity "github.com/rfanazhari/hris/domain/entity/calendar"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newHoliday(t *testing.T, at time.Time, name, holidayType, unitID string) calendar_entity.Holiday {
+	h, err := calendar_entity.HolidayFactory{Date: at, Name: name, Type: holidayType, OrganizationUnitID: unitID}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return *h
+}
+
+func TestHolidayFactory_Create(t *testing.T) {
+	unitID := uuid.NewString()
+
+	t.Run("ValidInput", func(t *testing.T) {
+		wib := time.FixedZone("WIB", 7*60*60)
+		h, err := calendar_entity.HolidayFactory{Date: time.Date(2025, 8, 17, 23, 0, 0, 0, wib), Name: " Hari Kemerdekaan ", Type: "national"}.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, date(2025, 8, 17), h.Date())
+		assert.Equal(t, "Hari Kemerdekaan", h.Name())
+		assert.Equal(t, enum.HolidayNational, h.Type())
+		assert.True(t, h.AppliesTo(nil))
+	})
+	t.Run("RegionalRequiresUnit", func(t *testing.T) {
+		_, err := calendar_entity.HolidayFactory{Date: date(2025, 4, 23), Name: "Galungan", Type: "regional"}.Create()
+		assert.EqualError(t, err, "regional holiday requires an organization unit")
+
+		h, err := calendar_entity.HolidayFactory{Date: date(2025, 4, 23), Name: "Galungan", Type: "regional", OrganizationUnitID: unitID}.Create()
+		assert.Nil(t, err)
+		id := uuid.MustParse(unitID)
+		other := uuid.New()
+		assert.True(t, h.AppliesTo(&id))
+		assert.False(t, h.AppliesTo(&other))
+		assert.False(t, h.AppliesTo(nil))
+	})
+	t.Run("NationalCannotHaveUnit", func(t *testing.T) {
+		_, err := calendar_entity.HolidayFactory{Date: date(2025, 1, 1), Name: "Tahun Baru", Type: "national", OrganizationUnitID: unitID}.Create()
+		assert.EqualError(t, err, "national holiday cannot be limited to an organization unit")
+	})
+	t.Run("InvalidInput", func(t *testing.T) {
+		_, err := calendar_entity.HolidayFactory{Name: "Tahun Baru", Type: "national"}.Create()
+		assert.EqualError(t, err, "holiday date cannot be empty")
+		_, err = calendar_entity.HolidayFactory{Date: date(2025, 1, 1), Name: " ", Type: "national"}.Create()
+		assert.EqualError(t, err, "holiday name cannot be empty")
+		_, err = calendar_entity.HolidayFactory{Date: date(2025, 1, 1), Name: "Libur", Type: "weekend"}.Create()
+		assert.EqualError(t, err, fmt.Errorf("invalid HolidayType: %q", "weekend").Error())
+		_, err = calendar_entity.HolidayFactory{Date: date(2025, 1, 1), Name: "Libur", Type: "company", OrganizationUnitID: "unit"}.Create()
+		assert.EqualError(t, err, "invalid organization unit id")
+	})
+}
+
+func TestWorkCalendar(t *testing.T) {
+	holidays := []calendar_entity.Holiday{
+		newHoliday(t, date(2025, 3, 31), "Idul Fitri", "national", ""),
+		newHoliday(t, date(2025, 4, 1), "Idul Fitri", "national", ""),
+		newHoliday(t, date(2025, 4, 2), "Cuti Bersama Idul Fitri", "collective_leave", ""),
+		newHoliday(t, date(2025, 4, 3), "Cuti Bersama Idul Fitri", "collective_leave", ""),
+		newHoliday(t, date(2025, 4, 3), "Company outing", "company", ""),
+	}
+
+	t.Run("FiveDayWeek", func(t *testing.T) {
+		cal, err := calendar_entity.WorkCalendarFactory{WorkWeek: "five_day", Holidays: holidays}.Create()
+		assert.Nil(t, err)
+
+		// 28 Mar - 8 Apr 2025: only Fri 28 Mar, Fri 4 Apr, Mon 7 and Tue 8 Apr are worked.
+		assert.Equal(t, 4, cal.WorkingDaysBetween(date(2025, 3, 28), date(2025, 4, 8)))
+		assert.Equal(t, 0, cal.WorkingDaysBetween(date(2025, 4, 8), date(2025, 4, 7)))
+		assert.Equal(t, date(2025, 4, 4), cal.NextWorkingDay(date(2025, 3, 28)))
+		assert.Equal(t, date(2025, 4, 7), cal.NextWorkingDay(time.Date(2025, 4, 4, 18, 0, 0, 0, time.UTC)))
+		assert.Equal(t, date(2025, 4, 7), cal.AddWorkingDays(date(2025, 3, 28), 2))
+
+		h, ok := cal.Holiday(date(2025, 4, 3))
+		assert.True(t, ok)
+		assert.Equal(t, enum.HolidayCompany, h.Type())
+		assert.Len(t, cal.Holidays(), 4)
+		assert.Equal(t, date(2025, 3, 31), cal.Holidays()[0].Date())
+	})
+	t.Run("SixDayWeekWorkingCollectiveLeave", func(t *testing.T) {
+		cal, err := calendar_entity.WorkCalendarFactory{WorkWeek: "six_day", Holidays: holidays, WorkOnCollectiveLeave: true}.Create()
+		assert.Nil(t, err)
+
+		assert.True(t, cal.IsWorkingDay(date(2025, 4, 5)))
+		assert.True(t, cal.IsWorkingDay(date(2025, 4, 2)))
+		assert.False(t, cal.IsWorkingDay(date(2025, 4, 3)))
+		assert.False(t, cal.IsWorkingDay(date(2025, 4, 6)))
+		// 31 Mar - 5 Apr 2025: 2 Apr, 4 Apr and Saturday 5 Apr.
+		assert.Equal(t, 3, cal.WorkingDaysBetween(date(2025, 3, 31), date(2025, 4, 5)))
+	})
+	t.Run("InvalidWorkWeek", func(t *testing.T) {
+		_, err := calendar_entity.WorkCalendarFactory{WorkWeek: "four_day"}.Create()
+		assert.EqualError(t, err, fmt.Errorf("invalid WorkWeek: %q", "four_day").Error())
+	})
+}
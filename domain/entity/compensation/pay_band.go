@@ -0,0 +1,99 @@
+package compensation_entity
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"time"
+)
+
+// PayBand is the salary band of a grade level from its effective date until the next
+// band of the same grade. Amounts are monthly base salaries in whole units of the currency.
+type PayBand struct {
+	id            uuid.UUID
+	gradeLevel    enum.GradeLevel
+	min           int64
+	midpoint      int64
+	max           int64
+	currency      string
+	effectiveDate time.Time
+}
+
+// ID returns the identifier of the band.
+func (b *PayBand) ID() uuid.UUID {
+	return b.id
+}
+
+// GradeLevel returns the grade level the band applies to.
+func (b *PayBand) GradeLevel() enum.GradeLevel {
+	return b.gradeLevel
+}
+
+// Min returns the band minimum.
+func (b *PayBand) Min() int64 {
+	return b.min
+}
+
+// Midpoint returns the band midpoint, the market rate for a fully proficient employee.
+func (b *PayBand) Midpoint() int64 {
+	return b.midpoint
+}
+
+// Max returns the band maximum.
+func (b *PayBand) Max() int64 {
+	return b.max
+}
+
+// Currency returns the ISO 4217 code of the band amounts.
+func (b *PayBand) Currency() string {
+	return b.currency
+}
+
+// EffectiveDate returns the date the band applies from.
+func (b *PayBand) EffectiveDate() time.Time {
+	return b.effectiveDate
+}
+
+// Contains reports whether salary lies within the band.
+func (b *PayBand) Contains(salary int64) bool {
+	return salary >= b.min && salary <= b.max
+}
+
+// ValidateRange checks that a job position's salary range lies within the band.
+func (b *PayBand) ValidateRange(salaryRange valueobject.SalaryRange) error {
+	if salaryRange.Currency != b.currency {
+		return fmt.Errorf("salary range currency %s does not match %s pay band currency %s", salaryRange.Currency, b.gradeLevel, b.currency)
+	}
+	if salaryRange.Min < b.min || salaryRange.Max > b.max {
+		return fmt.Errorf("salary range must be within the %s pay band of %d to %d", b.gradeLevel, b.min, b.max)
+	}
+	return nil
+}
+
+// CompaRatio returns salary as a share of the midpoint in basis points, e.g. 9500 for a
+// salary 5% below the midpoint.
+func (b *PayBand) CompaRatio(salary int64) int64 {
+	return ratio(salary, b.midpoint)
+}
+
+// RangePenetration returns how far salary lies into the band in basis points: 0 at the
+// minimum and 10000 at the maximum. Salaries outside the band give values below 0 or
+// above 10000.
+func (b *PayBand) RangePenetration(salary int64) int64 {
+	if b.max == b.min {
+		if salary < b.min {
+			return -10_000
+		}
+		return 10_000
+	}
+	return ratio(salary-b.min, b.max-b.min)
+}
+
+// ratio returns n / d in basis points, rounded half away from zero.
+func ratio(n, d int64) int64 {
+	if n < 0 {
+		return -ratio(-n, d)
+	}
+	return (n*10_000*2 + d) / (2 * d)
+}
@@ -0,0 +1,65 @@
+package compensation_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"time"
+)
+
+// PayBandFactory creates PayBands. The currency defaults to IDR.
+type PayBandFactory struct {
+	ID            string
+	GradeLevel    string
+	Min           int64
+	Midpoint      int64
+	Max           int64
+	Currency      string
+	EffectiveDate time.Time
+}
+
+// Create validates the factory input and returns the band.
+func (f PayBandFactory) Create() (*PayBand, error) {
+	id, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	grade, err := enum.ParseJobGradeLevel(f.GradeLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.Min <= 0 {
+		return nil, errors.New("band minimum must be positive")
+	}
+	if f.Max < f.Min {
+		return nil, errors.New("band maximum cannot be below minimum")
+	}
+	if f.Midpoint < f.Min || f.Midpoint > f.Max {
+		return nil, errors.New("band midpoint must be between minimum and maximum")
+	}
+
+	if f.Currency == "" {
+		f.Currency = "IDR"
+	}
+	currency, err := valueobject.ParseCurrency(f.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.EffectiveDate.IsZero() {
+		return nil, errors.New("effective date cannot be empty")
+	}
+
+	return &PayBand{
+		id:            id,
+		gradeLevel:    grade,
+		min:           f.Min,
+		midpoint:      f.Midpoint,
+		max:           f.Max,
+		currency:      currency.Code(),
+		effectiveDate: time.Date(f.EffectiveDate.Year(), f.EffectiveDate.Month(), f.EffectiveDate.Day(), 0, 0, 0, 0, time.UTC),
+	}, nil
+}
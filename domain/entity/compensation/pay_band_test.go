@@ -0,0 +1,109 @@
+package compensation_entity_test
+
+import (
+	"github.com/google/uuid"
+	compensation_entity "github.com/rfanazhari/hris/domain/entity/compensation"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newBand(t *testing.T, grade string, min, mid, max int64, effective time.Time) *compensation_entity.PayBand {
+	band, err := compensation_entity.PayBandFactory{
+		ID: uuid.NewString(), GradeLevel: grade, Min: min, Midpoint: mid, Max: max, EffectiveDate: effective,
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return band
+}
+
+func TestPayBandFactory_Create(t *testing.T) {
+	valid := compensation_entity.PayBandFactory{
+		ID: uuid.NewString(), GradeLevel: "Senior", Min: 12_000_000, Midpoint: 15_000_000, Max: 18_000_000,
+		EffectiveDate: time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("ValidInput", func(t *testing.T) {
+		band, err := valid.Create()
+		assert.Nil(t, err)
+		assert.Equal(t, enum.GradeSenior, band.GradeLevel())
+		assert.Equal(t, "IDR", band.Currency())
+		assert.Equal(t, date(2025, 1, 1), band.EffectiveDate())
+		assert.True(t, band.Contains(12_000_000))
+		assert.False(t, band.Contains(18_000_001))
+	})
+	t.Run("InvalidInput", func(t *testing.T) {
+		cases := map[string]func(f *compensation_entity.PayBandFactory){
+			"invalid format uuid":                               func(f *compensation_entity.PayBandFactory) { f.ID = "uuid" },
+			`invalid GradeLevel: "staff"`:                       func(f *compensation_entity.PayBandFactory) { f.GradeLevel = "staff" },
+			"band minimum must be positive":                     func(f *compensation_entity.PayBandFactory) { f.Min = 0 },
+			"band maximum cannot be below minimum":              func(f *compensation_entity.PayBandFactory) { f.Max = 11_000_000 },
+			"band midpoint must be between minimum and maximum": func(f *compensation_entity.PayBandFactory) { f.Midpoint = 19_000_000 },
+			"unsupported currency: XYZ":                         func(f *compensation_entity.PayBandFactory) { f.Currency = "XYZ" },
+			"effective date cannot be empty":                    func(f *compensation_entity.PayBandFactory) { f.EffectiveDate = time.Time{} },
+		}
+		for msg, mutate := range cases {
+			f := valid
+			mutate(&f)
+			_, err := f.Create()
+			assert.EqualError(t, err, msg)
+		}
+	})
+}
+
+func TestPayBand_Metrics(t *testing.T) {
+	band := newBand(t, "mid", 8_000_000, 10_000_000, 12_000_000, date(2025, 1, 1))
+
+	assert.Equal(t, int64(10_000), band.CompaRatio(10_000_000))
+	assert.Equal(t, int64(9_500), band.CompaRatio(9_500_000))
+	assert.Equal(t, int64(11_667), band.CompaRatio(11_666_667))
+	assert.Equal(t, int64(0), band.RangePenetration(8_000_000))
+	assert.Equal(t, int64(2_500), band.RangePenetration(9_000_000))
+	assert.Equal(t, int64(10_000), band.RangePenetration(12_000_000))
+	assert.Equal(t, int64(-2_500), band.RangePenetration(7_000_000))
+	assert.Equal(t, int64(12_500), band.RangePenetration(13_000_000))
+
+	flat := newBand(t, "intern", 3_000_000, 3_000_000, 3_000_000, date(2025, 1, 1))
+	assert.Equal(t, int64(10_000), flat.RangePenetration(3_000_000))
+	assert.Equal(t, int64(-10_000), flat.RangePenetration(2_000_000))
+
+	usd, _ := valueobject.NewSalaryRange(500, 700, "USD")
+	assert.EqualError(t, band.ValidateRange(*usd), "salary range currency USD does not match mid pay band currency IDR")
+}
+
+func TestPayStructure(t *testing.T) {
+	junior2024 := newBand(t, "junior", 5_000_000, 6_500_000, 8_000_000, date(2024, 1, 1))
+	junior2025 := newBand(t, "junior", 5_500_000, 7_000_000, 8_500_000, date(2025, 1, 1))
+	senior := newBand(t, "senior", 12_000_000, 15_000_000, 18_000_000, date(2024, 1, 1))
+
+	structure, err := compensation_entity.NewPayStructure(*junior2025, *senior, *junior2024)
+	assert.Nil(t, err)
+
+	band, ok := structure.Band(enum.GradeJunior, date(2024, 12, 31))
+	assert.True(t, ok)
+	assert.Equal(t, junior2024.ID(), band.ID())
+	band, _ = structure.Band(enum.GradeJunior, date(2025, 1, 1))
+	assert.Equal(t, junior2025.ID(), band.ID())
+	_, ok = structure.Band(enum.GradeJunior, date(2023, 12, 31))
+	assert.False(t, ok)
+
+	bands := structure.Bands(date(2025, 6, 1))
+	assert.Len(t, bands, 2)
+	assert.Equal(t, enum.GradeJunior, bands[0].GradeLevel())
+	assert.Equal(t, enum.GradeSenior, bands[1].GradeLevel())
+
+	salaryRange, _ := valueobject.NewSalaryRange(5_200_000, 8_000_000, "IDR")
+	assert.Nil(t, structure.ValidateRange(enum.GradeJunior, *salaryRange, date(2024, 6, 1)))
+	assert.EqualError(t, structure.ValidateRange(enum.GradeJunior, *salaryRange, date(2025, 6, 1)), "salary range must be within the junior pay band of 5500000 to 8500000")
+	assert.EqualError(t, structure.ValidateRange(enum.GradeLead, *salaryRange, date(2025, 6, 1)), "no pay band for grade lead")
+
+	_, err = compensation_entity.NewPayStructure(*junior2025, *newBand(t, "junior", 1, 1, 1, date(2025, 1, 1)))
+	assert.EqualError(t, err, "duplicate junior pay band effective 2025-01-01")
+}
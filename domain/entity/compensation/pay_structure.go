@@ -0,0 +1,65 @@
+package compensation_entity
+
+import (
+	"fmt"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"sort"
+	"time"
+)
+
+// PayStructure holds the pay bands of every grade level over time.
+type PayStructure struct {
+	bands map[enum.GradeLevel][]PayBand
+}
+
+// NewPayStructure returns a PayStructure of the given bands. A grade may have only one
+// band per effective date.
+func NewPayStructure(bands ...PayBand) (*PayStructure, error) {
+	s := &PayStructure{bands: map[enum.GradeLevel][]PayBand{}}
+	for _, b := range bands {
+		for _, existing := range s.bands[b.gradeLevel] {
+			if existing.effectiveDate.Equal(b.effectiveDate) {
+				return nil, fmt.Errorf("duplicate %s pay band effective %s", b.gradeLevel, b.effectiveDate.Format(time.DateOnly))
+			}
+		}
+		s.bands[b.gradeLevel] = append(s.bands[b.gradeLevel], b)
+	}
+	for _, list := range s.bands {
+		sort.Slice(list, func(i, j int) bool { return list[i].effectiveDate.Before(list[j].effectiveDate) })
+	}
+	return s, nil
+}
+
+// Band returns the band of the grade in effect at the given time.
+func (s *PayStructure) Band(grade enum.GradeLevel, at time.Time) (*PayBand, bool) {
+	list := s.bands[grade]
+	for i := len(list) - 1; i >= 0; i-- {
+		if !list[i].effectiveDate.After(at) {
+			band := list[i]
+			return &band, true
+		}
+	}
+	return nil, false
+}
+
+// Bands returns the bands in effect at the given time, ordered from the lowest grade.
+func (s *PayStructure) Bands(at time.Time) []PayBand {
+	var out []PayBand
+	for _, grade := range []enum.GradeLevel{enum.GradeIntern, enum.GradeJunior, enum.GradeMid, enum.GradeSenior, enum.GradeLead, enum.GradeManager, enum.GradeDirector} {
+		if band, ok := s.Band(grade, at); ok {
+			out = append(out, *band)
+		}
+	}
+	return out
+}
+
+// ValidateRange checks that the salary range of a position of the grade lies within the
+// grade's band in effect at the given time.
+func (s *PayStructure) ValidateRange(grade enum.GradeLevel, salaryRange valueobject.SalaryRange, at time.Time) error {
+	band, ok := s.Band(grade, at)
+	if !ok {
+		return fmt.Errorf("no pay band for grade %s", grade)
+	}
+	return band.ValidateRange(salaryRange)
+}
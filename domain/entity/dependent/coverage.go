@@ -0,0 +1,37 @@
+package dependent_entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// One BPJS Kesehatan membership covers the employee and at most four family members: one
+// spouse and three children (PerPres No. 82/2018). Further family members need a
+// membership of their own.
+const (
+	MaxBPJSSpouses  = 1
+	MaxBPJSChildren = 3
+)
+
+// CheckBPJSCoverage returns an error when more dependents are covered by BPJS Kesehatan on
+// at than one membership allows. Children who aged out no longer take a place.
+func CheckBPJSCoverage(dependents []Dependent, at time.Time) error {
+	var spouses, children int
+	for i := range dependents {
+		if !dependents[i].CoveredByBPJS(at) {
+			continue
+		}
+		if dependents[i].IsSpouse() {
+			spouses++
+		} else {
+			children++
+		}
+	}
+	if spouses > MaxBPJSSpouses {
+		return fmt.Errorf("bpjs kesehatan covers at most %d spouse", MaxBPJSSpouses)
+	}
+	if children > MaxBPJSChildren {
+		return fmt.Errorf("bpjs kesehatan covers at most %d children", MaxBPJSChildren)
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package dependent_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"time"
+)
+
+// Age limits of children under PerPres No. 82/2018: a child is a dependent until
+// the age of 21, or 25 while in formal education, as long as they are unmarried and have
+// no income of their own.
+const (
+	ChildAgeLimit        = 21
+	StudentChildAgeLimit = 25
+)
+
+// Dependent is a family member of an employee. Whether they count towards the employee's
+// PTKP and whether they are covered by the employee's BPJS Kesehatan membership is
+// recorded per dependent and only applies to the relationships the rules allow.
+type Dependent struct {
+	id           uuid.UUID
+	employeeID   uuid.UUID
+	name         string
+	relationship enum.RelationshipType
+	birthDate    time.Time
+	nik          *valueobject.NIK
+	student      bool
+	ptkp         bool
+	bpjs         bool
+	endDate      *time.Time
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// ID returns the identifier of the dependent.
+func (d *Dependent) ID() uuid.UUID {
+	return d.id
+}
+
+// EmployeeID returns the employee the dependent belongs to.
+func (d *Dependent) EmployeeID() uuid.UUID {
+	return d.employeeID
+}
+
+// Name returns the dependent's full name.
+func (d *Dependent) Name() string {
+	return d.name
+}
+
+// Relationship returns how the dependent is related to the employee.
+func (d *Dependent) Relationship() enum.RelationshipType {
+	return d.relationship
+}
+
+// BirthDate returns the dependent's date of birth.
+func (d *Dependent) BirthDate() time.Time {
+	return d.birthDate
+}
+
+// NIK returns the dependent's NIK, nil when not registered yet, e.g. for a newborn.
+func (d *Dependent) NIK() *valueobject.NIK {
+	return d.nik
+}
+
+// Student reports whether the dependent is in formal education.
+func (d *Dependent) Student() bool {
+	return d.student
+}
+
+// PTKP reports whether the employee claims the dependent for PTKP.
+func (d *Dependent) PTKP() bool {
+	return d.ptkp
+}
+
+// BPJS reports whether the dependent is registered on the employee's BPJS Kesehatan.
+func (d *Dependent) BPJS() bool {
+	return d.bpjs
+}
+
+// EndDate returns the first day the person is no longer a dependent, nil while they are.
+func (d *Dependent) EndDate() *time.Time {
+	return d.endDate
+}
+
+// CreatedAt returns when the dependent was registered.
+func (d *Dependent) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+// UpdatedAt returns when the dependent was last changed.
+func (d *Dependent) UpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+// IsChild reports whether the dependent is a son or daughter of the employee.
+func (d *Dependent) IsChild() bool {
+	return d.relationship == enum.RelationshipSon || d.relationship == enum.RelationshipDaughter
+}
+
+// IsSpouse reports whether the dependent is the employee's wife or husband.
+func (d *Dependent) IsSpouse() bool {
+	return d.relationship == enum.RelationshipWife || d.relationship == enum.RelationshipHusband
+}
+
+// AgeOn returns the dependent's age in whole years on the calendar date of at.
+func (d *Dependent) AgeOn(at time.Time) int {
+	day := dateOf(at)
+	age := day.Year() - d.birthDate.Year()
+	if day.Month() < d.birthDate.Month() || (day.Month() == d.birthDate.Month() && day.Day() < d.birthDate.Day()) {
+		age--
+	}
+	return max(age, 0)
+}
+
+// IsDependentOn reports whether the person is a dependent on the calendar date of at: born,
+// not ended and, for children, below the age limit.
+func (d *Dependent) IsDependentOn(at time.Time) bool {
+	day := dateOf(at)
+	if day.Before(d.birthDate) || (d.endDate != nil && !day.Before(*d.endDate)) {
+		return false
+	}
+	if !d.IsChild() {
+		return true
+	}
+	limit := ChildAgeLimit
+	if d.student {
+		limit = StudentChildAgeLimit
+	}
+	return d.AgeOn(day) < limit
+}
+
+// CountsForPTKP reports whether the dependent counts towards the employee's PTKP on at.
+func (d *Dependent) CountsForPTKP(at time.Time) bool {
+	return d.ptkp && d.IsDependentOn(at)
+}
+
+// CoveredByBPJS reports whether the dependent is covered by BPJS Kesehatan on at.
+func (d *Dependent) CoveredByBPJS(at time.Time) bool {
+	return d.bpjs && d.IsDependentOn(at)
+}
+
+// SetStudent records whether the dependent is in formal education.
+func (d *Dependent) SetStudent(student bool, at time.Time) error {
+	if err := d.checkOpen(); err != nil {
+		return err
+	}
+	d.student = student
+	d.touch(at)
+	return nil
+}
+
+// SetPTKP records whether the employee claims the dependent for PTKP.
+func (d *Dependent) SetPTKP(claimed bool, at time.Time) error {
+	if err := d.checkOpen(); err != nil {
+		return err
+	}
+	if claimed && !PTKPEligible(d.relationship) {
+		return fmt.Errorf("%s cannot be claimed for ptkp", d.relationship)
+	}
+	d.ptkp = claimed
+	d.touch(at)
+	return nil
+}
+
+// SetBPJS records whether the dependent is registered on the employee's BPJS Kesehatan.
+func (d *Dependent) SetBPJS(covered bool, at time.Time) error {
+	if err := d.checkOpen(); err != nil {
+		return err
+	}
+	if covered && !BPJSEligible(d.relationship) {
+		return fmt.Errorf("%s cannot be covered by bpjs kesehatan", d.relationship)
+	}
+	d.bpjs = covered
+	d.touch(at)
+	return nil
+}
+
+// SetNIK records the dependent's NIK, e.g. once a newborn is on the Kartu Keluarga.
+func (d *Dependent) SetNIK(nik valueobject.NIK, at time.Time) error {
+	if nik.IsZero() {
+		return errors.New("nik cannot be empty")
+	}
+	d.nik = &nik
+	d.touch(at)
+	return nil
+}
+
+// End records the first day the person is no longer a dependent, e.g. after a divorce,
+// death or when a parent starts earning their own income.
+func (d *Dependent) End(date time.Time, at time.Time) error {
+	if err := d.checkOpen(); err != nil {
+		return err
+	}
+	if date.IsZero() {
+		return errors.New("end date cannot be empty")
+	}
+	end := dateOf(date)
+	if !end.After(d.birthDate) {
+		return errors.New("end date must be after the birth date")
+	}
+	d.endDate = &end
+	d.touch(at)
+	return nil
+}
+
+func (d *Dependent) checkOpen() error {
+	if d.endDate != nil {
+		return errors.New("dependent has ended")
+	}
+	return nil
+}
+
+func (d *Dependent) touch(at time.Time) {
+	if at.IsZero() {
+		at = time.Now()
+	}
+	d.updatedAt = at
+}
+
+// PTKPEligible reports whether the relationship may count towards PTKP. UU PPh Pasal 7
+// ayat (1) counts relatives by blood or marriage in a straight line, i.e. children,
+// parents and parents-in-law; the spouse is covered by the K status instead.
+func PTKPEligible(r enum.RelationshipType) bool {
+	switch r {
+	case enum.RelationshipSon, enum.RelationshipDaughter,
+		enum.RelationshipFather, enum.RelationshipMother,
+		enum.RelationshipFatherInLaw, enum.RelationshipMotherInLaw:
+		return true
+	}
+	return false
+}
+
+// BPJSEligible reports whether the relationship may be covered as a family member of the
+// employee's BPJS Kesehatan membership, i.e. the spouse and children.
+func BPJSEligible(r enum.RelationshipType) bool {
+	switch r {
+	case enum.RelationshipWife, enum.RelationshipHusband, enum.RelationshipSon, enum.RelationshipDaughter:
+		return true
+	}
+	return false
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
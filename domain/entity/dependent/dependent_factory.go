@@ -0,0 +1,90 @@
+package dependent_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"strings"
+	"time"
+)
+
+// DependentFactory is a factory type for creating Dependents. NIK is optional because a
+// newborn only gets one once registered on the Kartu Keluarga.
+type DependentFactory struct {
+	ID           string
+	EmployeeID   string
+	Name         string
+	Relationship string
+	BirthDate    time.Time
+	NIK          string
+	Student      bool
+	PTKP         bool
+	BPJS         bool
+	CreatedAt    time.Time
+}
+
+// Create validates the factory data and returns a new Dependent.
+func (f DependentFactory) Create() (*Dependent, error) {
+	id, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	employeeID, err := uuid.Parse(f.EmployeeID)
+	if err != nil {
+		return nil, errors.New("invalid employee id")
+	}
+
+	name := strings.Join(strings.Fields(f.Name), " ")
+	if name == "" {
+		return nil, errors.New("dependent name cannot be empty")
+	}
+
+	relationship, err := enum.ParseRelationshipType(f.Relationship)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	if f.BirthDate.IsZero() {
+		return nil, errors.New("birth date cannot be empty")
+	}
+	birthDate := dateOf(f.BirthDate)
+	if birthDate.After(f.CreatedAt) {
+		return nil, errors.New("birth date cannot be in the future")
+	}
+
+	var nik *valueobject.NIK
+	if strings.TrimSpace(f.NIK) != "" {
+		nik, err = valueobject.NewNIK(f.NIK)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if f.PTKP && !PTKPEligible(relationship) {
+		return nil, fmt.Errorf("%s cannot be claimed for ptkp", relationship)
+	}
+	if f.BPJS && !BPJSEligible(relationship) {
+		return nil, fmt.Errorf("%s cannot be covered by bpjs kesehatan", relationship)
+	}
+
+	return &Dependent{
+		id:           id,
+		employeeID:   employeeID,
+		name:         name,
+		relationship: relationship,
+		birthDate:    birthDate,
+		nik:          nik,
+		student:      f.Student,
+		ptkp:         f.PTKP,
+		bpjs:         f.BPJS,
+		createdAt:    f.CreatedAt,
+		updatedAt:    f.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,140 @@
+package dependent_entity_test
+
+import (
+	"github.com/google/uuid"
+	dependent_entity "github.com/rfanazhari/hris/domain/entity/dependent"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newDependent(t *testing.T, relationship string, birthDate time.Time, ptkp, bpjs bool) *dependent_entity.Dependent {
+	dependent, err := dependent_entity.DependentFactory{
+		ID:           uuid.NewString(),
+		EmployeeID:   uuid.NewString(),
+		Name:         "Rina",
+		Relationship: relationship,
+		BirthDate:    birthDate,
+		PTKP:         ptkp,
+		BPJS:         bpjs,
+		CreatedAt:    date(2025, 1, 1),
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return dependent
+}
+
+func TestDependentFactory_Create(t *testing.T) {
+	t.Run("ValidInput", func(t *testing.T) {
+		dependent, err := dependent_entity.DependentFactory{
+			ID:           uuid.NewString(),
+			EmployeeID:   uuid.NewString(),
+			Name:         " Rina   Putri ",
+			Relationship: "daughter",
+			BirthDate:    time.Date(2010, 7, 5, 14, 0, 0, 0, time.UTC),
+			NIK:          "3273.014507.10.0001",
+			PTKP:         true,
+			BPJS:         true,
+		}.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Rina Putri", dependent.Name())
+		assert.Equal(t, date(2010, 7, 5), dependent.BirthDate())
+		assert.Equal(t, "3273014507100001", dependent.NIK().String())
+		assert.True(t, dependent.IsChild())
+		assert.True(t, dependent.PTKP())
+		assert.True(t, dependent.BPJS())
+	})
+	t.Run("InvalidInput", func(t *testing.T) {
+		base := dependent_entity.DependentFactory{
+			ID: uuid.NewString(), EmployeeID: uuid.NewString(), Name: "Rina", Relationship: "daughter",
+			BirthDate: date(2010, 7, 5), CreatedAt: date(2025, 1, 1),
+		}
+		cases := []struct {
+			change func(f *dependent_entity.DependentFactory)
+			err    string
+		}{
+			{func(f *dependent_entity.DependentFactory) { f.ID = "uuid" }, "invalid format uuid"},
+			{func(f *dependent_entity.DependentFactory) { f.EmployeeID = "" }, "invalid employee id"},
+			{func(f *dependent_entity.DependentFactory) { f.Name = " " }, "dependent name cannot be empty"},
+			{func(f *dependent_entity.DependentFactory) { f.BirthDate = time.Time{} }, "birth date cannot be empty"},
+			{func(f *dependent_entity.DependentFactory) { f.BirthDate = date(2025, 2, 1) }, "birth date cannot be in the future"},
+			{func(f *dependent_entity.DependentFactory) { f.NIK = "123" }, "nik must be 16 digits"},
+			{func(f *dependent_entity.DependentFactory) { f.Relationship, f.PTKP = "wife", true }, "wife cannot be claimed for ptkp"},
+			{func(f *dependent_entity.DependentFactory) { f.Relationship, f.BPJS = "mother", true }, "mother cannot be covered by bpjs kesehatan"},
+		}
+		for _, c := range cases {
+			f := base
+			c.change(&f)
+			_, err := f.Create()
+			assert.EqualError(t, err, c.err)
+		}
+	})
+}
+
+func TestDependent_IsDependentOn(t *testing.T) {
+	t.Run("ChildAgesOut", func(t *testing.T) {
+		child := newDependent(t, "son", date(2004, 3, 10), true, true)
+
+		assert.Equal(t, 20, child.AgeOn(date(2025, 3, 9)))
+		assert.True(t, child.CountsForPTKP(date(2025, 3, 9)))
+		assert.Equal(t, 21, child.AgeOn(date(2025, 3, 10)))
+		assert.False(t, child.CountsForPTKP(date(2025, 3, 10)))
+		assert.False(t, child.CoveredByBPJS(date(2025, 3, 10)))
+
+		assert.Nil(t, child.SetStudent(true, time.Time{}))
+		assert.True(t, child.CoveredByBPJS(date(2029, 3, 9)))
+		assert.False(t, child.CoveredByBPJS(date(2029, 3, 10)))
+	})
+	t.Run("ParentsDoNotAgeOut", func(t *testing.T) {
+		mother := newDependent(t, "mother", date(1950, 1, 1), true, false)
+
+		assert.True(t, mother.CountsForPTKP(date(2025, 6, 1)))
+		assert.False(t, mother.CoveredByBPJS(date(2025, 6, 1)))
+		assert.EqualError(t, mother.SetBPJS(true, time.Time{}), "mother cannot be covered by bpjs kesehatan")
+	})
+	t.Run("End", func(t *testing.T) {
+		wife := newDependent(t, "wife", date(1990, 1, 1), false, true)
+
+		assert.EqualError(t, wife.End(date(1989, 1, 1), time.Time{}), "end date must be after the birth date")
+		assert.Nil(t, wife.End(date(2025, 6, 1), time.Time{}))
+		assert.True(t, wife.CoveredByBPJS(date(2025, 5, 31)))
+		assert.False(t, wife.CoveredByBPJS(date(2025, 6, 1)))
+		assert.EqualError(t, wife.SetBPJS(false, time.Time{}), "dependent has ended")
+	})
+	t.Run("NIK", func(t *testing.T) {
+		baby := newDependent(t, "daughter", date(2024, 12, 20), false, false)
+		nik, _ := valueobject.NewNIK("3273016012240001")
+
+		assert.Nil(t, baby.NIK())
+		assert.Nil(t, baby.SetNIK(*nik, time.Time{}))
+		assert.Equal(t, nik.String(), baby.NIK().String())
+	})
+}
+
+func TestCheckBPJSCoverage(t *testing.T) {
+	at := date(2025, 6, 1)
+	children := []dependent_entity.Dependent{
+		*newDependent(t, "wife", date(1990, 1, 1), false, true),
+		*newDependent(t, "son", date(2012, 1, 1), true, true),
+		*newDependent(t, "daughter", date(2014, 1, 1), true, true),
+		*newDependent(t, "son", date(2016, 1, 1), true, true),
+	}
+	assert.Nil(t, dependent_entity.CheckBPJSCoverage(children, at))
+
+	fourth := append(children, *newDependent(t, "daughter", date(2020, 1, 1), false, true))
+	assert.EqualError(t, dependent_entity.CheckBPJSCoverage(fourth, at), "bpjs kesehatan covers at most 3 children")
+
+	// Once the eldest is over 21 the youngest takes their place.
+	fourth[1] = *newDependent(t, "son", date(2003, 1, 1), true, true)
+	assert.Nil(t, dependent_entity.CheckBPJSCoverage(fourth, at))
+
+	second := append(children[:1:1], *newDependent(t, "wife", date(1992, 1, 1), false, true))
+	assert.EqualError(t, dependent_entity.CheckBPJSCoverage(second, at), "bpjs kesehatan covers at most 1 spouse")
+}
@@ -0,0 +1,50 @@
+package disbursement_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"strings"
+)
+
+// Transfer is one credit of a bulk salary transfer.
+type Transfer struct {
+	employeeID  uuid.UUID
+	account     valueobject.BankAccount
+	amount      int64
+	description string
+}
+
+// NewTransfer constructs a Transfer with validation.
+func NewTransfer(employeeID uuid.UUID, account valueobject.BankAccount, amount int64, description string) (*Transfer, error) {
+	if employeeID == uuid.Nil {
+		return nil, errors.New("invalid employee id")
+	}
+	if account.IsZero() {
+		return nil, errors.New("invalid bank account")
+	}
+	if amount <= 0 {
+		return nil, errors.New("transfer amount must be positive")
+	}
+	return &Transfer{employeeID: employeeID, account: account, amount: amount, description: strings.TrimSpace(description)}, nil
+}
+
+// EmployeeID returns the employee being paid.
+func (t Transfer) EmployeeID() uuid.UUID {
+	return t.employeeID
+}
+
+// Account returns the account credited.
+func (t Transfer) Account() valueobject.BankAccount {
+	return t.account
+}
+
+// Amount returns the amount credited.
+func (t Transfer) Amount() int64 {
+	return t.amount
+}
+
+// Description returns the remark shown on the employee's statement.
+func (t Transfer) Description() string {
+	return t.description
+}
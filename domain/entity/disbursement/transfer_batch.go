@@ -0,0 +1,78 @@
+package disbursement_entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"time"
+)
+
+// TransferBatch is the bulk transfer paying the net pay of an approved payroll run from the
+// company's debit account.
+type TransferBatch struct {
+	reference    string
+	runID        uuid.UUID
+	debitAccount valueobject.BankAccount
+	valueDate    time.Time
+	currency     string
+	transfers    []Transfer
+}
+
+// Reference returns the batch reference printed in the file header.
+func (b TransferBatch) Reference() string {
+	return b.reference
+}
+
+// RunID returns the payroll run being paid.
+func (b TransferBatch) RunID() uuid.UUID {
+	return b.runID
+}
+
+// DebitAccount returns the company account debited.
+func (b TransferBatch) DebitAccount() valueobject.BankAccount {
+	return b.debitAccount
+}
+
+// ValueDate returns the date the transfers are executed.
+func (b TransferBatch) ValueDate() time.Time {
+	return b.valueDate
+}
+
+// Currency returns the currency of the transfers.
+func (b TransferBatch) Currency() string {
+	return b.currency
+}
+
+// Transfers returns a copy of the transfers.
+func (b TransferBatch) Transfers() []Transfer {
+	out := make([]Transfer, len(b.transfers))
+	copy(out, b.transfers)
+	return out
+}
+
+// Count returns the number of transfers, the control count of the file trailer.
+func (b TransferBatch) Count() int {
+	return len(b.transfers)
+}
+
+// Total returns the sum of the transfers, the control total of the file trailer.
+func (b TransferBatch) Total() int64 {
+	var total int64
+	for _, t := range b.transfers {
+		total += t.amount
+	}
+	return total
+}
+
+// ForBank returns the part of the batch crediting accounts at the given bank, for banks
+// whose payroll files only credit their own accounts.
+func (b TransferBatch) ForBank(bank enum.Bank) TransferBatch {
+	out := b
+	out.transfers = nil
+	for _, t := range b.transfers {
+		if t.account.Bank() == bank {
+			out.transfers = append(out.transfers, t)
+		}
+	}
+	return out
+}
@@ -0,0 +1,76 @@
+package disbursement_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"strings"
+	"time"
+)
+
+// TransferBatchFactory creates TransferBatch instances.
+type TransferBatchFactory struct {
+	Reference    string
+	RunID        string
+	DebitAccount valueobject.BankAccount
+	ValueDate    time.Time
+	Currency     string
+	Transfers    []Transfer
+}
+
+// Create validates the factory input and returns the batch. An employee can only be paid
+// once per batch.
+func (f TransferBatchFactory) Create() (*TransferBatch, error) {
+	reference := strings.TrimSpace(f.Reference)
+	if reference == "" {
+		return nil, errors.New("reference cannot be empty")
+	}
+	if len(reference) > 20 {
+		return nil, errors.New("reference cannot exceed 20 characters")
+	}
+
+	runID, err := uuid.Parse(f.RunID)
+	if err != nil {
+		return nil, errors.New("invalid payroll run id")
+	}
+
+	if f.DebitAccount.IsZero() {
+		return nil, errors.New("debit account cannot be empty")
+	}
+	if f.ValueDate.IsZero() {
+		return nil, errors.New("value date cannot be empty")
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(f.Currency))
+	if currency == "" {
+		currency = "IDR"
+	}
+	if len(currency) != 3 {
+		return nil, errors.New("invalid currency code")
+	}
+
+	if len(f.Transfers) == 0 {
+		return nil, errors.New("batch has no transfers")
+	}
+	seen := map[uuid.UUID]bool{}
+	for _, t := range f.Transfers {
+		if t.employeeID == uuid.Nil {
+			return nil, errors.New("invalid transfer")
+		}
+		if seen[t.employeeID] {
+			return nil, errors.New("duplicate transfer for employee")
+		}
+		seen[t.employeeID] = true
+	}
+
+	transfers := make([]Transfer, len(f.Transfers))
+	copy(transfers, f.Transfers)
+	return &TransferBatch{
+		reference:    reference,
+		runID:        runID,
+		debitAccount: f.DebitAccount,
+		valueDate:    time.Date(f.ValueDate.Year(), f.ValueDate.Month(), f.ValueDate.Day(), 0, 0, 0, 0, time.UTC),
+		currency:     currency,
+		transfers:    transfers,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package disbursement_entity_test
+
+import (
+	"github.com/google/uuid"
+	disbursement_entity "github.com/rfanazhari/hris/domain/entity/disbursement"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func account(t *testing.T, bank enum.Bank, number string) valueobject.BankAccount {
+	a, err := valueobject.NewBankAccount(bank, number, "Rina Wijaya")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return *a
+}
+
+func transfer(t *testing.T, a valueobject.BankAccount, amount int64) disbursement_entity.Transfer {
+	tr, err := disbursement_entity.NewTransfer(uuid.New(), a, amount, "Gaji April 2025")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return *tr
+}
+
+func TestTransferBatchFactory_Create(t *testing.T) {
+	bca := transfer(t, account(t, enum.BankBCA, "1234567890"), 7_500_000)
+	mandiri := transfer(t, account(t, enum.BankMandiri, "1230001234567"), 4_250_000)
+	valid := disbursement_entity.TransferBatchFactory{
+		Reference:    "PAYROLL-202504",
+		RunID:        uuid.NewString(),
+		DebitAccount: account(t, enum.BankBCA, "0987654321"),
+		ValueDate:    time.Date(2025, 4, 25, 10, 0, 0, 0, time.UTC),
+		Transfers:    []disbursement_entity.Transfer{bca, mandiri},
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		batch, err := valid.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "IDR", batch.Currency())
+		assert.Equal(t, time.Date(2025, 4, 25, 0, 0, 0, 0, time.UTC), batch.ValueDate())
+		assert.Equal(t, 2, batch.Count())
+		assert.Equal(t, int64(11_750_000), batch.Total())
+
+		onlyBCA := batch.ForBank(enum.BankBCA)
+		assert.Equal(t, 1, onlyBCA.Count())
+		assert.Equal(t, int64(7_500_000), onlyBCA.Total())
+		assert.Equal(t, 2, batch.Count())
+	})
+	t.Run("Duplicate", func(t *testing.T) {
+		f := valid
+		f.Transfers = []disbursement_entity.Transfer{bca, bca}
+
+		_, err := f.Create()
+
+		assert.EqualError(t, err, "duplicate transfer for employee")
+	})
+	t.Run("Empty", func(t *testing.T) {
+		f := valid
+		f.Transfers = nil
+
+		_, err := f.Create()
+
+		assert.EqualError(t, err, "batch has no transfers")
+	})
+	t.Run("LongReference", func(t *testing.T) {
+		f := valid
+		f.Reference = "PAYROLL-APRIL-2025-HEAD-OFFICE"
+
+		_, err := f.Create()
+
+		assert.EqualError(t, err, "reference cannot exceed 20 characters")
+	})
+	t.Run("NoDebitAccount", func(t *testing.T) {
+		f := valid
+		f.DebitAccount = valueobject.BankAccount{}
+
+		_, err := f.Create()
+
+		assert.EqualError(t, err, "debit account cannot be empty")
+	})
+	t.Run("InvalidTransfer", func(t *testing.T) {
+		_, err := disbursement_entity.NewTransfer(uuid.New(), account(t, enum.BankBNI, "0123456789"), 0, "")
+		assert.EqualError(t, err, "transfer amount must be positive")
+		_, err = disbursement_entity.NewTransfer(uuid.New(), valueobject.BankAccount{}, 1, "")
+		assert.EqualError(t, err, "invalid bank account")
+	})
+}
@@ -0,0 +1,70 @@
+package document_entity
+
+import (
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"time"
+)
+
+// DocumentVersion represents one revision of a managed document together with
+// the signers that must approve that exact file.
+type DocumentVersion struct {
+	number    int
+	file      valueobject.FileReference
+	signers   []Signer
+	createdAt time.Time
+	lockedAt  *time.Time
+}
+
+// Number returns the 1-based revision number.
+func (v DocumentVersion) Number() int { return v.number }
+
+// File returns the file reference of this revision.
+func (v DocumentVersion) File() valueobject.FileReference { return v.file }
+
+// Signers returns a copy of the signers assigned to this revision.
+func (v DocumentVersion) Signers() []Signer {
+	out := make([]Signer, len(v.signers))
+	for i, s := range v.signers {
+		out[i] = s.clone()
+	}
+	return out
+}
+
+// CreatedAt returns when the revision was added.
+func (v DocumentVersion) CreatedAt() time.Time { return v.createdAt }
+
+// LockedAt returns when the revision became fully signed, nil if not locked.
+func (v DocumentVersion) LockedAt() *time.Time { return copyTime(v.lockedAt) }
+
+// IsLocked reports whether the revision is fully signed and can no longer change.
+func (v DocumentVersion) IsLocked() bool { return v.lockedAt != nil }
+
+// Status summarizes the signers of the revision:
+// rejected if any signer rejected, signed if every signer signed, pending otherwise.
+// A revision without signers is pending.
+func (v DocumentVersion) Status() enum.SignatureStatus {
+	if len(v.signers) == 0 {
+		return enum.SignaturePending
+	}
+	signed := 0
+	for _, s := range v.signers {
+		switch s.status {
+		case enum.SignatureRejected:
+			return enum.SignatureRejected
+		case enum.SignatureSigned:
+			signed++
+		}
+	}
+	if signed == len(v.signers) {
+		return enum.SignatureSigned
+	}
+	return enum.SignaturePending
+}
+
+// clone returns a copy of the revision that shares no signers or times with it.
+func (v DocumentVersion) clone() DocumentVersion {
+	v.signers = v.Signers()
+	v.lockedAt = copyTime(v.lockedAt)
+	return v
+}
@@ -0,0 +1,212 @@
+package document_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"strings"
+	"time"
+)
+
+// ManagedDocument represents a document that goes through drafts, revisions and
+// signatures (e.g. contracts and offering letters). Every revision keeps its own
+// FileReference and signers; once all signers of the latest revision have signed,
+// that revision is locked and the document can no longer change.
+type ManagedDocument struct {
+	id        uuid.UUID
+	kind      enum.DocumentType
+	title     string
+	versions  []DocumentVersion
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// ID returns the unique identifier of the document.
+func (d *ManagedDocument) ID() uuid.UUID {
+	return d.id
+}
+
+// Kind returns the document type.
+func (d *ManagedDocument) Kind() enum.DocumentType {
+	return d.kind
+}
+
+// Title returns the human readable title of the document.
+func (d *ManagedDocument) Title() string {
+	return d.title
+}
+
+// Versions returns a copy of the revision history, oldest first.
+func (d *ManagedDocument) Versions() []DocumentVersion {
+	out := make([]DocumentVersion, len(d.versions))
+	for i, v := range d.versions {
+		out[i] = v.clone()
+	}
+	return out
+}
+
+// CurrentVersion returns the latest revision.
+func (d *ManagedDocument) CurrentVersion() DocumentVersion {
+	return d.versions[len(d.versions)-1].clone()
+}
+
+// Version returns the revision with the given number, if it exists.
+func (d *ManagedDocument) Version(number int) (DocumentVersion, bool) {
+	if number < 1 || number > len(d.versions) {
+		return DocumentVersion{}, false
+	}
+	return d.versions[number-1].clone(), true
+}
+
+// Status returns the signature status of the latest revision.
+func (d *ManagedDocument) Status() enum.SignatureStatus {
+	return d.CurrentVersion().Status()
+}
+
+// IsLocked reports whether the latest revision is fully signed.
+func (d *ManagedDocument) IsLocked() bool {
+	return d.CurrentVersion().IsLocked()
+}
+
+// CreatedAt returns the timestamp when the document was created.
+func (d *ManagedDocument) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+// UpdatedAt returns the timestamp of the last change to the document.
+func (d *ManagedDocument) UpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+// AddVersion appends a new revision with the given file. Signers of the previous
+// revision are carried over and reset to pending so they sign the new file.
+// Rejected revisions may be superseded; locked (fully signed) ones may not.
+func (d *ManagedDocument) AddVersion(file valueobject.FileReference, at time.Time) error {
+	if d.IsLocked() {
+		return errors.New("document is locked")
+	}
+	if file.URL() == "" || file.Filename() == "" || file.MimeType() == "" {
+		return errors.New("invalid file reference")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	prev := d.CurrentVersion()
+	signers := make([]Signer, len(prev.signers))
+	for i, s := range prev.signers {
+		signers[i] = Signer{name: s.name, email: s.email, status: enum.SignaturePending}
+	}
+
+	d.versions = append(d.versions, DocumentVersion{
+		number:    prev.number + 1,
+		file:      file,
+		signers:   signers,
+		createdAt: at,
+	})
+	d.updatedAt = at
+	return nil
+}
+
+// AddSigner registers a pending signer on the latest revision.
+func (d *ManagedDocument) AddSigner(name string, email valueobject.EmailAddress, at time.Time) error {
+	v := &d.versions[len(d.versions)-1]
+	if v.IsLocked() {
+		return errors.New("document is locked")
+	}
+	if v.Status() == enum.SignatureRejected {
+		return errors.New("version has been rejected")
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("signer name cannot be empty")
+	}
+	if email.Full() == "@" {
+		return errors.New("invalid signer email")
+	}
+	if v.indexOf(email) >= 0 {
+		return errors.New("signer already exists")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	v.signers = append(v.signers, Signer{name: name, email: email, status: enum.SignaturePending})
+	d.updatedAt = at
+	return nil
+}
+
+// Sign records the signature of the given signer on the latest revision. When the
+// last pending signer signs, the revision is locked.
+func (d *ManagedDocument) Sign(email valueobject.EmailAddress, at time.Time) error {
+	v, s, err := d.pendingSigner(email)
+	if err != nil {
+		return err
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	s.status = enum.SignatureSigned
+	s.decidedAt = &at
+	if v.Status() == enum.SignatureSigned {
+		v.lockedAt = &at
+	}
+	d.updatedAt = at
+	return nil
+}
+
+// Reject records that the given signer refused to sign the latest revision.
+// A new revision has to be added before signing can continue.
+func (d *ManagedDocument) Reject(email valueobject.EmailAddress, reason string, at time.Time) error {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return errors.New("rejection reason cannot be empty")
+	}
+	_, s, err := d.pendingSigner(email)
+	if err != nil {
+		return err
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	s.status = enum.SignatureRejected
+	s.reason = reason
+	s.decidedAt = &at
+	d.updatedAt = at
+	return nil
+}
+
+// Document returns the latest revision as an immutable valueobject.Document.
+func (d *ManagedDocument) Document(validity valueobject.ValidityPeriodDocument) (*valueobject.Document, error) {
+	return valueobject.NewDocument(d.kind, d.CurrentVersion().file, validity)
+}
+
+func (d *ManagedDocument) pendingSigner(email valueobject.EmailAddress) (*DocumentVersion, *Signer, error) {
+	v := &d.versions[len(d.versions)-1]
+	if v.IsLocked() {
+		return nil, nil, errors.New("document is locked")
+	}
+	if v.Status() == enum.SignatureRejected {
+		return nil, nil, errors.New("version has been rejected")
+	}
+	i := v.indexOf(email)
+	if i < 0 {
+		return nil, nil, errors.New("signer not found")
+	}
+	if v.signers[i].status != enum.SignaturePending {
+		return nil, nil, errors.New("signer has already signed")
+	}
+	return v, &v.signers[i], nil
+}
+
+func (v *DocumentVersion) indexOf(email valueobject.EmailAddress) int {
+	for i, s := range v.signers {
+		if strings.EqualFold(s.email.Full(), email.Full()) {
+			return i
+		}
+	}
+	return -1
+}
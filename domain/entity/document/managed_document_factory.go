@@ -0,0 +1,63 @@
+package document_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/rfanazhari/hris/pkg/validation"
+	"strings"
+	"time"
+)
+
+// ManagedDocumentFactory is a factory type for creating ManagedDocument instances
+// with their first revision.
+type ManagedDocumentFactory struct {
+	ID        string
+	Kind      string
+	Title     string
+	File      valueobject.FileReference
+	CreatedAt time.Time
+}
+
+// Create validates the factory data and returns a ManagedDocument holding version 1.
+func (f ManagedDocumentFactory) Create() (*ManagedDocument, error) {
+	newUUID, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	kind, err := enum.ParseDocumentType(f.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	title := strings.TrimSpace(f.Title)
+	if title == "" {
+		return nil, errors.New("title cannot be empty")
+	}
+	if len(title) < 3 {
+		return nil, validation.CharacterLong("title", 3)
+	}
+
+	if f.File.URL() == "" || f.File.Filename() == "" || f.File.MimeType() == "" {
+		return nil, errors.New("invalid file reference")
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	return &ManagedDocument{
+		id:    newUUID,
+		kind:  kind,
+		title: title,
+		versions: []DocumentVersion{{
+			number:    1,
+			file:      f.File,
+			createdAt: f.CreatedAt,
+		}},
+		createdAt: f.CreatedAt,
+		updatedAt: f.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package document_entity_test
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	document_entity "github.com/rfanazhari/hris/domain/entity/document"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/rfanazhari/hris/pkg/validation"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestManagedDocumentFactory_Create(t *testing.T) {
+	file, _ := valueobject.NewFileReference("https://storage.example.com/docs/pkwt-v1.pdf", "pkwt-v1.pdf", "application/pdf")
+
+	t.Run("ValidInput", func(t *testing.T) {
+		factory := document_entity.ManagedDocumentFactory{
+			ID:        uuid.NewString(),
+			Kind:      "pkwt",
+			Title:     "PKWT Budi Santoso",
+			File:      *file,
+			CreatedAt: time.Time{},
+		}
+
+		doc, err := factory.Create()
+
+		assert.Nil(t, err)
+		assert.NotNil(t, doc)
+		assert.Equal(t, enum.DocPKWT, doc.Kind())
+		assert.Equal(t, factory.Title, doc.Title())
+		assert.Len(t, doc.Versions(), 1)
+		assert.Equal(t, 1, doc.CurrentVersion().Number())
+		assert.Equal(t, *file, doc.CurrentVersion().File())
+		assert.Equal(t, enum.SignaturePending, doc.Status())
+		assert.False(t, doc.IsLocked())
+		assert.False(t, doc.CreatedAt().IsZero())
+	})
+	t.Run("InvalidID", func(t *testing.T) {
+		factory := document_entity.ManagedDocumentFactory{ID: "uuid", Kind: "pkwt", Title: "PKWT", File: *file}
+
+		doc, err := factory.Create()
+
+		assert.Nil(t, doc)
+		assert.EqualError(t, err, "invalid format uuid")
+	})
+	t.Run("InvalidKind", func(t *testing.T) {
+		factory := document_entity.ManagedDocumentFactory{ID: uuid.NewString(), Kind: "contract", Title: "PKWT", File: *file}
+
+		doc, err := factory.Create()
+
+		assert.Nil(t, doc)
+		assert.EqualError(t, err, fmt.Errorf("invalid DocumentType: %q", "contract").Error())
+	})
+	t.Run("EmptyTitle", func(t *testing.T) {
+		factory := document_entity.ManagedDocumentFactory{ID: uuid.NewString(), Kind: "nda", Title: "  ", File: *file}
+
+		doc, err := factory.Create()
+
+		assert.Nil(t, doc)
+		assert.EqualError(t, err, "title cannot be empty")
+	})
+	t.Run("InvalidCharLengthTitle", func(t *testing.T) {
+		factory := document_entity.ManagedDocumentFactory{ID: uuid.NewString(), Kind: "nda", Title: "ND", File: *file}
+
+		doc, err := factory.Create()
+
+		assert.Nil(t, doc)
+		assert.EqualError(t, err, validation.CharacterLong("title", 3).Error())
+	})
+	t.Run("InvalidFile", func(t *testing.T) {
+		factory := document_entity.ManagedDocumentFactory{ID: uuid.NewString(), Kind: "nda", Title: "NDA Budi"}
+
+		doc, err := factory.Create()
+
+		assert.Nil(t, doc)
+		assert.EqualError(t, err, "invalid file reference")
+	})
+}
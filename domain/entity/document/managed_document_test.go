@@ -0,0 +1,119 @@
+package document_entity_test
+
+import (
+	"github.com/google/uuid"
+	document_entity "github.com/rfanazhari/hris/domain/entity/document"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func newManagedDocument(t *testing.T) *document_entity.ManagedDocument {
+	file, _ := valueobject.NewFileReference("https://storage.example.com/docs/offer-v1.pdf", "offer-v1.pdf", "application/pdf")
+	doc, err := document_entity.ManagedDocumentFactory{
+		ID:        uuid.NewString(),
+		Kind:      "offering_letter",
+		Title:     "Offering Letter Siti",
+		File:      *file,
+		CreatedAt: time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC),
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return doc
+}
+
+func TestManagedDocument_Signing(t *testing.T) {
+	hr, _ := valueobject.NewEmailAddress("hr", "example.com")
+	siti, _ := valueobject.NewEmailAddress("siti", "example.com")
+	at := time.Date(2025, 1, 3, 10, 0, 0, 0, time.UTC)
+
+	t.Run("LocksWhenFullySigned", func(t *testing.T) {
+		doc := newManagedDocument(t)
+		assert.Nil(t, doc.AddSigner("HR Manager", *hr, at))
+		assert.Nil(t, doc.AddSigner("Siti", *siti, at))
+
+		assert.Nil(t, doc.Sign(*hr, at))
+		assert.Equal(t, enum.SignaturePending, doc.Status())
+		assert.False(t, doc.IsLocked())
+
+		assert.Nil(t, doc.Sign(*siti, at.Add(time.Hour)))
+		assert.Equal(t, enum.SignatureSigned, doc.Status())
+		assert.True(t, doc.IsLocked())
+		assert.Equal(t, at.Add(time.Hour), *doc.CurrentVersion().LockedAt())
+
+		signers := doc.CurrentVersion().Signers()
+		assert.Equal(t, enum.SignatureSigned, signers[0].Status())
+		assert.Equal(t, at, *signers[0].DecidedAt())
+	})
+	t.Run("LockedDocumentRejectsChanges", func(t *testing.T) {
+		doc := newManagedDocument(t)
+		_ = doc.AddSigner("HR Manager", *hr, at)
+		_ = doc.Sign(*hr, at)
+
+		file, _ := valueobject.NewFileReference("https://storage.example.com/docs/offer-v2.pdf", "offer-v2.pdf", "application/pdf")
+		assert.EqualError(t, doc.AddVersion(*file, at), "document is locked")
+		assert.EqualError(t, doc.AddSigner("Siti", *siti, at), "document is locked")
+		assert.EqualError(t, doc.Sign(*hr, at), "document is locked")
+	})
+	t.Run("RejectRequiresNewVersion", func(t *testing.T) {
+		doc := newManagedDocument(t)
+		_ = doc.AddSigner("HR Manager", *hr, at)
+		_ = doc.AddSigner("Siti", *siti, at)
+		_ = doc.Sign(*hr, at)
+
+		assert.EqualError(t, doc.Reject(*siti, " ", at), "rejection reason cannot be empty")
+		assert.Nil(t, doc.Reject(*siti, "salary differs from agreement", at))
+		assert.Equal(t, enum.SignatureRejected, doc.Status())
+		assert.EqualError(t, doc.Sign(*siti, at), "version has been rejected")
+
+		file, _ := valueobject.NewFileReference("https://storage.example.com/docs/offer-v2.pdf", "offer-v2.pdf", "application/pdf")
+		assert.Nil(t, doc.AddVersion(*file, at.Add(24*time.Hour)))
+		assert.Len(t, doc.Versions(), 2)
+		assert.Equal(t, 2, doc.CurrentVersion().Number())
+		assert.Equal(t, enum.SignaturePending, doc.Status())
+		for _, s := range doc.CurrentVersion().Signers() {
+			assert.Equal(t, enum.SignaturePending, s.Status())
+			assert.Nil(t, s.DecidedAt())
+		}
+
+		first, ok := doc.Version(1)
+		assert.True(t, ok)
+		assert.Equal(t, enum.SignatureRejected, first.Status())
+		assert.Equal(t, "salary differs from agreement", first.Signers()[1].Reason())
+	})
+	t.Run("AccessorsReturnCopies", func(t *testing.T) {
+		doc := newManagedDocument(t)
+		_ = doc.AddSigner("HR Manager", *hr, at)
+		_ = doc.Sign(*hr, at)
+
+		*doc.CurrentVersion().LockedAt() = at.AddDate(1, 0, 0)
+		*doc.Versions()[0].Signers()[0].DecidedAt() = at.AddDate(1, 0, 0)
+
+		assert.Equal(t, at, *doc.CurrentVersion().LockedAt())
+		assert.Equal(t, at, *doc.CurrentVersion().Signers()[0].DecidedAt())
+	})
+	t.Run("SignerErrors", func(t *testing.T) {
+		doc := newManagedDocument(t)
+		assert.EqualError(t, doc.AddSigner("", *hr, at), "signer name cannot be empty")
+		assert.EqualError(t, doc.AddSigner("HR", valueobject.EmailAddress{}, at), "invalid signer email")
+		assert.Nil(t, doc.AddSigner("HR", *hr, at))
+		assert.EqualError(t, doc.AddSigner("HR again", *hr, at), "signer already exists")
+		assert.EqualError(t, doc.Sign(*siti, at), "signer not found")
+		_ = doc.AddSigner("Siti", *siti, at)
+		_ = doc.Sign(*hr, at)
+		assert.EqualError(t, doc.Sign(*hr, at), "signer has already signed")
+	})
+	t.Run("Document", func(t *testing.T) {
+		doc := newManagedDocument(t)
+		validity, _ := valueobject.NewValidityPeriodDocument(at, nil)
+
+		d, err := doc.Document(*validity)
+
+		assert.Nil(t, err)
+		assert.Equal(t, enum.DocOfferingLetter, d.Kind())
+		assert.Equal(t, doc.CurrentVersion().File(), d.File())
+	})
+}
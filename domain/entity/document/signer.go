@@ -0,0 +1,45 @@
+package document_entity
+
+import (
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"time"
+)
+
+// Signer represents a party that must sign a specific document version.
+// A signer starts as pending and moves exactly once to signed or rejected.
+type Signer struct {
+	name      string
+	email     valueobject.EmailAddress
+	status    enum.SignatureStatus
+	reason    string
+	decidedAt *time.Time
+}
+
+// Name returns the display name of the signer.
+func (s Signer) Name() string { return s.name }
+
+// Email returns the email address used to identify the signer.
+func (s Signer) Email() valueobject.EmailAddress { return s.email }
+
+// Status returns the current signature status of the signer.
+func (s Signer) Status() enum.SignatureStatus { return s.status }
+
+// Reason returns the rejection reason, empty unless the signer rejected.
+func (s Signer) Reason() string { return s.reason }
+
+// DecidedAt returns when the signer signed or rejected, nil while pending.
+func (s Signer) DecidedAt() *time.Time { return copyTime(s.decidedAt) }
+
+func (s Signer) clone() Signer {
+	s.decidedAt = copyTime(s.decidedAt)
+	return s
+}
+
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
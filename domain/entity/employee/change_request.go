@@ -0,0 +1,200 @@
+package employee_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"strings"
+	"time"
+)
+
+// ChangeRequest is an employee's proposal to change their own personal or contact data,
+// reviewed by HR before it takes effect. The diff is applied to the employee's data as it
+// is when the request is approved and validated by the same factories as any other change.
+type ChangeRequest struct {
+	id           uuid.UUID
+	employeeID   uuid.UUID
+	personal     *PersonalInfoDiff
+	contact      *ContactInfoDiff
+	fields       []string
+	documents    []valueobject.FileReference
+	reason       string
+	status       enum.ChangeRequestStatus
+	decidedBy    *uuid.UUID
+	decidedAt    *time.Time
+	decisionNote string
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// ID returns the unique identifier of the change request.
+func (r *ChangeRequest) ID() uuid.UUID {
+	return r.id
+}
+
+// EmployeeID returns the employee whose data changes.
+func (r *ChangeRequest) EmployeeID() uuid.UUID {
+	return r.employeeID
+}
+
+// Personal returns the personal data changes, if any.
+func (r *ChangeRequest) Personal() *PersonalInfoDiff {
+	if r.personal == nil {
+		return nil
+	}
+	d := *r.personal
+	return &d
+}
+
+// Contact returns the contact data changes, if any.
+func (r *ChangeRequest) Contact() *ContactInfoDiff {
+	if r.contact == nil {
+		return nil
+	}
+	d := *r.contact
+	return &d
+}
+
+// Fields returns the names of the fields the request changes, e.g. "marital_status".
+func (r *ChangeRequest) Fields() []string {
+	return append([]string(nil), r.fields...)
+}
+
+// Documents returns the supporting documents, e.g. a marriage certificate.
+func (r *ChangeRequest) Documents() []valueobject.FileReference {
+	return append([]valueobject.FileReference(nil), r.documents...)
+}
+
+// Reason returns why the employee asks for the change.
+func (r *ChangeRequest) Reason() string {
+	return r.reason
+}
+
+// Status returns the current status of the request.
+func (r *ChangeRequest) Status() enum.ChangeRequestStatus {
+	return r.status
+}
+
+// DecidedBy returns the reviewer who decided the request, if any.
+func (r *ChangeRequest) DecidedBy() *uuid.UUID {
+	return copyOf(r.decidedBy)
+}
+
+// DecidedAt returns when the request was decided, if it was.
+func (r *ChangeRequest) DecidedAt() *time.Time {
+	return copyOf(r.decidedAt)
+}
+
+// DecisionNote returns the reason given for a rejection.
+func (r *ChangeRequest) DecisionNote() string {
+	return r.decisionNote
+}
+
+// CreatedAt returns when the request was made.
+func (r *ChangeRequest) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// UpdatedAt returns when the request was last changed.
+func (r *ChangeRequest) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// Proposed returns the employee's personal and contact data with the changes applied.
+func (r *ChangeRequest) Proposed(employee *Employee) (*PersonalInfo, *ContactInfo, error) {
+	if employee == nil || employee.id != r.employeeID {
+		return nil, nil, errors.New("change request belongs to another employee")
+	}
+	return proposed(employee, r.personal, r.contact)
+}
+
+// Approve applies the changes to the employee and marks the request approved. Either
+// every change is applied or, when the changed data no longer validates, none is.
+func (r *ChangeRequest) Approve(reviewerID uuid.UUID, employee *Employee, at time.Time) error {
+	if err := r.checkDecision(reviewerID); err != nil {
+		return err
+	}
+	personal, contact, err := r.Proposed(employee)
+	if err != nil {
+		return err
+	}
+	at = r.decide(enum.ChangeRequestApproved, reviewerID, "", at)
+	employee.personalInfo = *personal
+	employee.contactInfo = *contact
+	employee.updatedAt = at
+	return nil
+}
+
+// Reject marks a pending request as rejected with a reason.
+func (r *ChangeRequest) Reject(reviewerID uuid.UUID, note string, at time.Time) error {
+	if err := r.checkDecision(reviewerID); err != nil {
+		return err
+	}
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return errors.New("rejection note cannot be empty")
+	}
+	r.decide(enum.ChangeRequestRejected, reviewerID, note, at)
+	return nil
+}
+
+// Cancel withdraws a pending request.
+func (r *ChangeRequest) Cancel(at time.Time) error {
+	if r.status != enum.ChangeRequestPending {
+		return errors.New("change request is not pending")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+	r.status = enum.ChangeRequestCancelled
+	r.updatedAt = at
+	return nil
+}
+
+func (r *ChangeRequest) checkDecision(reviewerID uuid.UUID) error {
+	if r.status != enum.ChangeRequestPending {
+		return errors.New("change request is not pending")
+	}
+	if reviewerID == uuid.Nil {
+		return errors.New("reviewer cannot be empty")
+	}
+	if reviewerID == r.employeeID {
+		return errors.New("employee cannot decide on their own change request")
+	}
+	return nil
+}
+
+func (r *ChangeRequest) decide(status enum.ChangeRequestStatus, reviewerID uuid.UUID, note string, at time.Time) time.Time {
+	if at.IsZero() {
+		at = time.Now()
+	}
+	r.status = status
+	r.decidedBy = &reviewerID
+	r.decidedAt = &at
+	r.decisionNote = note
+	r.updatedAt = at
+	return at
+}
+
+// proposed builds the employee's data with the diffs applied through the factories.
+func proposed(employee *Employee, personal *PersonalInfoDiff, contact *ContactInfoDiff) (*PersonalInfo, *ContactInfo, error) {
+	personalFactory := employee.personalInfo.Factory()
+	if personal != nil {
+		personalFactory = personal.Apply(personalFactory)
+	}
+	newPersonal, err := personalFactory.Create()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contactFactory := employee.contactInfo.Factory()
+	if contact != nil {
+		contactFactory = contact.Apply(contactFactory)
+	}
+	newContact, err := contactFactory.Create()
+	if err != nil {
+		return nil, nil, err
+	}
+	return newPersonal, newContact, nil
+}
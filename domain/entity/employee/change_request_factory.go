@@ -0,0 +1,77 @@
+package employee_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"strings"
+	"time"
+)
+
+// ChangeRequestFactory is a factory type for creating ChangeRequests.
+type ChangeRequestFactory struct {
+	ID        string
+	Employee  *Employee
+	Personal  *PersonalInfoDiff
+	Contact   *ContactInfoDiff
+	Documents []valueobject.FileReference
+	Reason    string
+	CreatedAt time.Time
+}
+
+// Create validates the factory data and returns a pending ChangeRequest. The request must
+// change at least one field and the changed data must pass the PersonalInfo and
+// ContactInfo factories. Changes to personal data need a supporting document.
+func (f ChangeRequestFactory) Create() (*ChangeRequest, error) {
+	id, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	if f.Employee == nil {
+		return nil, errors.New("employee cannot be empty")
+	}
+
+	var fields []string
+	if f.Personal != nil {
+		fields = append(fields, f.Personal.Changes(f.Employee.personalInfo.Factory())...)
+	}
+	personalChanges := len(fields)
+	if f.Contact != nil {
+		fields = append(fields, f.Contact.Changes(f.Employee.contactInfo.Factory())...)
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("change request changes nothing")
+	}
+	if _, _, err := proposed(f.Employee, f.Personal, f.Contact); err != nil {
+		return nil, err
+	}
+	if personalChanges > 0 && len(f.Documents) == 0 {
+		return nil, errors.New("personal data changes need a supporting document")
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	r := &ChangeRequest{
+		id:         id,
+		employeeID: f.Employee.id,
+		fields:     fields,
+		documents:  append([]valueobject.FileReference(nil), f.Documents...),
+		reason:     strings.TrimSpace(f.Reason),
+		status:     enum.ChangeRequestPending,
+		createdAt:  f.CreatedAt,
+		updatedAt:  f.CreatedAt,
+	}
+	if f.Personal != nil {
+		d := *f.Personal
+		r.personal = &d
+	}
+	if f.Contact != nil {
+		d := *f.Contact
+		r.contact = &d
+	}
+	return r, nil
+}
@@ -0,0 +1,163 @@
+package employee_entity_test
+
+import (
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func personalInfo(employee *employee_entity.Employee) *employee_entity.PersonalInfo {
+	info := employee.PersonalInfo()
+	return &info
+}
+
+func contactInfo(employee *employee_entity.Employee) *employee_entity.ContactInfo {
+	info := employee.ContactInfo()
+	return &info
+}
+
+func newCertificate(t *testing.T) valueobject.FileReference {
+	file, err := valueobject.NewFileReference("https://files.example.com/akta-nikah.pdf", "akta-nikah.pdf", "application/pdf")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return *file
+}
+
+func TestChangeRequestFactory_Create(t *testing.T) {
+	at := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("ValidInput", func(t *testing.T) {
+		employee := newEmployee(t)
+
+		request, err := employee_entity.ChangeRequestFactory{
+			ID:        uuid.NewString(),
+			Employee:  employee,
+			Personal:  &employee_entity.PersonalInfoDiff{MaritalStatus: ptr("married"), Religion: ptr("islam")},
+			Contact:   &employee_entity.ContactInfoDiff{Email: ptr("siti@example.com")},
+			Documents: []valueobject.FileReference{newCertificate(t)},
+			Reason:    " married last month ",
+			CreatedAt: at,
+		}.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, employee.ID(), request.EmployeeID())
+		assert.Equal(t, enum.ChangeRequestPending, request.Status())
+		assert.Equal(t, []string{"marital_status", "email"}, request.Fields())
+		assert.Equal(t, "married last month", request.Reason())
+		assert.Len(t, request.Documents(), 1)
+		assert.Equal(t, enum.MaritalSingle, personalInfo(employee).MaritalStatus())
+	})
+	t.Run("ContactChangeNeedsNoDocument", func(t *testing.T) {
+		_, err := employee_entity.ChangeRequestFactory{
+			ID:       uuid.NewString(),
+			Employee: newEmployee(t),
+			Contact:  &employee_entity.ContactInfoDiff{Phone: ptr("81234567890")},
+		}.Create()
+
+		assert.Nil(t, err)
+	})
+	t.Run("InvalidInput", func(t *testing.T) {
+		employee := newEmployee(t)
+
+		_, err := employee_entity.ChangeRequestFactory{ID: "uuid", Employee: employee}.Create()
+		assert.EqualError(t, err, "invalid format uuid")
+		_, err = employee_entity.ChangeRequestFactory{ID: uuid.NewString()}.Create()
+		assert.EqualError(t, err, "employee cannot be empty")
+		_, err = employee_entity.ChangeRequestFactory{
+			ID: uuid.NewString(), Employee: employee,
+			Personal: &employee_entity.PersonalInfoDiff{MaritalStatus: ptr("single")},
+		}.Create()
+		assert.EqualError(t, err, "change request changes nothing")
+		_, err = employee_entity.ChangeRequestFactory{
+			ID: uuid.NewString(), Employee: employee,
+			Personal: &employee_entity.PersonalInfoDiff{MaritalStatus: ptr("married")},
+		}.Create()
+		assert.EqualError(t, err, "personal data changes need a supporting document")
+		_, err = employee_entity.ChangeRequestFactory{
+			ID: uuid.NewString(), Employee: employee,
+			Personal:  &employee_entity.PersonalInfoDiff{MaritalStatus: ptr("complicated")},
+			Documents: []valueobject.FileReference{newCertificate(t)},
+		}.Create()
+		assert.NotNil(t, err)
+		_, err = employee_entity.ChangeRequestFactory{
+			ID: uuid.NewString(), Employee: employee,
+			Contact: &employee_entity.ContactInfoDiff{Email: ptr("siti.example.com")},
+		}.Create()
+		assert.EqualError(t, err, "email must contain @")
+	})
+}
+
+func TestChangeRequest_Decide(t *testing.T) {
+	at := time.Date(2025, 4, 2, 9, 0, 0, 0, time.UTC)
+	reviewer := uuid.New()
+	newRequest := func(t *testing.T, employee *employee_entity.Employee, contact *employee_entity.ContactInfoDiff) *employee_entity.ChangeRequest {
+		request, err := employee_entity.ChangeRequestFactory{
+			ID:        uuid.NewString(),
+			Employee:  employee,
+			Personal:  &employee_entity.PersonalInfoDiff{MaritalStatus: ptr("married")},
+			Contact:   contact,
+			Documents: []valueobject.FileReference{newCertificate(t)},
+		}.Create()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return request
+	}
+
+	t.Run("Approve", func(t *testing.T) {
+		employee := newEmployee(t)
+		request := newRequest(t, employee, &employee_entity.ContactInfoDiff{
+			EmergencyContacts: &[]employee_entity.EmergencyContactFactory{{Name: "Budi", Relationship: "husband", Phone: "81398765432"}},
+		})
+
+		assert.EqualError(t, request.Approve(employee.ID(), employee, at), "employee cannot decide on their own change request")
+		assert.EqualError(t, request.Approve(reviewer, newEmployee(t), at), "change request belongs to another employee")
+		assert.Nil(t, request.Approve(reviewer, employee, at))
+
+		assert.Equal(t, enum.ChangeRequestApproved, request.Status())
+		assert.Equal(t, reviewer, *request.DecidedBy())
+		assert.Equal(t, at, *request.DecidedAt())
+		assert.Equal(t, enum.MaritalMarried, personalInfo(employee).MaritalStatus())
+		assert.Len(t, contactInfo(employee).EmergencyContacts(), 1)
+		assert.Equal(t, at, employee.UpdatedAt())
+		assert.EqualError(t, request.Approve(reviewer, employee, at), "change request is not pending")
+	})
+	t.Run("ApproveIsAtomic", func(t *testing.T) {
+		// The request only changes the street; once HR has removed the address altogether
+		// the changed address is incomplete, so the marital status is not applied either.
+		employee := newEmployee(t)
+		address, _ := employee_entity.ContactInfoFactory{Street: "Jl. Merdeka 1", City: "Bandung", State: "Jawa Barat", PostalCode: "40111", Country: "Indonesia"}.Create()
+		assert.Nil(t, employee.UpdateContactInfo(*address, at))
+		request := newRequest(t, employee, &employee_entity.ContactInfoDiff{Street: ptr("Jl. Asia Afrika 8")})
+		assert.Nil(t, employee.UpdateContactInfo(employee_entity.ContactInfo{}, at))
+
+		assert.NotNil(t, request.Approve(reviewer, employee, at))
+		assert.Equal(t, enum.ChangeRequestPending, request.Status())
+		assert.Equal(t, enum.MaritalSingle, personalInfo(employee).MaritalStatus())
+		assert.Nil(t, contactInfo(employee).Address())
+	})
+	t.Run("RejectAndCancel", func(t *testing.T) {
+		employee := newEmployee(t)
+		request := newRequest(t, employee, nil)
+
+		assert.EqualError(t, request.Reject(reviewer, " ", at), "rejection note cannot be empty")
+		assert.Nil(t, request.Reject(reviewer, "certificate is unreadable", at))
+		assert.Equal(t, enum.ChangeRequestRejected, request.Status())
+		assert.Equal(t, "certificate is unreadable", request.DecisionNote())
+		assert.Equal(t, enum.MaritalSingle, personalInfo(employee).MaritalStatus())
+		assert.EqualError(t, request.Cancel(at), "change request is not pending")
+
+		other := newRequest(t, employee, nil)
+		assert.Nil(t, other.Cancel(at))
+		assert.Equal(t, enum.ChangeRequestCancelled, other.Status())
+	})
+}
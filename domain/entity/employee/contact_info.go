@@ -0,0 +1,75 @@
+package employee_entity
+
+import (
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+)
+
+// EmergencyContact is a person to call when something happens to the employee.
+type EmergencyContact struct {
+	name         string
+	relationship enum.RelationshipType
+	phone        valueobject.PhoneNumber
+}
+
+// Name returns the name of the contact.
+func (c EmergencyContact) Name() string { return c.name }
+
+// Relationship returns how the contact is related to the employee.
+func (c EmergencyContact) Relationship() enum.RelationshipType { return c.relationship }
+
+// Phone returns the phone number of the contact.
+func (c EmergencyContact) Phone() valueobject.PhoneNumber { return c.phone }
+
+// ContactInfo holds how to reach an employee. Every part is optional.
+type ContactInfo struct {
+	address           *valueobject.Address
+	phone             *valueobject.PhoneNumber
+	email             *valueobject.EmailAddress
+	emergencyContacts []EmergencyContact
+}
+
+// Address returns the home address, if known.
+func (c *ContactInfo) Address() *valueobject.Address {
+	return c.address
+}
+
+// Phone returns the personal phone number, if known.
+func (c *ContactInfo) Phone() *valueobject.PhoneNumber {
+	return c.phone
+}
+
+// Email returns the personal email address, if known.
+func (c *ContactInfo) Email() *valueobject.EmailAddress {
+	return c.email
+}
+
+// EmergencyContacts returns a copy of the emergency contacts in order of preference.
+func (c *ContactInfo) EmergencyContacts() []EmergencyContact {
+	out := make([]EmergencyContact, len(c.emergencyContacts))
+	copy(out, c.emergencyContacts)
+	return out
+}
+
+// Factory returns a factory holding the current values, to be changed and re-created.
+func (c *ContactInfo) Factory() ContactInfoFactory {
+	var f ContactInfoFactory
+	if c.address != nil {
+		f.Street, f.City, f.State, f.PostalCode, f.Country = c.address.Street(), c.address.City(), c.address.State(), c.address.PostalCode(), c.address.Country()
+	}
+	if c.phone != nil {
+		f.PhoneCountryCode, f.Phone = c.phone.CountryCode(), c.phone.Number()
+	}
+	if c.email != nil {
+		f.Email = c.email.Full()
+	}
+	for _, e := range c.emergencyContacts {
+		f.EmergencyContacts = append(f.EmergencyContacts, EmergencyContactFactory{
+			Name:             e.name,
+			Relationship:     string(e.relationship),
+			PhoneCountryCode: e.phone.CountryCode(),
+			Phone:            e.phone.Number(),
+		})
+	}
+	return f
+}
@@ -0,0 +1,103 @@
+package employee_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rfanazhari/hris/domain/enum"
+	vo "github.com/rfanazhari/hris/domain/valueobject"
+	"strings"
+)
+
+// MaxEmergencyContacts is the number of emergency contacts an employee may register.
+const MaxEmergencyContacts = 3
+
+// EmergencyContactFactory holds the fields of an EmergencyContact. The phone country code
+// defaults to 62.
+type EmergencyContactFactory struct {
+	Name             string
+	Relationship     string
+	PhoneCountryCode string
+	Phone            string
+}
+
+// ContactInfoFactory is a factory type for creating ContactInfo.
+//
+// The address is optional but, once any of its fields is given, all of them are required.
+// Phone country codes default to 62. The email is a full address, e.g. "budi@example.com".
+type ContactInfoFactory struct {
+	Street            string
+	City              string
+	State             string
+	PostalCode        string
+	Country           string
+	PhoneCountryCode  string
+	Phone             string
+	Email             string
+	EmergencyContacts []EmergencyContactFactory
+}
+
+// Create validates the factory data and returns ContactInfo.
+func (f ContactInfoFactory) Create() (*ContactInfo, error) {
+	info := &ContactInfo{}
+
+	if strings.TrimSpace(f.Street+f.City+f.State+f.PostalCode+f.Country) != "" {
+		address, err := vo.NewAddress(f.Street, f.City, f.State, f.PostalCode, f.Country)
+		if err != nil {
+			return nil, err
+		}
+		info.address = address
+	}
+
+	if strings.TrimSpace(f.Phone) != "" {
+		phone, err := newPhone(f.PhoneCountryCode, f.Phone)
+		if err != nil {
+			return nil, err
+		}
+		info.phone = phone
+	}
+
+	if email := strings.TrimSpace(f.Email); email != "" {
+		username, domain, ok := strings.Cut(email, "@")
+		if !ok {
+			return nil, errors.New("email must contain @")
+		}
+		address, err := vo.NewEmailAddress(username, domain)
+		if err != nil {
+			return nil, err
+		}
+		info.email = address
+	}
+
+	if len(f.EmergencyContacts) > MaxEmergencyContacts {
+		return nil, fmt.Errorf("at most %d emergency contacts are allowed", MaxEmergencyContacts)
+	}
+	seen := map[string]bool{}
+	for _, c := range f.EmergencyContacts {
+		name := strings.Join(strings.Fields(c.Name), " ")
+		if name == "" {
+			return nil, errors.New("emergency contact name cannot be empty")
+		}
+		relationship, err := enum.ParseRelationshipType(c.Relationship)
+		if err != nil {
+			return nil, err
+		}
+		phone, err := newPhone(c.PhoneCountryCode, c.Phone)
+		if err != nil {
+			return nil, fmt.Errorf("emergency contact %s: %w", name, err)
+		}
+		if seen[phone.Full()] {
+			return nil, errors.New("duplicate emergency contact phone number")
+		}
+		seen[phone.Full()] = true
+		info.emergencyContacts = append(info.emergencyContacts, EmergencyContact{name: name, relationship: relationship, phone: *phone})
+	}
+
+	return info, nil
+}
+
+func newPhone(countryCode, number string) (*vo.PhoneNumber, error) {
+	if strings.TrimSpace(countryCode) == "" {
+		countryCode = "62"
+	}
+	return vo.NewPhoneNumber(countryCode, number)
+}
@@ -0,0 +1,54 @@
+package employee_entity_test
+
+import (
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestContactInfoFactory_Create(t *testing.T) {
+	t.Run("ValidInput", func(t *testing.T) {
+		info, err := employee_entity.ContactInfoFactory{
+			Street: "Jl. Merdeka 1", City: "Bandung", State: "Jawa Barat", PostalCode: "40111", Country: "Indonesia",
+			Phone: "81234567890", Email: "siti@example.com",
+			EmergencyContacts: []employee_entity.EmergencyContactFactory{
+				{Name: " Budi  Santoso ", Relationship: "husband", Phone: "81398765432"},
+			},
+		}.Create()
+
+		assert.Nil(t, err)
+		assert.NotNil(t, info.Address())
+		assert.Equal(t, "62", info.Phone().CountryCode())
+		assert.Equal(t, "siti@example.com", info.Email().Full())
+		assert.Len(t, info.EmergencyContacts(), 1)
+		assert.Equal(t, "Budi Santoso", info.EmergencyContacts()[0].Name())
+		assert.Equal(t, enum.RelationshipHusband, info.EmergencyContacts()[0].Relationship())
+
+		again, err := info.Factory().Create()
+		assert.Nil(t, err)
+		assert.Equal(t, info, again)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		info, err := employee_entity.ContactInfoFactory{}.Create()
+
+		assert.Nil(t, err)
+		assert.Nil(t, info.Address())
+		assert.Nil(t, info.Phone())
+		assert.Nil(t, info.Email())
+	})
+	t.Run("InvalidInput", func(t *testing.T) {
+		contact := employee_entity.EmergencyContactFactory{Name: "Budi", Relationship: "husband", Phone: "81398765432"}
+
+		_, err := employee_entity.ContactInfoFactory{Email: "siti.example.com"}.Create()
+		assert.EqualError(t, err, "email must contain @")
+		_, err = employee_entity.ContactInfoFactory{EmergencyContacts: []employee_entity.EmergencyContactFactory{contact, contact}}.Create()
+		assert.EqualError(t, err, "duplicate emergency contact phone number")
+		_, err = employee_entity.ContactInfoFactory{EmergencyContacts: []employee_entity.EmergencyContactFactory{{Relationship: "husband", Phone: "81398765432"}}}.Create()
+		assert.EqualError(t, err, "emergency contact name cannot be empty")
+		_, err = employee_entity.ContactInfoFactory{EmergencyContacts: make([]employee_entity.EmergencyContactFactory, 4)}.Create()
+		assert.EqualError(t, err, "at most 3 emergency contacts are allowed")
+		_, err = employee_entity.ContactInfoFactory{City: "Bandung"}.Create()
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,479 @@
+package employee_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Employee is the aggregate root for an employee's master data, contracts and documents.
+type Employee struct {
+	id                  uuid.UUID
+	personalInfo        PersonalInfo
+	contactInfo         ContactInfo
+	organizationUnitID  *uuid.UUID
+	employmentContracts []EmploymentContract
+	documents           []valueobject.Document
+	salaryRecords       []SalaryRecord
+	bankAccount         *valueobject.BankAccount
+	status              enum.EmploymentStatus
+	statusHistory       []StatusChange
+	createdAt           time.Time
+	updatedAt           time.Time
+}
+
+// ID returns the unique identifier of the employee.
+func (e *Employee) ID() uuid.UUID {
+	return e.id
+}
+
+// PersonalInfo returns the personal information of the employee.
+func (e *Employee) PersonalInfo() PersonalInfo {
+	return e.personalInfo
+}
+
+// ContactInfo returns how to reach the employee.
+func (e *Employee) ContactInfo() ContactInfo {
+	return e.contactInfo
+}
+
+// OrganizationUnitID returns the organization unit the employee belongs to, or nil if unassigned.
+func (e *Employee) OrganizationUnitID() *uuid.UUID {
+	return e.organizationUnitID
+}
+
+// EmploymentContracts returns a copy of the employee's contract history.
+func (e *Employee) EmploymentContracts() []EmploymentContract {
+	out := make([]EmploymentContract, len(e.employmentContracts))
+	copy(out, e.employmentContracts)
+	return out
+}
+
+// Documents returns a copy of the documents stored for the employee.
+func (e *Employee) Documents() []valueobject.Document {
+	out := make([]valueobject.Document, len(e.documents))
+	copy(out, e.documents)
+	return out
+}
+
+// SalaryRecords returns a copy of the salary history ordered by effective date.
+func (e *Employee) SalaryRecords() []SalaryRecord {
+	out := make([]SalaryRecord, len(e.salaryRecords))
+	copy(out, e.salaryRecords)
+	return out
+}
+
+// BankAccount returns the account salary is paid to, or nil if none is registered.
+func (e *Employee) BankAccount() *valueobject.BankAccount {
+	return e.bankAccount
+}
+
+// Status returns the employment status.
+func (e *Employee) Status() enum.EmploymentStatus {
+	return e.status
+}
+
+// StatusHistory returns a copy of the status changes, oldest first.
+func (e *Employee) StatusHistory() []StatusChange {
+	out := make([]StatusChange, len(e.statusHistory))
+	copy(out, e.statusHistory)
+	return out
+}
+
+// StatusOn returns the employment status in effect on the calendar date of at.
+func (e *Employee) StatusOn(at time.Time) enum.EmploymentStatus {
+	if len(e.statusHistory) == 0 {
+		return e.status
+	}
+	day := dateOf(at)
+	status := e.statusHistory[0].from
+	for _, c := range e.statusHistory {
+		if c.effectiveDate.After(day) {
+			break
+		}
+		status = c.to
+	}
+	return status
+}
+
+// HasLeft reports whether the employee has resigned, been terminated or retired.
+func (e *Employee) HasLeft() bool {
+	return e.status == enum.EmploymentResigned || e.status == enum.EmploymentTerminated || e.status == enum.EmploymentRetired
+}
+
+// CreatedAt returns the timestamp when the employee was created.
+func (e *Employee) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+// UpdatedAt returns the timestamp of the last change to the employee.
+func (e *Employee) UpdatedAt() time.Time {
+	return e.updatedAt
+}
+
+// HireDate returns the start date of the earliest employment contract, or the zero time if
+// the employee has no contract yet.
+func (e *Employee) HireDate() time.Time {
+	var hired time.Time
+	for _, c := range e.employmentContracts {
+		if hired.IsZero() || c.startDate.Before(hired) {
+			hired = c.startDate
+		}
+	}
+	return hired
+}
+
+// IsEmployedOn reports whether a contract covers the calendar date of at. Expired and
+// terminated contracts still count up to their end date.
+func (e *Employee) IsEmployedOn(at time.Time) bool {
+	day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, at.Location())
+	for _, c := range e.employmentContracts {
+		if c.status != enum.ContractStatusActive && c.endDate == nil {
+			continue
+		}
+		if day.Before(time.Date(c.startDate.Year(), c.startDate.Month(), c.startDate.Day(), 0, 0, 0, 0, at.Location())) {
+			continue
+		}
+		if c.endDate == nil || !day.After(*c.endDate) {
+			return true
+		}
+	}
+	return false
+}
+
+// ActiveContract returns the contract in effect at the given instant, if any.
+func (e *Employee) ActiveContract(at time.Time) (*EmploymentContract, bool) {
+	for i := range e.employmentContracts {
+		if e.employmentContracts[i].IsEffective(at) {
+			c := e.employmentContracts[i]
+			return &c, true
+		}
+	}
+	return nil, false
+}
+
+// AddEmploymentContract appends a contract to the employee's history.
+// An active contract must not overlap another active contract.
+func (e *Employee) AddEmploymentContract(contract EmploymentContract, at time.Time) error {
+	if contract.id == uuid.Nil {
+		return errors.New("invalid employment contract")
+	}
+	for _, c := range e.employmentContracts {
+		if c.id == contract.id {
+			return errors.New("employment contract already exists")
+		}
+		if c.status == enum.ContractStatusActive && contract.status == enum.ContractStatusActive && overlaps(c, contract) {
+			return errors.New("employment contract overlaps an active contract")
+		}
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	e.employmentContracts = append(e.employmentContracts, contract)
+	e.updatedAt = at
+	return nil
+}
+
+// SetContractEnd moves the end date of an active contract, e.g. to the final working day
+// of a termination, or back when the termination is withdrawn. A PKWT contract keeps an
+// end date.
+func (e *Employee) SetContractEnd(contractID uuid.UUID, endDate *time.Time, at time.Time) error {
+	i := e.contractIndex(contractID)
+	if i < 0 {
+		return errors.New("employment contract not found")
+	}
+	c := &e.employmentContracts[i]
+	if c.status != enum.ContractStatusActive {
+		return errors.New("employment contract is not active")
+	}
+	if endDate == nil && c.contractType == enum.ContractPKWT {
+		return errors.New("pkwt contract requires an end date")
+	}
+	if endDate != nil && endDate.Before(c.startDate) {
+		return errors.New("end date cannot be before start date")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	if endDate != nil {
+		end := *endDate
+		endDate = &end
+	}
+	c.endDate = endDate
+	e.updatedAt = at
+	return nil
+}
+
+// CloseContract sets an active contract that has an end date to expired or terminated.
+// The employee stays employed until the end date.
+func (e *Employee) CloseContract(contractID uuid.UUID, status enum.ContractStatus, at time.Time) error {
+	if status != enum.ContractStatusExpired && status != enum.ContractStatusTerminated {
+		return fmt.Errorf("invalid closing ContractStatus: %q", status)
+	}
+	i := e.contractIndex(contractID)
+	if i < 0 {
+		return errors.New("employment contract not found")
+	}
+	c := &e.employmentContracts[i]
+	if c.status != enum.ContractStatusActive {
+		return errors.New("employment contract is not active")
+	}
+	if c.endDate == nil {
+		return errors.New("employment contract has no end date")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	c.status = status
+	e.updatedAt = at
+	return nil
+}
+
+// ConfirmProbation records that the employee passed the probation of the contract and moves
+// them from probation to active.
+func (e *Employee) ConfirmProbation(contractID, reviewerID uuid.UUID, note string, at time.Time) error {
+	if at.IsZero() {
+		at = time.Now()
+	}
+	i, probation, err := e.probation(contractID)
+	if err != nil {
+		return err
+	}
+	if err := probation.decide(enum.ProbationConfirmed, reviewerID, note, at); err != nil {
+		return err
+	}
+	if e.status == enum.EmploymentProbation {
+		if err := e.ChangeStatus(enum.EmploymentActive, at, "probation confirmed", at); err != nil {
+			return err
+		}
+	}
+	e.employmentContracts[i].probation = probation
+	e.updatedAt = at
+	return nil
+}
+
+// ExtendProbation moves the end of the contract's probation before it ends, as long as the
+// probation stays within MaxProbationMonths.
+func (e *Employee) ExtendProbation(contractID uuid.UUID, endDate time.Time, reviewerID uuid.UUID, note string, at time.Time) error {
+	if at.IsZero() {
+		at = time.Now()
+	}
+	i, probation, err := e.probation(contractID)
+	if err != nil {
+		return err
+	}
+	if err := probation.extend(endDate, reviewerID, note, at); err != nil {
+		return err
+	}
+	e.employmentContracts[i].probation = probation
+	e.updatedAt = at
+	return nil
+}
+
+// FailProbation records that the employee did not pass the probation of the contract. The
+// employment itself ends through the termination of the contract.
+func (e *Employee) FailProbation(contractID, reviewerID uuid.UUID, note string, at time.Time) error {
+	if strings.TrimSpace(note) == "" {
+		return errors.New("probation failure note cannot be empty")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+	i, probation, err := e.probation(contractID)
+	if err != nil {
+		return err
+	}
+	if err := probation.decide(enum.ProbationFailed, reviewerID, note, at); err != nil {
+		return err
+	}
+	e.employmentContracts[i].probation = probation
+	e.updatedAt = at
+	return nil
+}
+
+// SalaryAt returns the salary record in effect at the given instant, if any.
+func (e *Employee) SalaryAt(at time.Time) (*SalaryRecord, bool) {
+	for i := len(e.salaryRecords) - 1; i >= 0; i-- {
+		if !e.salaryRecords[i].effectiveDate.After(at) {
+			s := e.salaryRecords[i]
+			return &s, true
+		}
+	}
+	return nil, false
+}
+
+// AddSalaryRecord adds a salary record to the employee's salary history.
+// Only one record may take effect on a given date.
+func (e *Employee) AddSalaryRecord(record SalaryRecord, at time.Time) error {
+	if record.id == uuid.Nil {
+		return errors.New("invalid salary record")
+	}
+	for _, r := range e.salaryRecords {
+		if r.id == record.id {
+			return errors.New("salary record already exists")
+		}
+		if r.effectiveDate.Equal(record.effectiveDate) {
+			return errors.New("salary record already exists for the effective date")
+		}
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	e.salaryRecords = append(e.salaryRecords, record)
+	sort.Slice(e.salaryRecords, func(i, j int) bool {
+		return e.salaryRecords[i].effectiveDate.Before(e.salaryRecords[j].effectiveDate)
+	})
+	e.updatedAt = at
+	return nil
+}
+
+// UpdatePersonalInfo replaces the employee's personal data.
+func (e *Employee) UpdatePersonalInfo(info PersonalInfo, at time.Time) error {
+	if info.name.FirstName() == "" {
+		return errors.New("invalid personal info")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	e.personalInfo = info
+	e.updatedAt = at
+	return nil
+}
+
+// UpdateContactInfo replaces the employee's contact data.
+func (e *Employee) UpdateContactInfo(info ContactInfo, at time.Time) error {
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	e.contactInfo = info
+	e.updatedAt = at
+	return nil
+}
+
+// AssignOrganizationUnit moves the employee to the given organization unit.
+func (e *Employee) AssignOrganizationUnit(unitID uuid.UUID, at time.Time) error {
+	if unitID == uuid.Nil {
+		return errors.New("invalid organization unit id")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	e.organizationUnitID = &unitID
+	e.updatedAt = at
+	return nil
+}
+
+// ChangeStatus moves the employee to a new employment status from the effective date,
+// which defaults to the date of at. Only the moves allowed by CanChangeStatus are accepted,
+// and changes cannot be dated in the future or before the previous change.
+func (e *Employee) ChangeStatus(status enum.EmploymentStatus, effectiveDate time.Time, reason string, at time.Time) error {
+	if !status.Valid() {
+		return fmt.Errorf("invalid EmploymentStatus: %q", status)
+	}
+	if status == e.status {
+		return fmt.Errorf("employee is already %s", status)
+	}
+	if !CanChangeStatus(e.status, status) {
+		return fmt.Errorf("employee cannot change from %s to %s", e.status, status)
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return errors.New("status change reason cannot be empty")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+	if effectiveDate.IsZero() {
+		effectiveDate = at
+	}
+	effective := dateOf(effectiveDate)
+	if effective.After(dateOf(at)) {
+		return errors.New("status change cannot take effect in the future")
+	}
+	if n := len(e.statusHistory); n > 0 && effective.Before(e.statusHistory[n-1].effectiveDate) {
+		return errors.New("status change cannot take effect before the previous change")
+	}
+
+	e.statusHistory = append(e.statusHistory, StatusChange{
+		from:          e.status,
+		to:            status,
+		effectiveDate: effective,
+		reason:        reason,
+		recordedAt:    at,
+	})
+	e.status = status
+	e.updatedAt = at
+	return nil
+}
+
+// SetBankAccount registers the account salary is paid to, replacing any earlier one.
+func (e *Employee) SetBankAccount(account valueobject.BankAccount, at time.Time) error {
+	if account.IsZero() {
+		return errors.New("invalid bank account")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	e.bankAccount = &account
+	e.updatedAt = at
+	return nil
+}
+
+// AddDocument stores a document for the employee.
+func (e *Employee) AddDocument(doc valueobject.Document, at time.Time) error {
+	if !doc.Kind().Valid() {
+		return errors.New("invalid document type")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	e.documents = append(e.documents, doc)
+	e.updatedAt = at
+	return nil
+}
+
+// probation returns the index of the contract and a copy of its probation to change.
+func (e *Employee) probation(contractID uuid.UUID) (int, *Probation, error) {
+	i := e.contractIndex(contractID)
+	if i < 0 {
+		return 0, nil, errors.New("employment contract not found")
+	}
+	probation := e.employmentContracts[i].Probation()
+	if probation == nil {
+		return 0, nil, errors.New("employment contract has no probation period")
+	}
+	return i, probation, nil
+}
+
+func (e *Employee) contractIndex(id uuid.UUID) int {
+	for i := range e.employmentContracts {
+		if e.employmentContracts[i].id == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func overlaps(a, b EmploymentContract) bool {
+	if a.endDate != nil && a.endDate.Before(b.startDate) {
+		return false
+	}
+	if b.endDate != nil && b.endDate.Before(a.startDate) {
+		return false
+	}
+	return true
+}
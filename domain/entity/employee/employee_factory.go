@@ -0,0 +1,63 @@
+package employee_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// EmployeeFactory is a factory type for creating Employee aggregates with validated properties.
+type EmployeeFactory struct {
+	ID                 string
+	PersonalInfo       *PersonalInfo
+	ContactInfo        *ContactInfo
+	OrganizationUnitID string
+	Status             string
+	CreatedAt          time.Time
+}
+
+// Create validates the factory data and returns a new Employee.
+func (f EmployeeFactory) Create() (*Employee, error) {
+	newUUID, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	if f.PersonalInfo == nil {
+		return nil, errors.New("personal info cannot be empty")
+	}
+
+	var organizationUnitID *uuid.UUID
+	if f.OrganizationUnitID != "" {
+		unitID, err := uuid.Parse(f.OrganizationUnitID)
+		if err != nil {
+			return nil, errors.New("invalid organization unit id")
+		}
+		organizationUnitID = &unitID
+	}
+
+	var contactInfo ContactInfo
+	if f.ContactInfo != nil {
+		contactInfo = *f.ContactInfo
+	}
+
+	status, err := enum.ParseEmploymentStatus(f.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	return &Employee{
+		id:                 newUUID,
+		personalInfo:       *f.PersonalInfo,
+		contactInfo:        contactInfo,
+		organizationUnitID: organizationUnitID,
+		status:             status,
+		createdAt:          f.CreatedAt,
+		updatedAt:          f.CreatedAt,
+	}, nil
+}
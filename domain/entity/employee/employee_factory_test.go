@@ -0,0 +1,93 @@
+package employee_entity_test
+
+import (
+	"fmt"
+	"github.com/go-faker/faker/v4"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func newPersonalInfo(t *testing.T) *employee_entity.PersonalInfo {
+	personalInfo, err := employee_entity.PersonalInfoFactory{
+		FirstName:     faker.FirstName(),
+		LastName:      faker.LastName(),
+		BirthDate:     time.Date(1995, 5, 17, 0, 0, 0, 0, time.UTC),
+		PlaceOfBirth:  "bandung",
+		Gender:        "F",
+		Nationality:   "wni",
+		MaritalStatus: "single",
+		Religion:      "islam",
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return personalInfo
+}
+
+func TestEmployeeFactory_Create(t *testing.T) {
+	t.Run("ValidInput", func(t *testing.T) {
+		personalInfo := newPersonalInfo(t)
+		factory := employee_entity.EmployeeFactory{
+			ID:           uuid.NewString(),
+			PersonalInfo: personalInfo,
+			Status:       "active",
+			CreatedAt:    time.Time{},
+		}
+
+		employee, err := factory.Create()
+
+		assert.Nil(t, err)
+		assert.NotNil(t, employee)
+		assert.Equal(t, factory.ID, employee.ID().String())
+		assert.Equal(t, *personalInfo, employee.PersonalInfo())
+		assert.Equal(t, enum.EmploymentActive, employee.Status())
+		assert.Empty(t, employee.EmploymentContracts())
+		assert.False(t, employee.CreatedAt().IsZero())
+		assert.Equal(t, employee.CreatedAt(), employee.UpdatedAt())
+	})
+	t.Run("InvalidID", func(t *testing.T) {
+		factory := employee_entity.EmployeeFactory{ID: "uuid", PersonalInfo: newPersonalInfo(t), Status: "active"}
+
+		employee, err := factory.Create()
+
+		assert.Nil(t, employee)
+		assert.EqualError(t, err, "invalid format uuid")
+	})
+	t.Run("EmptyPersonalInfo", func(t *testing.T) {
+		factory := employee_entity.EmployeeFactory{ID: uuid.NewString(), Status: "active"}
+
+		employee, err := factory.Create()
+
+		assert.Nil(t, employee)
+		assert.EqualError(t, err, "personal info cannot be empty")
+	})
+	t.Run("ValidInputWithOrganizationUnit", func(t *testing.T) {
+		unitID := uuid.NewString()
+		factory := employee_entity.EmployeeFactory{ID: uuid.NewString(), PersonalInfo: newPersonalInfo(t), OrganizationUnitID: unitID, Status: "active"}
+
+		employee, err := factory.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, unitID, employee.OrganizationUnitID().String())
+	})
+	t.Run("InvalidOrganizationUnitID", func(t *testing.T) {
+		factory := employee_entity.EmployeeFactory{ID: uuid.NewString(), PersonalInfo: newPersonalInfo(t), OrganizationUnitID: "unit", Status: "active"}
+
+		employee, err := factory.Create()
+
+		assert.Nil(t, employee)
+		assert.EqualError(t, err, "invalid organization unit id")
+	})
+	t.Run("InvalidStatus", func(t *testing.T) {
+		factory := employee_entity.EmployeeFactory{ID: uuid.NewString(), PersonalInfo: newPersonalInfo(t), Status: "fired"}
+
+		employee, err := factory.Create()
+
+		assert.Nil(t, employee)
+		assert.EqualError(t, err, fmt.Errorf("invalid EmploymentStatus: %q", "fired").Error())
+	})
+}
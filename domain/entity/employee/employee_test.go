@@ -0,0 +1,325 @@
+package employee_entity_test
+
+import (
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func newEmployee(t *testing.T) *employee_entity.Employee {
+	employee, err := employee_entity.EmployeeFactory{
+		ID:           uuid.NewString(),
+		PersonalInfo: newPersonalInfo(t),
+		Status:       "active",
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return employee
+}
+
+func newContract(t *testing.T, contractType string, start time.Time, end *time.Time) *employee_entity.EmploymentContract {
+	contract, err := employee_entity.EmploymentContractFactory{
+		ID:           uuid.NewString(),
+		ContractType: contractType,
+		StartDate:    start,
+		EndDate:      end,
+		Status:       "active",
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return contract
+}
+
+func TestEmployee_AddEmploymentContract(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	t.Run("ActiveContract", func(t *testing.T) {
+		employee := newEmployee(t)
+		pkwt := newContract(t, "pkwt", start, &end)
+		pkwtt := newContract(t, "pkwtt", end.AddDate(0, 0, 1), nil)
+
+		assert.Nil(t, employee.AddEmploymentContract(*pkwt, start))
+		assert.Nil(t, employee.AddEmploymentContract(*pkwtt, end))
+		assert.Len(t, employee.EmploymentContracts(), 2)
+
+		active, ok := employee.ActiveContract(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+		assert.True(t, ok)
+		assert.Equal(t, pkwt.ID(), active.ID())
+
+		active, ok = employee.ActiveContract(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
+		assert.True(t, ok)
+		assert.Equal(t, enum.ContractPKWTT, active.ContractType())
+
+		assert.Equal(t, start, employee.HireDate())
+
+		_, ok = employee.ActiveContract(start.AddDate(0, 0, -1))
+		assert.False(t, ok)
+		assert.Equal(t, end, employee.UpdatedAt())
+	})
+	t.Run("Overlap", func(t *testing.T) {
+		employee := newEmployee(t)
+		_ = employee.AddEmploymentContract(*newContract(t, "pkwt", start, &end), start)
+
+		err := employee.AddEmploymentContract(*newContract(t, "pkwtt", end, nil), start)
+
+		assert.EqualError(t, err, "employment contract overlaps an active contract")
+	})
+	t.Run("Duplicate", func(t *testing.T) {
+		employee := newEmployee(t)
+		contract := newContract(t, "pkwt", start, &end)
+		_ = employee.AddEmploymentContract(*contract, start)
+
+		err := employee.AddEmploymentContract(*contract, start)
+
+		assert.EqualError(t, err, "employment contract already exists")
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		employee := newEmployee(t)
+
+		err := employee.AddEmploymentContract(employee_entity.EmploymentContract{}, start)
+
+		assert.EqualError(t, err, "invalid employment contract")
+	})
+}
+
+func TestEmployee_EndContract(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	lastDay := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	t.Run("ScheduleAndClose", func(t *testing.T) {
+		employee := newEmployee(t)
+		contract := newContract(t, "pkwtt", start, nil)
+		_ = employee.AddEmploymentContract(*contract, start)
+
+		assert.EqualError(t, employee.CloseContract(contract.ID(), enum.ContractStatusTerminated, lastDay), "employment contract has no end date")
+		assert.Nil(t, employee.SetContractEnd(contract.ID(), &lastDay, lastDay))
+		assert.Nil(t, employee.CloseContract(contract.ID(), enum.ContractStatusTerminated, lastDay))
+
+		closed := employee.EmploymentContracts()[0]
+		assert.Equal(t, enum.ContractStatusTerminated, closed.Status())
+		assert.Equal(t, lastDay, *closed.EndDate())
+		assert.True(t, employee.IsEmployedOn(lastDay))
+		assert.False(t, employee.IsEmployedOn(lastDay.AddDate(0, 0, 1)))
+		assert.EqualError(t, employee.SetContractEnd(contract.ID(), nil, lastDay), "employment contract is not active")
+	})
+	t.Run("InvalidInput", func(t *testing.T) {
+		employee := newEmployee(t)
+		end := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+		contract := newContract(t, "pkwt", start, &end)
+		_ = employee.AddEmploymentContract(*contract, start)
+		beforeStart := start.AddDate(0, 0, -1)
+
+		assert.EqualError(t, employee.SetContractEnd(uuid.New(), &lastDay, lastDay), "employment contract not found")
+		assert.EqualError(t, employee.SetContractEnd(contract.ID(), nil, lastDay), "pkwt contract requires an end date")
+		assert.EqualError(t, employee.SetContractEnd(contract.ID(), &beforeStart, lastDay), "end date cannot be before start date")
+		assert.EqualError(t, employee.CloseContract(contract.ID(), enum.ContractStatusActive, lastDay), `invalid closing ContractStatus: "active"`)
+	})
+}
+
+func TestEmployee_AddDocument(t *testing.T) {
+	employee := newEmployee(t)
+	file, _ := valueobject.NewFileReference("https://storage.example.com/docs/ktp.jpg", "ktp.jpg", "image/jpeg")
+	validity, _ := valueobject.NewValidityPeriodDocument(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+	doc, _ := valueobject.NewDocument(enum.DocKTP, *file, *validity)
+
+	assert.Nil(t, employee.AddDocument(*doc, time.Time{}))
+	assert.Len(t, employee.Documents(), 1)
+	assert.EqualError(t, employee.AddDocument(valueobject.Document{}, time.Time{}), "invalid document type")
+}
+
+func TestEmployee_SetBankAccount(t *testing.T) {
+	employee := newEmployee(t)
+	account, _ := valueobject.NewBankAccount(enum.BankMandiri, "1230001234567", "Rina Wijaya")
+	at := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Nil(t, employee.BankAccount())
+	assert.Nil(t, employee.SetBankAccount(*account, at))
+	assert.Equal(t, "1230001234567", employee.BankAccount().Number())
+	assert.Equal(t, at, employee.UpdatedAt())
+	assert.EqualError(t, employee.SetBankAccount(valueobject.BankAccount{}, at), "invalid bank account")
+}
+
+func TestEmployee_AssignOrganizationUnit(t *testing.T) {
+	employee := newEmployee(t)
+	unitID := uuid.New()
+	at := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Nil(t, employee.OrganizationUnitID())
+	assert.Nil(t, employee.AssignOrganizationUnit(unitID, at))
+	assert.Equal(t, unitID, *employee.OrganizationUnitID())
+	assert.Equal(t, at, employee.UpdatedAt())
+	assert.EqualError(t, employee.AssignOrganizationUnit(uuid.Nil, at), "invalid organization unit id")
+}
+
+func TestEmployee_ChangeStatus(t *testing.T) {
+	at := time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("Lifecycle", func(t *testing.T) {
+		employee := newEmployee(t)
+
+		assert.True(t, employee.HireDate().IsZero())
+		assert.Nil(t, employee.ChangeStatus(enum.EmploymentOnLeave, time.Time{}, "annual leave", at))
+		assert.Equal(t, enum.EmploymentOnLeave, employee.Status())
+		assert.Equal(t, at, employee.UpdatedAt())
+		assert.Nil(t, employee.ChangeStatus(enum.EmploymentActive, time.Time{}, "back from leave", at.AddDate(0, 0, 5)))
+		assert.Nil(t, employee.ChangeStatus(enum.EmploymentSuspended, at.AddDate(0, 0, 9), "misconduct investigation", at.AddDate(0, 0, 10)))
+		assert.Nil(t, employee.ChangeStatus(enum.EmploymentResigned, time.Time{}, "resignation", at.AddDate(0, 0, 20)))
+		assert.True(t, employee.HasLeft())
+
+		history := employee.StatusHistory()
+		assert.Len(t, history, 4)
+		assert.Equal(t, enum.EmploymentActive, history[2].From())
+		assert.Equal(t, enum.EmploymentSuspended, history[2].To())
+		assert.Equal(t, time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), history[2].EffectiveDate())
+		assert.Equal(t, "misconduct investigation", history[2].Reason())
+		assert.Equal(t, at.AddDate(0, 0, 10), history[2].RecordedAt())
+
+		assert.Equal(t, enum.EmploymentActive, employee.StatusOn(at.AddDate(0, 0, -1)))
+		assert.Equal(t, enum.EmploymentOnLeave, employee.StatusOn(at.AddDate(0, 0, 4)))
+		assert.Equal(t, enum.EmploymentSuspended, employee.StatusOn(at.AddDate(0, 0, 9)))
+		assert.Equal(t, enum.EmploymentResigned, employee.StatusOn(at.AddDate(0, 1, 0)))
+	})
+	t.Run("InvalidChanges", func(t *testing.T) {
+		employee := newEmployee(t)
+		assert.Nil(t, employee.ChangeStatus(enum.EmploymentOnLeave, time.Time{}, "annual leave", at))
+
+		assert.EqualError(t, employee.ChangeStatus("fired", at, "fired", at), `invalid EmploymentStatus: "fired"`)
+		assert.EqualError(t, employee.ChangeStatus(enum.EmploymentOnLeave, at, "annual leave", at), "employee is already on_leave")
+		assert.EqualError(t, employee.ChangeStatus(enum.EmploymentSuspended, at, "investigation", at), "employee cannot change from on_leave to suspended")
+		assert.EqualError(t, employee.ChangeStatus(enum.EmploymentActive, at, " ", at), "status change reason cannot be empty")
+		assert.EqualError(t, employee.ChangeStatus(enum.EmploymentActive, at.AddDate(0, 0, 1), "back from leave", at), "status change cannot take effect in the future")
+		assert.EqualError(t, employee.ChangeStatus(enum.EmploymentActive, at.AddDate(0, 0, -1), "back from leave", at), "status change cannot take effect before the previous change")
+
+		assert.Nil(t, employee.ChangeStatus(enum.EmploymentResigned, at, "resignation", at))
+		assert.EqualError(t, employee.ChangeStatus(enum.EmploymentOnLeave, at, "annual leave", at), "employee cannot change from resigned to on_leave")
+		assert.Len(t, employee.StatusHistory(), 2)
+	})
+}
+
+func TestCanChangeStatus(t *testing.T) {
+	assert.True(t, employee_entity.CanChangeStatus(enum.EmploymentProbation, enum.EmploymentActive))
+	assert.True(t, employee_entity.CanChangeStatus(enum.EmploymentActive, enum.EmploymentRetired))
+	assert.False(t, employee_entity.CanChangeStatus(enum.EmploymentProbation, enum.EmploymentRetired))
+	assert.False(t, employee_entity.CanChangeStatus(enum.EmploymentInactive, enum.EmploymentOnLeave))
+	for _, left := range []enum.EmploymentStatus{enum.EmploymentResigned, enum.EmploymentTerminated, enum.EmploymentRetired} {
+		assert.False(t, employee_entity.CanChangeStatus(left, enum.EmploymentActive))
+	}
+}
+
+func TestEmployee_Probation(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	probationEnd := start.AddDate(0, 2, -1)
+	reviewer := uuid.New()
+	newProbationer := func(t *testing.T) (*employee_entity.Employee, uuid.UUID) {
+		employee, err := employee_entity.EmployeeFactory{ID: uuid.NewString(), PersonalInfo: newPersonalInfo(t), Status: "probation"}.Create()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		contract, err := employee_entity.EmploymentContractFactory{
+			ID: uuid.NewString(), ContractType: "pkwtt", StartDate: start, ProbationEnd: &probationEnd, Status: "active",
+		}.Create()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		_ = employee.AddEmploymentContract(*contract, time.Time{})
+		return employee, contract.ID()
+	}
+	probationOf := func(employee *employee_entity.Employee) *employee_entity.Probation {
+		contracts := employee.EmploymentContracts()
+		return contracts[0].Probation()
+	}
+
+	t.Run("ExtendAndConfirm", func(t *testing.T) {
+		employee, contractID := newProbationer(t)
+		at := time.Date(2025, 2, 20, 10, 0, 0, 0, time.UTC)
+		assert.True(t, probationOf(employee).EvaluationDue(at, employee_entity.ProbationReminderDays))
+
+		assert.EqualError(t, employee.ExtendProbation(contractID, start.AddDate(0, 3, 0), reviewer, "", at), "probation cannot exceed 3 months")
+		assert.EqualError(t, employee.ExtendProbation(contractID, probationEnd, reviewer, "", at), "extended probation must end after the current end date")
+		assert.Nil(t, employee.ExtendProbation(contractID, start.AddDate(0, 3, -1), reviewer, "needs another month on the ledger work", at))
+		probation := probationOf(employee)
+		assert.Equal(t, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), probation.EndDate())
+		assert.Equal(t, probationEnd, *probation.ExtendedFrom())
+		assert.False(t, probation.EvaluationDue(at, employee_entity.ProbationReminderDays))
+		assert.Equal(t, enum.EmploymentProbation, employee.Status())
+
+		// Confirmation is still possible once the probation has run out.
+		assert.Nil(t, employee.ConfirmProbation(contractID, reviewer, "meets expectations", at.AddDate(0, 1, 15)))
+		assert.Equal(t, enum.ProbationConfirmed, probationOf(employee).Status())
+		assert.Equal(t, reviewer, *probationOf(employee).DecidedBy())
+		assert.Equal(t, enum.EmploymentActive, employee.Status())
+		assert.Equal(t, "probation confirmed", employee.StatusHistory()[0].Reason())
+		assert.EqualError(t, employee.FailProbation(contractID, reviewer, "late", at), "probation is confirmed")
+	})
+	t.Run("Fail", func(t *testing.T) {
+		employee, contractID := newProbationer(t)
+
+		assert.EqualError(t, employee.FailProbation(contractID, reviewer, " ", start.AddDate(0, 1, 0)), "probation failure note cannot be empty")
+		assert.EqualError(t, employee.FailProbation(contractID, uuid.Nil, "missed targets", start.AddDate(0, 1, 0)), "reviewer cannot be empty")
+		assert.EqualError(t, employee.FailProbation(contractID, reviewer, "missed targets", probationEnd.AddDate(0, 0, 1)), "probation has already ended")
+		assert.EqualError(t, employee.ExtendProbation(contractID, start.AddDate(0, 3, -1), reviewer, "", probationEnd.AddDate(0, 0, 1)), "probation has already ended")
+		assert.Nil(t, employee.FailProbation(contractID, reviewer, "missed targets", start.AddDate(0, 1, 0)))
+		assert.Equal(t, enum.ProbationFailed, probationOf(employee).Status())
+		assert.Equal(t, "missed targets", probationOf(employee).Note())
+		assert.Equal(t, enum.EmploymentProbation, employee.Status())
+	})
+	t.Run("NoProbation", func(t *testing.T) {
+		employee := newEmployee(t)
+		contract := newContract(t, "pkwtt", start, nil)
+		_ = employee.AddEmploymentContract(*contract, time.Time{})
+
+		assert.EqualError(t, employee.ConfirmProbation(contract.ID(), reviewer, "", start), "employment contract has no probation period")
+		assert.EqualError(t, employee.ConfirmProbation(uuid.New(), reviewer, "", start), "employment contract not found")
+	})
+}
+
+func TestEmployee_SalaryRecords(t *testing.T) {
+	employee := newEmployee(t)
+	newRecord := func(amount int64, effective time.Time) employee_entity.SalaryRecord {
+		record, err := employee_entity.SalaryRecordFactory{ID: uuid.NewString(), Amount: amount, Currency: "idr", EffectiveDate: effective}.Create()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return *record
+	}
+	raise := newRecord(9_000_000, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	initial := newRecord(8_000_000, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	assert.Nil(t, employee.AddSalaryRecord(raise, time.Time{}))
+	assert.Nil(t, employee.AddSalaryRecord(initial, time.Time{}))
+	assert.EqualError(t, employee.AddSalaryRecord(initial, time.Time{}), "salary record already exists")
+	assert.EqualError(t, employee.AddSalaryRecord(newRecord(1, raise.EffectiveDate()), time.Time{}), "salary record already exists for the effective date")
+	assert.EqualError(t, employee.AddSalaryRecord(employee_entity.SalaryRecord{}, time.Time{}), "invalid salary record")
+
+	assert.Equal(t, initial.ID(), employee.SalaryRecords()[0].ID())
+	_, ok := employee.SalaryAt(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+	salary, ok := employee.SalaryAt(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, int64(8_000_000), salary.Amount())
+	assert.Equal(t, "IDR", salary.Currency())
+	salary, _ = employee.SalaryAt(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, int64(9_000_000), salary.Amount())
+}
+
+func TestEmployee_IsEmployedOn(t *testing.T) {
+	employee := newEmployee(t)
+	start := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	contract, _ := employee_entity.EmploymentContractFactory{
+		ID: uuid.NewString(), ContractType: "pkwt", StartDate: start, EndDate: &end, Status: "expired",
+	}.Create()
+	_ = employee.AddEmploymentContract(*contract, time.Time{})
+
+	assert.False(t, employee.IsEmployedOn(start.AddDate(0, 0, -1)))
+	assert.True(t, employee.IsEmployedOn(start.Add(9*time.Hour)))
+	assert.True(t, employee.IsEmployedOn(end))
+	assert.False(t, employee.IsEmployedOn(end.AddDate(0, 0, 1)))
+}
@@ -0,0 +1,77 @@
+package employee_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"time"
+)
+
+// EmploymentContract represents an employment agreement between the company and an employee.
+type EmploymentContract struct {
+	id           uuid.UUID
+	contractType enum.ContractType
+	startDate    time.Time
+	endDate      *time.Time
+	document     *valueobject.Document
+	probation    *Probation
+	status       enum.ContractStatus
+}
+
+// ID returns the unique identifier of the contract.
+func (c *EmploymentContract) ID() uuid.UUID {
+	return c.id
+}
+
+// ContractType returns the type of the contract (PKWT, PKWTT, ...).
+func (c *EmploymentContract) ContractType() enum.ContractType {
+	return c.contractType
+}
+
+// StartDate returns the first day of the contract.
+func (c *EmploymentContract) StartDate() time.Time {
+	return c.startDate
+}
+
+// EndDate returns a copy of the last day of the contract, or nil for an open-ended
+// contract.
+func (c *EmploymentContract) EndDate() *time.Time {
+	return copyOf(c.endDate)
+}
+
+// Document returns the signed contract document, or nil if none is attached yet.
+func (c *EmploymentContract) Document() *valueobject.Document {
+	return c.document
+}
+
+// Probation returns a copy of the probation period, or nil if the contract has none.
+func (c *EmploymentContract) Probation() *Probation {
+	if c.probation == nil {
+		return nil
+	}
+	p := *c.probation
+	return &p
+}
+
+// Status returns the contract status.
+func (c *EmploymentContract) Status() enum.ContractStatus {
+	return c.status
+}
+
+// IsEffective reports whether the contract is active and covers the given instant.
+func (c *EmploymentContract) IsEffective(at time.Time) bool {
+	if c.status != enum.ContractStatusActive || at.Before(c.startDate) {
+		return false
+	}
+	return c.endDate == nil || !at.After(*c.endDate)
+}
+
+// AttachDocument sets the contract document, e.g. after it has been generated or signed.
+func (c *EmploymentContract) AttachDocument(doc valueobject.Document) error {
+	if !doc.Kind().Valid() {
+		return errors.New("invalid document type")
+	}
+	c.document = &doc
+	return nil
+}
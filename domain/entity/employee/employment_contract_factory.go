@@ -0,0 +1,78 @@
+package employee_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"time"
+)
+
+// EmploymentContractFactory is a factory type for creating EmploymentContract instances with validated properties.
+type EmploymentContractFactory struct {
+	ID           string
+	ContractType string
+	StartDate    time.Time
+	EndDate      *time.Time
+	Document     *valueobject.Document
+	ProbationEnd *time.Time
+	Status       string
+}
+
+// Create validates the factory data and returns a new EmploymentContract.
+// PKWT (fixed-term) contracts require an end date; PKWTT and permanent contracts must not have one.
+// A probation starts with the contract, ends on ProbationEnd and is only allowed for PKWTT and
+// permanent contracts, for at most MaxProbationMonths.
+func (f EmploymentContractFactory) Create() (*EmploymentContract, error) {
+	newUUID, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	contractType, err := enum.ParseContractType(f.ContractType)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.StartDate.IsZero() {
+		return nil, errors.New("start date cannot be empty")
+	}
+
+	if f.EndDate != nil && f.EndDate.Before(f.StartDate) {
+		return nil, errors.New("end date cannot be before start date")
+	}
+
+	switch contractType {
+	case enum.ContractPKWT:
+		if f.EndDate == nil {
+			return nil, errors.New("pkwt contract requires an end date")
+		}
+	case enum.ContractPKWTT, enum.ContractPermanent:
+		if f.EndDate != nil {
+			return nil, errors.New("permanent contract cannot have an end date")
+		}
+	}
+
+	var probation *Probation
+	if f.ProbationEnd != nil {
+		probation, err = newProbation(contractType, f.StartDate, *f.ProbationEnd)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	status, err := enum.ParseContractStatus(f.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EmploymentContract{
+		id:           newUUID,
+		contractType: contractType,
+		startDate:    f.StartDate,
+		endDate:      f.EndDate,
+		document:     f.Document,
+		probation:    probation,
+		status:       status,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package employee_entity_test
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestEmploymentContractFactory_Create(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, -1)
+
+	t.Run("ValidPKWT", func(t *testing.T) {
+		factory := employee_entity.EmploymentContractFactory{
+			ID:           uuid.NewString(),
+			ContractType: "pkwt",
+			StartDate:    start,
+			EndDate:      &end,
+			Status:       "active",
+		}
+
+		contract, err := factory.Create()
+
+		assert.Nil(t, err)
+		assert.NotNil(t, contract)
+		assert.Equal(t, enum.ContractPKWT, contract.ContractType())
+		assert.Equal(t, start, contract.StartDate())
+		assert.Equal(t, end, *contract.EndDate())
+		*contract.EndDate() = end.AddDate(1, 0, 0)
+		assert.Equal(t, end, *contract.EndDate())
+		assert.Equal(t, enum.ContractStatusActive, contract.Status())
+		assert.Nil(t, contract.Document())
+		assert.True(t, contract.IsEffective(start))
+		assert.True(t, contract.IsEffective(end))
+		assert.False(t, contract.IsEffective(end.AddDate(0, 0, 1)))
+	})
+	t.Run("ValidPKWTT", func(t *testing.T) {
+		factory := employee_entity.EmploymentContractFactory{
+			ID:           uuid.NewString(),
+			ContractType: "pkwtt",
+			StartDate:    start,
+			Status:       "active",
+		}
+
+		contract, err := factory.Create()
+
+		assert.Nil(t, err)
+		assert.Nil(t, contract.EndDate())
+		assert.True(t, contract.IsEffective(start.AddDate(10, 0, 0)))
+	})
+	t.Run("InvalidID", func(t *testing.T) {
+		factory := employee_entity.EmploymentContractFactory{ID: "uuid", ContractType: "pkwtt", StartDate: start, Status: "active"}
+
+		contract, err := factory.Create()
+
+		assert.Nil(t, contract)
+		assert.EqualError(t, err, "invalid format uuid")
+	})
+	t.Run("InvalidContractType", func(t *testing.T) {
+		factory := employee_entity.EmploymentContractFactory{ID: uuid.NewString(), ContractType: "daily", StartDate: start, Status: "active"}
+
+		contract, err := factory.Create()
+
+		assert.Nil(t, contract)
+		assert.EqualError(t, err, fmt.Errorf("invalid ContractType: %q", "daily").Error())
+	})
+	t.Run("EmptyStartDate", func(t *testing.T) {
+		factory := employee_entity.EmploymentContractFactory{ID: uuid.NewString(), ContractType: "pkwtt", Status: "active"}
+
+		contract, err := factory.Create()
+
+		assert.Nil(t, contract)
+		assert.EqualError(t, err, "start date cannot be empty")
+	})
+	t.Run("EndBeforeStart", func(t *testing.T) {
+		before := start.AddDate(0, 0, -1)
+		factory := employee_entity.EmploymentContractFactory{ID: uuid.NewString(), ContractType: "pkwt", StartDate: start, EndDate: &before, Status: "active"}
+
+		contract, err := factory.Create()
+
+		assert.Nil(t, contract)
+		assert.EqualError(t, err, "end date cannot be before start date")
+	})
+	t.Run("PKWTWithoutEndDate", func(t *testing.T) {
+		factory := employee_entity.EmploymentContractFactory{ID: uuid.NewString(), ContractType: "pkwt", StartDate: start, Status: "active"}
+
+		contract, err := factory.Create()
+
+		assert.Nil(t, contract)
+		assert.EqualError(t, err, "pkwt contract requires an end date")
+	})
+	t.Run("PKWTTWithEndDate", func(t *testing.T) {
+		factory := employee_entity.EmploymentContractFactory{ID: uuid.NewString(), ContractType: "pkwtt", StartDate: start, EndDate: &end, Status: "active"}
+
+		contract, err := factory.Create()
+
+		assert.Nil(t, contract)
+		assert.EqualError(t, err, "permanent contract cannot have an end date")
+	})
+	t.Run("Probation", func(t *testing.T) {
+		probationEnd := start.AddDate(0, 3, -1)
+		factory := employee_entity.EmploymentContractFactory{ID: uuid.NewString(), ContractType: "pkwtt", StartDate: start, ProbationEnd: &probationEnd, Status: "active"}
+
+		contract, err := factory.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, start, contract.Probation().StartDate())
+		assert.Equal(t, probationEnd, contract.Probation().EndDate())
+		assert.Equal(t, enum.ProbationOngoing, contract.Probation().Status())
+		assert.Equal(t, 89, contract.Probation().DaysLeft(start))
+
+		factory.ID, factory.ContractType = uuid.NewString(), "permanent"
+		contract, err = factory.Create()
+		assert.Nil(t, err)
+		assert.Equal(t, probationEnd, contract.Probation().EndDate())
+	})
+	t.Run("InvalidProbation", func(t *testing.T) {
+		tooLong := start.AddDate(0, 3, 0)
+		before := start.AddDate(0, 0, -1)
+		probationEnd := start.AddDate(0, 2, 0)
+
+		_, err := employee_entity.EmploymentContractFactory{ID: uuid.NewString(), ContractType: "pkwtt", StartDate: start, ProbationEnd: &tooLong, Status: "active"}.Create()
+		assert.EqualError(t, err, "probation cannot exceed 3 months")
+		_, err = employee_entity.EmploymentContractFactory{ID: uuid.NewString(), ContractType: "pkwtt", StartDate: start, ProbationEnd: &before, Status: "active"}.Create()
+		assert.EqualError(t, err, "probation end cannot be before start date")
+		_, err = employee_entity.EmploymentContractFactory{ID: uuid.NewString(), ContractType: "pkwt", StartDate: start, EndDate: &end, ProbationEnd: &probationEnd, Status: "active"}.Create()
+		assert.EqualError(t, err, "pkwt contract cannot have a probation period")
+		_, err = employee_entity.EmploymentContractFactory{ID: uuid.NewString(), ContractType: "freelance", StartDate: start, ProbationEnd: &probationEnd, Status: "active"}.Create()
+		assert.EqualError(t, err, "freelance contract cannot have a probation period")
+		_, err = employee_entity.EmploymentContractFactory{ID: uuid.NewString(), ContractType: "internship", StartDate: start, ProbationEnd: &probationEnd, Status: "active"}.Create()
+		assert.EqualError(t, err, "internship contract cannot have a probation period")
+	})
+	t.Run("InvalidStatus", func(t *testing.T) {
+		factory := employee_entity.EmploymentContractFactory{ID: uuid.NewString(), ContractType: "pkwtt", StartDate: start, Status: "draft"}
+
+		contract, err := factory.Create()
+
+		assert.Nil(t, contract)
+		assert.EqualError(t, err, fmt.Errorf("invalid ContractStatus: %q", "draft").Error())
+	})
+}
@@ -0,0 +1,114 @@
+package employee_entity
+
+import (
+	"slices"
+	"time"
+)
+
+// PersonalInfoDiff lists the personal data fields to change. Nil fields keep their
+// current value.
+type PersonalInfoDiff struct {
+	FirstName     *string
+	MiddleName    *string
+	NickName      *string
+	LastName      *string
+	BirthDate     *time.Time
+	PlaceOfBirth  *string
+	Gender        *string
+	Nationality   *string
+	MaritalStatus *string
+	Religion      *string
+}
+
+// Apply returns the factory with the changed fields replaced.
+func (d PersonalInfoDiff) Apply(f PersonalInfoFactory) PersonalInfoFactory {
+	set(&f.FirstName, d.FirstName)
+	set(&f.MiddleName, d.MiddleName)
+	set(&f.NickName, d.NickName)
+	set(&f.LastName, d.LastName)
+	set(&f.BirthDate, d.BirthDate)
+	set(&f.PlaceOfBirth, d.PlaceOfBirth)
+	set(&f.Gender, d.Gender)
+	set(&f.Nationality, d.Nationality)
+	set(&f.MaritalStatus, d.MaritalStatus)
+	set(&f.Religion, d.Religion)
+	return f
+}
+
+// Changes returns the names of the fields whose value differs from the factory's.
+func (d PersonalInfoDiff) Changes(f PersonalInfoFactory) []string {
+	var out []string
+	out = changed(out, "first_name", d.FirstName, f.FirstName)
+	out = changed(out, "middle_name", d.MiddleName, f.MiddleName)
+	out = changed(out, "nick_name", d.NickName, f.NickName)
+	out = changed(out, "last_name", d.LastName, f.LastName)
+	if d.BirthDate != nil && !d.BirthDate.Equal(f.BirthDate) {
+		out = append(out, "birth_date")
+	}
+	out = changed(out, "place_of_birth", d.PlaceOfBirth, f.PlaceOfBirth)
+	out = changed(out, "gender", d.Gender, f.Gender)
+	out = changed(out, "nationality", d.Nationality, f.Nationality)
+	out = changed(out, "marital_status", d.MaritalStatus, f.MaritalStatus)
+	out = changed(out, "religion", d.Religion, f.Religion)
+	return out
+}
+
+// ContactInfoDiff lists the contact data fields to change. Nil fields keep their current
+// value; EmergencyContacts replaces the whole list.
+type ContactInfoDiff struct {
+	Street            *string
+	City              *string
+	State             *string
+	PostalCode        *string
+	Country           *string
+	PhoneCountryCode  *string
+	Phone             *string
+	Email             *string
+	EmergencyContacts *[]EmergencyContactFactory
+}
+
+// Apply returns the factory with the changed fields replaced.
+func (d ContactInfoDiff) Apply(f ContactInfoFactory) ContactInfoFactory {
+	set(&f.Street, d.Street)
+	set(&f.City, d.City)
+	set(&f.State, d.State)
+	set(&f.PostalCode, d.PostalCode)
+	set(&f.Country, d.Country)
+	set(&f.PhoneCountryCode, d.PhoneCountryCode)
+	set(&f.Phone, d.Phone)
+	set(&f.Email, d.Email)
+	if d.EmergencyContacts != nil {
+		f.EmergencyContacts = slices.Clone(*d.EmergencyContacts)
+	}
+	return f
+}
+
+// Changes returns the names of the fields whose value differs from the factory's.
+func (d ContactInfoDiff) Changes(f ContactInfoFactory) []string {
+	var out []string
+	out = changed(out, "street", d.Street, f.Street)
+	out = changed(out, "city", d.City, f.City)
+	out = changed(out, "state", d.State, f.State)
+	out = changed(out, "postal_code", d.PostalCode, f.PostalCode)
+	out = changed(out, "country", d.Country, f.Country)
+	out = changed(out, "phone_country_code", d.PhoneCountryCode, f.PhoneCountryCode)
+	out = changed(out, "phone", d.Phone, f.Phone)
+	out = changed(out, "email", d.Email, f.Email)
+	if d.EmergencyContacts != nil && !slices.Equal(*d.EmergencyContacts, f.EmergencyContacts) {
+		out = append(out, "emergency_contacts")
+	}
+	return out
+}
+
+func set[T any](field *T, value *T) {
+	if value != nil {
+		*field = *value
+	}
+}
+
+func changed(out []string, name string, value *string, current string) []string {
+	if value != nil && *value != current {
+		out = append(out, name)
+	}
+	return out
+}
@@ -43,3 +43,19 @@ func (p *PersonalInfo) MaritalStatus() enum.MaritalStatus {
 func (p *PersonalInfo) Religion() enum.Religion {
 	return p.religion
 }
+
+// Factory returns a factory holding the current values, to be changed and re-created.
+func (p *PersonalInfo) Factory() PersonalInfoFactory {
+	return PersonalInfoFactory{
+		FirstName:     p.name.FirstName(),
+		MiddleName:    p.name.MiddleName(),
+		NickName:      p.name.NickName(),
+		LastName:      p.name.LastName(),
+		BirthDate:     p.birthDate,
+		PlaceOfBirth:  p.placeOfBirth,
+		Gender:        string(p.gender),
+		Nationality:   string(p.nationality),
+		MaritalStatus: string(p.maritalStatus),
+		Religion:      string(p.religion),
+	}
+}
@@ -0,0 +1,139 @@
+package employee_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"strings"
+	"time"
+)
+
+// MaxProbationMonths is the longest probation a PKWTT contract may require under UU No. 13/2003
+// Pasal 60 ayat (1), extensions included. PKWT contracts cannot require one (Pasal 58).
+const MaxProbationMonths = 3
+
+// ProbationReminderDays is how many days before the end of a probation its evaluation is due.
+const ProbationReminderDays = 14
+
+// Probation is the probation period of a PKWTT or permanent contract, from the contract
+// start to its end date, and the decision taken on it.
+type Probation struct {
+	startDate    time.Time
+	endDate      time.Time
+	extendedFrom *time.Time
+	status       enum.ProbationStatus
+	decidedBy    *uuid.UUID
+	decidedAt    *time.Time
+	note         string
+}
+
+// StartDate returns the first day of the probation.
+func (p Probation) StartDate() time.Time { return p.startDate }
+
+// EndDate returns the last day of the probation.
+func (p Probation) EndDate() time.Time { return p.endDate }
+
+// ExtendedFrom returns the original end date of an extended probation, or nil.
+func (p Probation) ExtendedFrom() *time.Time { return copyOf(p.extendedFrom) }
+
+// Status returns whether the probation is ongoing, confirmed or failed.
+func (p Probation) Status() enum.ProbationStatus { return p.status }
+
+// DecidedBy returns who last confirmed, extended or failed the probation, or nil.
+func (p Probation) DecidedBy() *uuid.UUID { return copyOf(p.decidedBy) }
+
+// DecidedAt returns when the probation was last confirmed, extended or failed, or nil.
+func (p Probation) DecidedAt() *time.Time { return copyOf(p.decidedAt) }
+
+// Note returns the reviewer's note on the last decision.
+func (p Probation) Note() string { return p.note }
+
+// IsOngoing reports whether no decision on the probation has been taken yet.
+func (p Probation) IsOngoing() bool { return p.status == enum.ProbationOngoing }
+
+// DaysLeft returns the days from the calendar date of at to the end of the probation,
+// negative once it has passed.
+func (p Probation) DaysLeft(at time.Time) int {
+	return int(p.endDate.Sub(dateOf(at)).Hours() / 24)
+}
+
+// EvaluationDue reports whether the probation is ongoing and ends within the given days
+// of at, or has ended without a decision.
+func (p Probation) EvaluationDue(at time.Time, days int) bool {
+	return p.IsOngoing() && p.DaysLeft(at) <= days
+}
+
+// newProbation validates a probation of a contract of the given type starting on start.
+// Only PKWTT and permanent contracts may have one.
+func newProbation(contractType enum.ContractType, start, end time.Time) (*Probation, error) {
+	if contractType != enum.ContractPKWTT && contractType != enum.ContractPermanent {
+		return nil, fmt.Errorf("%s contract cannot have a probation period", contractType)
+	}
+	start, end = dateOf(start), dateOf(end)
+	if end.Before(start) {
+		return nil, errors.New("probation end cannot be before start date")
+	}
+	if end.After(maxProbationEnd(start)) {
+		return nil, fmt.Errorf("probation cannot exceed %d months", MaxProbationMonths)
+	}
+	return &Probation{startDate: start, endDate: end, status: enum.ProbationOngoing}, nil
+}
+
+// decide confirms or fails an ongoing probation. A probation can still be confirmed after
+// its end date, but not failed.
+func (p *Probation) decide(status enum.ProbationStatus, reviewerID uuid.UUID, note string, at time.Time) error {
+	if err := p.check(reviewerID); err != nil {
+		return err
+	}
+	if status == enum.ProbationFailed && dateOf(at).After(p.endDate) {
+		return errors.New("probation has already ended")
+	}
+	p.status = status
+	p.record(reviewerID, note, at)
+	return nil
+}
+
+// extend moves the end of an ongoing probation that has not ended yet, within the maximum.
+func (p *Probation) extend(end time.Time, reviewerID uuid.UUID, note string, at time.Time) error {
+	if err := p.check(reviewerID); err != nil {
+		return err
+	}
+	if dateOf(at).After(p.endDate) {
+		return errors.New("probation has already ended")
+	}
+	end = dateOf(end)
+	if !end.After(p.endDate) {
+		return errors.New("extended probation must end after the current end date")
+	}
+	if end.After(maxProbationEnd(p.startDate)) {
+		return fmt.Errorf("probation cannot exceed %d months", MaxProbationMonths)
+	}
+	if p.extendedFrom == nil {
+		from := p.endDate
+		p.extendedFrom = &from
+	}
+	p.endDate = end
+	p.record(reviewerID, note, at)
+	return nil
+}
+
+func (p *Probation) check(reviewerID uuid.UUID) error {
+	if !p.IsOngoing() {
+		return fmt.Errorf("probation is %s", p.status)
+	}
+	if reviewerID == uuid.Nil {
+		return errors.New("reviewer cannot be empty")
+	}
+	return nil
+}
+
+func (p *Probation) record(reviewerID uuid.UUID, note string, at time.Time) {
+	p.decidedBy = &reviewerID
+	p.decidedAt = &at
+	p.note = strings.TrimSpace(note)
+}
+
+func maxProbationEnd(start time.Time) time.Time {
+	return start.AddDate(0, MaxProbationMonths, -1)
+}
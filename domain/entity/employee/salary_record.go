@@ -0,0 +1,43 @@
+package employee_entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// SalaryRecord represents the employee's wage from an effective date onwards.
+// amount is the monthly wage (upah pokok plus tunjangan tetap) in whole units of the
+// currency; it is the basis for overtime, THR and severance. bonus is an optional
+// one-off amount granted with the record.
+type SalaryRecord struct {
+	id            uuid.UUID
+	amount        int64
+	currency      string
+	effectiveDate time.Time
+	bonus         int64
+}
+
+// ID returns the unique identifier of the salary record.
+func (s SalaryRecord) ID() uuid.UUID {
+	return s.id
+}
+
+// Amount returns the monthly wage.
+func (s SalaryRecord) Amount() int64 {
+	return s.amount
+}
+
+// Currency returns the ISO 4217 currency code of the amounts.
+func (s SalaryRecord) Currency() string {
+	return s.currency
+}
+
+// EffectiveDate returns the date from which the wage applies.
+func (s SalaryRecord) EffectiveDate() time.Time {
+	return s.effectiveDate
+}
+
+// Bonus returns the one-off bonus granted with the record, 0 if none.
+func (s SalaryRecord) Bonus() int64 {
+	return s.bonus
+}
@@ -0,0 +1,50 @@
+package employee_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"time"
+)
+
+// SalaryRecordFactory is a factory type for creating SalaryRecord entities.
+type SalaryRecordFactory struct {
+	ID            string
+	Amount        int64
+	Currency      string
+	EffectiveDate time.Time
+	Bonus         int64
+}
+
+// Create validates the factory data and returns a new SalaryRecord.
+func (f SalaryRecordFactory) Create() (*SalaryRecord, error) {
+	newUUID, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	if f.Amount <= 0 {
+		return nil, errors.New("salary amount must be positive")
+	}
+
+	currency, err := valueobject.ParseCurrency(f.Currency)
+	if err != nil {
+		return nil, errors.New("invalid currency code")
+	}
+
+	if f.EffectiveDate.IsZero() {
+		return nil, errors.New("effective date cannot be empty")
+	}
+
+	if f.Bonus < 0 {
+		return nil, errors.New("bonus cannot be negative")
+	}
+
+	return &SalaryRecord{
+		id:            newUUID,
+		amount:        f.Amount,
+		currency:      currency.Code(),
+		effectiveDate: f.EffectiveDate,
+		bonus:         f.Bonus,
+	}, nil
+}
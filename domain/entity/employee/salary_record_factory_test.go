@@ -0,0 +1,65 @@
+package employee_entity_test
+
+import (
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSalaryRecordFactory_Create(t *testing.T) {
+	effective := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	valid := employee_entity.SalaryRecordFactory{
+		ID:            uuid.NewString(),
+		Amount:        8_500_000,
+		Currency:      " idr ",
+		EffectiveDate: effective,
+		Bonus:         1_000_000,
+	}
+
+	t.Run("ValidInput", func(t *testing.T) {
+		record, err := valid.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(8_500_000), record.Amount())
+		assert.Equal(t, "IDR", record.Currency())
+		assert.Equal(t, effective, record.EffectiveDate())
+		assert.Equal(t, int64(1_000_000), record.Bonus())
+	})
+	t.Run("InvalidID", func(t *testing.T) {
+		f := valid
+		f.ID = "uuid"
+
+		_, err := f.Create()
+		assert.EqualError(t, err, "invalid format uuid")
+	})
+	t.Run("NonPositiveAmount", func(t *testing.T) {
+		f := valid
+		f.Amount = 0
+
+		_, err := f.Create()
+		assert.EqualError(t, err, "salary amount must be positive")
+	})
+	t.Run("InvalidCurrency", func(t *testing.T) {
+		f := valid
+		f.Currency = "RP"
+
+		_, err := f.Create()
+		assert.EqualError(t, err, "invalid currency code")
+	})
+	t.Run("EmptyEffectiveDate", func(t *testing.T) {
+		f := valid
+		f.EffectiveDate = time.Time{}
+
+		_, err := f.Create()
+		assert.EqualError(t, err, "effective date cannot be empty")
+	})
+	t.Run("NegativeBonus", func(t *testing.T) {
+		f := valid
+		f.Bonus = -1
+
+		_, err := f.Create()
+		assert.EqualError(t, err, "bonus cannot be negative")
+	})
+}
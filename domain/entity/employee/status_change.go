@@ -0,0 +1,60 @@
+package employee_entity
+
+import (
+	"github.com/rfanazhari/hris/domain/enum"
+	"slices"
+	"time"
+)
+
+// statusTransitions lists the statuses an employee may move to from each status.
+// Resigned, terminated and retired end the employment and have no way out; a returning
+// employee is hired again.
+var statusTransitions = map[enum.EmploymentStatus][]enum.EmploymentStatus{
+	enum.EmploymentProbation: {enum.EmploymentActive, enum.EmploymentSuspended, enum.EmploymentResigned, enum.EmploymentTerminated},
+	enum.EmploymentActive:    {enum.EmploymentOnLeave, enum.EmploymentSuspended, enum.EmploymentInactive, enum.EmploymentResigned, enum.EmploymentTerminated, enum.EmploymentRetired},
+	enum.EmploymentOnLeave:   {enum.EmploymentActive, enum.EmploymentResigned, enum.EmploymentTerminated, enum.EmploymentRetired},
+	enum.EmploymentSuspended: {enum.EmploymentProbation, enum.EmploymentActive, enum.EmploymentResigned, enum.EmploymentTerminated, enum.EmploymentRetired},
+	enum.EmploymentInactive:  {enum.EmploymentActive, enum.EmploymentResigned, enum.EmploymentTerminated, enum.EmploymentRetired},
+}
+
+// CanChangeStatus reports whether an employee may move from one status to the other.
+func CanChangeStatus(from, to enum.EmploymentStatus) bool {
+	return slices.Contains(statusTransitions[from], to)
+}
+
+// StatusChange records a move of the employee from one employment status to another.
+type StatusChange struct {
+	from          enum.EmploymentStatus
+	to            enum.EmploymentStatus
+	effectiveDate time.Time
+	reason        string
+	recordedAt    time.Time
+}
+
+// From returns the status before the change.
+func (s StatusChange) From() enum.EmploymentStatus { return s.from }
+
+// To returns the status after the change.
+func (s StatusChange) To() enum.EmploymentStatus { return s.to }
+
+// EffectiveDate returns the date from which the new status applies.
+func (s StatusChange) EffectiveDate() time.Time { return s.effectiveDate }
+
+// Reason returns why the status changed.
+func (s StatusChange) Reason() string { return s.reason }
+
+// RecordedAt returns when the change was recorded.
+func (s StatusChange) RecordedAt() time.Time { return s.recordedAt }
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// copyOf returns a pointer to a copy of *v, or nil, so getters do not expose internal state.
+func copyOf[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	c := *v
+	return &c
+}
@@ -3,6 +3,7 @@ package entity
 import (
 	"errors"
 	"github.com/google/uuid"
+	compensation_entity "github.com/rfanazhari/hris/domain/entity/compensation"
 	"github.com/rfanazhari/hris/domain/enum"
 	"github.com/rfanazhari/hris/domain/valueobject"
 	"github.com/rfanazhari/hris/pkg/validation"
@@ -10,6 +11,8 @@ import (
 )
 
 // JobPositionFactory represents a factory for creating JobPosition objects with validation rules applied.
+// When PayStructure is set the salary range must lie within the band of the grade level
+// in effect at CreatedAt.
 type JobPositionFactory struct {
 	ID             string
 	Title          string
@@ -18,6 +21,7 @@ type JobPositionFactory struct {
 	SalaryMin      int64
 	SalaryMax      int64
 	SalaryCurrency string
+	PayStructure   *compensation_entity.PayStructure
 	CreatedAt      time.Time
 }
 
@@ -54,6 +58,11 @@ func (f JobPositionFactory) Create() (*JobPosition, error) {
 	if errSalary != nil {
 		return nil, errSalary
 	}
+	if f.PayStructure != nil {
+		if err := f.PayStructure.ValidateRange(grade, *salaryRange, f.CreatedAt); err != nil {
+			return nil, err
+		}
+	}
 
 	return &JobPosition{
 		id:          newUUID,
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"github.com/google/uuid"
+	compensation_entity "github.com/rfanazhari/hris/domain/entity/compensation"
 	"github.com/rfanazhari/hris/domain/enum"
 	"github.com/rfanazhari/hris/domain/valueobject"
 	"github.com/rfanazhari/hris/pkg/fake"
@@ -159,4 +160,35 @@ func TestJobPositionFactory_Create(t *testing.T) {
 		assert.Nil(t, jobPosition)
 		assert.EqualError(t, err, errSalary.Error())
 	})
+
+	t.Run("OutsidePayBand", func(t *testing.T) {
+		band, _ := compensation_entity.PayBandFactory{
+			ID: uuid.NewString(), GradeLevel: "junior", Min: 6_000_000, Midpoint: 8_000_000, Max: 10_000_000,
+			EffectiveDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		}.Create()
+		structure, _ := compensation_entity.NewPayStructure(*band)
+		factory := JobPositionFactory{
+			ID:             uuid.NewString(),
+			Title:          "Developer",
+			Description:    fake.Paragraph(1, 12),
+			GradeLevel:     "junior",
+			SalaryMin:      6_000_000,
+			SalaryMax:      9_000_000,
+			SalaryCurrency: "idr",
+			PayStructure:   structure,
+			CreatedAt:      time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		jobPosition, err := factory.Create()
+		assert.Nil(t, err)
+		assert.NotNil(t, jobPosition)
+
+		factory.SalaryMax = 12_000_000
+		_, err = factory.Create()
+		assert.EqualError(t, err, "salary range must be within the junior pay band of 6000000 to 10000000")
+
+		factory.GradeLevel = "senior"
+		_, err = factory.Create()
+		assert.EqualError(t, err, "no pay band for grade senior")
+	})
 }
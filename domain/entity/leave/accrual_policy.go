@@ -0,0 +1,111 @@
+package leave_entity
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// AccrualTier grants daysPerYear of annual leave once the employee has served minTenureMonths.
+type AccrualTier struct {
+	minTenureMonths int
+	daysPerYear     int
+}
+
+// NewAccrualTier constructs an AccrualTier with validation.
+func NewAccrualTier(minTenureMonths, daysPerYear int) (*AccrualTier, error) {
+	if minTenureMonths < 0 {
+		return nil, errors.New("minimum tenure cannot be negative")
+	}
+	if daysPerYear <= 0 {
+		return nil, errors.New("days per year must be positive")
+	}
+	return &AccrualTier{minTenureMonths: minTenureMonths, daysPerYear: daysPerYear}, nil
+}
+
+// MinTenureMonths returns the tenure required for the tier.
+func (t AccrualTier) MinTenureMonths() int {
+	return t.minTenureMonths
+}
+
+// DaysPerYear returns the annual leave granted per year in the tier.
+func (t AccrualTier) DaysPerYear() int {
+	return t.daysPerYear
+}
+
+// AccrualPolicy defines how annual leave is granted and carried over.
+// Annual leave is granted on every work anniversary according to the highest tier reached.
+// Up to carryOverMax unused days are carried into the next year and expire after
+// carryOverValidMonths; the rest is forfeited at year end.
+type AccrualPolicy struct {
+	tiers                []AccrualTier
+	carryOverMax         int
+	carryOverValidMonths int
+}
+
+// NewAccrualPolicy constructs an AccrualPolicy with validation.
+func NewAccrualPolicy(tiers []AccrualTier, carryOverMax, carryOverValidMonths int) (*AccrualPolicy, error) {
+	if len(tiers) == 0 {
+		return nil, errors.New("accrual policy requires at least one tier")
+	}
+	if carryOverMax < 0 {
+		return nil, errors.New("carry over maximum cannot be negative")
+	}
+	if carryOverMax > 0 && carryOverValidMonths <= 0 {
+		return nil, errors.New("carry over validity must be positive")
+	}
+	sorted := make([]AccrualTier, len(tiers))
+	copy(sorted, tiers)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].minTenureMonths < sorted[j].minTenureMonths })
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].minTenureMonths == sorted[i-1].minTenureMonths {
+			return nil, errors.New("accrual tiers cannot share the same tenure")
+		}
+	}
+	return &AccrualPolicy{tiers: sorted, carryOverMax: carryOverMax, carryOverValidMonths: carryOverValidMonths}, nil
+}
+
+// DefaultAccrualPolicy returns the statutory minimum of UU No. 13/2003 Pasal 79 ayat (2) huruf c:
+// 12 working days per year after 12 months of continuous service, without carry over.
+func DefaultAccrualPolicy() AccrualPolicy {
+	return AccrualPolicy{tiers: []AccrualTier{{minTenureMonths: 12, daysPerYear: 12}}}
+}
+
+// Tiers returns a copy of the tiers ordered by tenure.
+func (p AccrualPolicy) Tiers() []AccrualTier {
+	out := make([]AccrualTier, len(p.tiers))
+	copy(out, p.tiers)
+	return out
+}
+
+// CarryOverMax returns the maximum days carried into the next year.
+func (p AccrualPolicy) CarryOverMax() int {
+	return p.carryOverMax
+}
+
+// CarryOverValidMonths returns how many months carried days remain usable.
+func (p AccrualPolicy) CarryOverValidMonths() int {
+	return p.carryOverValidMonths
+}
+
+// EntitlementFor returns the annual leave days for the given tenure in months.
+func (p AccrualPolicy) EntitlementFor(tenureMonths int) int {
+	days := 0
+	for _, t := range p.tiers {
+		if tenureMonths >= t.minTenureMonths {
+			days = t.daysPerYear
+		}
+	}
+	return days
+}
+
+// AnnualGrant returns the work anniversary in year and the days granted on it.
+// No days are granted before the first anniversary tier is reached.
+func (p AccrualPolicy) AnnualGrant(hireDate time.Time, year int) (time.Time, int) {
+	if hireDate.IsZero() || year <= hireDate.Year() {
+		return time.Time{}, 0
+	}
+	years := year - hireDate.Year()
+	anniversary := hireDate.AddDate(years, 0, 0)
+	return anniversary, p.EntitlementFor(years * 12)
+}
@@ -0,0 +1,266 @@
+package leave_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"strings"
+	"time"
+)
+
+// LedgerEntry is a single signed movement of leave days in a LeaveLedger.
+// Positive days add to the balance (accrual, carry over, reversal), negative days
+// reduce it (usage, expiry). reference links the entry to its source, e.g. the
+// leave request ID or the accrual year.
+type LedgerEntry struct {
+	id            uuid.UUID
+	entryType     enum.LeaveEntryType
+	leaveType     enum.LeaveType
+	days          int
+	effectiveDate time.Time
+	expiresAt     *time.Time
+	reference     string
+	note          string
+}
+
+// ID returns the unique identifier of the entry.
+func (e LedgerEntry) ID() uuid.UUID {
+	return e.id
+}
+
+// EntryType returns the kind of movement.
+func (e LedgerEntry) EntryType() enum.LeaveEntryType {
+	return e.entryType
+}
+
+// LeaveType returns the leave type whose balance the entry affects.
+func (e LedgerEntry) LeaveType() enum.LeaveType {
+	return e.leaveType
+}
+
+// Days returns the signed number of days.
+func (e LedgerEntry) Days() int {
+	return e.days
+}
+
+// EffectiveDate returns the date from which the entry counts towards the balance.
+func (e LedgerEntry) EffectiveDate() time.Time {
+	return e.effectiveDate
+}
+
+// ExpiresAt returns when carried over days lapse, if applicable.
+func (e LedgerEntry) ExpiresAt() *time.Time {
+	return e.expiresAt
+}
+
+// Reference returns the source of the entry.
+func (e LedgerEntry) Reference() string {
+	return e.reference
+}
+
+// Note returns the free text note of the entry.
+func (e LedgerEntry) Note() string {
+	return e.note
+}
+
+// LeaveLedger is the append-only balance ledger of one employee. Balances are never
+// stored; they are derived from the entries effective on a given date.
+type LeaveLedger struct {
+	employeeID uuid.UUID
+	entries    []LedgerEntry
+}
+
+// NewLeaveLedger creates an empty ledger for the employee.
+func NewLeaveLedger(employeeID uuid.UUID) (*LeaveLedger, error) {
+	if employeeID == uuid.Nil {
+		return nil, errors.New("invalid employee id")
+	}
+	return &LeaveLedger{employeeID: employeeID}, nil
+}
+
+// EmployeeID returns the employee owning the ledger.
+func (l *LeaveLedger) EmployeeID() uuid.UUID {
+	return l.employeeID
+}
+
+// Entries returns a copy of all entries in posting order.
+func (l *LeaveLedger) Entries() []LedgerEntry {
+	out := make([]LedgerEntry, len(l.entries))
+	copy(out, l.entries)
+	return out
+}
+
+// Balance returns the days available for the leave type on the given date.
+func (l *LeaveLedger) Balance(leaveType enum.LeaveType, at time.Time) int {
+	day := calendarDate(at)
+	total := 0
+	for _, e := range l.entries {
+		if e.leaveType == leaveType && !e.effectiveDate.After(day) {
+			total += e.days
+		}
+	}
+	return total
+}
+
+// Accrue grants days effective on the given date. Accruals are idempotent by reference
+// so a periodic job can safely be re-run.
+func (l *LeaveLedger) Accrue(leaveType enum.LeaveType, days int, at time.Time, reference string) error {
+	if days <= 0 {
+		return errors.New("accrued days must be positive")
+	}
+	reference = strings.TrimSpace(reference)
+	if reference == "" {
+		return errors.New("reference cannot be empty")
+	}
+	if l.find(enum.LeaveEntryAccrual, reference) != nil {
+		return errors.New("accrual already recorded")
+	}
+	l.post(enum.LeaveEntryAccrual, leaveType, days, at, nil, reference, "")
+	return nil
+}
+
+// Consume deducts days used by an approved leave request. The balance on the given
+// date and on every later entry date must cover the usage, so requests approved out of
+// date order cannot overdraw the ledger.
+func (l *LeaveLedger) Consume(leaveType enum.LeaveType, days int, at time.Time, reference string) error {
+	if days <= 0 {
+		return errors.New("used days must be positive")
+	}
+	reference = strings.TrimSpace(reference)
+	if reference == "" {
+		return errors.New("reference cannot be empty")
+	}
+	if l.find(enum.LeaveEntryUsage, reference) != nil {
+		return errors.New("usage already recorded")
+	}
+	if l.lowestBalance(leaveType, at) < days {
+		return errors.New("insufficient leave balance")
+	}
+	l.post(enum.LeaveEntryUsage, leaveType, -days, at, nil, reference, "")
+	return nil
+}
+
+// Reverse restores the days of a previously recorded usage, e.g. when approved
+// leave is cancelled.
+func (l *LeaveLedger) Reverse(reference string, at time.Time) error {
+	usage := l.find(enum.LeaveEntryUsage, reference)
+	if usage == nil {
+		return errors.New("usage not found")
+	}
+	if l.find(enum.LeaveEntryReversal, reference) != nil {
+		return errors.New("usage already reversed")
+	}
+	l.post(enum.LeaveEntryReversal, usage.leaveType, -usage.days, usage.effectiveDate, nil, reference, "")
+	return nil
+}
+
+// Adjust records a manual correction of the balance. A note is mandatory for audit.
+func (l *LeaveLedger) Adjust(leaveType enum.LeaveType, days int, at time.Time, note string) error {
+	if days == 0 {
+		return errors.New("adjusted days cannot be zero")
+	}
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return errors.New("adjustment note cannot be empty")
+	}
+	l.post(enum.LeaveEntryAdjustment, leaveType, days, at, nil, uuid.NewString(), note)
+	return nil
+}
+
+// CloseYear closes the annual leave balance of the given year under the policy.
+// The balance on 31 December is forfeited; up to the policy's carry over maximum is
+// re-granted on 1 January and expires after the policy's validity period.
+func (l *LeaveLedger) CloseYear(year int, policy AccrualPolicy, loc *time.Location) error {
+	if loc == nil {
+		loc = time.UTC
+	}
+	reference := fmt.Sprintf("year-end-%d", year)
+	if l.find(enum.LeaveEntryExpiry, reference) != nil || l.find(enum.LeaveEntryCarryOver, reference) != nil {
+		return errors.New("year already closed")
+	}
+
+	yearEnd := time.Date(year, time.December, 31, 0, 0, 0, 0, loc)
+	balance := l.Balance(enum.LeaveAnnual, yearEnd)
+	if balance <= 0 {
+		return nil
+	}
+
+	l.post(enum.LeaveEntryExpiry, enum.LeaveAnnual, -balance, yearEnd, nil, reference, "")
+	carried := balance
+	if carried > policy.carryOverMax {
+		carried = policy.carryOverMax
+	}
+	if carried > 0 {
+		start := time.Date(year+1, time.January, 1, 0, 0, 0, 0, loc)
+		expiresAt := start.AddDate(0, policy.carryOverValidMonths, 0)
+		l.post(enum.LeaveEntryCarryOver, enum.LeaveAnnual, carried, start, &expiresAt, reference, "")
+	}
+	return nil
+}
+
+// ExpireCarryOver forfeits carried over days whose validity ended on or before at.
+// Carried days are consumed first, so only the part not used by annual leave taken
+// within the validity period lapses.
+func (l *LeaveLedger) ExpireCarryOver(at time.Time) {
+	day := calendarDate(at)
+	for _, c := range l.Entries() {
+		if c.entryType != enum.LeaveEntryCarryOver || c.expiresAt == nil || c.expiresAt.After(day) {
+			continue
+		}
+		reference := "expire-" + c.reference
+		if l.find(enum.LeaveEntryExpiry, reference) != nil {
+			continue
+		}
+		used := 0
+		for _, e := range l.entries {
+			if e.leaveType != enum.LeaveAnnual || e.effectiveDate.Before(c.effectiveDate) || !e.effectiveDate.Before(*c.expiresAt) {
+				continue
+			}
+			if e.entryType == enum.LeaveEntryUsage || e.entryType == enum.LeaveEntryReversal {
+				used -= e.days
+			}
+		}
+		if remaining := c.days - used; remaining > 0 {
+			l.post(enum.LeaveEntryExpiry, enum.LeaveAnnual, -remaining, *c.expiresAt, nil, reference, "")
+		}
+	}
+}
+
+func (l *LeaveLedger) post(entryType enum.LeaveEntryType, leaveType enum.LeaveType, days int, at time.Time, expiresAt *time.Time, reference, note string) {
+	if at.IsZero() {
+		at = time.Now()
+	}
+	l.entries = append(l.entries, LedgerEntry{
+		id:            uuid.New(),
+		entryType:     entryType,
+		leaveType:     leaveType,
+		days:          days,
+		effectiveDate: calendarDate(at),
+		expiresAt:     expiresAt,
+		reference:     reference,
+		note:          note,
+	})
+}
+
+// lowestBalance returns the lowest balance of the leave type from the given date on,
+// checked on that date and on the date of every later entry.
+func (l *LeaveLedger) lowestBalance(leaveType enum.LeaveType, at time.Time) int {
+	day := calendarDate(at)
+	lowest := l.Balance(leaveType, day)
+	for _, e := range l.entries {
+		if e.leaveType == leaveType && e.effectiveDate.After(day) {
+			lowest = min(lowest, l.Balance(leaveType, e.effectiveDate))
+		}
+	}
+	return lowest
+}
+
+func (l *LeaveLedger) find(entryType enum.LeaveEntryType, reference string) *LedgerEntry {
+	for i := range l.entries {
+		if l.entries[i].entryType == entryType && l.entries[i].reference == reference {
+			return &l.entries[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package leave_entity_test
+
+import (
+	"github.com/google/uuid"
+	leave_entity "github.com/rfanazhari/hris/domain/entity/leave"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newLedger(t *testing.T) *leave_entity.LeaveLedger {
+	ledger, err := leave_entity.NewLeaveLedger(uuid.New())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return ledger
+}
+
+func TestLeaveLedger_Balance(t *testing.T) {
+	t.Run("AccrueAndConsume", func(t *testing.T) {
+		ledger := newLedger(t)
+		assert.Nil(t, ledger.Accrue(enum.LeaveAnnual, 12, date(2025, 3, 1), "annual-2025"))
+		assert.EqualError(t, ledger.Accrue(enum.LeaveAnnual, 12, date(2025, 3, 1), "annual-2025"), "accrual already recorded")
+
+		assert.Equal(t, 0, ledger.Balance(enum.LeaveAnnual, date(2025, 2, 28)))
+		assert.Equal(t, 12, ledger.Balance(enum.LeaveAnnual, date(2025, 3, 1)))
+
+		assert.Nil(t, ledger.Consume(enum.LeaveAnnual, 5, date(2025, 4, 7), "req-1"))
+		assert.Equal(t, 7, ledger.Balance(enum.LeaveAnnual, date(2025, 5, 1)))
+		assert.EqualError(t, ledger.Consume(enum.LeaveAnnual, 8, date(2025, 5, 1), "req-2"), "insufficient leave balance")
+		assert.EqualError(t, ledger.Consume(enum.LeaveAnnual, 1, date(2025, 2, 1), "req-3"), "insufficient leave balance")
+	})
+	t.Run("ConsumeOutOfOrder", func(t *testing.T) {
+		ledger := newLedger(t)
+		_ = ledger.Accrue(enum.LeaveAnnual, 12, date(2025, 1, 1), "annual-2025")
+		assert.Nil(t, ledger.Consume(enum.LeaveAnnual, 10, date(2025, 3, 1), "req-1"))
+
+		// 12 days are left on 1 February, but the March leave already takes 10 of them.
+		assert.EqualError(t, ledger.Consume(enum.LeaveAnnual, 5, date(2025, 2, 1), "req-2"), "insufficient leave balance")
+		assert.Nil(t, ledger.Consume(enum.LeaveAnnual, 2, date(2025, 2, 1), "req-3"))
+		assert.Equal(t, 0, ledger.Balance(enum.LeaveAnnual, date(2025, 4, 1)))
+	})
+	t.Run("Reverse", func(t *testing.T) {
+		ledger := newLedger(t)
+		_ = ledger.Accrue(enum.LeaveAnnual, 12, date(2025, 3, 1), "annual-2025")
+		_ = ledger.Consume(enum.LeaveAnnual, 3, date(2025, 4, 7), "req-1")
+
+		assert.Nil(t, ledger.Reverse("req-1", date(2025, 4, 1)))
+		assert.Equal(t, 12, ledger.Balance(enum.LeaveAnnual, date(2025, 5, 1)))
+		assert.EqualError(t, ledger.Reverse("req-1", date(2025, 4, 1)), "usage already reversed")
+		assert.EqualError(t, ledger.Reverse("req-9", date(2025, 4, 1)), "usage not found")
+	})
+	t.Run("Adjust", func(t *testing.T) {
+		ledger := newLedger(t)
+		assert.EqualError(t, ledger.Adjust(enum.LeaveAnnual, 2, date(2025, 1, 1), " "), "adjustment note cannot be empty")
+		assert.EqualError(t, ledger.Adjust(enum.LeaveAnnual, 0, date(2025, 1, 1), "migration"), "adjusted days cannot be zero")
+		assert.Nil(t, ledger.Adjust(enum.LeaveAnnual, 4, date(2025, 1, 1), "opening balance migration"))
+		assert.Equal(t, 4, ledger.Balance(enum.LeaveAnnual, date(2025, 1, 1)))
+	})
+	t.Run("InvalidEmployee", func(t *testing.T) {
+		ledger, err := leave_entity.NewLeaveLedger(uuid.Nil)
+
+		assert.Nil(t, ledger)
+		assert.EqualError(t, err, "invalid employee id")
+	})
+}
+
+func TestLeaveLedger_CarryOver(t *testing.T) {
+	tier, _ := leave_entity.NewAccrualTier(12, 12)
+	policy, _ := leave_entity.NewAccrualPolicy([]leave_entity.AccrualTier{*tier}, 5, 3)
+
+	t.Run("CarryOverCappedAndExpired", func(t *testing.T) {
+		ledger := newLedger(t)
+		_ = ledger.Accrue(enum.LeaveAnnual, 12, date(2025, 3, 1), "annual-2025")
+		_ = ledger.Consume(enum.LeaveAnnual, 4, date(2025, 6, 2), "req-1")
+
+		assert.Nil(t, ledger.CloseYear(2025, *policy, time.UTC))
+		assert.EqualError(t, ledger.CloseYear(2025, *policy, time.UTC), "year already closed")
+		assert.Equal(t, 0, ledger.Balance(enum.LeaveAnnual, date(2025, 12, 31)))
+		assert.Equal(t, 5, ledger.Balance(enum.LeaveAnnual, date(2026, 1, 1)))
+
+		_ = ledger.Consume(enum.LeaveAnnual, 2, date(2026, 2, 9), "req-2")
+		ledger.ExpireCarryOver(date(2026, 3, 31))
+		assert.Equal(t, 3, ledger.Balance(enum.LeaveAnnual, date(2026, 3, 31)))
+
+		ledger.ExpireCarryOver(date(2026, 4, 1))
+		ledger.ExpireCarryOver(date(2026, 4, 2))
+		assert.Equal(t, 0, ledger.Balance(enum.LeaveAnnual, date(2026, 4, 1)))
+	})
+	t.Run("NoCarryOverByDefault", func(t *testing.T) {
+		ledger := newLedger(t)
+		_ = ledger.Accrue(enum.LeaveAnnual, 12, date(2025, 3, 1), "annual-2025")
+
+		assert.Nil(t, ledger.CloseYear(2025, leave_entity.DefaultAccrualPolicy(), time.UTC))
+		assert.Equal(t, 0, ledger.Balance(enum.LeaveAnnual, date(2026, 1, 1)))
+	})
+}
+
+func TestAccrualPolicy(t *testing.T) {
+	t.Run("AnnualGrant", func(t *testing.T) {
+		policy := leave_entity.DefaultAccrualPolicy()
+		hired := date(2024, 8, 19)
+
+		grantDate, days := policy.AnnualGrant(hired, 2024)
+		assert.True(t, grantDate.IsZero())
+		assert.Equal(t, 0, days)
+
+		grantDate, days = policy.AnnualGrant(hired, 2025)
+		assert.Equal(t, date(2025, 8, 19), grantDate)
+		assert.Equal(t, 12, days)
+	})
+	t.Run("TenureTiers", func(t *testing.T) {
+		first, _ := leave_entity.NewAccrualTier(12, 12)
+		senior, _ := leave_entity.NewAccrualTier(60, 15)
+		policy, err := leave_entity.NewAccrualPolicy([]leave_entity.AccrualTier{*senior, *first}, 0, 0)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, policy.EntitlementFor(11))
+		assert.Equal(t, 12, policy.EntitlementFor(24))
+		assert.Equal(t, 15, policy.EntitlementFor(60))
+		assert.Equal(t, 12, policy.Tiers()[0].DaysPerYear())
+	})
+	t.Run("InvalidPolicy", func(t *testing.T) {
+		tier, _ := leave_entity.NewAccrualTier(12, 12)
+
+		_, err := leave_entity.NewAccrualPolicy(nil, 0, 0)
+		assert.EqualError(t, err, "accrual policy requires at least one tier")
+		_, err = leave_entity.NewAccrualPolicy([]leave_entity.AccrualTier{*tier, *tier}, 0, 0)
+		assert.EqualError(t, err, "accrual tiers cannot share the same tenure")
+		_, err = leave_entity.NewAccrualPolicy([]leave_entity.AccrualTier{*tier}, 6, 0)
+		assert.EqualError(t, err, "carry over validity must be positive")
+		_, err = leave_entity.NewAccrualTier(12, 0)
+		assert.EqualError(t, err, "days per year must be positive")
+	})
+}
@@ -0,0 +1,168 @@
+package leave_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"strings"
+	"time"
+)
+
+// LeaveRequest represents an employee's request for leave over an inclusive range of
+// calendar dates. days holds the number of days charged, counted by the caller as
+// working or calendar days depending on the LeaveTypePolicy.
+type LeaveRequest struct {
+	id           uuid.UUID
+	employeeID   uuid.UUID
+	leaveType    enum.LeaveType
+	startDate    time.Time
+	endDate      time.Time
+	days         int
+	reason       string
+	attachment   *valueobject.FileReference
+	status       enum.LeaveRequestStatus
+	decidedBy    *uuid.UUID
+	decidedAt    *time.Time
+	decisionNote string
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// ID returns the unique identifier of the leave request.
+func (r *LeaveRequest) ID() uuid.UUID {
+	return r.id
+}
+
+// EmployeeID returns the employee requesting leave.
+func (r *LeaveRequest) EmployeeID() uuid.UUID {
+	return r.employeeID
+}
+
+// LeaveType returns the requested leave type.
+func (r *LeaveRequest) LeaveType() enum.LeaveType {
+	return r.leaveType
+}
+
+// StartDate returns the first day of leave.
+func (r *LeaveRequest) StartDate() time.Time {
+	return r.startDate
+}
+
+// EndDate returns the last day of leave (inclusive).
+func (r *LeaveRequest) EndDate() time.Time {
+	return r.endDate
+}
+
+// Days returns the number of days charged for the request.
+func (r *LeaveRequest) Days() int {
+	return r.days
+}
+
+// Reason returns the reason given by the employee.
+func (r *LeaveRequest) Reason() string {
+	return r.reason
+}
+
+// Attachment returns the supporting document, if any.
+func (r *LeaveRequest) Attachment() *valueobject.FileReference {
+	return r.attachment
+}
+
+// Status returns the current status of the request.
+func (r *LeaveRequest) Status() enum.LeaveRequestStatus {
+	return r.status
+}
+
+// DecidedBy returns the approver who approved or rejected the request.
+func (r *LeaveRequest) DecidedBy() *uuid.UUID {
+	return r.decidedBy
+}
+
+// DecidedAt returns when the request was approved or rejected.
+func (r *LeaveRequest) DecidedAt() *time.Time {
+	return r.decidedAt
+}
+
+// DecisionNote returns the note recorded with the decision.
+func (r *LeaveRequest) DecisionNote() string {
+	return r.decisionNote
+}
+
+// CreatedAt returns the timestamp when the request was submitted.
+func (r *LeaveRequest) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// UpdatedAt returns the timestamp of the last change to the request.
+func (r *LeaveRequest) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// Covers reports whether the request is approved and date falls within it.
+func (r *LeaveRequest) Covers(date time.Time) bool {
+	if r.status != enum.LeaveRequestApproved {
+		return false
+	}
+	day := calendarDate(date)
+	return !day.Before(calendarDate(r.startDate)) && !day.After(calendarDate(r.endDate))
+}
+
+// Approve marks a pending request as approved.
+func (r *LeaveRequest) Approve(approverID uuid.UUID, at time.Time) error {
+	return r.decide(enum.LeaveRequestApproved, approverID, "", at)
+}
+
+// Reject marks a pending request as rejected with a reason.
+func (r *LeaveRequest) Reject(approverID uuid.UUID, note string, at time.Time) error {
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return errors.New("rejection note cannot be empty")
+	}
+	return r.decide(enum.LeaveRequestRejected, approverID, note, at)
+}
+
+// Cancel withdraws a pending request, or an approved one before the leave starts.
+func (r *LeaveRequest) Cancel(at time.Time) error {
+	if at.IsZero() {
+		at = time.Now()
+	}
+	switch r.status {
+	case enum.LeaveRequestPending:
+	case enum.LeaveRequestApproved:
+		if !calendarDate(at).Before(calendarDate(r.startDate)) {
+			return errors.New("leave has already started")
+		}
+	default:
+		return errors.New("leave request is not cancellable")
+	}
+	r.status = enum.LeaveRequestCancelled
+	r.updatedAt = at
+	return nil
+}
+
+func (r *LeaveRequest) decide(status enum.LeaveRequestStatus, approverID uuid.UUID, note string, at time.Time) error {
+	if r.status != enum.LeaveRequestPending {
+		return errors.New("leave request is not pending")
+	}
+	if approverID == uuid.Nil {
+		return errors.New("approver cannot be empty")
+	}
+	if approverID == r.employeeID {
+		return errors.New("employee cannot decide on their own leave request")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+	r.status = status
+	r.decidedBy = &approverID
+	r.decidedAt = &at
+	r.decisionNote = note
+	r.updatedAt = at
+	return nil
+}
+
+// calendarDate truncates t to midnight of its calendar day in its own location.
+func calendarDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,80 @@
+package leave_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"strings"
+	"time"
+)
+
+// LeaveRequestFactory is a factory type for creating LeaveRequest entities.
+// Days is the number of days charged and must be computed by the caller.
+type LeaveRequestFactory struct {
+	ID         string
+	EmployeeID string
+	LeaveType  string
+	StartDate  time.Time
+	EndDate    time.Time
+	Days       int
+	Reason     string
+	Attachment *valueobject.FileReference
+	CreatedAt  time.Time
+}
+
+// Create validates the factory data and returns a new pending LeaveRequest.
+func (f LeaveRequestFactory) Create() (*LeaveRequest, error) {
+	id, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	employeeID, err := uuid.Parse(f.EmployeeID)
+	if err != nil {
+		return nil, errors.New("invalid employee id")
+	}
+
+	leaveType, err := enum.ParseLeaveType(f.LeaveType)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.StartDate.IsZero() {
+		return nil, errors.New("start date cannot be empty")
+	}
+	if f.EndDate.IsZero() {
+		return nil, errors.New("end date cannot be empty")
+	}
+	startDate := calendarDate(f.StartDate)
+	endDate := calendarDate(f.EndDate)
+	if endDate.Before(startDate) {
+		return nil, errors.New("end date cannot be before start date")
+	}
+
+	if f.Days <= 0 {
+		return nil, errors.New("leave days must be positive")
+	}
+
+	if f.Attachment != nil && (f.Attachment.URL() == "" || f.Attachment.Filename() == "" || f.Attachment.MimeType() == "") {
+		return nil, errors.New("invalid file reference")
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	return &LeaveRequest{
+		id:         id,
+		employeeID: employeeID,
+		leaveType:  leaveType,
+		startDate:  startDate,
+		endDate:    endDate,
+		days:       f.Days,
+		reason:     strings.TrimSpace(f.Reason),
+		attachment: f.Attachment,
+		status:     enum.LeaveRequestPending,
+		createdAt:  f.CreatedAt,
+		updatedAt:  f.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,151 @@
+package leave_entity_test
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	leave_entity "github.com/rfanazhari/hris/domain/entity/leave"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func newLeaveRequest(t *testing.T, leaveType string, days int) *leave_entity.LeaveRequest {
+	req, err := leave_entity.LeaveRequestFactory{
+		ID:         uuid.NewString(),
+		EmployeeID: uuid.NewString(),
+		LeaveType:  leaveType,
+		StartDate:  date(2025, 4, 7),
+		EndDate:    date(2025, 4, 7).AddDate(0, 0, days-1),
+		Days:       days,
+		Reason:     "family trip",
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return req
+}
+
+func TestLeaveRequestFactory_Create(t *testing.T) {
+	valid := leave_entity.LeaveRequestFactory{
+		ID:         uuid.NewString(),
+		EmployeeID: uuid.NewString(),
+		LeaveType:  "annual",
+		StartDate:  time.Date(2025, 4, 7, 13, 0, 0, 0, time.UTC),
+		EndDate:    date(2025, 4, 9),
+		Days:       3,
+	}
+
+	t.Run("ValidInput", func(t *testing.T) {
+		req, err := valid.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, enum.LeaveAnnual, req.LeaveType())
+		assert.Equal(t, enum.LeaveRequestPending, req.Status())
+		assert.Equal(t, date(2025, 4, 7), req.StartDate())
+		assert.Equal(t, 3, req.Days())
+		assert.False(t, req.CreatedAt().IsZero())
+	})
+	t.Run("InvalidID", func(t *testing.T) {
+		f := valid
+		f.ID = "uuid"
+
+		_, err := f.Create()
+		assert.EqualError(t, err, "invalid format uuid")
+	})
+	t.Run("InvalidLeaveType", func(t *testing.T) {
+		f := valid
+		f.LeaveType = "holiday"
+
+		_, err := f.Create()
+		assert.EqualError(t, err, fmt.Errorf("invalid LeaveType: %q", "holiday").Error())
+	})
+	t.Run("EndBeforeStart", func(t *testing.T) {
+		f := valid
+		f.EndDate = date(2025, 4, 6)
+
+		_, err := f.Create()
+		assert.EqualError(t, err, "end date cannot be before start date")
+	})
+	t.Run("NonPositiveDays", func(t *testing.T) {
+		f := valid
+		f.Days = 0
+
+		_, err := f.Create()
+		assert.EqualError(t, err, "leave days must be positive")
+	})
+}
+
+func TestLeaveRequest_Decisions(t *testing.T) {
+	approver := uuid.New()
+	at := date(2025, 4, 1)
+
+	t.Run("ApproveAndCover", func(t *testing.T) {
+		req := newLeaveRequest(t, "annual", 3)
+		assert.False(t, req.Covers(date(2025, 4, 8)))
+
+		assert.Nil(t, req.Approve(approver, at))
+		assert.Equal(t, approver, *req.DecidedBy())
+		assert.True(t, req.Covers(time.Date(2025, 4, 9, 17, 0, 0, 0, time.UTC)))
+		assert.False(t, req.Covers(date(2025, 4, 10)))
+		assert.EqualError(t, req.Reject(approver, "busy", at), "leave request is not pending")
+	})
+	t.Run("Reject", func(t *testing.T) {
+		req := newLeaveRequest(t, "annual", 1)
+
+		assert.EqualError(t, req.Reject(approver, "", at), "rejection note cannot be empty")
+		assert.EqualError(t, req.Approve(req.EmployeeID(), at), "employee cannot decide on their own leave request")
+		assert.Nil(t, req.Reject(approver, "month-end closing", at))
+		assert.Equal(t, enum.LeaveRequestRejected, req.Status())
+		assert.EqualError(t, req.Cancel(at), "leave request is not cancellable")
+	})
+	t.Run("Cancel", func(t *testing.T) {
+		req := newLeaveRequest(t, "annual", 2)
+		_ = req.Approve(approver, at)
+
+		assert.EqualError(t, req.Cancel(date(2025, 4, 7)), "leave has already started")
+		assert.Nil(t, req.Cancel(date(2025, 4, 6)))
+		assert.Equal(t, enum.LeaveRequestCancelled, req.Status())
+	})
+}
+
+func TestLeaveTypePolicy_Check(t *testing.T) {
+	policies := map[enum.LeaveType]leave_entity.LeaveTypePolicy{}
+	for _, p := range leave_entity.DefaultLeaveTypePolicies() {
+		policies[p.LeaveType()] = p
+	}
+
+	t.Run("MaxDays", func(t *testing.T) {
+		assert.Nil(t, policies[enum.LeaveMarriage].Check(newLeaveRequest(t, "marriage", 3), enum.GenderMale))
+		assert.EqualError(t, policies[enum.LeaveMarriage].Check(newLeaveRequest(t, "marriage", 4), enum.GenderMale), "marriage leave cannot exceed 3 days")
+	})
+	t.Run("Gender", func(t *testing.T) {
+		assert.EqualError(t, policies[enum.LeaveMaternity].Check(newLeaveRequest(t, "maternity", 90), enum.GenderMale), "maternity leave is not available for this employee")
+		assert.Nil(t, policies[enum.LeaveMaternity].Check(newLeaveRequest(t, "maternity", 90), enum.GenderFemale))
+		assert.True(t, policies[enum.LeaveMaternity].CalendarDays())
+	})
+	t.Run("Attachment", func(t *testing.T) {
+		assert.EqualError(t, policies[enum.LeaveSick].Check(newLeaveRequest(t, "sick", 2), enum.GenderMale), "sick leave requires a supporting document")
+
+		file, _ := valueobject.NewFileReference("https://storage.example.com/leave/surat-dokter.pdf", "surat-dokter.pdf", "application/pdf")
+		req, _ := leave_entity.LeaveRequestFactory{
+			ID: uuid.NewString(), EmployeeID: uuid.NewString(), LeaveType: "sick",
+			StartDate: date(2025, 4, 7), EndDate: date(2025, 4, 8), Days: 2, Attachment: file,
+		}.Create()
+		assert.Nil(t, policies[enum.LeaveSick].Check(req, enum.GenderMale))
+	})
+	t.Run("WrongType", func(t *testing.T) {
+		assert.EqualError(t, policies[enum.LeaveSick].Check(newLeaveRequest(t, "annual", 1), enum.GenderMale), "policy for sick cannot check annual leave")
+	})
+	t.Run("Factory", func(t *testing.T) {
+		policy, err := leave_entity.LeaveTypePolicyFactory{LeaveType: "annual", Paid: true, BalanceTracked: true, Gender: "F"}.Create()
+
+		assert.Nil(t, err)
+		assert.True(t, policy.BalanceTracked())
+		assert.Equal(t, enum.GenderFemale, policy.Gender())
+
+		_, err = leave_entity.LeaveTypePolicyFactory{LeaveType: "annual", MaxDays: -1}.Create()
+		assert.EqualError(t, err, "max days cannot be negative")
+	})
+}
@@ -0,0 +1,103 @@
+package leave_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rfanazhari/hris/domain/enum"
+)
+
+// LeaveTypePolicy holds the rules of one leave type.
+//
+// Fields:
+//   - maxDays: maximum days per request, 0 means unlimited (annual leave is limited by its balance instead)
+//   - calendarDays: days are counted in calendar days instead of working days (e.g. maternity)
+//   - paid: the leave is paid
+//   - balanceTracked: approved days are deducted from the employee's leave ledger
+//   - requiresAttachment: a supporting document is mandatory (e.g. doctor's note)
+//   - gender: restricts the leave to one gender, empty means any
+type LeaveTypePolicy struct {
+	leaveType          enum.LeaveType
+	maxDays            int
+	calendarDays       bool
+	paid               bool
+	balanceTracked     bool
+	requiresAttachment bool
+	gender             enum.Gender
+}
+
+// LeaveType returns the leave type the policy applies to.
+func (p LeaveTypePolicy) LeaveType() enum.LeaveType {
+	return p.leaveType
+}
+
+// MaxDays returns the maximum days per request, 0 means unlimited.
+func (p LeaveTypePolicy) MaxDays() int {
+	return p.maxDays
+}
+
+// CalendarDays reports whether days are counted in calendar days.
+func (p LeaveTypePolicy) CalendarDays() bool {
+	return p.calendarDays
+}
+
+// Paid reports whether the leave is paid.
+func (p LeaveTypePolicy) Paid() bool {
+	return p.paid
+}
+
+// BalanceTracked reports whether the leave is deducted from the leave ledger.
+func (p LeaveTypePolicy) BalanceTracked() bool {
+	return p.balanceTracked
+}
+
+// RequiresAttachment reports whether a supporting document is mandatory.
+func (p LeaveTypePolicy) RequiresAttachment() bool {
+	return p.requiresAttachment
+}
+
+// Gender returns the gender the leave is restricted to, empty means any.
+func (p LeaveTypePolicy) Gender() enum.Gender {
+	return p.gender
+}
+
+// Check validates a leave request against the policy for an employee of the given gender.
+func (p LeaveTypePolicy) Check(request *LeaveRequest, gender enum.Gender) error {
+	if request == nil {
+		return errors.New("leave request cannot be nil")
+	}
+	if request.leaveType != p.leaveType {
+		return fmt.Errorf("policy for %s cannot check %s leave", p.leaveType, request.leaveType)
+	}
+	if p.gender != "" && p.gender != gender {
+		return fmt.Errorf("%s leave is not available for this employee", p.leaveType)
+	}
+	if p.maxDays > 0 && request.days > p.maxDays {
+		return fmt.Errorf("%s leave cannot exceed %d days", p.leaveType, p.maxDays)
+	}
+	if p.requiresAttachment && request.attachment == nil {
+		return fmt.Errorf("%s leave requires a supporting document", p.leaveType)
+	}
+	return nil
+}
+
+// DefaultLeaveTypePolicies returns the statutory leave rules of UU No. 13/2003:
+//   - annual: balance based (12 working days after 12 months, see DefaultAccrualPolicy), Pasal 79
+//   - sick: unlimited with a doctor's note, Pasal 93 ayat (2) huruf a
+//   - maternity: 3 months (1.5 before and 1.5 after birth) counted as 90 calendar days, female only, Pasal 82
+//   - marriage 3 days; child marriage, child circumcision/baptism, paternity and bereavement 2 days;
+//     death of a household member 1 day, Pasal 93 ayat (4)
+//   - unpaid: unlimited and unpaid
+func DefaultLeaveTypePolicies() []LeaveTypePolicy {
+	return []LeaveTypePolicy{
+		{leaveType: enum.LeaveAnnual, paid: true, balanceTracked: true},
+		{leaveType: enum.LeaveSick, paid: true, requiresAttachment: true},
+		{leaveType: enum.LeaveMaternity, maxDays: 90, calendarDays: true, paid: true, gender: enum.GenderFemale},
+		{leaveType: enum.LeavePaternity, maxDays: 2, paid: true, gender: enum.GenderMale},
+		{leaveType: enum.LeaveMarriage, maxDays: 3, paid: true},
+		{leaveType: enum.LeaveChildMarriage, maxDays: 2, paid: true},
+		{leaveType: enum.LeaveChildCircumcision, maxDays: 2, paid: true},
+		{leaveType: enum.LeaveBereavement, maxDays: 2, paid: true},
+		{leaveType: enum.LeaveHouseholdBereavement, maxDays: 1, paid: true},
+		{leaveType: enum.LeaveUnpaid},
+	}
+}
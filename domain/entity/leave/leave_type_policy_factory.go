@@ -0,0 +1,47 @@
+package leave_entity
+
+import (
+	"errors"
+	"github.com/rfanazhari/hris/domain/enum"
+)
+
+// LeaveTypePolicyFactory is a factory type for creating company specific LeaveTypePolicy values.
+type LeaveTypePolicyFactory struct {
+	LeaveType          string
+	MaxDays            int
+	CalendarDays       bool
+	Paid               bool
+	BalanceTracked     bool
+	RequiresAttachment bool
+	Gender             string
+}
+
+// Create validates the factory data and returns a new LeaveTypePolicy.
+func (f LeaveTypePolicyFactory) Create() (*LeaveTypePolicy, error) {
+	leaveType, err := enum.ParseLeaveType(f.LeaveType)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.MaxDays < 0 {
+		return nil, errors.New("max days cannot be negative")
+	}
+
+	var gender enum.Gender
+	if f.Gender != "" {
+		gender, err = enum.ParseGender(f.Gender)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &LeaveTypePolicy{
+		leaveType:          leaveType,
+		maxDays:            f.MaxDays,
+		calendarDays:       f.CalendarDays,
+		paid:               f.Paid,
+		balanceTracked:     f.BalanceTracked,
+		requiresAttachment: f.RequiresAttachment,
+		gender:             gender,
+	}, nil
+}
@@ -0,0 +1,332 @@
+package loan_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// Loan is a company loan or salary advance repaid through payroll deductions. Interest is
+// flat: the annual rate applies to the full principal over the installment term. One
+// installment is deducted per pay period from the first period onwards, except in paused
+// months, so pauses extend the term. Settling early or at termination repays the remaining
+// principal; interest not yet repaid is waived. Principal the final payslip cannot cover is
+// recorded as a receivable from the former employee, and the loan is settled either way.
+type Loan struct {
+	id           uuid.UUID
+	employeeID   uuid.UUID
+	loanType     enum.LoanType
+	principal    int64
+	interestRate int64
+	installments int
+	firstMonth   time.Time
+	status       enum.LoanStatus
+	pauses       []pause
+	repayments   []Repayment
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// ID returns the identifier of the loan.
+func (l *Loan) ID() uuid.UUID {
+	return l.id
+}
+
+// EmployeeID returns the borrowing employee.
+func (l *Loan) EmployeeID() uuid.UUID {
+	return l.employeeID
+}
+
+// Type returns whether this is a loan or a salary advance.
+func (l *Loan) Type() enum.LoanType {
+	return l.loanType
+}
+
+// Principal returns the amount lent.
+func (l *Loan) Principal() int64 {
+	return l.principal
+}
+
+// InterestRate returns the flat annual interest rate in basis points.
+func (l *Loan) InterestRate() int64 {
+	return l.interestRate
+}
+
+// Installments returns the number of installments.
+func (l *Loan) Installments() int {
+	return l.installments
+}
+
+// FirstMonth returns the first day of the month of the first deduction.
+func (l *Loan) FirstMonth() time.Time {
+	return l.firstMonth
+}
+
+// Status returns the repayment state.
+func (l *Loan) Status() enum.LoanStatus {
+	return l.status
+}
+
+// Repayments returns the repayments made so far.
+func (l *Loan) Repayments() []Repayment {
+	out := make([]Repayment, len(l.repayments))
+	copy(out, l.repayments)
+	return out
+}
+
+// CreatedAt returns when the loan was granted.
+func (l *Loan) CreatedAt() time.Time {
+	return l.createdAt
+}
+
+// UpdatedAt returns when the loan was last changed.
+func (l *Loan) UpdatedAt() time.Time {
+	return l.updatedAt
+}
+
+// TotalInterest returns the flat interest over the whole term.
+func (l *Loan) TotalInterest() int64 {
+	return (l.principal*l.interestRate*int64(l.installments) + 60_000) / 120_000
+}
+
+// TotalRepayable returns the principal plus the total interest.
+func (l *Loan) TotalRepayable() int64 {
+	return l.principal + l.TotalInterest()
+}
+
+// InstallmentAmount returns the regular installment; the last one may be smaller.
+func (l *Loan) InstallmentAmount() int64 {
+	return ceilDiv(l.principal, l.installments) + ceilDiv(l.TotalInterest(), l.installments)
+}
+
+// Repaid returns the sum of the repayments, excluding the receivable.
+func (l *Loan) Repaid() int64 {
+	var total int64
+	for _, r := range l.repayments {
+		if r.source != enum.RepaymentReceivable {
+			total += r.amount
+		}
+	}
+	return total
+}
+
+// Receivable returns the principal left over after the final payslip, which the former
+// employee still owes outside payroll.
+func (l *Loan) Receivable() int64 {
+	var total int64
+	for _, r := range l.repayments {
+		if r.source == enum.RepaymentReceivable {
+			total += r.amount
+		}
+	}
+	return total
+}
+
+// Outstanding returns the balance still to be repaid under the schedule, 0 once settled.
+func (l *Loan) Outstanding() int64 {
+	if l.status == enum.LoanSettled {
+		return 0
+	}
+	return l.TotalRepayable() - l.Repaid()
+}
+
+// SettlementAmount returns the principal not yet repaid, which settles the loan early.
+func (l *Loan) SettlementAmount() int64 {
+	if l.status == enum.LoanSettled {
+		return 0
+	}
+	total := l.principal
+	for _, r := range l.repayments {
+		total -= r.principal
+	}
+	return total
+}
+
+// Schedule returns the planned installments, skipping the months paused so far. While the
+// loan is paused with no resume month, only the installments before the pause are returned.
+func (l *Loan) Schedule() []Installment {
+	principal, interest := l.principal, l.TotalInterest()
+	principalPart, interestPart := ceilDiv(principal, l.installments), ceilDiv(interest, l.installments)
+	out := make([]Installment, 0, l.installments)
+	month := l.firstMonth
+	for len(out) < l.installments {
+		if l.isPausedFrom(month) {
+			break
+		}
+		if !l.IsPausedIn(month) {
+			p, i := min(principalPart, principal), min(interestPart, interest)
+			principal, interest = principal-p, interest-i
+			out = append(out, Installment{number: len(out) + 1, month: month, principal: p, interest: i})
+		}
+		month = month.AddDate(0, 1, 0)
+	}
+	return out
+}
+
+// IsPausedIn reports whether deductions are paused in the month of at.
+func (l *Loan) IsPausedIn(at time.Time) bool {
+	month := monthOf(at)
+	for _, p := range l.pauses {
+		if !month.Before(p.from) && (p.to == nil || month.Before(*p.to)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPausedFrom reports whether deductions are paused from the month of at with no resume
+// month.
+func (l *Loan) isPausedFrom(at time.Time) bool {
+	month := monthOf(at)
+	for _, p := range l.pauses {
+		if p.to == nil && !month.Before(p.from) {
+			return true
+		}
+	}
+	return false
+}
+
+// Deduction returns the amount to deduct in the pay period: the regular installment, or
+// the settlement amount when final is set because the employee leaves in the period.
+func (l *Loan) Deduction(period payroll_entity.PayPeriod, final bool) int64 {
+	if l.status == enum.LoanSettled {
+		return 0
+	}
+	if final {
+		return l.SettlementAmount()
+	}
+	month := monthOf(period.Start())
+	if month.Before(l.firstMonth) || l.IsPausedIn(month) {
+		return 0
+	}
+	return min(l.InstallmentAmount(), l.Outstanding())
+}
+
+// RecordPayrollRepayment records an amount deducted by a payroll run. A final deduction
+// repays principal only and settles the loan; the principal it does not cover is recorded
+// as a receivable. A final deduction may be 0 when the net pay leaves no room.
+func (l *Loan) RecordPayrollRepayment(runID uuid.UUID, amount int64, date time.Time, final bool, at time.Time) error {
+	for _, r := range l.repayments {
+		if r.runID != nil && *r.runID == runID {
+			return errors.New("payroll run already recorded")
+		}
+	}
+	if final {
+		if l.status == enum.LoanSettled {
+			return errors.New("loan is settled")
+		}
+		settlement := l.SettlementAmount()
+		if amount < 0 {
+			return errors.New("repayment cannot be negative")
+		}
+		if amount > settlement {
+			return errors.New("repayment exceeds the outstanding balance")
+		}
+		if amount > 0 {
+			l.record(Repayment{date: dateOf(date), amount: amount, principal: amount, source: enum.RepaymentPayroll, runID: &runID}, false, at)
+		}
+		if shortfall := settlement - amount; shortfall > 0 {
+			l.record(Repayment{date: dateOf(date), amount: shortfall, principal: shortfall, source: enum.RepaymentReceivable, runID: &runID}, false, at)
+		}
+		l.status = enum.LoanSettled
+		l.touch(at)
+		return nil
+	}
+
+	if err := l.checkRepayment(amount, l.Outstanding()); err != nil {
+		return err
+	}
+	interest := l.TotalInterest()
+	for _, r := range l.repayments {
+		interest -= r.interest
+	}
+	interestPart := min(interest, (amount*l.TotalInterest()*2+l.TotalRepayable())/(2*l.TotalRepayable()))
+	if amount == l.Outstanding() {
+		interestPart = interest
+	}
+	l.record(Repayment{date: dateOf(date), amount: amount, principal: amount - interestPart, interest: interestPart, source: enum.RepaymentPayroll, runID: &runID}, amount == l.Outstanding(), at)
+	return nil
+}
+
+// Settle repays the remaining principal early and waives the remaining interest. It
+// returns the amount paid.
+func (l *Loan) Settle(date time.Time, at time.Time) (int64, error) {
+	if l.status == enum.LoanSettled {
+		return 0, errors.New("loan is settled")
+	}
+	amount := l.SettlementAmount()
+	l.record(Repayment{date: dateOf(date), amount: amount, principal: amount, source: enum.RepaymentEarlySettlement}, true, at)
+	return amount, nil
+}
+
+// Pause suspends deductions from the month of from onwards until the loan is resumed.
+func (l *Loan) Pause(from time.Time, at time.Time) error {
+	switch l.status {
+	case enum.LoanSettled:
+		return errors.New("loan is settled")
+	case enum.LoanPaused:
+		return errors.New("loan is already paused")
+	}
+	l.pauses = append(l.pauses, pause{from: monthOf(from)})
+	l.status = enum.LoanPaused
+	l.touch(at)
+	return nil
+}
+
+// Resume restarts deductions from the month of from.
+func (l *Loan) Resume(from time.Time, at time.Time) error {
+	if l.status != enum.LoanPaused {
+		return errors.New("loan is not paused")
+	}
+	last := &l.pauses[len(l.pauses)-1]
+	month := monthOf(from)
+	if month.Before(last.from) {
+		return errors.New("resume month cannot be before the pause month")
+	}
+	last.to = &month
+	l.status = enum.LoanActive
+	l.touch(at)
+	return nil
+}
+
+func (l *Loan) checkRepayment(amount, limit int64) error {
+	if l.status == enum.LoanSettled {
+		return errors.New("loan is settled")
+	}
+	if amount <= 0 {
+		return errors.New("repayment must be positive")
+	}
+	if amount > limit {
+		return errors.New("repayment exceeds the outstanding balance")
+	}
+	return nil
+}
+
+func (l *Loan) record(r Repayment, settled bool, at time.Time) {
+	l.repayments = append(l.repayments, r)
+	if settled {
+		l.status = enum.LoanSettled
+	}
+	l.touch(at)
+}
+
+func (l *Loan) touch(at time.Time) {
+	if at.IsZero() {
+		at = time.Now()
+	}
+	l.updatedAt = at
+}
+
+func ceilDiv(n int64, d int) int64 {
+	return (n + int64(d) - 1) / int64(d)
+}
+
+func monthOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
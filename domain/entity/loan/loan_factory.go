@@ -0,0 +1,80 @@
+package loan_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// MaxInstallments is the longest repayment term, in months.
+const MaxInstallments = 60
+
+// LoanFactory creates Loans.
+//
+// Fields:
+//   - InterestRate: flat annual rate in basis points, must be 0 for salary advances
+//   - Installments: number of monthly installments, defaults to 1
+//   - FirstMonth: any date in the month of the first deduction
+type LoanFactory struct {
+	ID           string
+	EmployeeID   string
+	Type         string
+	Principal    int64
+	InterestRate int64
+	Installments int
+	FirstMonth   time.Time
+	CreatedAt    time.Time
+}
+
+// Create validates the factory input and returns an active loan.
+func (f LoanFactory) Create() (*Loan, error) {
+	id, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+	employeeID, err := uuid.Parse(f.EmployeeID)
+	if err != nil {
+		return nil, errors.New("invalid employee id")
+	}
+	loanType, err := enum.ParseLoanType(f.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.Principal <= 0 {
+		return nil, errors.New("principal must be positive")
+	}
+	if f.InterestRate < 0 || f.InterestRate > 10_000 {
+		return nil, errors.New("interest rate must be between 0 and 10000 basis points")
+	}
+	if loanType == enum.LoanSalaryAdvance && f.InterestRate != 0 {
+		return nil, errors.New("salary advance cannot bear interest")
+	}
+	if f.Installments == 0 {
+		f.Installments = 1
+	}
+	if f.Installments < 1 || f.Installments > MaxInstallments {
+		return nil, errors.New("installments must be between 1 and 60")
+	}
+	if f.FirstMonth.IsZero() {
+		return nil, errors.New("first deduction month cannot be empty")
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	return &Loan{
+		id:           id,
+		employeeID:   employeeID,
+		loanType:     loanType,
+		principal:    f.Principal,
+		interestRate: f.InterestRate,
+		installments: f.Installments,
+		firstMonth:   monthOf(f.FirstMonth),
+		status:       enum.LoanActive,
+		createdAt:    f.CreatedAt,
+		updatedAt:    f.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,189 @@
+package loan_entity_test
+
+import (
+	"github.com/google/uuid"
+	loan_entity "github.com/rfanazhari/hris/domain/entity/loan"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// newLoan lends 12.000.000 at a flat 12% a year over 12 months from January 2025:
+// 1.440.000 interest, 1.120.000 a month.
+func newLoan(t *testing.T) *loan_entity.Loan {
+	loan, err := loan_entity.LoanFactory{
+		ID: uuid.NewString(), EmployeeID: uuid.NewString(), Type: "loan",
+		Principal: 12_000_000, InterestRate: 1200, Installments: 12, FirstMonth: date(2025, 1, 15),
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return loan
+}
+
+func TestLoanFactory_Create(t *testing.T) {
+	t.Run("ValidInput", func(t *testing.T) {
+		loan := newLoan(t)
+
+		assert.Equal(t, enum.LoanActive, loan.Status())
+		assert.Equal(t, date(2025, 1, 1), loan.FirstMonth())
+		assert.Equal(t, int64(1_440_000), loan.TotalInterest())
+		assert.Equal(t, int64(13_440_000), loan.Outstanding())
+		assert.Equal(t, int64(1_120_000), loan.InstallmentAmount())
+	})
+	t.Run("InvalidInput", func(t *testing.T) {
+		valid := loan_entity.LoanFactory{ID: uuid.NewString(), EmployeeID: uuid.NewString(), Type: "salary_advance", Principal: 1, FirstMonth: date(2025, 1, 1)}
+		_, err := valid.Create()
+		assert.Nil(t, err)
+
+		for _, tc := range []struct {
+			name   string
+			change func(f *loan_entity.LoanFactory)
+			err    string
+		}{
+			{"ID", func(f *loan_entity.LoanFactory) { f.ID = "uuid" }, "invalid format uuid"},
+			{"EmployeeID", func(f *loan_entity.LoanFactory) { f.EmployeeID = "" }, "invalid employee id"},
+			{"Type", func(f *loan_entity.LoanFactory) { f.Type = "mortgage" }, `invalid LoanType: "mortgage"`},
+			{"Principal", func(f *loan_entity.LoanFactory) { f.Principal = 0 }, "principal must be positive"},
+			{"AdvanceInterest", func(f *loan_entity.LoanFactory) { f.InterestRate = 100 }, "salary advance cannot bear interest"},
+			{"Installments", func(f *loan_entity.LoanFactory) { f.Installments = 61 }, "installments must be between 1 and 60"},
+			{"FirstMonth", func(f *loan_entity.LoanFactory) { f.FirstMonth = time.Time{} }, "first deduction month cannot be empty"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				f := valid
+				tc.change(&f)
+				_, err := f.Create()
+				assert.EqualError(t, err, tc.err)
+			})
+		}
+	})
+}
+
+func TestLoan_Schedule(t *testing.T) {
+	t.Run("RemainderOnLastInstallment", func(t *testing.T) {
+		loan, _ := loan_entity.LoanFactory{ID: uuid.NewString(), EmployeeID: uuid.NewString(), Type: "salary_advance", Principal: 1_000_000, Installments: 3, FirstMonth: date(2025, 1, 1)}.Create()
+
+		var amounts []int64
+		for _, i := range loan.Schedule() {
+			amounts = append(amounts, i.Amount())
+		}
+		assert.Equal(t, []int64{333_334, 333_334, 333_332}, amounts)
+	})
+	t.Run("PauseExtendsTerm", func(t *testing.T) {
+		loan := newLoan(t)
+		assert.Nil(t, loan.Pause(date(2025, 3, 10), time.Time{}))
+		assert.EqualError(t, loan.Pause(date(2025, 3, 10), time.Time{}), "loan is already paused")
+		assert.EqualError(t, loan.Resume(date(2025, 2, 1), time.Time{}), "resume month cannot be before the pause month")
+		assert.Nil(t, loan.Resume(date(2025, 5, 1), time.Time{}))
+		assert.EqualError(t, loan.Resume(date(2025, 5, 1), time.Time{}), "loan is not paused")
+
+		schedule := loan.Schedule()
+		assert.Len(t, schedule, 12)
+		assert.Equal(t, date(2025, 2, 1), schedule[1].Month())
+		assert.Equal(t, date(2025, 5, 1), schedule[2].Month())
+		assert.Equal(t, date(2026, 2, 1), schedule[11].Month())
+		assert.Equal(t, int64(1_000_000), schedule[11].Principal())
+		assert.Equal(t, int64(120_000), schedule[11].Interest())
+	})
+	t.Run("OpenEndedPause", func(t *testing.T) {
+		loan := newLoan(t)
+		assert.Nil(t, loan.Pause(date(2025, 3, 1), time.Time{}))
+
+		schedule := loan.Schedule()
+		assert.Len(t, schedule, 2)
+		assert.Equal(t, date(2025, 2, 1), schedule[1].Month())
+	})
+}
+
+func TestLoan_Repayment(t *testing.T) {
+	runID := uuid.New()
+
+	t.Run("Deduction", func(t *testing.T) {
+		loan := newLoan(t)
+		_ = loan.Pause(date(2025, 3, 1), time.Time{})
+
+		assert.Equal(t, int64(0), loan.Deduction(payroll_entity.MonthlyPayPeriod(2024, time.December), false))
+		assert.Equal(t, int64(1_120_000), loan.Deduction(payroll_entity.MonthlyPayPeriod(2025, time.February), false))
+		assert.Equal(t, int64(0), loan.Deduction(payroll_entity.MonthlyPayPeriod(2025, time.March), false))
+		assert.Equal(t, int64(12_000_000), loan.Deduction(payroll_entity.MonthlyPayPeriod(2025, time.March), true))
+	})
+	t.Run("PayrollInstallments", func(t *testing.T) {
+		loan := newLoan(t)
+
+		assert.Nil(t, loan.RecordPayrollRepayment(runID, 1_120_000, date(2025, 1, 31), false, time.Time{}))
+		assert.EqualError(t, loan.RecordPayrollRepayment(runID, 1_120_000, date(2025, 1, 31), false, time.Time{}), "payroll run already recorded")
+		assert.EqualError(t, loan.RecordPayrollRepayment(uuid.New(), 0, date(2025, 2, 28), false, time.Time{}), "repayment must be positive")
+		assert.EqualError(t, loan.RecordPayrollRepayment(uuid.New(), 13_000_000, date(2025, 2, 28), false, time.Time{}), "repayment exceeds the outstanding balance")
+
+		repayment := loan.Repayments()[0]
+		assert.Equal(t, int64(1_000_000), repayment.Principal())
+		assert.Equal(t, int64(120_000), repayment.Interest())
+		assert.Equal(t, runID, *repayment.RunID())
+		*repayment.RunID() = uuid.Nil
+		assert.Equal(t, runID, *loan.Repayments()[0].RunID())
+		assert.Equal(t, int64(12_320_000), loan.Outstanding())
+		assert.Equal(t, int64(11_000_000), loan.SettlementAmount())
+
+		// A final payroll deduction repays principal only and waives the interest.
+		assert.Nil(t, loan.RecordPayrollRepayment(uuid.New(), 11_000_000, date(2025, 2, 28), true, time.Time{}))
+		assert.Equal(t, enum.LoanSettled, loan.Status())
+		assert.Equal(t, int64(0), loan.Outstanding())
+		assert.Equal(t, int64(0), loan.Deduction(payroll_entity.MonthlyPayPeriod(2025, time.March), false))
+	})
+	t.Run("FinalShortfallIsReceivable", func(t *testing.T) {
+		loan := newLoan(t)
+
+		assert.EqualError(t, loan.RecordPayrollRepayment(runID, -1, date(2025, 1, 31), true, time.Time{}), "repayment cannot be negative")
+		assert.Nil(t, loan.RecordPayrollRepayment(runID, 4_000_000, date(2025, 1, 31), true, time.Time{}))
+
+		assert.Equal(t, enum.LoanSettled, loan.Status())
+		assert.Equal(t, int64(4_000_000), loan.Repaid())
+		assert.Equal(t, int64(8_000_000), loan.Receivable())
+		assert.Equal(t, enum.RepaymentReceivable, loan.Repayments()[1].Source())
+
+		// A final payslip with no room left settles the whole principal as a receivable.
+		loan = newLoan(t)
+		assert.Nil(t, loan.RecordPayrollRepayment(runID, 0, date(2025, 1, 31), true, time.Time{}))
+		assert.Equal(t, enum.LoanSettled, loan.Status())
+		assert.Equal(t, int64(12_000_000), loan.Receivable())
+		assert.Len(t, loan.Repayments(), 1)
+	})
+	t.Run("LastInstallmentPaysRemainingInterest", func(t *testing.T) {
+		loan, _ := loan_entity.LoanFactory{ID: uuid.NewString(), EmployeeID: uuid.NewString(), Type: "loan", Principal: 1_000_000, InterestRate: 1000, Installments: 3, FirstMonth: date(2025, 1, 1)}.Create()
+		assert.Equal(t, int64(25_000), loan.TotalInterest())
+
+		for _, month := range []time.Month{time.January, time.February, time.March} {
+			due := loan.Deduction(payroll_entity.MonthlyPayPeriod(2025, month), false)
+			assert.Nil(t, loan.RecordPayrollRepayment(uuid.New(), due, date(2025, month, 28), false, time.Time{}))
+		}
+
+		var interest int64
+		for _, r := range loan.Repayments() {
+			interest += r.Interest()
+		}
+		assert.Equal(t, int64(25_000), interest)
+		assert.Equal(t, int64(1_025_000), loan.Repaid())
+		assert.Equal(t, enum.LoanSettled, loan.Status())
+	})
+	t.Run("EarlySettlement", func(t *testing.T) {
+		loan := newLoan(t)
+		_ = loan.RecordPayrollRepayment(runID, 1_120_000, date(2025, 1, 31), false, time.Time{})
+
+		amount, err := loan.Settle(date(2025, 2, 10), date(2025, 2, 10))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(11_000_000), amount)
+		assert.Equal(t, enum.RepaymentEarlySettlement, loan.Repayments()[1].Source())
+		assert.Equal(t, enum.LoanSettled, loan.Status())
+		assert.Equal(t, date(2025, 2, 10), loan.UpdatedAt())
+
+		_, err = loan.Settle(date(2025, 2, 10), time.Time{})
+		assert.EqualError(t, err, "loan is settled")
+		assert.EqualError(t, loan.Pause(date(2025, 3, 1), time.Time{}), "loan is settled")
+	})
+}
@@ -0,0 +1,71 @@
+package loan_entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// Repayment is an amount repaid on a loan, split into the principal and interest it covers.
+type Repayment struct {
+	date      time.Time
+	amount    int64
+	principal int64
+	interest  int64
+	source    enum.LoanRepaymentSource
+	runID     *uuid.UUID
+}
+
+// Date returns the date of the repayment.
+func (r Repayment) Date() time.Time { return r.date }
+
+// Amount returns the amount repaid.
+func (r Repayment) Amount() int64 { return r.amount }
+
+// Principal returns the part of the amount that repaid principal.
+func (r Repayment) Principal() int64 { return r.principal }
+
+// Interest returns the part of the amount that paid interest.
+func (r Repayment) Interest() int64 { return r.interest }
+
+// Source returns how the repayment was made.
+func (r Repayment) Source() enum.LoanRepaymentSource { return r.source }
+
+// RunID returns a copy of the payroll run that deducted the repayment, nil for other
+// sources.
+func (r Repayment) RunID() *uuid.UUID {
+	if r.runID == nil {
+		return nil
+	}
+	id := *r.runID
+	return &id
+}
+
+// Installment is one planned repayment of a loan's schedule.
+type Installment struct {
+	number    int
+	month     time.Time
+	principal int64
+	interest  int64
+}
+
+// Number returns the 1-based position of the installment.
+func (i Installment) Number() int { return i.number }
+
+// Month returns the first day of the month the installment is planned in.
+func (i Installment) Month() time.Time { return i.month }
+
+// Principal returns the principal part of the installment.
+func (i Installment) Principal() int64 { return i.principal }
+
+// Interest returns the interest part of the installment.
+func (i Installment) Interest() int64 { return i.interest }
+
+// Amount returns the total installment.
+func (i Installment) Amount() int64 { return i.principal + i.interest }
+
+// pause suspends deductions from month from until, but excluding, month to.
+type pause struct {
+	from time.Time
+	to   *time.Time
+}
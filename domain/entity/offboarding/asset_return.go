@@ -0,0 +1,35 @@
+package offboarding_entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// AssetReturn is a company asset the leaving employee must hand back, e.g. a laptop or an
+// access card. An asset that cannot be returned, e.g. because it was lost and deducted,
+// is waived with a note.
+type AssetReturn struct {
+	tag        string
+	name       string
+	returnedAt *time.Time
+	receivedBy *uuid.UUID
+	waiverNote string
+}
+
+// Tag returns the asset tag or serial number identifying the asset.
+func (a AssetReturn) Tag() string { return a.tag }
+
+// Name returns what the asset is.
+func (a AssetReturn) Name() string { return a.name }
+
+// ReturnedAt returns when the asset was returned or waived, nil while outstanding.
+func (a AssetReturn) ReturnedAt() *time.Time { return a.returnedAt }
+
+// ReceivedBy returns who received or waived the asset, if anyone.
+func (a AssetReturn) ReceivedBy() *uuid.UUID { return a.receivedBy }
+
+// WaiverNote returns why the asset was waived, empty for a returned asset.
+func (a AssetReturn) WaiverNote() string { return a.waiverNote }
+
+// IsOutstanding reports whether the asset is neither returned nor waived.
+func (a AssetReturn) IsOutstanding() bool { return a.returnedAt == nil }
@@ -0,0 +1,174 @@
+package offboarding_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// severanceFactors holds the multipliers of the pesangon and UPMK tables of PP No. 35/2021
+// Pasal 40 per termination reason, in percent.
+var severanceFactors = map[enum.TerminationReason][2]int64{
+	enum.TerminationResignation:   {0, 0},
+	enum.TerminationEndOfContract: {0, 0},
+	enum.TerminationLayoff:        {100, 100},
+	enum.TerminationRetirement:    {175, 100},
+	enum.TerminationMisconduct:    {50, 100},
+	// Not in the tables: an employee let go during probation is owed no severance.
+	enum.TerminationFailedProbation: {0, 0},
+}
+
+// SeveranceInput holds what the severance of a terminated employee is calculated from.
+// MonthlyWage is the wage on the final working day and OtherEntitlements any further
+// uang penggantian hak agreed in the PK, PP or PKB, e.g. travel costs home.
+type SeveranceInput struct {
+	Reason            enum.TerminationReason
+	ContractType      enum.ContractType
+	HireDate          time.Time
+	ContractStart     time.Time
+	LastWorkingDay    time.Time
+	MonthlyWage       int64
+	UnusedLeaveDays   int
+	WorkWeek          enum.WorkWeek
+	OtherEntitlements int64
+}
+
+// Severance is what an employee receives when employment ends:
+//   - Pesangon and UPMK (uang penghargaan masa kerja) of PP No. 35/2021 Pasal 40 for
+//     PKWTT employees, the table months multiplied by the factor of the reason
+//   - UPH (uang penggantian hak) for untaken annual leave and other entitlements
+//   - Compensation (uang kompensasi) of Pasal 15-16 for PKWT employees, one month's wage
+//     per twelve months of the contract
+type Severance struct {
+	monthsOfService int
+	monthlyWage     int64
+	pesangonMonths  int
+	pesangonFactor  int64
+	pesangon        int64
+	upmkMonths      int
+	upmkFactor      int64
+	upmk            int64
+	uph             int64
+	compensation    int64
+}
+
+// CalculateSeverance calculates the severance. The daily wage for untaken leave is the
+// monthly wage divided by 21 for a five-day week or 25 for a six-day week.
+func CalculateSeverance(in SeveranceInput) (*Severance, error) {
+	factors, ok := severanceFactors[in.Reason]
+	if !ok {
+		return nil, fmt.Errorf("invalid TerminationReason: %q", in.Reason)
+	}
+	if !in.ContractType.Valid() {
+		return nil, fmt.Errorf("invalid ContractType: %q", in.ContractType)
+	}
+	if in.HireDate.IsZero() || in.LastWorkingDay.IsZero() {
+		return nil, errors.New("hire date and final working day cannot be empty")
+	}
+	lastDay := dateOf(in.LastWorkingDay)
+	if lastDay.Before(dateOf(in.HireDate)) {
+		return nil, errors.New("final working day cannot be before the hire date")
+	}
+	if in.MonthlyWage < 0 || in.UnusedLeaveDays < 0 || in.OtherEntitlements < 0 {
+		return nil, errors.New("severance amounts cannot be negative")
+	}
+
+	s := &Severance{monthsOfService: monthsBetween(in.HireDate, lastDay), monthlyWage: in.MonthlyWage}
+	switch in.ContractType {
+	case enum.ContractPKWTT, enum.ContractPermanent:
+		years := s.YearsOfService()
+		s.pesangonMonths, s.upmkMonths = pesangonMonths(years), upmkMonths(years)
+		s.pesangonFactor, s.upmkFactor = factors[0], factors[1]
+		s.pesangon = percentOf(in.MonthlyWage*int64(s.pesangonMonths), s.pesangonFactor)
+		s.upmk = percentOf(in.MonthlyWage*int64(s.upmkMonths), s.upmkFactor)
+	case enum.ContractPKWT:
+		if in.ContractStart.IsZero() {
+			return nil, errors.New("contract start cannot be empty")
+		}
+		if months := monthsBetween(in.ContractStart, lastDay); months >= 1 {
+			s.compensation = (in.MonthlyWage*int64(months) + 6) / 12
+		}
+	}
+
+	divisor := int64(21)
+	if in.WorkWeek == enum.WorkWeekSixDay {
+		divisor = 25
+	}
+	s.uph = (in.MonthlyWage*int64(in.UnusedLeaveDays)+divisor/2)/divisor + in.OtherEntitlements
+	return s, nil
+}
+
+// MonthsOfService returns the completed months from the hire date to the final working day.
+func (s Severance) MonthsOfService() int { return s.monthsOfService }
+
+// YearsOfService returns the completed years of service.
+func (s Severance) YearsOfService() int { return s.monthsOfService / 12 }
+
+// MonthlyWage returns the wage the severance is calculated from.
+func (s Severance) MonthlyWage() int64 { return s.monthlyWage }
+
+// PesangonMonths returns the months of wage of the pesangon table for the years of service.
+func (s Severance) PesangonMonths() int { return s.pesangonMonths }
+
+// PesangonFactor returns the pesangon multiplier of the reason in percent, e.g. 175.
+func (s Severance) PesangonFactor() int64 { return s.pesangonFactor }
+
+// Pesangon returns the uang pesangon.
+func (s Severance) Pesangon() int64 { return s.pesangon }
+
+// UPMKMonths returns the months of wage of the UPMK table for the years of service.
+func (s Severance) UPMKMonths() int { return s.upmkMonths }
+
+// UPMKFactor returns the UPMK multiplier of the reason in percent.
+func (s Severance) UPMKFactor() int64 { return s.upmkFactor }
+
+// UPMK returns the uang penghargaan masa kerja.
+func (s Severance) UPMK() int64 { return s.upmk }
+
+// UPH returns the uang penggantian hak.
+func (s Severance) UPH() int64 { return s.uph }
+
+// Compensation returns the uang kompensasi of a PKWT contract.
+func (s Severance) Compensation() int64 { return s.compensation }
+
+// Total returns the sum of all components.
+func (s Severance) Total() int64 { return s.pesangon + s.upmk + s.uph + s.compensation }
+
+// pesangonMonths follows Pasal 40 ayat (2): one month below a year of service, one more per
+// year up to nine months from eight years.
+func pesangonMonths(years int) int {
+	return min(years+1, 9)
+}
+
+// upmkMonths follows Pasal 40 ayat (3): two months from three years of service, one more
+// per three years up to eight months, and ten months from 24 years.
+func upmkMonths(years int) int {
+	switch {
+	case years < 3:
+		return 0
+	case years >= 24:
+		return 10
+	default:
+		return years/3 + 1
+	}
+}
+
+func percentOf(amount, percent int64) int64 {
+	return (amount*percent + 50) / 100
+}
+
+// monthsBetween returns the completed months from the first day up to and including the
+// last day.
+func monthsBetween(first, last time.Time) int {
+	from, to := dateOf(first), dateOf(last).AddDate(0, 0, 1)
+	months := (to.Year()-from.Year())*12 + int(to.Month()-from.Month())
+	if to.Day() < from.Day() {
+		months--
+	}
+	return max(months, 0)
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,241 @@
+package offboarding_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"strings"
+	"time"
+)
+
+// Termination is the offboarding of an employee: why and when employment ends, the assets
+// to hand back and the severance owed. It is scheduled until the final working day has
+// passed and every asset is returned, after which it is completed.
+type Termination struct {
+	id              uuid.UUID
+	employeeID      uuid.UUID
+	contractID      uuid.UUID
+	reason          enum.TerminationReason
+	noticeDate      time.Time
+	lastWorkingDay  time.Time
+	previousEndDate *time.Time
+	severance       Severance
+	assets          []AssetReturn
+	note            string
+	status          enum.TerminationStatus
+	completedAt     *time.Time
+	createdAt       time.Time
+	updatedAt       time.Time
+}
+
+// ID returns the identifier of the termination.
+func (t *Termination) ID() uuid.UUID {
+	return t.id
+}
+
+// EmployeeID returns the leaving employee.
+func (t *Termination) EmployeeID() uuid.UUID {
+	return t.employeeID
+}
+
+// ContractID returns the employment contract that ends.
+func (t *Termination) ContractID() uuid.UUID {
+	return t.contractID
+}
+
+// Reason returns why employment ends.
+func (t *Termination) Reason() enum.TerminationReason {
+	return t.reason
+}
+
+// NoticeDate returns when the resignation was submitted or the termination notified.
+func (t *Termination) NoticeDate() time.Time {
+	return t.noticeDate
+}
+
+// LastWorkingDay returns the final working day, the new end date of the contract.
+func (t *Termination) LastWorkingDay() time.Time {
+	return t.lastWorkingDay
+}
+
+// PreviousEndDate returns the contract end date before the termination, nil for an
+// open-ended contract. It is restored when the termination is cancelled.
+func (t *Termination) PreviousEndDate() *time.Time {
+	return t.previousEndDate
+}
+
+// Severance returns the severance owed.
+func (t *Termination) Severance() Severance {
+	return t.severance
+}
+
+// Assets returns the assets to hand back.
+func (t *Termination) Assets() []AssetReturn {
+	return append([]AssetReturn(nil), t.assets...)
+}
+
+// Note returns the remarks on the termination.
+func (t *Termination) Note() string {
+	return t.note
+}
+
+// Status returns whether the termination is scheduled, completed or cancelled.
+func (t *Termination) Status() enum.TerminationStatus {
+	return t.status
+}
+
+// CompletedAt returns when the termination was completed, if it was.
+func (t *Termination) CompletedAt() *time.Time {
+	return t.completedAt
+}
+
+// CreatedAt returns when the termination was recorded.
+func (t *Termination) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// UpdatedAt returns when the termination was last changed.
+func (t *Termination) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// EmploymentStatus returns the employee's status once the termination is completed.
+func (t *Termination) EmploymentStatus() enum.EmploymentStatus {
+	switch t.reason {
+	case enum.TerminationResignation:
+		return enum.EmploymentResigned
+	case enum.TerminationRetirement:
+		return enum.EmploymentRetired
+	default:
+		return enum.EmploymentTerminated
+	}
+}
+
+// ContractStatus returns the contract's status once the termination is completed: expired
+// when a PKWT contract runs to its end date, terminated otherwise.
+func (t *Termination) ContractStatus() enum.ContractStatus {
+	if t.reason == enum.TerminationEndOfContract {
+		return enum.ContractStatusExpired
+	}
+	return enum.ContractStatusTerminated
+}
+
+// OutstandingAssets returns the assets that are neither returned nor waived.
+func (t *Termination) OutstandingAssets() []AssetReturn {
+	var out []AssetReturn
+	for _, a := range t.assets {
+		if a.IsOutstanding() {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+// AddAsset adds an asset to the return checklist.
+func (t *Termination) AddAsset(tag, name string, at time.Time) error {
+	if err := t.checkScheduled(); err != nil {
+		return err
+	}
+	tag, name = strings.TrimSpace(tag), strings.TrimSpace(name)
+	if tag == "" || name == "" {
+		return errors.New("asset tag and name cannot be empty")
+	}
+	if t.asset(tag) >= 0 {
+		return fmt.Errorf("asset %s is already listed", tag)
+	}
+	t.assets = append(t.assets, AssetReturn{tag: tag, name: name})
+	t.touch(at)
+	return nil
+}
+
+// ReturnAsset records that the asset was handed back to the receiver.
+func (t *Termination) ReturnAsset(tag string, receivedBy uuid.UUID, at time.Time) error {
+	return t.closeAsset(tag, receivedBy, "", at)
+}
+
+// WaiveAsset closes an asset that will not be returned. The note says why.
+func (t *Termination) WaiveAsset(tag string, actorID uuid.UUID, note string, at time.Time) error {
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return errors.New("waiver note cannot be empty")
+	}
+	return t.closeAsset(tag, actorID, note, at)
+}
+
+// Complete closes the termination once the final working day has passed and every asset
+// is returned or waived.
+func (t *Termination) Complete(at time.Time) error {
+	if err := t.checkScheduled(); err != nil {
+		return err
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+	if !dateOf(at).After(t.lastWorkingDay) {
+		return errors.New("final working day has not passed yet")
+	}
+	if outstanding := t.OutstandingAssets(); len(outstanding) > 0 {
+		return fmt.Errorf("%d assets are not returned yet", len(outstanding))
+	}
+	at = t.touch(at)
+	t.status = enum.TerminationCompleted
+	t.completedAt = &at
+	return nil
+}
+
+// Cancel withdraws a scheduled termination, e.g. when a resignation is retracted.
+func (t *Termination) Cancel(at time.Time) error {
+	if err := t.checkScheduled(); err != nil {
+		return err
+	}
+	t.status = enum.TerminationCancelled
+	t.touch(at)
+	return nil
+}
+
+func (t *Termination) closeAsset(tag string, actorID uuid.UUID, note string, at time.Time) error {
+	if err := t.checkScheduled(); err != nil {
+		return err
+	}
+	i := t.asset(strings.TrimSpace(tag))
+	if i < 0 {
+		return fmt.Errorf("unknown asset %s", tag)
+	}
+	if actorID == uuid.Nil {
+		return errors.New("receiver cannot be empty")
+	}
+	a := &t.assets[i]
+	if !a.IsOutstanding() {
+		return fmt.Errorf("asset %s is already returned", a.tag)
+	}
+	at = t.touch(at)
+	a.returnedAt = &at
+	a.receivedBy = &actorID
+	a.waiverNote = note
+	return nil
+}
+
+func (t *Termination) checkScheduled() error {
+	if t.status != enum.TerminationScheduled {
+		return fmt.Errorf("termination is %s", t.status)
+	}
+	return nil
+}
+
+func (t *Termination) asset(tag string) int {
+	for i := range t.assets {
+		if t.assets[i].tag == tag {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *Termination) touch(at time.Time) time.Time {
+	if at.IsZero() {
+		at = time.Now()
+	}
+	t.updatedAt = at
+	return at
+}
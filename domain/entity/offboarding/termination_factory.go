@@ -0,0 +1,151 @@
+package offboarding_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"strings"
+	"time"
+)
+
+// ResignationNoticeDays is how long before leaving an employee must resign in writing,
+// PP No. 35/2021 Pasal 36 huruf i.
+const ResignationNoticeDays = 30
+
+// TerminationFactory is a factory type for creating Terminations.
+//
+// ContractType, ContractStart and ContractEnd describe the contract in effect on the final
+// working day; HireDate is the start of the employee's service. MonthlyWage, UnusedLeaveDays,
+// WorkWeek and OtherEntitlements feed the severance. NoticeWaived lets the company accept a
+// resignation on shorter notice.
+type TerminationFactory struct {
+	ID                string
+	EmployeeID        string
+	ContractID        string
+	Reason            string
+	NoticeDate        time.Time
+	NoticeWaived      bool
+	LastWorkingDay    time.Time
+	HireDate          time.Time
+	ContractType      string
+	ContractStart     time.Time
+	ContractEnd       *time.Time
+	MonthlyWage       int64
+	UnusedLeaveDays   int
+	WorkWeek          string
+	OtherEntitlements int64
+	Note              string
+	CreatedAt         time.Time
+}
+
+// Create validates the factory data and returns a scheduled Termination with its severance.
+func (f TerminationFactory) Create() (*Termination, error) {
+	id, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	employeeID, err := uuid.Parse(f.EmployeeID)
+	if err != nil {
+		return nil, errors.New("invalid employee id")
+	}
+
+	contractID, err := uuid.Parse(f.ContractID)
+	if err != nil {
+		return nil, errors.New("invalid employment contract id")
+	}
+
+	reason, err := enum.ParseTerminationReason(f.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	contractType, err := enum.ParseContractType(f.ContractType)
+	if err != nil {
+		return nil, err
+	}
+
+	workWeek := enum.WorkWeekFiveDay
+	if strings.TrimSpace(f.WorkWeek) != "" {
+		workWeek, err = enum.ParseWorkWeek(f.WorkWeek)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+	if f.NoticeDate.IsZero() {
+		f.NoticeDate = f.CreatedAt
+	}
+	notice := dateOf(f.NoticeDate)
+
+	if f.LastWorkingDay.IsZero() {
+		return nil, errors.New("final working day cannot be empty")
+	}
+	lastDay := dateOf(f.LastWorkingDay)
+	if lastDay.Before(dateOf(f.ContractStart)) {
+		return nil, errors.New("final working day cannot be before the contract start")
+	}
+	if f.ContractEnd != nil && lastDay.After(dateOf(*f.ContractEnd)) {
+		return nil, errors.New("final working day cannot be after the contract end")
+	}
+	if lastDay.Before(notice) {
+		return nil, errors.New("final working day cannot be before the notice date")
+	}
+
+	switch reason {
+	case enum.TerminationResignation:
+		if !f.NoticeWaived && notice.AddDate(0, 0, ResignationNoticeDays).After(lastDay.AddDate(0, 0, 1)) {
+			return nil, errors.New("resignation must be submitted at least 30 days before leaving")
+		}
+	case enum.TerminationEndOfContract:
+		if contractType != enum.ContractPKWT || f.ContractEnd == nil {
+			return nil, errors.New("end of contract applies to pkwt contracts only")
+		}
+		if !lastDay.Equal(dateOf(*f.ContractEnd)) {
+			return nil, errors.New("end of contract must be on the contract end date")
+		}
+	case enum.TerminationFailedProbation:
+		if contractType != enum.ContractPKWTT && contractType != enum.ContractPermanent {
+			return nil, errors.New("failed probation applies to pkwtt and permanent contracts only")
+		}
+	}
+
+	severance, err := CalculateSeverance(SeveranceInput{
+		Reason:            reason,
+		ContractType:      contractType,
+		HireDate:          f.HireDate,
+		ContractStart:     f.ContractStart,
+		LastWorkingDay:    lastDay,
+		MonthlyWage:       f.MonthlyWage,
+		UnusedLeaveDays:   f.UnusedLeaveDays,
+		WorkWeek:          workWeek,
+		OtherEntitlements: f.OtherEntitlements,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var previousEnd *time.Time
+	if f.ContractEnd != nil {
+		end := dateOf(*f.ContractEnd)
+		previousEnd = &end
+	}
+
+	return &Termination{
+		id:              id,
+		employeeID:      employeeID,
+		contractID:      contractID,
+		reason:          reason,
+		noticeDate:      notice,
+		lastWorkingDay:  lastDay,
+		previousEndDate: previousEnd,
+		severance:       *severance,
+		note:            strings.TrimSpace(f.Note),
+		status:          enum.TerminationScheduled,
+		createdAt:       f.CreatedAt,
+		updatedAt:       f.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,197 @@
+package offboarding_entity_test
+
+import (
+	"github.com/google/uuid"
+	offboarding_entity "github.com/rfanazhari/hris/domain/entity/offboarding"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCalculateSeverance(t *testing.T) {
+	cases := []struct {
+		name     string
+		reason   enum.TerminationReason
+		hired    time.Time
+		lastDay  time.Time
+		wage     int64
+		years    int
+		pesangon int64
+		upmk     int64
+	}{
+		// Eight years: nine months of pesangon and three of UPMK.
+		{"Layoff", enum.TerminationLayoff, date(2017, 4, 1), date(2025, 3, 31), 10_000_000, 8, 90_000_000, 30_000_000},
+		// 25 years: 1,75 x 9 months of pesangon and ten months of UPMK.
+		{"Retirement", enum.TerminationRetirement, date(2000, 1, 1), date(2024, 12, 31), 8_000_000, 25, 126_000_000, 80_000_000},
+		// Two and a half years: 0,5 x 3 months of pesangon, no UPMK yet.
+		{"Misconduct", enum.TerminationMisconduct, date(2022, 10, 1), date(2025, 3, 31), 10_000_000, 2, 15_000_000, 0},
+		{"LayoffFirstYear", enum.TerminationLayoff, date(2025, 1, 1), date(2025, 3, 31), 10_000_000, 0, 10_000_000, 0},
+		{"Resignation", enum.TerminationResignation, date(2010, 1, 1), date(2025, 3, 31), 10_000_000, 15, 0, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			severance, err := offboarding_entity.CalculateSeverance(offboarding_entity.SeveranceInput{
+				Reason: c.reason, ContractType: enum.ContractPKWTT, HireDate: c.hired, LastWorkingDay: c.lastDay, MonthlyWage: c.wage,
+			})
+
+			assert.Nil(t, err)
+			assert.Equal(t, c.years, severance.YearsOfService())
+			assert.Equal(t, c.pesangon, severance.Pesangon())
+			assert.Equal(t, c.upmk, severance.UPMK())
+			assert.Equal(t, int64(0), severance.Compensation())
+		})
+	}
+	t.Run("UPH", func(t *testing.T) {
+		severance, _ := offboarding_entity.CalculateSeverance(offboarding_entity.SeveranceInput{
+			Reason: enum.TerminationResignation, ContractType: enum.ContractPKWTT, HireDate: date(2020, 1, 1), LastWorkingDay: date(2025, 3, 31),
+			MonthlyWage: 10_000_000, UnusedLeaveDays: 5, WorkWeek: enum.WorkWeekFiveDay, OtherEntitlements: 500_000,
+		})
+		assert.Equal(t, int64(2_380_952+500_000), severance.UPH())
+
+		severance, _ = offboarding_entity.CalculateSeverance(offboarding_entity.SeveranceInput{
+			Reason: enum.TerminationResignation, ContractType: enum.ContractPKWTT, HireDate: date(2020, 1, 1), LastWorkingDay: date(2025, 3, 31),
+			MonthlyWage: 10_000_000, UnusedLeaveDays: 5, WorkWeek: enum.WorkWeekSixDay,
+		})
+		assert.Equal(t, int64(2_000_000), severance.UPH())
+		assert.Equal(t, int64(2_000_000), severance.Total())
+	})
+	t.Run("PKWTCompensation", func(t *testing.T) {
+		severance, err := offboarding_entity.CalculateSeverance(offboarding_entity.SeveranceInput{
+			Reason: enum.TerminationEndOfContract, ContractType: enum.ContractPKWT, HireDate: date(2024, 7, 1),
+			ContractStart: date(2024, 7, 1), LastWorkingDay: date(2025, 6, 30), MonthlyWage: 6_000_000,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(6_000_000), severance.Compensation())
+		assert.Equal(t, int64(0), severance.Pesangon())
+
+		// Ended early after six months: half a month's wage.
+		severance, _ = offboarding_entity.CalculateSeverance(offboarding_entity.SeveranceInput{
+			Reason: enum.TerminationLayoff, ContractType: enum.ContractPKWT, HireDate: date(2024, 7, 1),
+			ContractStart: date(2024, 7, 1), LastWorkingDay: date(2024, 12, 31), MonthlyWage: 6_000_000,
+		})
+		assert.Equal(t, int64(3_000_000), severance.Compensation())
+	})
+}
+
+func TestTerminationFactory_Create(t *testing.T) {
+	base := offboarding_entity.TerminationFactory{
+		ID: uuid.NewString(), EmployeeID: uuid.NewString(), ContractID: uuid.NewString(),
+		Reason: "resignation", NoticeDate: date(2025, 3, 1), LastWorkingDay: date(2025, 3, 31),
+		HireDate: date(2020, 1, 1), ContractType: "pkwtt", ContractStart: date(2020, 1, 1), MonthlyWage: 10_000_000,
+		CreatedAt: date(2025, 3, 1),
+	}
+
+	t.Run("ValidInput", func(t *testing.T) {
+		termination, err := base.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, enum.TerminationScheduled, termination.Status())
+		assert.Equal(t, enum.EmploymentResigned, termination.EmploymentStatus())
+		assert.Equal(t, enum.ContractStatusTerminated, termination.ContractStatus())
+		assert.Nil(t, termination.PreviousEndDate())
+		assert.Equal(t, 5, termination.Severance().YearsOfService())
+	})
+	t.Run("EndOfContract", func(t *testing.T) {
+		end := date(2025, 6, 30)
+		f := base
+		f.Reason, f.ContractType, f.ContractStart, f.ContractEnd, f.LastWorkingDay = "end_of_contract", "pkwt", date(2024, 7, 1), &end, end
+
+		termination, err := f.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, enum.ContractStatusExpired, termination.ContractStatus())
+		assert.Equal(t, enum.EmploymentTerminated, termination.EmploymentStatus())
+		assert.Equal(t, end, *termination.PreviousEndDate())
+
+		f.LastWorkingDay = date(2025, 5, 31)
+		_, err = f.Create()
+		assert.EqualError(t, err, "end of contract must be on the contract end date")
+	})
+	t.Run("InvalidInput", func(t *testing.T) {
+		end := date(2025, 6, 30)
+		cases := []struct {
+			change func(f *offboarding_entity.TerminationFactory)
+			err    string
+		}{
+			{func(f *offboarding_entity.TerminationFactory) { f.ContractID = "" }, "invalid employment contract id"},
+			{func(f *offboarding_entity.TerminationFactory) { f.Reason = "fired" }, `invalid TerminationReason: "fired"`},
+			{func(f *offboarding_entity.TerminationFactory) { f.LastWorkingDay = time.Time{} }, "final working day cannot be empty"},
+			{func(f *offboarding_entity.TerminationFactory) { f.LastWorkingDay = date(2025, 3, 29) }, "resignation must be submitted at least 30 days before leaving"},
+			{func(f *offboarding_entity.TerminationFactory) { f.LastWorkingDay = date(2025, 2, 28) }, "final working day cannot be before the notice date"},
+			{func(f *offboarding_entity.TerminationFactory) { f.Reason = "end_of_contract" }, "end of contract applies to pkwt contracts only"},
+			{func(f *offboarding_entity.TerminationFactory) {
+				f.Reason, f.ContractType, f.ContractEnd = "failed_probation", "pkwt", &end
+			}, "failed probation applies to pkwtt and permanent contracts only"},
+			{func(f *offboarding_entity.TerminationFactory) {
+				f.Reason, f.ContractType = "failed_probation", "internship"
+			}, "failed probation applies to pkwtt and permanent contracts only"},
+			{func(f *offboarding_entity.TerminationFactory) {
+				f.ContractType, f.ContractEnd, f.LastWorkingDay = "pkwt", &end, date(2025, 7, 31)
+			}, "final working day cannot be after the contract end"},
+		}
+		for _, c := range cases {
+			f := base
+			c.change(&f)
+			_, err := f.Create()
+			assert.EqualError(t, err, c.err)
+		}
+
+		f := base
+		f.LastWorkingDay, f.NoticeWaived = date(2025, 3, 14), true
+		_, err := f.Create()
+		assert.Nil(t, err)
+
+		f = base
+		f.Reason, f.ContractType = "failed_probation", "permanent"
+		_, err = f.Create()
+		assert.Nil(t, err)
+	})
+}
+
+func TestTermination_Lifecycle(t *testing.T) {
+	newTermination := func(t *testing.T) *offboarding_entity.Termination {
+		termination, err := offboarding_entity.TerminationFactory{
+			ID: uuid.NewString(), EmployeeID: uuid.NewString(), ContractID: uuid.NewString(),
+			Reason: "layoff", NoticeDate: date(2025, 3, 1), LastWorkingDay: date(2025, 3, 31),
+			HireDate: date(2020, 1, 1), ContractType: "pkwtt", ContractStart: date(2020, 1, 1), MonthlyWage: 10_000_000,
+		}.Create()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return termination
+	}
+	ga := uuid.New()
+
+	t.Run("AssetsAndComplete", func(t *testing.T) {
+		termination := newTermination(t)
+		assert.Nil(t, termination.AddAsset("LPT-0042", "Laptop", time.Time{}))
+		assert.Nil(t, termination.AddAsset("CARD-17", "Access card", time.Time{}))
+		assert.EqualError(t, termination.AddAsset("LPT-0042", "Laptop", time.Time{}), "asset LPT-0042 is already listed")
+
+		assert.EqualError(t, termination.Complete(date(2025, 3, 31)), "final working day has not passed yet")
+		assert.EqualError(t, termination.Complete(date(2025, 4, 1)), "2 assets are not returned yet")
+
+		assert.Nil(t, termination.ReturnAsset("LPT-0042", ga, date(2025, 3, 31)))
+		assert.EqualError(t, termination.ReturnAsset("LPT-0042", ga, date(2025, 3, 31)), "asset LPT-0042 is already returned")
+		assert.EqualError(t, termination.WaiveAsset("CARD-17", ga, "", date(2025, 3, 31)), "waiver note cannot be empty")
+		assert.Nil(t, termination.WaiveAsset("CARD-17", ga, "lost, replacement fee deducted", date(2025, 3, 31)))
+		assert.Empty(t, termination.OutstandingAssets())
+
+		assert.Nil(t, termination.Complete(date(2025, 4, 1)))
+		assert.Equal(t, enum.TerminationCompleted, termination.Status())
+		assert.Equal(t, date(2025, 4, 1), *termination.CompletedAt())
+		assert.EqualError(t, termination.Cancel(time.Time{}), "termination is completed")
+	})
+	t.Run("Cancel", func(t *testing.T) {
+		termination := newTermination(t)
+
+		assert.Nil(t, termination.Cancel(time.Time{}))
+		assert.Equal(t, enum.TerminationCancelled, termination.Status())
+		assert.EqualError(t, termination.AddAsset("LPT-0042", "Laptop", time.Time{}), "termination is cancelled")
+	})
+}
@@ -0,0 +1,188 @@
+package onboarding_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"strings"
+	"time"
+)
+
+// Checklist is the onboarding checklist of one new hire, instantiated from a Template with
+// due dates counted from the employee's start date.
+type Checklist struct {
+	id          uuid.UUID
+	employeeID  uuid.UUID
+	templateID  uuid.UUID
+	startDate   time.Time
+	tasks       []Task
+	completedAt *time.Time
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// ID returns the identifier of the checklist.
+func (c *Checklist) ID() uuid.UUID {
+	return c.id
+}
+
+// EmployeeID returns the new hire.
+func (c *Checklist) EmployeeID() uuid.UUID {
+	return c.employeeID
+}
+
+// TemplateID returns the template the checklist was instantiated from.
+func (c *Checklist) TemplateID() uuid.UUID {
+	return c.templateID
+}
+
+// StartDate returns the employee's first working day.
+func (c *Checklist) StartDate() time.Time {
+	return c.startDate
+}
+
+// Tasks returns the tasks in template order.
+func (c *Checklist) Tasks() []Task {
+	out := make([]Task, len(c.tasks))
+	copy(out, c.tasks)
+	return out
+}
+
+// CompletedAt returns when the last task was closed, nil while tasks are pending.
+func (c *Checklist) CompletedAt() *time.Time {
+	return c.completedAt
+}
+
+// CreatedAt returns when the checklist was instantiated.
+func (c *Checklist) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// UpdatedAt returns when the checklist was last changed.
+func (c *Checklist) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// Task returns the task with the key.
+func (c *Checklist) Task(key string) (Task, bool) {
+	i := c.index(key)
+	if i < 0 {
+		return Task{}, false
+	}
+	return c.tasks[i], true
+}
+
+// Progress returns the number of closed tasks and the total number of tasks.
+func (c *Checklist) Progress() (closed, total int) {
+	for _, t := range c.tasks {
+		if t.IsClosed() {
+			closed++
+		}
+	}
+	return closed, len(c.tasks)
+}
+
+// Percent returns the share of closed tasks, rounded down to a whole percent.
+func (c *Checklist) Percent() int {
+	closed, total := c.Progress()
+	return closed * 100 / total
+}
+
+// IsComplete reports whether every task is closed.
+func (c *Checklist) IsComplete() bool {
+	return c.completedAt != nil
+}
+
+// Ready returns the pending tasks whose dependencies are all closed.
+func (c *Checklist) Ready() []Task {
+	var out []Task
+	for _, t := range c.tasks {
+		if !t.IsClosed() && c.blocker(t) == "" {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// Overdue returns the pending tasks whose due date is before the calendar date of at.
+func (c *Checklist) Overdue(at time.Time) []Task {
+	var out []Task
+	for _, t := range c.tasks {
+		if t.IsOverdue(at) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// Complete marks a ready task as done.
+func (c *Checklist) Complete(key string, actorID uuid.UUID, note string, at time.Time) error {
+	return c.close(key, enum.TaskCompleted, actorID, strings.TrimSpace(note), at)
+}
+
+// Skip closes a pending task that does not apply to this employee. The note says why.
+func (c *Checklist) Skip(key string, actorID uuid.UUID, note string, at time.Time) error {
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return errors.New("skip note cannot be empty")
+	}
+	return c.close(key, enum.TaskSkipped, actorID, note, at)
+}
+
+func (c *Checklist) close(key string, status enum.TaskStatus, actorID uuid.UUID, note string, at time.Time) error {
+	i := c.index(key)
+	if i < 0 {
+		return fmt.Errorf("unknown task %s", key)
+	}
+	if actorID == uuid.Nil {
+		return errors.New("actor cannot be empty")
+	}
+	t := &c.tasks[i]
+	if t.IsClosed() {
+		return fmt.Errorf("task %s is already %s", key, t.status)
+	}
+	if blocker := c.blocker(*t); blocker != "" {
+		return fmt.Errorf("task %s is waiting for %s", key, blocker)
+	}
+	at = c.touch(at)
+	t.status = status
+	t.closedBy = &actorID
+	t.closedAt = &at
+	t.note = note
+	if closed, total := c.Progress(); closed == total {
+		c.completedAt = &at
+	}
+	return nil
+}
+
+// blocker returns the key of the first pending dependency of the task, if any.
+func (c *Checklist) blocker(t Task) string {
+	for _, d := range t.dependsOn {
+		if dep, ok := c.Task(d); ok && !dep.IsClosed() {
+			return d
+		}
+	}
+	return ""
+}
+
+func (c *Checklist) index(key string) int {
+	for i := range c.tasks {
+		if c.tasks[i].key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *Checklist) touch(at time.Time) time.Time {
+	if at.IsZero() {
+		at = time.Now()
+	}
+	c.updatedAt = at
+	return at
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
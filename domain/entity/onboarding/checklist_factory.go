@@ -0,0 +1,67 @@
+package onboarding_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// ChecklistFactory is a factory type for instantiating an onboarding Checklist from a
+// Template.
+type ChecklistFactory struct {
+	ID         string
+	EmployeeID string
+	Template   *Template
+	StartDate  time.Time
+	CreatedAt  time.Time
+}
+
+// Create validates the factory data and returns a Checklist with every task pending and
+// due its offset after the start date.
+func (f ChecklistFactory) Create() (*Checklist, error) {
+	id, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	employeeID, err := uuid.Parse(f.EmployeeID)
+	if err != nil {
+		return nil, errors.New("invalid employee id")
+	}
+
+	if f.Template == nil || len(f.Template.tasks) == 0 {
+		return nil, errors.New("onboarding template cannot be empty")
+	}
+
+	if f.StartDate.IsZero() {
+		return nil, errors.New("start date cannot be empty")
+	}
+	start := dateOf(f.StartDate)
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	tasks := make([]Task, len(f.Template.tasks))
+	for i, t := range f.Template.tasks {
+		tasks[i] = Task{
+			key:       t.key,
+			title:     t.title,
+			owner:     t.owner,
+			dueDate:   start.AddDate(0, 0, t.dueOffset),
+			dependsOn: append([]string(nil), t.dependsOn...),
+			status:    enum.TaskPending,
+		}
+	}
+
+	return &Checklist{
+		id:         id,
+		employeeID: employeeID,
+		templateID: f.Template.id,
+		startDate:  start,
+		tasks:      tasks,
+		createdAt:  f.CreatedAt,
+		updatedAt:  f.CreatedAt,
+	}, nil
+}
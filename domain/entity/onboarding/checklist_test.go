@@ -0,0 +1,139 @@
+package onboarding_entity_test
+
+import (
+	"github.com/google/uuid"
+	onboarding_entity "github.com/rfanazhari/hris/domain/entity/onboarding"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func task(t *testing.T, key string, owner enum.TaskOwner, offset int, dependsOn ...string) onboarding_entity.TaskTemplate {
+	tt, err := onboarding_entity.NewTaskTemplate(key, key, owner, offset, dependsOn...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return *tt
+}
+
+// newTemplate lists the IT tasks: the laptop is ordered a week before the start date and
+// the email account is created once the employee has signed the IT policy.
+func newTemplate(t *testing.T) *onboarding_entity.Template {
+	template, err := onboarding_entity.TemplateFactory{
+		ID:           uuid.NewString(),
+		Name:         "Engineering PKWTT",
+		ContractType: "pkwtt",
+		Tasks: []onboarding_entity.TaskTemplate{
+			task(t, "order_laptop", enum.TaskOwnerIT, -7),
+			task(t, "sign_it_policy", enum.TaskOwnerEmployee, 0),
+			task(t, "create_email", enum.TaskOwnerIT, 1, "sign_it_policy"),
+			task(t, "orientation", enum.TaskOwnerManager, 5, "create_email", "order_laptop"),
+		},
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return template
+}
+
+func TestTemplateFactory_Create(t *testing.T) {
+	t.Run("ValidInput", func(t *testing.T) {
+		template := newTemplate(t)
+
+		assert.Nil(t, template.OrganizationUnitID())
+		assert.Equal(t, enum.ContractPKWTT, *template.ContractType())
+		assert.Len(t, template.Tasks(), 4)
+		contractType := enum.ContractPKWTT
+		assert.True(t, template.Matches(nil, &contractType))
+		assert.False(t, template.Matches(nil, nil))
+	})
+	t.Run("InvalidTasks", func(t *testing.T) {
+		_, err := onboarding_entity.NewTaskTemplate("Create Email", "Create email", enum.TaskOwnerIT, 0)
+		assert.EqualError(t, err, `invalid task key: "Create Email"`)
+		_, err = onboarding_entity.NewTaskTemplate("create_email", "Create email", enum.TaskOwnerIT, 0, "create_email")
+		assert.EqualError(t, err, "task create_email cannot depend on itself")
+
+		create := func(tasks ...onboarding_entity.TaskTemplate) error {
+			_, err := onboarding_entity.TemplateFactory{ID: uuid.NewString(), Name: "Default", Tasks: tasks}.Create()
+			return err
+		}
+		assert.EqualError(t, create(), "template needs at least one task")
+		assert.EqualError(t, create(task(t, "a_task", enum.TaskOwnerHR, 0), task(t, "a_task", enum.TaskOwnerHR, 1)), "duplicate task a_task")
+		assert.EqualError(t, create(task(t, "a_task", enum.TaskOwnerHR, 0, "b_task")), "task a_task depends on unknown task b_task")
+		assert.EqualError(t, create(
+			task(t, "a_task", enum.TaskOwnerHR, 0, "c_task"),
+			task(t, "b_task", enum.TaskOwnerHR, 0, "a_task"),
+			task(t, "c_task", enum.TaskOwnerHR, 0, "b_task"),
+		), "task a_task depends on itself through its dependencies")
+	})
+}
+
+func TestChecklist(t *testing.T) {
+	start := date(2025, 7, 1)
+	actor := uuid.New()
+	newChecklist := func(t *testing.T) *onboarding_entity.Checklist {
+		checklist, err := onboarding_entity.ChecklistFactory{
+			ID: uuid.NewString(), EmployeeID: uuid.NewString(), Template: newTemplate(t), StartDate: start,
+		}.Create()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return checklist
+	}
+
+	t.Run("DueDatesAndOverdue", func(t *testing.T) {
+		checklist := newChecklist(t)
+
+		laptop, _ := checklist.Task("order_laptop")
+		assert.Equal(t, date(2025, 6, 24), laptop.DueDate())
+		assert.Empty(t, checklist.Overdue(date(2025, 6, 24)))
+		assert.Len(t, checklist.Overdue(date(2025, 6, 25)), 1)
+		assert.Len(t, checklist.Overdue(date(2025, 7, 7)), 4)
+		assert.Equal(t, []string{"order_laptop", "sign_it_policy"}, keys(checklist.Ready()))
+	})
+	t.Run("Dependencies", func(t *testing.T) {
+		checklist := newChecklist(t)
+
+		assert.EqualError(t, checklist.Complete("create_email", actor, "", time.Time{}), "task create_email is waiting for sign_it_policy")
+		assert.Nil(t, checklist.Complete("sign_it_policy", actor, "", time.Time{}))
+		assert.Nil(t, checklist.Complete("create_email", actor, "", time.Time{}))
+		assert.EqualError(t, checklist.Complete("orientation", actor, "", time.Time{}), "task orientation is waiting for order_laptop")
+		assert.EqualError(t, checklist.Complete("create_email", actor, "", time.Time{}), "task create_email is already completed")
+		assert.EqualError(t, checklist.Complete("badge", actor, "", time.Time{}), "unknown task badge")
+	})
+	t.Run("Progress", func(t *testing.T) {
+		checklist := newChecklist(t)
+		at := date(2025, 7, 3)
+
+		assert.EqualError(t, checklist.Skip("order_laptop", actor, " ", at), "skip note cannot be empty")
+		assert.Nil(t, checklist.Skip("order_laptop", actor, "brings own device", at))
+		assert.Nil(t, checklist.Complete("sign_it_policy", actor, "", at))
+		closed, total := checklist.Progress()
+		assert.Equal(t, 2, closed)
+		assert.Equal(t, 4, total)
+		assert.Equal(t, 50, checklist.Percent())
+		assert.False(t, checklist.IsComplete())
+
+		assert.Nil(t, checklist.Complete("create_email", actor, "", at))
+		assert.Nil(t, checklist.Complete("orientation", actor, "", at.Add(time.Hour)))
+		assert.True(t, checklist.IsComplete())
+		assert.Equal(t, at.Add(time.Hour), *checklist.CompletedAt())
+		assert.Empty(t, checklist.Overdue(date(2025, 12, 31)))
+		laptop, _ := checklist.Task("order_laptop")
+		assert.Equal(t, enum.TaskSkipped, laptop.Status())
+		assert.Equal(t, "brings own device", laptop.Note())
+	})
+}
+
+func keys(tasks []onboarding_entity.Task) []string {
+	var out []string
+	for _, t := range tasks {
+		out = append(out, t.Key())
+	}
+	return out
+}
@@ -0,0 +1,55 @@
+package onboarding_entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// Task is one task of an employee's onboarding checklist.
+type Task struct {
+	key       string
+	title     string
+	owner     enum.TaskOwner
+	dueDate   time.Time
+	dependsOn []string
+	status    enum.TaskStatus
+	closedBy  *uuid.UUID
+	closedAt  *time.Time
+	note      string
+}
+
+// Key returns the identifier of the task within its checklist.
+func (t Task) Key() string { return t.key }
+
+// Title returns what is to be done.
+func (t Task) Title() string { return t.title }
+
+// Owner returns who is responsible for the task.
+func (t Task) Owner() enum.TaskOwner { return t.owner }
+
+// DueDate returns the day the task is due.
+func (t Task) DueDate() time.Time { return t.dueDate }
+
+// DependsOn returns the keys of the tasks that must be closed first.
+func (t Task) DependsOn() []string { return append([]string(nil), t.dependsOn...) }
+
+// Status returns whether the task is pending, completed or skipped.
+func (t Task) Status() enum.TaskStatus { return t.status }
+
+// ClosedBy returns who completed or skipped the task, if anyone.
+func (t Task) ClosedBy() *uuid.UUID { return t.closedBy }
+
+// ClosedAt returns when the task was completed or skipped, if it was.
+func (t Task) ClosedAt() *time.Time { return t.closedAt }
+
+// Note returns the note left when closing the task, e.g. why it was skipped.
+func (t Task) Note() string { return t.note }
+
+// IsClosed reports whether the task is completed or skipped.
+func (t Task) IsClosed() bool { return t.status != enum.TaskPending }
+
+// IsOverdue reports whether the task is still pending after its due date.
+func (t Task) IsOverdue(at time.Time) bool {
+	return !t.IsClosed() && dateOf(at).After(t.dueDate)
+}
@@ -0,0 +1,131 @@
+package onboarding_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var taskKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,39}$`)
+
+// TaskTemplate describes one task of an onboarding template. The task is due DueOffset days
+// after the employee's start date; a negative offset means before it, e.g. ordering a
+// laptop. A task cannot be completed before the tasks it depends on are.
+type TaskTemplate struct {
+	key       string
+	title     string
+	owner     enum.TaskOwner
+	dueOffset int
+	dependsOn []string
+}
+
+// NewTaskTemplate constructs a TaskTemplate. The key identifies the task within its
+// template, e.g. "create_email".
+func NewTaskTemplate(key, title string, owner enum.TaskOwner, dueOffset int, dependsOn ...string) (*TaskTemplate, error) {
+	if !taskKeyPattern.MatchString(key) {
+		return nil, fmt.Errorf("invalid task key: %q", key)
+	}
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, errors.New("task title cannot be empty")
+	}
+	if !owner.Valid() {
+		return nil, fmt.Errorf("invalid TaskOwner: %q", owner)
+	}
+	seen := map[string]bool{}
+	for _, d := range dependsOn {
+		if d == key {
+			return nil, fmt.Errorf("task %s cannot depend on itself", key)
+		}
+		if seen[d] {
+			return nil, fmt.Errorf("task %s depends on %s twice", key, d)
+		}
+		seen[d] = true
+	}
+	return &TaskTemplate{key: key, title: title, owner: owner, dueOffset: dueOffset, dependsOn: append([]string(nil), dependsOn...)}, nil
+}
+
+// Key returns the identifier of the task within its template.
+func (t TaskTemplate) Key() string { return t.key }
+
+// Title returns what is to be done.
+func (t TaskTemplate) Title() string { return t.title }
+
+// Owner returns who is responsible for the task.
+func (t TaskTemplate) Owner() enum.TaskOwner { return t.owner }
+
+// DueOffset returns the days between the start date and the due date.
+func (t TaskTemplate) DueOffset() int { return t.dueOffset }
+
+// DependsOn returns the keys of the tasks that must be closed first.
+func (t TaskTemplate) DependsOn() []string { return append([]string(nil), t.dependsOn...) }
+
+// Template is the list of onboarding tasks for new hires of an organization unit and/or
+// contract type. A template without a unit applies to every unit and one without a
+// contract type to every contract type.
+type Template struct {
+	id                 uuid.UUID
+	name               string
+	organizationUnitID *uuid.UUID
+	contractType       *enum.ContractType
+	tasks              []TaskTemplate
+	createdAt          time.Time
+	updatedAt          time.Time
+}
+
+// ID returns the identifier of the template.
+func (t *Template) ID() uuid.UUID {
+	return t.id
+}
+
+// Name returns the name of the template.
+func (t *Template) Name() string {
+	return t.name
+}
+
+// OrganizationUnitID returns the unit the template is for, nil for every unit.
+func (t *Template) OrganizationUnitID() *uuid.UUID {
+	return t.organizationUnitID
+}
+
+// ContractType returns the contract type the template is for, nil for every type.
+func (t *Template) ContractType() *enum.ContractType {
+	return t.contractType
+}
+
+// Tasks returns the tasks in the order they are listed.
+func (t *Template) Tasks() []TaskTemplate {
+	return append([]TaskTemplate(nil), t.tasks...)
+}
+
+// CreatedAt returns when the template was created.
+func (t *Template) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// UpdatedAt returns when the template was last changed.
+func (t *Template) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// SameScope reports whether both templates apply to the same unit and contract type.
+func (t *Template) SameScope(other Template) bool {
+	return equalPtr(t.organizationUnitID, other.organizationUnitID) && equalPtr(t.contractType, other.contractType)
+}
+
+// Matches reports whether the template is the one for the unit and contract type exactly,
+// where a nil unit or contract type asks for a template that applies to all of them.
+func (t *Template) Matches(unitID *uuid.UUID, contractType *enum.ContractType) bool {
+	return equalPtr(t.organizationUnitID, unitID) && equalPtr(t.contractType, contractType)
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
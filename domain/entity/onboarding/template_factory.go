@@ -0,0 +1,123 @@
+package onboarding_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"strings"
+	"time"
+)
+
+// TemplateFactory is a factory type for creating onboarding Templates. OrganizationUnitID
+// and ContractType are optional.
+type TemplateFactory struct {
+	ID                 string
+	Name               string
+	OrganizationUnitID string
+	ContractType       string
+	Tasks              []TaskTemplate
+	CreatedAt          time.Time
+}
+
+// Create validates the factory data and returns a new Template. Task keys must be unique
+// and every dependency must refer to a task of the template without forming a cycle.
+func (f TemplateFactory) Create() (*Template, error) {
+	id, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	name := strings.TrimSpace(f.Name)
+	if name == "" {
+		return nil, errors.New("template name cannot be empty")
+	}
+
+	var unitID *uuid.UUID
+	if f.OrganizationUnitID != "" {
+		parsed, err := uuid.Parse(f.OrganizationUnitID)
+		if err != nil {
+			return nil, errors.New("invalid organization unit id")
+		}
+		unitID = &parsed
+	}
+
+	var contractType *enum.ContractType
+	if strings.TrimSpace(f.ContractType) != "" {
+		parsed, err := enum.ParseContractType(f.ContractType)
+		if err != nil {
+			return nil, err
+		}
+		contractType = &parsed
+	}
+
+	if len(f.Tasks) == 0 {
+		return nil, errors.New("template needs at least one task")
+	}
+	tasks := map[string]TaskTemplate{}
+	for _, t := range f.Tasks {
+		if t.key == "" {
+			return nil, errors.New("invalid task template")
+		}
+		if _, ok := tasks[t.key]; ok {
+			return nil, fmt.Errorf("duplicate task %s", t.key)
+		}
+		tasks[t.key] = t
+	}
+	for _, t := range f.Tasks {
+		for _, d := range t.dependsOn {
+			if _, ok := tasks[d]; !ok {
+				return nil, fmt.Errorf("task %s depends on unknown task %s", t.key, d)
+			}
+		}
+	}
+	if key, ok := cyclic(f.Tasks, tasks); ok {
+		return nil, fmt.Errorf("task %s depends on itself through its dependencies", key)
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	return &Template{
+		id:                 id,
+		name:               name,
+		organizationUnitID: unitID,
+		contractType:       contractType,
+		tasks:              append([]TaskTemplate(nil), f.Tasks...),
+		createdAt:          f.CreatedAt,
+		updatedAt:          f.CreatedAt,
+	}, nil
+}
+
+// cyclic returns the key of a task that is part of a dependency cycle, if any.
+func cyclic(list []TaskTemplate, tasks map[string]TaskTemplate) (string, bool) {
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := map[string]int{}
+	var visit func(key string) bool
+	visit = func(key string) bool {
+		switch state[key] {
+		case visiting:
+			return true
+		case done:
+			return false
+		}
+		state[key] = visiting
+		for _, d := range tasks[key].dependsOn {
+			if visit(d) {
+				return true
+			}
+		}
+		state[key] = done
+		return false
+	}
+	for _, t := range list {
+		if visit(t.key) {
+			return t.key, true
+		}
+	}
+	return "", false
+}
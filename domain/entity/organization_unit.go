@@ -12,6 +12,7 @@ type OrganizationUnit struct {
 	name         string
 	parentUnitID *uuid.UUID
 	kind         enum.OrganizationUnitKind
+	jkkRiskClass *enum.JKKRiskClass
 	createdAt    time.Time
 }
 
@@ -35,6 +36,12 @@ func (o *OrganizationUnit) Type() enum.OrganizationUnitKind {
 	return o.kind
 }
 
+// JKKRiskClass returns the BPJS JKK risk class of the unit's workplace, or nil if the unit
+// inherits the class of its parent.
+func (o *OrganizationUnit) JKKRiskClass() *enum.JKKRiskClass {
+	return o.jkkRiskClass
+}
+
 // CreatedAt returns the timestamp indicating when the OrganizationUnit was created.
 func (o *OrganizationUnit) CreatedAt() time.Time {
 	return o.createdAt
@@ -14,6 +14,7 @@ type OrganizationUnitFactory struct {
 	Name         string
 	ParentUnitID string
 	Type         string
+	JKKRiskClass string
 	CreatedAt    time.Time
 }
 
@@ -55,11 +56,21 @@ func (f OrganizationUnitFactory) Create() (*OrganizationUnit, error) {
 		return nil, errKind
 	}
 
+	var jkkRiskClass *enum.JKKRiskClass
+	if f.JKKRiskClass != "" {
+		class, err := enum.ParseJKKRiskClass(f.JKKRiskClass)
+		if err != nil {
+			return nil, err
+		}
+		jkkRiskClass = &class
+	}
+
 	return &OrganizationUnit{
 		id:           newUUID,
 		name:         f.Name,
 		parentUnitID: parentUnitID,
 		kind:         kind,
+		jkkRiskClass: jkkRiskClass,
 		createdAt:    f.CreatedAt,
 	}, nil
 }
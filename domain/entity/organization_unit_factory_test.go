@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/rfanazhari/hris/domain/entity"
+	"github.com/rfanazhari/hris/domain/enum"
 	"github.com/stretchr/testify/assert"
 	"testing"
 	"time"
@@ -133,4 +134,21 @@ func TestOrganizationUnitFactory_Create(t *testing.T) {
 		assert.Nil(t, orgUnit)
 		assert.EqualError(t, err, fmt.Errorf("invalid OrganizationUnitKind: %q", "gudep").Error())
 	})
+	t.Run("JKKRiskClass", func(t *testing.T) {
+		factory := entity.OrganizationUnitFactory{
+			ID:           uuid.NewString(),
+			Name:         "Plant Cikarang",
+			Type:         "division",
+			JKKRiskClass: "High",
+		}
+
+		orgUnit, err := factory.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, enum.JKKRiskHigh, *orgUnit.JKKRiskClass())
+
+		factory.JKKRiskClass = "extreme"
+		_, err = factory.Create()
+		assert.EqualError(t, err, fmt.Errorf("invalid JKKRiskClass: %q", "extreme").Error())
+	})
 }
@@ -0,0 +1,83 @@
+package overtime_entity
+
+import (
+	"errors"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// HourlyWageDivisor divides the monthly wage into the hourly overtime wage,
+// PP No. 35/2021 Pasal 31 ayat (3).
+const HourlyWageDivisor = 173
+
+// Overtime limits of PP No. 35/2021 Pasal 26 ayat (1). They apply to overtime on workdays;
+// overtime on rest days and public holidays is bounded by MaxOvertime instead.
+const (
+	MaxDailyOvertime  = 4 * time.Hour
+	MaxWeeklyOvertime = 18 * time.Hour
+)
+
+// payTier applies multiplier (in halves) to the overtime worked up to the given hour.
+type payTier struct {
+	upTo   time.Duration
+	halves int64
+}
+
+// payTiers returns the multipliers of Kepmenakertrans No. KEP.102/MEN/VI/2004 Pasal 11:
+//   - workday: 1.5x the first hour, 2x every following hour
+//   - rest day or public holiday, 6 day week: 2x the first 7 hours, 3x the 8th, 4x the 9th and 10th
+//   - rest day or public holiday, 5 day week: 2x the first 8 hours, 3x the 9th, 4x the 10th and 11th
+func payTiers(dayType enum.OvertimeDayType, workWeek enum.WorkWeek) []payTier {
+	if dayType == enum.OvertimeWorkday {
+		return []payTier{{time.Hour, 3}, {MaxDailyOvertime, 4}}
+	}
+	normal := 8 * time.Hour
+	if workWeek == enum.WorkWeekSixDay {
+		normal = 7 * time.Hour
+	}
+	return []payTier{{normal, 4}, {normal + time.Hour, 6}, {normal + 3*time.Hour, 8}}
+}
+
+// MaxOvertime returns the longest overtime that can be worked on one day of the given type.
+func MaxOvertime(dayType enum.OvertimeDayType, workWeek enum.WorkWeek) time.Duration {
+	tiers := payTiers(dayType, workWeek)
+	return tiers[len(tiers)-1].upTo
+}
+
+// OvertimePay computes the pay for overtime worked on one day from the monthly wage.
+// Partial hours are paid pro rata by the minute and the result is rounded to the nearest unit.
+func OvertimePay(monthlyWage int64, worked time.Duration, dayType enum.OvertimeDayType, workWeek enum.WorkWeek) (int64, error) {
+	if monthlyWage <= 0 {
+		return 0, errors.New("monthly wage must be positive")
+	}
+	if !dayType.Valid() {
+		return 0, errors.New("invalid overtime day type")
+	}
+	if !workWeek.Valid() {
+		return 0, errors.New("invalid work week")
+	}
+	if worked < 0 {
+		return 0, errors.New("overtime cannot be negative")
+	}
+	if worked > MaxOvertime(dayType, workWeek) {
+		return 0, errors.New("overtime exceeds the daily limit")
+	}
+
+	// Sum minutes x multiplier in halves, then divide once to avoid compounding rounding.
+	var weighted int64
+	var from time.Duration
+	for _, tier := range payTiers(dayType, workWeek) {
+		if worked <= from {
+			break
+		}
+		upTo := tier.upTo
+		if worked < upTo {
+			upTo = worked
+		}
+		weighted += int64((upTo-from)/time.Minute) * tier.halves
+		from = tier.upTo
+	}
+
+	divisor := int64(HourlyWageDivisor * 60 * 2)
+	return (monthlyWage*weighted + divisor/2) / divisor, nil
+}
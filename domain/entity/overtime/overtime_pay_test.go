@@ -0,0 +1,58 @@
+package overtime_entity_test
+
+import (
+	overtime_entity "github.com/rfanazhari/hris/domain/entity/overtime"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestOvertimePay(t *testing.T) {
+	// 3.460.000 / 173 = 20.000 per hour.
+	const wage = 3_460_000
+
+	tests := []struct {
+		name     string
+		worked   time.Duration
+		dayType  enum.OvertimeDayType
+		workWeek enum.WorkWeek
+		want     int64
+	}{
+		{"WorkdayFirstHour", time.Hour, enum.OvertimeWorkday, enum.WorkWeekFiveDay, 30_000},
+		{"WorkdayThreeHours", 3 * time.Hour, enum.OvertimeWorkday, enum.WorkWeekFiveDay, 110_000},
+		{"WorkdayNinetyMinutes", 90 * time.Minute, enum.OvertimeWorkday, enum.WorkWeekSixDay, 50_000},
+		{"RestDayFiveDayWeek", 11 * time.Hour, enum.OvertimeRestDay, enum.WorkWeekFiveDay, 540_000},
+		{"RestDayFiveDayWeekNormalHours", 8 * time.Hour, enum.OvertimeRestDay, enum.WorkWeekFiveDay, 320_000},
+		{"HolidaySixDayWeek", 10 * time.Hour, enum.OvertimePublicHoliday, enum.WorkWeekSixDay, 500_000},
+		{"HolidaySixDayWeekEighthHour", 8 * time.Hour, enum.OvertimePublicHoliday, enum.WorkWeekSixDay, 340_000},
+		{"Nothing", 0, enum.OvertimeWorkday, enum.WorkWeekFiveDay, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := overtime_entity.OvertimePay(wage, tt.worked, tt.dayType, tt.workWeek)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("Rounding", func(t *testing.T) {
+		// 5.000.000 / 173 x 1.5 = 43.352,60
+		got, _ := overtime_entity.OvertimePay(5_000_000, time.Hour, enum.OvertimeWorkday, enum.WorkWeekFiveDay)
+		assert.Equal(t, int64(43_353), got)
+	})
+	t.Run("Limits", func(t *testing.T) {
+		_, err := overtime_entity.OvertimePay(wage, 4*time.Hour+time.Minute, enum.OvertimeWorkday, enum.WorkWeekFiveDay)
+		assert.EqualError(t, err, "overtime exceeds the daily limit")
+		_, err = overtime_entity.OvertimePay(wage, 11*time.Hour, enum.OvertimeRestDay, enum.WorkWeekSixDay)
+		assert.EqualError(t, err, "overtime exceeds the daily limit")
+		_, err = overtime_entity.OvertimePay(0, time.Hour, enum.OvertimeWorkday, enum.WorkWeekFiveDay)
+		assert.EqualError(t, err, "monthly wage must be positive")
+
+		assert.Equal(t, 4*time.Hour, overtime_entity.MaxOvertime(enum.OvertimeWorkday, enum.WorkWeekSixDay))
+		assert.Equal(t, 10*time.Hour, overtime_entity.MaxOvertime(enum.OvertimeRestDay, enum.WorkWeekSixDay))
+		assert.Equal(t, 11*time.Hour, overtime_entity.MaxOvertime(enum.OvertimePublicHoliday, enum.WorkWeekFiveDay))
+	})
+}
@@ -0,0 +1,198 @@
+package overtime_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"strings"
+	"time"
+)
+
+// OvertimeRequest represents overtime planned for an employee on one day, its approval and
+// the time actually worked. The day type and work week are fixed when the request is made
+// so the pay does not change when the calendar is edited later.
+type OvertimeRequest struct {
+	id           uuid.UUID
+	employeeID   uuid.UUID
+	dayType      enum.OvertimeDayType
+	workWeek     enum.WorkWeek
+	start        time.Time
+	end          time.Time
+	reason       string
+	status       enum.OvertimeStatus
+	decidedBy    *uuid.UUID
+	decidedAt    *time.Time
+	decisionNote string
+	actual       time.Duration
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// ID returns the unique identifier of the overtime request.
+func (o *OvertimeRequest) ID() uuid.UUID {
+	return o.id
+}
+
+// EmployeeID returns the employee working overtime.
+func (o *OvertimeRequest) EmployeeID() uuid.UUID {
+	return o.employeeID
+}
+
+// DayType returns the kind of day the overtime is worked on.
+func (o *OvertimeRequest) DayType() enum.OvertimeDayType {
+	return o.dayType
+}
+
+// WorkWeek returns the work week arrangement of the employee when the request was made.
+func (o *OvertimeRequest) WorkWeek() enum.WorkWeek {
+	return o.workWeek
+}
+
+// Start returns the planned start of the overtime.
+func (o *OvertimeRequest) Start() time.Time {
+	return o.start
+}
+
+// End returns the planned end of the overtime.
+func (o *OvertimeRequest) End() time.Time {
+	return o.end
+}
+
+// Date returns the calendar date the overtime starts on.
+func (o *OvertimeRequest) Date() time.Time {
+	return time.Date(o.start.Year(), o.start.Month(), o.start.Day(), 0, 0, 0, 0, o.start.Location())
+}
+
+// Planned returns the planned overtime duration.
+func (o *OvertimeRequest) Planned() time.Duration {
+	return o.end.Sub(o.start)
+}
+
+// Reason returns the work to be done during the overtime.
+func (o *OvertimeRequest) Reason() string {
+	return o.reason
+}
+
+// Status returns the current status of the request.
+func (o *OvertimeRequest) Status() enum.OvertimeStatus {
+	return o.status
+}
+
+// DecidedBy returns the approver who approved or rejected the request.
+func (o *OvertimeRequest) DecidedBy() *uuid.UUID {
+	return o.decidedBy
+}
+
+// DecidedAt returns when the request was approved or rejected.
+func (o *OvertimeRequest) DecidedAt() *time.Time {
+	return o.decidedAt
+}
+
+// DecisionNote returns the note recorded with the decision.
+func (o *OvertimeRequest) DecisionNote() string {
+	return o.decisionNote
+}
+
+// Actual returns the overtime actually worked, 0 until the request is completed.
+func (o *OvertimeRequest) Actual() time.Duration {
+	return o.actual
+}
+
+// Payable returns the overtime to be paid: the time actually worked within the approved plan.
+func (o *OvertimeRequest) Payable() time.Duration {
+	if o.status != enum.OvertimeCompleted {
+		return 0
+	}
+	if o.actual > o.Planned() {
+		return o.Planned()
+	}
+	return o.actual
+}
+
+// CreatedAt returns the timestamp when the request was made.
+func (o *OvertimeRequest) CreatedAt() time.Time {
+	return o.createdAt
+}
+
+// UpdatedAt returns the timestamp of the last change to the request.
+func (o *OvertimeRequest) UpdatedAt() time.Time {
+	return o.updatedAt
+}
+
+// IsActive reports whether the request still counts towards the overtime limits.
+func (o *OvertimeRequest) IsActive() bool {
+	return o.status == enum.OvertimePending || o.status == enum.OvertimeApproved || o.status == enum.OvertimeCompleted
+}
+
+// Pay computes the overtime pay of a completed request from the monthly wage.
+func (o *OvertimeRequest) Pay(monthlyWage int64) (int64, error) {
+	if o.status != enum.OvertimeCompleted {
+		return 0, errors.New("overtime is not completed")
+	}
+	return OvertimePay(monthlyWage, o.Payable(), o.dayType, o.workWeek)
+}
+
+// Approve marks a pending request as approved.
+func (o *OvertimeRequest) Approve(approverID uuid.UUID, at time.Time) error {
+	return o.decide(enum.OvertimeApproved, approverID, "", at)
+}
+
+// Reject marks a pending request as rejected with a reason.
+func (o *OvertimeRequest) Reject(approverID uuid.UUID, note string, at time.Time) error {
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return errors.New("rejection note cannot be empty")
+	}
+	return o.decide(enum.OvertimeRejected, approverID, note, at)
+}
+
+// Cancel withdraws a pending or approved request that has not been completed.
+func (o *OvertimeRequest) Cancel(at time.Time) error {
+	if o.status != enum.OvertimePending && o.status != enum.OvertimeApproved {
+		return errors.New("overtime request is not cancellable")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+	o.status = enum.OvertimeCancelled
+	o.updatedAt = at
+	return nil
+}
+
+// Complete records the overtime actually worked on an approved request.
+func (o *OvertimeRequest) Complete(actual time.Duration, at time.Time) error {
+	if o.status != enum.OvertimeApproved {
+		return errors.New("overtime request is not approved")
+	}
+	if actual < 0 {
+		return errors.New("overtime cannot be negative")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+	o.actual = actual.Truncate(time.Minute)
+	o.status = enum.OvertimeCompleted
+	o.updatedAt = at
+	return nil
+}
+
+func (o *OvertimeRequest) decide(status enum.OvertimeStatus, approverID uuid.UUID, note string, at time.Time) error {
+	if o.status != enum.OvertimePending {
+		return errors.New("overtime request is not pending")
+	}
+	if approverID == uuid.Nil {
+		return errors.New("approver cannot be empty")
+	}
+	if approverID == o.employeeID {
+		return errors.New("employee cannot decide on their own overtime request")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+	o.status = status
+	o.decidedBy = &approverID
+	o.decidedAt = &at
+	o.decisionNote = note
+	o.updatedAt = at
+	return nil
+}
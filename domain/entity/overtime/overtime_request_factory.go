@@ -0,0 +1,77 @@
+package overtime_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"strings"
+	"time"
+)
+
+// OvertimeRequestFactory is a factory type for creating OvertimeRequest entities.
+type OvertimeRequestFactory struct {
+	ID         string
+	EmployeeID string
+	DayType    string
+	WorkWeek   string
+	Start      time.Time
+	End        time.Time
+	Reason     string
+	CreatedAt  time.Time
+}
+
+// Create validates the factory data and returns a new pending OvertimeRequest.
+// The planned duration may not exceed the daily maximum of the day type.
+func (f OvertimeRequestFactory) Create() (*OvertimeRequest, error) {
+	id, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	employeeID, err := uuid.Parse(f.EmployeeID)
+	if err != nil {
+		return nil, errors.New("invalid employee id")
+	}
+
+	dayType, err := enum.ParseOvertimeDayType(f.DayType)
+	if err != nil {
+		return nil, err
+	}
+
+	workWeek, err := enum.ParseWorkWeek(f.WorkWeek)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.Start.IsZero() || f.End.IsZero() {
+		return nil, errors.New("overtime start and end cannot be empty")
+	}
+	if !f.End.After(f.Start) {
+		return nil, errors.New("overtime end must be after start")
+	}
+	if f.End.Sub(f.Start) > MaxOvertime(dayType, workWeek) {
+		return nil, errors.New("overtime exceeds the daily limit")
+	}
+
+	reason := strings.TrimSpace(f.Reason)
+	if reason == "" {
+		return nil, errors.New("overtime reason cannot be empty")
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	return &OvertimeRequest{
+		id:         id,
+		employeeID: employeeID,
+		dayType:    dayType,
+		workWeek:   workWeek,
+		start:      f.Start,
+		end:        f.End,
+		reason:     reason,
+		status:     enum.OvertimePending,
+		createdAt:  f.CreatedAt,
+		updatedAt:  f.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package overtime_entity_test
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	overtime_entity "github.com/rfanazhari/hris/domain/entity/overtime"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func newOvertimeRequest(t *testing.T, start time.Time, planned time.Duration) *overtime_entity.OvertimeRequest {
+	req, err := overtime_entity.OvertimeRequestFactory{
+		ID:         uuid.NewString(),
+		EmployeeID: uuid.NewString(),
+		DayType:    "workday",
+		WorkWeek:   "five_day",
+		Start:      start,
+		End:        start.Add(planned),
+		Reason:     "month-end closing",
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return req
+}
+
+func TestOvertimeRequestFactory_Create(t *testing.T) {
+	start := time.Date(2025, 3, 3, 17, 0, 0, 0, time.UTC)
+	valid := overtime_entity.OvertimeRequestFactory{
+		ID:         uuid.NewString(),
+		EmployeeID: uuid.NewString(),
+		DayType:    "rest_day",
+		WorkWeek:   "six_day",
+		Start:      start,
+		End:        start.Add(10 * time.Hour),
+		Reason:     "stock opname",
+	}
+
+	t.Run("ValidInput", func(t *testing.T) {
+		req, err := valid.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, enum.OvertimeRestDay, req.DayType())
+		assert.Equal(t, enum.WorkWeekSixDay, req.WorkWeek())
+		assert.Equal(t, enum.OvertimePending, req.Status())
+		assert.Equal(t, 10*time.Hour, req.Planned())
+		assert.Equal(t, time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), req.Date())
+		assert.True(t, req.IsActive())
+	})
+	t.Run("InvalidDayType", func(t *testing.T) {
+		f := valid
+		f.DayType = "weekend"
+
+		_, err := f.Create()
+		assert.EqualError(t, err, fmt.Errorf("invalid OvertimeDayType: %q", "weekend").Error())
+	})
+	t.Run("EndBeforeStart", func(t *testing.T) {
+		f := valid
+		f.End = start
+
+		_, err := f.Create()
+		assert.EqualError(t, err, "overtime end must be after start")
+	})
+	t.Run("ExceedsDailyLimit", func(t *testing.T) {
+		f := valid
+		f.DayType = "workday"
+		f.End = start.Add(5 * time.Hour)
+
+		_, err := f.Create()
+		assert.EqualError(t, err, "overtime exceeds the daily limit")
+	})
+	t.Run("EmptyReason", func(t *testing.T) {
+		f := valid
+		f.Reason = " "
+
+		_, err := f.Create()
+		assert.EqualError(t, err, "overtime reason cannot be empty")
+	})
+}
+
+func TestOvertimeRequest_Lifecycle(t *testing.T) {
+	start := time.Date(2025, 3, 3, 17, 0, 0, 0, time.UTC)
+	approver := uuid.New()
+
+	t.Run("ApproveCompleteAndPay", func(t *testing.T) {
+		req := newOvertimeRequest(t, start, 2*time.Hour)
+		_, err := req.Pay(3_460_000)
+		assert.EqualError(t, err, "overtime is not completed")
+		assert.EqualError(t, req.Complete(time.Hour, start), "overtime request is not approved")
+
+		assert.Nil(t, req.Approve(approver, start))
+		assert.Nil(t, req.Complete(3*time.Hour+30*time.Second, start.Add(3*time.Hour)))
+		assert.Equal(t, enum.OvertimeCompleted, req.Status())
+		assert.Equal(t, 3*time.Hour, req.Actual())
+		assert.Equal(t, 2*time.Hour, req.Payable())
+
+		pay, err := req.Pay(3_460_000)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(70_000), pay)
+		assert.EqualError(t, req.Cancel(start), "overtime request is not cancellable")
+	})
+	t.Run("RejectAndCancel", func(t *testing.T) {
+		req := newOvertimeRequest(t, start, time.Hour)
+		assert.EqualError(t, req.Approve(req.EmployeeID(), start), "employee cannot decide on their own overtime request")
+		assert.EqualError(t, req.Reject(approver, "", start), "rejection note cannot be empty")
+		assert.Nil(t, req.Reject(approver, "not budgeted", start))
+		assert.False(t, req.IsActive())
+
+		other := newOvertimeRequest(t, start, time.Hour)
+		assert.Nil(t, other.Cancel(start))
+		assert.Equal(t, enum.OvertimeCancelled, other.Status())
+		assert.EqualError(t, other.Approve(approver, start), "overtime request is not pending")
+	})
+}
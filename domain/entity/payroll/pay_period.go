@@ -0,0 +1,71 @@
+package payroll_entity
+
+import (
+	"errors"
+	"time"
+)
+
+// PayPeriod is the inclusive range of calendar dates a payroll run pays for.
+type PayPeriod struct {
+	start time.Time
+	end   time.Time
+}
+
+// NewPayPeriod constructs a PayPeriod from its first and last date.
+func NewPayPeriod(start, end time.Time) (*PayPeriod, error) {
+	if start.IsZero() || end.IsZero() {
+		return nil, errors.New("pay period dates cannot be empty")
+	}
+	start, end = dateOf(start), dateOf(end)
+	if end.Before(start) {
+		return nil, errors.New("pay period end cannot be before start")
+	}
+	return &PayPeriod{start: start, end: end}, nil
+}
+
+// MonthlyPayPeriod returns the pay period covering the whole calendar month.
+func MonthlyPayPeriod(year int, month time.Month) PayPeriod {
+	start := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return PayPeriod{start: start, end: start.AddDate(0, 1, -1)}
+}
+
+// Start returns the first date of the period at midnight UTC.
+func (p PayPeriod) Start() time.Time {
+	return p.start
+}
+
+// End returns the last date of the period at midnight UTC.
+func (p PayPeriod) End() time.Time {
+	return p.end
+}
+
+// Year returns the year the period ends in, which is the tax year it belongs to.
+func (p PayPeriod) Year() int {
+	return p.end.Year()
+}
+
+// Month returns the month the period ends in.
+func (p PayPeriod) Month() time.Month {
+	return p.end.Month()
+}
+
+// Days returns the number of calendar days in the period.
+func (p PayPeriod) Days() int {
+	return int(p.end.Sub(p.start).Hours()/24) + 1
+}
+
+// Contains reports whether the calendar date of t falls within the period.
+func (p PayPeriod) Contains(t time.Time) bool {
+	d := dateOf(t)
+	return !d.Before(p.start) && !d.After(p.end)
+}
+
+// IsZero reports whether the period is unset.
+func (p PayPeriod) IsZero() bool {
+	return p.start.IsZero()
+}
+
+// dateOf normalizes t to midnight UTC of its calendar day.
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
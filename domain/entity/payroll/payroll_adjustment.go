@@ -0,0 +1,49 @@
+package payroll_entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// PayrollAdjustment is a one-off earning or deduction entered for an employee, such as
+// an incentive, a correction of last month's pay or a deduction for damaged equipment.
+// It is added to the payslip of the pay period containing its date.
+type PayrollAdjustment struct {
+	id         uuid.UUID
+	employeeID uuid.UUID
+	date       time.Time
+	line       PayslipLine
+	createdAt  time.Time
+}
+
+// ID returns the unique identifier of the adjustment.
+func (a *PayrollAdjustment) ID() uuid.UUID {
+	return a.id
+}
+
+// EmployeeID returns the employee the adjustment applies to.
+func (a *PayrollAdjustment) EmployeeID() uuid.UUID {
+	return a.employeeID
+}
+
+// Date returns the date deciding the pay period of the adjustment.
+func (a *PayrollAdjustment) Date() time.Time {
+	return a.date
+}
+
+// Line returns the payslip line of the adjustment.
+func (a *PayrollAdjustment) Line() PayslipLine {
+	return a.line
+}
+
+// CreatedAt returns the timestamp when the adjustment was entered.
+func (a *PayrollAdjustment) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+// kindAllowed reports whether adjustments may use the line kind; employer contributions
+// are computed by the payroll run, not entered by hand.
+func kindAllowed(kind enum.PayLineKind) bool {
+	return kind == enum.PayLineEarning || kind == enum.PayLineDeduction
+}
@@ -0,0 +1,69 @@
+package payroll_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// PayrollAdjustmentFactory is a factory type for creating PayrollAdjustment entities.
+type PayrollAdjustmentFactory struct {
+	ID         string
+	EmployeeID string
+	Date       time.Time
+	Code       string
+	Name       string
+	Kind       string
+	Amount     int64
+	Taxable    bool
+	CreatedAt  time.Time
+}
+
+// Create validates the factory data and returns a new PayrollAdjustment.
+func (f PayrollAdjustmentFactory) Create() (*PayrollAdjustment, error) {
+	id, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	employeeID, err := uuid.Parse(f.EmployeeID)
+	if err != nil {
+		return nil, errors.New("invalid employee id")
+	}
+
+	if f.Date.IsZero() {
+		return nil, errors.New("adjustment date cannot be empty")
+	}
+
+	kind, err := enum.ParsePayLineKind(f.Kind)
+	if err != nil {
+		return nil, err
+	}
+	if !kindAllowed(kind) {
+		return nil, errors.New("adjustment must be an earning or a deduction")
+	}
+	if f.Amount <= 0 {
+		return nil, errors.New("adjustment amount must be positive")
+	}
+
+	line, err := NewPayslipLine(f.Code, f.Name, kind, f.Amount, f.Taxable)
+	if err != nil {
+		return nil, err
+	}
+	if reservedCodes[line.code] {
+		return nil, errors.New("line code is reserved")
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	return &PayrollAdjustment{
+		id:         id,
+		employeeID: employeeID,
+		date:       dateOf(f.Date),
+		line:       *line,
+		createdAt:  f.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,182 @@
+package payroll_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// PayrollRun is the aggregate that pays every employee for one pay period. A draft or
+// calculated run may be (re)calculated, replacing its payslips; once approved the run
+// is locked and can only be marked as paid.
+type PayrollRun struct {
+	id           uuid.UUID
+	period       PayPeriod
+	currency     string
+	status       enum.PayrollRunStatus
+	payslips     []Payslip
+	calculatedAt *time.Time
+	approvedBy   *uuid.UUID
+	approvedAt   *time.Time
+	paidAt       *time.Time
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// ID returns the unique identifier of the run.
+func (r *PayrollRun) ID() uuid.UUID {
+	return r.id
+}
+
+// Period returns the pay period of the run.
+func (r *PayrollRun) Period() PayPeriod {
+	return r.period
+}
+
+// Currency returns the ISO 4217 currency the run pays in.
+func (r *PayrollRun) Currency() string {
+	return r.currency
+}
+
+// Status returns the current status of the run.
+func (r *PayrollRun) Status() enum.PayrollRunStatus {
+	return r.status
+}
+
+// IsLocked reports whether the payslips can no longer change.
+func (r *PayrollRun) IsLocked() bool {
+	return r.status == enum.PayrollApproved || r.status == enum.PayrollPaid
+}
+
+// Payslips returns a copy of the payslips of the run.
+func (r *PayrollRun) Payslips() []Payslip {
+	out := make([]Payslip, len(r.payslips))
+	copy(out, r.payslips)
+	return out
+}
+
+// Payslip returns the payslip of the employee, if the run has one.
+func (r *PayrollRun) Payslip(employeeID uuid.UUID) (Payslip, bool) {
+	for _, p := range r.payslips {
+		if p.employeeID == employeeID {
+			return p, true
+		}
+	}
+	return Payslip{}, false
+}
+
+// TotalGrossPay returns the gross pay of all payslips.
+func (r *PayrollRun) TotalGrossPay() int64 {
+	var total int64
+	for _, p := range r.payslips {
+		total += p.GrossPay()
+	}
+	return total
+}
+
+// TotalNetPay returns the take-home pay of all payslips, i.e. the amount to transfer.
+func (r *PayrollRun) TotalNetPay() int64 {
+	var total int64
+	for _, p := range r.payslips {
+		total += p.NetPay()
+	}
+	return total
+}
+
+// CalculatedAt returns when the payslips were last calculated.
+func (r *PayrollRun) CalculatedAt() *time.Time {
+	return r.calculatedAt
+}
+
+// ApprovedBy returns who approved the run.
+func (r *PayrollRun) ApprovedBy() *uuid.UUID {
+	return r.approvedBy
+}
+
+// ApprovedAt returns when the run was approved.
+func (r *PayrollRun) ApprovedAt() *time.Time {
+	return r.approvedAt
+}
+
+// PaidAt returns when the run was marked as paid.
+func (r *PayrollRun) PaidAt() *time.Time {
+	return r.paidAt
+}
+
+// CreatedAt returns the timestamp when the run was created.
+func (r *PayrollRun) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// UpdatedAt returns the timestamp of the last change to the run.
+func (r *PayrollRun) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// RecordCalculation replaces the payslips of a draft or calculated run with the result of
+// a new calculation. Every payslip must belong to the run and pay a different employee.
+func (r *PayrollRun) RecordCalculation(payslips []Payslip, at time.Time) error {
+	if r.IsLocked() {
+		return errors.New("payroll run is locked")
+	}
+	seen := make(map[uuid.UUID]struct{}, len(payslips))
+	for _, p := range payslips {
+		if p.runID != r.id {
+			return errors.New("payslip belongs to another payroll run")
+		}
+		if p.currency != r.currency {
+			return fmt.Errorf("payslip currency %s does not match payroll currency %s", p.currency, r.currency)
+		}
+		if _, ok := seen[p.employeeID]; ok {
+			return errors.New("duplicate payslip for employee")
+		}
+		seen[p.employeeID] = struct{}{}
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	r.payslips = make([]Payslip, len(payslips))
+	copy(r.payslips, payslips)
+	r.status = enum.PayrollCalculated
+	r.calculatedAt = &at
+	r.updatedAt = at
+	return nil
+}
+
+// Approve locks a calculated run.
+func (r *PayrollRun) Approve(approverID uuid.UUID, at time.Time) error {
+	if r.status != enum.PayrollCalculated {
+		return errors.New("payroll run is not calculated")
+	}
+	if len(r.payslips) == 0 {
+		return errors.New("payroll run has no payslips")
+	}
+	if approverID == uuid.Nil {
+		return errors.New("approver cannot be empty")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+	r.status = enum.PayrollApproved
+	r.approvedBy = &approverID
+	r.approvedAt = &at
+	r.updatedAt = at
+	return nil
+}
+
+// MarkPaid records that the salaries of an approved run were transferred.
+func (r *PayrollRun) MarkPaid(at time.Time) error {
+	if r.status != enum.PayrollApproved {
+		return errors.New("payroll run is not approved")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+	r.status = enum.PayrollPaid
+	r.paidAt = &at
+	r.updatedAt = at
+	return nil
+}
@@ -0,0 +1,54 @@
+package payroll_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"strings"
+	"time"
+)
+
+// PayrollRunFactory is a factory type for creating PayrollRun aggregates.
+type PayrollRunFactory struct {
+	ID          string
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Currency    string
+	CreatedAt   time.Time
+}
+
+// Create validates the factory data and returns a new draft PayrollRun.
+// The currency defaults to IDR.
+func (f PayrollRunFactory) Create() (*PayrollRun, error) {
+	id, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	period, err := NewPayPeriod(f.PeriodStart, f.PeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(f.Currency) == "" {
+		f.Currency = "IDR"
+	}
+	currency, err := valueobject.ParseCurrency(f.Currency)
+	if err != nil {
+		return nil, errors.New("invalid currency code")
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	return &PayrollRun{
+		id:        id,
+		period:    *period,
+		currency:  currency.Code(),
+		status:    enum.PayrollDraft,
+		createdAt: f.CreatedAt,
+		updatedAt: f.CreatedAt,
+	}, nil
+}
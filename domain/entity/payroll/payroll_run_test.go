@@ -0,0 +1,88 @@
+package payroll_entity_test
+
+import (
+	"github.com/google/uuid"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func newRun(t *testing.T) *payroll_entity.PayrollRun {
+	run, err := payroll_entity.PayrollRunFactory{
+		ID:          uuid.NewString(),
+		PeriodStart: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:   time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC),
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return run
+}
+
+func newPayslip(t *testing.T, run *payroll_entity.PayrollRun, employeeID uuid.UUID, basic int64) payroll_entity.Payslip {
+	draft, _ := payroll_entity.NewPayslipDraft(employeeID, run.Period(), run.Currency(), basic, 30, 30)
+	_ = draft.AddLine(line(t, "BASIC", enum.PayLineEarning, basic, true))
+	slip, err := draft.Finalize(uuid.New(), run.ID(), time.Time{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return *slip
+}
+
+func TestPayrollRunFactory_Create(t *testing.T) {
+	t.Run("ValidInput", func(t *testing.T) {
+		run := newRun(t)
+
+		assert.Equal(t, enum.PayrollDraft, run.Status())
+		assert.Equal(t, "IDR", run.Currency())
+		assert.Equal(t, 30, run.Period().Days())
+		assert.False(t, run.IsLocked())
+	})
+	t.Run("InvalidID", func(t *testing.T) {
+		_, err := payroll_entity.PayrollRunFactory{ID: "uuid"}.Create()
+		assert.EqualError(t, err, "invalid format uuid")
+	})
+	t.Run("InvalidPeriod", func(t *testing.T) {
+		_, err := payroll_entity.PayrollRunFactory{ID: uuid.NewString()}.Create()
+		assert.EqualError(t, err, "pay period dates cannot be empty")
+	})
+}
+
+func TestPayrollRun_Lifecycle(t *testing.T) {
+	at := time.Date(2025, 4, 25, 10, 0, 0, 0, time.UTC)
+	approver := uuid.New()
+
+	t.Run("RerunApproveAndPay", func(t *testing.T) {
+		run := newRun(t)
+		employeeID := uuid.New()
+		assert.EqualError(t, run.Approve(approver, at), "payroll run is not calculated")
+
+		assert.Nil(t, run.RecordCalculation([]payroll_entity.Payslip{newPayslip(t, run, employeeID, 5_000_000)}, at))
+		assert.Equal(t, enum.PayrollCalculated, run.Status())
+		assert.Nil(t, run.RecordCalculation([]payroll_entity.Payslip{newPayslip(t, run, employeeID, 5_500_000), newPayslip(t, run, uuid.New(), 4_000_000)}, at.Add(time.Hour)))
+		assert.Equal(t, int64(9_500_000), run.TotalNetPay())
+		assert.Equal(t, at.Add(time.Hour), *run.CalculatedAt())
+		slip, ok := run.Payslip(employeeID)
+		assert.True(t, ok)
+		assert.Equal(t, int64(5_500_000), slip.GrossPay())
+
+		assert.EqualError(t, run.MarkPaid(at), "payroll run is not approved")
+		assert.Nil(t, run.Approve(approver, at))
+		assert.True(t, run.IsLocked())
+		assert.EqualError(t, run.RecordCalculation(nil, at), "payroll run is locked")
+		assert.Nil(t, run.MarkPaid(at))
+		assert.Equal(t, enum.PayrollPaid, run.Status())
+	})
+	t.Run("InvalidPayslips", func(t *testing.T) {
+		run := newRun(t)
+		other := newRun(t)
+		employeeID := uuid.New()
+
+		assert.EqualError(t, run.RecordCalculation([]payroll_entity.Payslip{newPayslip(t, other, employeeID, 1)}, at), "payslip belongs to another payroll run")
+		assert.EqualError(t, run.RecordCalculation([]payroll_entity.Payslip{newPayslip(t, run, employeeID, 1), newPayslip(t, run, employeeID, 2)}, at), "duplicate payslip for employee")
+		assert.Nil(t, run.RecordCalculation(nil, at))
+		assert.EqualError(t, run.Approve(approver, at), "payroll run has no payslips")
+	})
+}
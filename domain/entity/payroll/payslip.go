@@ -0,0 +1,144 @@
+package payroll_entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// Payslip is the immutable result of a payroll run for one employee. It is produced
+// by PayslipDraft.Finalize and cannot be changed afterwards; a re-run replaces it.
+type Payslip struct {
+	id         uuid.UUID
+	runID      uuid.UUID
+	employeeID uuid.UUID
+	period     PayPeriod
+	currency   string
+	workedDays int
+	periodDays int
+	lines      []PayslipLine
+	createdAt  time.Time
+}
+
+// ID returns the unique identifier of the payslip.
+func (p Payslip) ID() uuid.UUID {
+	return p.id
+}
+
+// RunID returns the payroll run the payslip belongs to.
+func (p Payslip) RunID() uuid.UUID {
+	return p.runID
+}
+
+// EmployeeID returns the employee being paid.
+func (p Payslip) EmployeeID() uuid.UUID {
+	return p.employeeID
+}
+
+// Period returns the pay period.
+func (p Payslip) Period() PayPeriod {
+	return p.period
+}
+
+// Currency returns the ISO 4217 currency code of all amounts.
+func (p Payslip) Currency() string {
+	return p.currency
+}
+
+// WorkedDays returns the days the employee was employed in the period, as used for proration.
+func (p Payslip) WorkedDays() int {
+	return p.workedDays
+}
+
+// PeriodDays returns the days of the period used for proration.
+func (p Payslip) PeriodDays() int {
+	return p.periodDays
+}
+
+// IsProrated reports whether the employee was employed for only part of the period.
+func (p Payslip) IsProrated() bool {
+	return p.workedDays < p.periodDays
+}
+
+// Lines returns a copy of the payslip lines in calculation order.
+func (p Payslip) Lines() []PayslipLine {
+	out := make([]PayslipLine, len(p.lines))
+	copy(out, p.lines)
+	return out
+}
+
+// Line returns the first line with the given code, if any.
+func (p Payslip) Line(code string) (PayslipLine, bool) {
+	for _, l := range p.lines {
+		if l.code == code {
+			return l, true
+		}
+	}
+	return PayslipLine{}, false
+}
+
+// Earnings returns the earning lines.
+func (p Payslip) Earnings() []PayslipLine {
+	return linesOf(p.lines, enum.PayLineEarning)
+}
+
+// Deductions returns the deduction lines.
+func (p Payslip) Deductions() []PayslipLine {
+	return linesOf(p.lines, enum.PayLineDeduction)
+}
+
+// EmployerContributions returns the employer contribution lines.
+func (p Payslip) EmployerContributions() []PayslipLine {
+	return linesOf(p.lines, enum.PayLineEmployerContribution)
+}
+
+// GrossPay returns the sum of all earnings.
+func (p Payslip) GrossPay() int64 {
+	return sumOf(p.lines, enum.PayLineEarning)
+}
+
+// TaxableGross returns the taxable earnings and employer contributions, the gross income for PPh 21.
+func (p Payslip) TaxableGross() int64 {
+	var total int64
+	for _, l := range p.lines {
+		if l.taxable && l.kind != enum.PayLineDeduction {
+			total += l.amount
+		}
+	}
+	return total
+}
+
+// TotalDeductions returns the sum of all deductions.
+func (p Payslip) TotalDeductions() int64 {
+	return sumOf(p.lines, enum.PayLineDeduction)
+}
+
+// NetPay returns the take-home pay: gross pay minus deductions.
+func (p Payslip) NetPay() int64 {
+	return p.GrossPay() - p.TotalDeductions()
+}
+
+// CreatedAt returns the timestamp when the payslip was calculated.
+func (p Payslip) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func linesOf(lines []PayslipLine, kind enum.PayLineKind) []PayslipLine {
+	var out []PayslipLine
+	for _, l := range lines {
+		if l.kind == kind {
+			out = append(out, l)
+		}
+	}
+	return out
+}
+
+func sumOf(lines []PayslipLine, kind enum.PayLineKind) int64 {
+	var total int64
+	for _, l := range lines {
+		if l.kind == kind {
+			total += l.amount
+		}
+	}
+	return total
+}
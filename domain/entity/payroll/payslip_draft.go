@@ -0,0 +1,159 @@
+package payroll_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"strings"
+	"time"
+)
+
+// PayslipDraft collects the lines of one employee's payslip while a payroll run is being
+// calculated. Pay components add lines in order and may read what earlier components
+// added (e.g. tax reads the taxable gross); Finalize turns the draft into a Payslip.
+type PayslipDraft struct {
+	employeeID uuid.UUID
+	period     PayPeriod
+	currency   string
+	monthlyPay int64
+	workedDays int
+	periodDays int
+	lines      []PayslipLine
+}
+
+// NewPayslipDraft starts a payslip for the employee. monthlyPay is the full monthly wage
+// and workedDays/periodDays the proration fraction for partial periods.
+func NewPayslipDraft(employeeID uuid.UUID, period PayPeriod, currency string, monthlyPay int64, workedDays, periodDays int) (*PayslipDraft, error) {
+	if employeeID == uuid.Nil {
+		return nil, errors.New("invalid employee id")
+	}
+	if period.IsZero() {
+		return nil, errors.New("pay period cannot be empty")
+	}
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if len(currency) != 3 {
+		return nil, errors.New("invalid currency code")
+	}
+	if monthlyPay < 0 {
+		return nil, errors.New("monthly pay cannot be negative")
+	}
+	if periodDays <= 0 || workedDays < 0 || workedDays > periodDays {
+		return nil, errors.New("invalid proration days")
+	}
+	return &PayslipDraft{
+		employeeID: employeeID,
+		period:     period,
+		currency:   currency,
+		monthlyPay: monthlyPay,
+		workedDays: workedDays,
+		periodDays: periodDays,
+	}, nil
+}
+
+// EmployeeID returns the employee being paid.
+func (d *PayslipDraft) EmployeeID() uuid.UUID {
+	return d.employeeID
+}
+
+// Period returns the pay period.
+func (d *PayslipDraft) Period() PayPeriod {
+	return d.period
+}
+
+// Currency returns the currency of the payslip.
+func (d *PayslipDraft) Currency() string {
+	return d.currency
+}
+
+// MonthlyPay returns the full monthly wage before proration.
+func (d *PayslipDraft) MonthlyPay() int64 {
+	return d.monthlyPay
+}
+
+// WorkedDays returns the days the employee was employed in the period.
+func (d *PayslipDraft) WorkedDays() int {
+	return d.workedDays
+}
+
+// PeriodDays returns the days of the period.
+func (d *PayslipDraft) PeriodDays() int {
+	return d.periodDays
+}
+
+// Prorate scales a monthly amount to the days worked, rounded to the nearest unit.
+func (d *PayslipDraft) Prorate(amount int64) int64 {
+	if d.workedDays == d.periodDays {
+		return amount
+	}
+	days := int64(d.periodDays)
+	return (amount*int64(d.workedDays) + days/2) / days
+}
+
+// Lines returns a copy of the lines added so far.
+func (d *PayslipDraft) Lines() []PayslipLine {
+	out := make([]PayslipLine, len(d.lines))
+	copy(out, d.lines)
+	return out
+}
+
+// AddLine appends a line. Zero amounts are skipped so payslips only show what applies.
+func (d *PayslipDraft) AddLine(line PayslipLine) error {
+	if line.code == "" || !line.kind.Valid() {
+		return errors.New("invalid payslip line")
+	}
+	if line.amount == 0 {
+		return nil
+	}
+	d.lines = append(d.lines, line)
+	return nil
+}
+
+// GrossPay returns the sum of the earnings added so far.
+func (d *PayslipDraft) GrossPay() int64 {
+	return sumOf(d.lines, enum.PayLineEarning)
+}
+
+// TaxableGross returns the taxable earnings and employer contributions added so far.
+func (d *PayslipDraft) TaxableGross() int64 {
+	var total int64
+	for _, l := range d.lines {
+		if l.taxable && l.kind != enum.PayLineDeduction {
+			total += l.amount
+		}
+	}
+	return total
+}
+
+// TotalDeductions returns the sum of the deductions added so far.
+func (d *PayslipDraft) TotalDeductions() int64 {
+	return sumOf(d.lines, enum.PayLineDeduction)
+}
+
+// NetPay returns gross pay minus deductions added so far.
+func (d *PayslipDraft) NetPay() int64 {
+	return d.GrossPay() - d.TotalDeductions()
+}
+
+// Finalize produces the immutable payslip for the run. Deductions may not exceed gross pay.
+func (d *PayslipDraft) Finalize(id, runID uuid.UUID, at time.Time) (*Payslip, error) {
+	if id == uuid.Nil || runID == uuid.Nil {
+		return nil, errors.New("invalid format uuid")
+	}
+	if d.NetPay() < 0 {
+		return nil, errors.New("net pay cannot be negative")
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+	return &Payslip{
+		id:         id,
+		runID:      runID,
+		employeeID: d.employeeID,
+		period:     d.period,
+		currency:   d.currency,
+		workedDays: d.workedDays,
+		periodDays: d.periodDays,
+		lines:      d.Lines(),
+		createdAt:  at,
+	}, nil
+}
@@ -0,0 +1,135 @@
+package payroll_entity_test
+
+import (
+	"github.com/google/uuid"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func line(t *testing.T, code string, kind enum.PayLineKind, amount int64, taxable bool) payroll_entity.PayslipLine {
+	l, err := payroll_entity.NewPayslipLine(code, code, kind, amount, taxable)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return *l
+}
+
+func TestPayPeriod(t *testing.T) {
+	period := payroll_entity.MonthlyPayPeriod(2024, time.February)
+
+	assert.Equal(t, 29, period.Days())
+	assert.Equal(t, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), period.End())
+	assert.True(t, period.Contains(time.Date(2024, 2, 29, 23, 0, 0, 0, time.UTC)))
+	assert.False(t, period.Contains(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)))
+
+	_, err := payroll_entity.NewPayPeriod(period.End(), period.Start())
+	assert.EqualError(t, err, "pay period end cannot be before start")
+}
+
+func TestPayslipLine(t *testing.T) {
+	l, err := payroll_entity.NewPayslipLine(" basic ", "Gaji Pokok", enum.PayLineEarning, 5_000_000, true)
+	assert.Nil(t, err)
+	assert.Equal(t, "BASIC", l.Code())
+
+	_, err = payroll_entity.NewPayslipLine("LOAN", "Pinjaman", enum.PayLineDeduction, -1, false)
+	assert.EqualError(t, err, "line amount cannot be negative")
+	_, err = payroll_entity.NewPayslipLine("LOAN", "Pinjaman", enum.PayLineDeduction, 1, true)
+	assert.EqualError(t, err, "deduction cannot be taxable")
+	_, err = payroll_entity.NewPayslipLine("", "Pinjaman", enum.PayLineDeduction, 1, false)
+	assert.EqualError(t, err, "line code cannot be empty")
+}
+
+func TestPayslipDraft(t *testing.T) {
+	period := payroll_entity.MonthlyPayPeriod(2025, time.April)
+
+	t.Run("TotalsAndFinalize", func(t *testing.T) {
+		draft, err := payroll_entity.NewPayslipDraft(uuid.New(), period, "idr", 9_000_000, 20, 30)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(6_000_000), draft.Prorate(9_000_000))
+
+		assert.Nil(t, draft.AddLine(line(t, "BASIC", enum.PayLineEarning, draft.Prorate(draft.MonthlyPay()), true)))
+		assert.Nil(t, draft.AddLine(line(t, "REIMBURSE", enum.PayLineEarning, 250_000, false)))
+		assert.Nil(t, draft.AddLine(line(t, "BPJS_JKK", enum.PayLineEmployerContribution, 14_400, true)))
+		assert.Nil(t, draft.AddLine(line(t, "LOAN", enum.PayLineDeduction, 500_000, false)))
+		assert.Nil(t, draft.AddLine(line(t, "ZERO", enum.PayLineDeduction, 0, false)))
+		assert.Len(t, draft.Lines(), 4)
+		assert.Equal(t, int64(6_250_000), draft.GrossPay())
+		assert.Equal(t, int64(6_014_400), draft.TaxableGross())
+
+		slip, err := draft.Finalize(uuid.New(), uuid.New(), time.Time{})
+		assert.Nil(t, err)
+		assert.Equal(t, "IDR", slip.Currency())
+		assert.True(t, slip.IsProrated())
+		assert.Equal(t, int64(5_750_000), slip.NetPay())
+		assert.Equal(t, int64(6_014_400), slip.TaxableGross())
+		assert.Equal(t, int64(500_000), slip.TotalDeductions())
+		assert.Len(t, slip.Earnings(), 2)
+		assert.Len(t, slip.EmployerContributions(), 1)
+		basic, ok := slip.Line("BASIC")
+		assert.True(t, ok)
+		assert.Equal(t, int64(6_000_000), basic.Amount())
+	})
+	t.Run("NegativeNetPay", func(t *testing.T) {
+		draft, _ := payroll_entity.NewPayslipDraft(uuid.New(), period, "IDR", 1_000_000, 30, 30)
+		_ = draft.AddLine(line(t, "BASIC", enum.PayLineEarning, 1_000_000, true))
+		_ = draft.AddLine(line(t, "LOAN", enum.PayLineDeduction, 1_000_001, false))
+
+		_, err := draft.Finalize(uuid.New(), uuid.New(), time.Time{})
+		assert.EqualError(t, err, "net pay cannot be negative")
+	})
+	t.Run("InvalidDraft", func(t *testing.T) {
+		_, err := payroll_entity.NewPayslipDraft(uuid.New(), period, "IDR", 1_000_000, 31, 30)
+		assert.EqualError(t, err, "invalid proration days")
+		_, err = payroll_entity.NewPayslipDraft(uuid.Nil, period, "IDR", 1_000_000, 30, 30)
+		assert.EqualError(t, err, "invalid employee id")
+	})
+}
+
+func TestPayrollAdjustmentFactory_Create(t *testing.T) {
+	valid := payroll_entity.PayrollAdjustmentFactory{
+		ID:         uuid.NewString(),
+		EmployeeID: uuid.NewString(),
+		Date:       time.Date(2025, 4, 10, 15, 0, 0, 0, time.UTC),
+		Code:       "incentive",
+		Name:       "Insentif Penjualan",
+		Kind:       "earning",
+		Amount:     750_000,
+		Taxable:    true,
+	}
+
+	t.Run("ValidInput", func(t *testing.T) {
+		adj, err := valid.Create()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "INCENTIVE", adj.Line().Code())
+		assert.Equal(t, time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC), adj.Date())
+		assert.True(t, adj.Line().Taxable())
+	})
+	t.Run("EmployerContribution", func(t *testing.T) {
+		f := valid
+		f.Kind = "employer_contribution"
+
+		_, err := f.Create()
+		assert.EqualError(t, err, "adjustment must be an earning or a deduction")
+	})
+	t.Run("ReservedCode", func(t *testing.T) {
+		f := valid
+		f.Code = "basic"
+
+		_, err := f.Create()
+		assert.EqualError(t, err, "line code is reserved")
+		f.Code = "pph21"
+		_, err = f.Create()
+		assert.EqualError(t, err, "line code is reserved")
+	})
+	t.Run("NonPositiveAmount", func(t *testing.T) {
+		f := valid
+		f.Amount = 0
+
+		_, err := f.Create()
+		assert.EqualError(t, err, "adjustment amount must be positive")
+	})
+}
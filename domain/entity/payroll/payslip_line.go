@@ -0,0 +1,122 @@
+package payroll_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rfanazhari/hris/domain/enum"
+	"strings"
+)
+
+// Code constants of the payslip lines produced by the payroll run itself.
+const (
+	LineBasic           = "BASIC"
+	LineOvertime        = "OVERTIME"
+	LineIncomeTax       = "PPH21"
+	LineIncomeTaxRefund = "PPH21_REFUND"
+	LineTHR             = "THR"
+	LineReimbursement   = "REIMBURSEMENT"
+)
+
+// Code constants of the BPJS lines: the employee part is deducted, the employer part is
+// shown as an employer contribution.
+const (
+	LineBPJSHealth         = "BPJS_KES"
+	LineBPJSJHT            = "BPJS_JHT"
+	LineBPJSJP             = "BPJS_JP"
+	LineBPJSHealthEmployer = "BPJS_KES_ER"
+	LineBPJSJHTEmployer    = "BPJS_JHT_ER"
+	LineBPJSJPEmployer     = "BPJS_JP_ER"
+	LineBPJSJKK            = "BPJS_JKK"
+	LineBPJSJKM            = "BPJS_JKM"
+)
+
+// Code constants of the loan repayment deductions.
+const (
+	LineLoan          = "LOAN"
+	LineSalaryAdvance = "ADVANCE"
+)
+
+// reservedCodes cannot be used by payroll adjustments or other configurable components.
+var reservedCodes = map[string]bool{
+	LineBasic:           true,
+	LineOvertime:        true,
+	LineIncomeTax:       true,
+	LineIncomeTaxRefund: true,
+	LineTHR:             true,
+	LineReimbursement:   true,
+
+	LineBPJSHealth:         true,
+	LineBPJSJHT:            true,
+	LineBPJSJP:             true,
+	LineBPJSHealthEmployer: true,
+	LineBPJSJHTEmployer:    true,
+	LineBPJSJPEmployer:     true,
+	LineBPJSJKK:            true,
+	LineBPJSJKM:            true,
+
+	LineLoan:          true,
+	LineSalaryAdvance: true,
+}
+
+// IsReservedCode reports whether code is used by a line the payroll run produces itself.
+func IsReservedCode(code string) bool {
+	return reservedCodes[strings.ToUpper(strings.TrimSpace(code))]
+}
+
+// PayslipLine is one amount on a payslip. code identifies the component (e.g. BASIC,
+// OVERTIME, PPH21) and taxable marks earnings and employer contributions that form part
+// of the gross income for PPh 21.
+type PayslipLine struct {
+	code    string
+	name    string
+	kind    enum.PayLineKind
+	amount  int64
+	taxable bool
+}
+
+// NewPayslipLine constructs a PayslipLine with validation. The code is uppercased.
+func NewPayslipLine(code, name string, kind enum.PayLineKind, amount int64, taxable bool) (*PayslipLine, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return nil, errors.New("line code cannot be empty")
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("line name cannot be empty")
+	}
+	if !kind.Valid() {
+		return nil, fmt.Errorf("invalid PayLineKind: %q", kind)
+	}
+	if amount < 0 {
+		return nil, errors.New("line amount cannot be negative")
+	}
+	if kind == enum.PayLineDeduction && taxable {
+		return nil, errors.New("deduction cannot be taxable")
+	}
+	return &PayslipLine{code: code, name: name, kind: kind, amount: amount, taxable: taxable}, nil
+}
+
+// Code returns the component code of the line.
+func (l PayslipLine) Code() string {
+	return l.code
+}
+
+// Name returns the label printed on the payslip.
+func (l PayslipLine) Name() string {
+	return l.name
+}
+
+// Kind returns how the line affects pay.
+func (l PayslipLine) Kind() enum.PayLineKind {
+	return l.kind
+}
+
+// Amount returns the amount of the line.
+func (l PayslipLine) Amount() int64 {
+	return l.amount
+}
+
+// Taxable reports whether the line is part of the gross income for PPh 21.
+func (l PayslipLine) Taxable() bool {
+	return l.taxable
+}
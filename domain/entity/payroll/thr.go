@@ -0,0 +1,139 @@
+package payroll_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	calendar_entity "github.com/rfanazhari/hris/domain/entity/calendar"
+	"github.com/rfanazhari/hris/domain/enum"
+	"strings"
+	"time"
+)
+
+// THRPaymentNotice is how long before the religious holiday THR must be paid,
+// Permenaker No. 6/2016 Pasal 5 ayat (4).
+const THRPaymentNotice = 7 * 24 * time.Hour
+
+// religiousHolidays are the hari raya keagamaan of Permenaker No. 6/2016 Pasal 1 angka 2,
+// matched against the start of the national holiday names.
+var religiousHolidays = map[enum.Religion]string{
+	enum.ReligionIslam:      "Hari Raya Idul Fitri",
+	enum.ReligionProtestant: "Hari Raya Natal",
+	enum.ReligionCatholic:   "Hari Raya Natal",
+	enum.ReligionHindu:      "Hari Suci Nyepi",
+	enum.ReligionBuddha:     "Hari Raya Waisak",
+	enum.ReligionKonghucu:   "Tahun Baru Imlek",
+}
+
+// ReligiousHoliday returns the first national holiday among holidays that is the religious
+// holiday of the religion. Employees of another or no religion receive THR before Idul Fitri.
+func ReligiousHoliday(religion enum.Religion, holidays []calendar_entity.Holiday) (*calendar_entity.Holiday, bool) {
+	prefix, ok := religiousHolidays[religion]
+	if !ok {
+		prefix = religiousHolidays[enum.ReligionIslam]
+	}
+	for i := range holidays {
+		if holidays[i].Type() == enum.HolidayNational && strings.HasPrefix(holidays[i].Name(), prefix) {
+			h := holidays[i]
+			return &h, true
+		}
+	}
+	return nil, false
+}
+
+// THR is an employee's tunjangan hari raya keagamaan for one religious holiday. Employees
+// with 12 months of service or more receive one month's wage; those with at least one month
+// receive months of service / 12 of it, Permenaker No. 6/2016 Pasal 3.
+type THR struct {
+	employeeID    uuid.UUID
+	holiday       calendar_entity.Holiday
+	serviceMonths int
+	monthlyWage   int64
+	currency      string
+	amount        int64
+}
+
+// NewTHR computes the THR of an employee hired on hireDate for the holiday. Service is
+// counted in whole months up to the holiday.
+func NewTHR(employeeID uuid.UUID, holiday calendar_entity.Holiday, hireDate time.Time, monthlyWage int64, currency string) (*THR, error) {
+	if employeeID == uuid.Nil {
+		return nil, errors.New("invalid employee id")
+	}
+	if holiday.Date().IsZero() {
+		return nil, errors.New("invalid holiday")
+	}
+	if hireDate.IsZero() {
+		return nil, errors.New("hire date cannot be empty")
+	}
+	if monthlyWage < 0 {
+		return nil, errors.New("monthly wage cannot be negative")
+	}
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if len(currency) != 3 {
+		return nil, errors.New("invalid currency code")
+	}
+
+	months := monthsBetween(hireDate, holiday.Date())
+	amount := monthlyWage
+	if months < 1 {
+		amount = 0
+	} else if months < 12 {
+		amount = monthlyWage * int64(months) / 12
+	}
+	return &THR{
+		employeeID:    employeeID,
+		holiday:       holiday,
+		serviceMonths: max(months, 0),
+		monthlyWage:   monthlyWage,
+		currency:      currency,
+		amount:        amount,
+	}, nil
+}
+
+// EmployeeID returns the employee the THR is paid to.
+func (t THR) EmployeeID() uuid.UUID {
+	return t.employeeID
+}
+
+// Holiday returns the religious holiday the THR is paid for.
+func (t THR) Holiday() calendar_entity.Holiday {
+	return t.holiday
+}
+
+// DueDate returns the last day the THR may be paid.
+func (t THR) DueDate() time.Time {
+	return t.holiday.Date().Add(-THRPaymentNotice)
+}
+
+// ServiceMonths returns the whole months of service up to the holiday.
+func (t THR) ServiceMonths() int {
+	return t.serviceMonths
+}
+
+// MonthlyWage returns the wage the THR is based on.
+func (t THR) MonthlyWage() int64 {
+	return t.monthlyWage
+}
+
+// Currency returns the currency of the THR.
+func (t THR) Currency() string {
+	return t.currency
+}
+
+// Amount returns the THR due.
+func (t THR) Amount() int64 {
+	return t.amount
+}
+
+// Entitled reports whether the employee has served the one month needed for THR.
+func (t THR) Entitled() bool {
+	return t.serviceMonths >= 1
+}
+
+// monthsBetween returns the whole months from a to b, negative if b is before a.
+func monthsBetween(a, b time.Time) int {
+	months := (b.Year()-a.Year())*12 + int(b.Month()-a.Month())
+	if b.Day() < a.Day() {
+		months--
+	}
+	return months
+}
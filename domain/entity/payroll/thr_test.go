@@ -0,0 +1,104 @@
+package payroll_entity_test
+
+import (
+	"github.com/google/uuid"
+	calendar_entity "github.com/rfanazhari/hris/domain/entity/calendar"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func holidays2025(t *testing.T) []calendar_entity.Holiday {
+	var out []calendar_entity.Holiday
+	for _, h := range []struct {
+		date time.Time
+		name string
+		kind string
+	}{
+		{time.Date(2025, 1, 29, 0, 0, 0, 0, time.UTC), "Tahun Baru Imlek 2576 Kongzili", "national"},
+		{time.Date(2025, 3, 29, 0, 0, 0, 0, time.UTC), "Hari Suci Nyepi Tahun Baru Saka 1947", "national"},
+		{time.Date(2025, 3, 28, 0, 0, 0, 0, time.UTC), "Cuti Bersama Idul Fitri", "collective_leave"},
+		{time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), "Hari Raya Idul Fitri 1446 Hijriah", "national"},
+		{time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), "Hari Raya Idul Fitri 1446 Hijriah", "national"},
+		{time.Date(2025, 5, 12, 0, 0, 0, 0, time.UTC), "Hari Raya Waisak 2569 BE", "national"},
+		{time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC), "Hari Raya Natal", "national"},
+	} {
+		holiday, err := calendar_entity.HolidayFactory{Date: h.date, Name: h.name, Type: h.kind}.Create()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		out = append(out, *holiday)
+	}
+	return out
+}
+
+func TestReligiousHoliday(t *testing.T) {
+	holidays := holidays2025(t)
+	tests := []struct {
+		religion enum.Religion
+		want     time.Time
+	}{
+		{enum.ReligionIslam, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)},
+		{enum.ReligionProtestant, time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{enum.ReligionCatholic, time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{enum.ReligionHindu, time.Date(2025, 3, 29, 0, 0, 0, 0, time.UTC)},
+		{enum.ReligionBuddha, time.Date(2025, 5, 12, 0, 0, 0, 0, time.UTC)},
+		{enum.ReligionKonghucu, time.Date(2025, 1, 29, 0, 0, 0, 0, time.UTC)},
+		{enum.ReligionOther, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)},
+		{enum.ReligionNone, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.religion), func(t *testing.T) {
+			holiday, ok := payroll_entity.ReligiousHoliday(tt.religion, holidays)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, holiday.Date())
+		})
+	}
+
+	_, ok := payroll_entity.ReligiousHoliday(enum.ReligionHindu, holidays[:1])
+	assert.False(t, ok)
+}
+
+func TestNewTHR(t *testing.T) {
+	idulFitri, _ := payroll_entity.ReligiousHoliday(enum.ReligionIslam, holidays2025(t))
+	tests := []struct {
+		name     string
+		hired    time.Time
+		months   int
+		amount   int64
+		entitled bool
+	}{
+		{"LongService", time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC), 56, 12_000_000, true},
+		{"ExactlyTwelveMonths", time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), 12, 12_000_000, true},
+		{"ElevenMonths", time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), 11, 11_000_000, true},
+		{"SixMonths", time.Date(2024, 9, 15, 0, 0, 0, 0, time.UTC), 6, 6_000_000, true},
+		{"LessThanOneMonth", time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), 0, 0, false},
+		{"HiredAfterHoliday", time.Date(2025, 4, 14, 0, 0, 0, 0, time.UTC), 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			thr, err := payroll_entity.NewTHR(uuid.New(), *idulFitri, tt.hired, 12_000_000, "idr")
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.months, thr.ServiceMonths())
+			assert.Equal(t, tt.amount, thr.Amount())
+			assert.Equal(t, tt.entitled, thr.Entitled())
+			assert.Equal(t, "IDR", thr.Currency())
+			assert.Equal(t, time.Date(2025, 3, 24, 0, 0, 0, 0, time.UTC), thr.DueDate())
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := payroll_entity.NewTHR(uuid.Nil, *idulFitri, time.Now(), 1, "IDR")
+		assert.EqualError(t, err, "invalid employee id")
+		_, err = payroll_entity.NewTHR(uuid.New(), *idulFitri, time.Time{}, 1, "IDR")
+		assert.EqualError(t, err, "hire date cannot be empty")
+		_, err = payroll_entity.NewTHR(uuid.New(), calendar_entity.Holiday{}, time.Now(), 1, "IDR")
+		assert.EqualError(t, err, "invalid holiday")
+	})
+}
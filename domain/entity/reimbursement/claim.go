@@ -0,0 +1,251 @@
+package reimbursement_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Claim is an employee's request to be reimbursed for expenses of one category. It is
+// prepared as a draft, submitted for approval and, once approved, paid either through
+// the next payroll run or by a separate payout.
+type Claim struct {
+	id           uuid.UUID
+	employeeID   uuid.UUID
+	category     enum.ReimbursementCategory
+	payout       enum.PayoutMethod
+	items        []ClaimItem
+	status       enum.ClaimStatus
+	submittedAt  *time.Time
+	decidedBy    *uuid.UUID
+	decidedAt    *time.Time
+	decisionNote string
+	paidAt       *time.Time
+	payrollRunID *uuid.UUID
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// ID returns the unique identifier of the claim.
+func (c *Claim) ID() uuid.UUID {
+	return c.id
+}
+
+// EmployeeID returns the claiming employee.
+func (c *Claim) EmployeeID() uuid.UUID {
+	return c.employeeID
+}
+
+// Category returns the expense category.
+func (c *Claim) Category() enum.ReimbursementCategory {
+	return c.category
+}
+
+// PayoutMethod returns how the claim is paid once approved.
+func (c *Claim) PayoutMethod() enum.PayoutMethod {
+	return c.payout
+}
+
+// Items returns a copy of the claimed expenses.
+func (c *Claim) Items() []ClaimItem {
+	out := make([]ClaimItem, len(c.items))
+	copy(out, c.items)
+	return out
+}
+
+// Status returns the current status of the claim.
+func (c *Claim) Status() enum.ClaimStatus {
+	return c.status
+}
+
+// SubmittedAt returns when the claim was submitted, if it was.
+func (c *Claim) SubmittedAt() *time.Time {
+	return c.submittedAt
+}
+
+// DecidedBy returns the approver who decided the claim, if any.
+func (c *Claim) DecidedBy() *uuid.UUID {
+	return c.decidedBy
+}
+
+// DecidedAt returns when the claim was decided, if it was.
+func (c *Claim) DecidedAt() *time.Time {
+	return c.decidedAt
+}
+
+// DecisionNote returns the reason given for a rejection.
+func (c *Claim) DecisionNote() string {
+	return c.decisionNote
+}
+
+// PaidAt returns when the claim was paid, if it was.
+func (c *Claim) PaidAt() *time.Time {
+	return c.paidAt
+}
+
+// PayrollRunID returns the payroll run that paid the claim, if it was paid through payroll.
+func (c *Claim) PayrollRunID() *uuid.UUID {
+	return c.payrollRunID
+}
+
+// CreatedAt returns when the claim was created.
+func (c *Claim) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// UpdatedAt returns when the claim was last changed.
+func (c *Claim) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// Total returns the sum of the claimed expenses.
+func (c *Claim) Total() int64 {
+	var total int64
+	for _, i := range c.items {
+		total += i.amount
+	}
+	return total
+}
+
+// TotalIn returns the sum of the expenses dated in the given year.
+func (c *Claim) TotalIn(year int) int64 {
+	var total int64
+	for _, i := range c.items {
+		if i.date.Year() == year {
+			total += i.amount
+		}
+	}
+	return total
+}
+
+// Years returns the distinct years the expenses are dated in, in ascending order.
+func (c *Claim) Years() []int {
+	seen := map[int]bool{}
+	var years []int
+	for _, i := range c.items {
+		if !seen[i.date.Year()] {
+			seen[i.date.Year()] = true
+			years = append(years, i.date.Year())
+		}
+	}
+	sort.Ints(years)
+	return years
+}
+
+// CountsTowardsLimit reports whether the claim uses up the annual limit: submitted,
+// approved and paid claims do.
+func (c *Claim) CountsTowardsLimit() bool {
+	return c.status == enum.ClaimSubmitted || c.status == enum.ClaimApproved || c.status == enum.ClaimPaid
+}
+
+// AddItem adds an expense to a draft claim.
+func (c *Claim) AddItem(item ClaimItem, at time.Time) error {
+	if c.status != enum.ClaimDraft {
+		return errors.New("claim is not a draft")
+	}
+	if item.amount <= 0 {
+		return errors.New("invalid claim item")
+	}
+	c.items = append(c.items, item)
+	c.touch(at)
+	return nil
+}
+
+// RemoveItem removes the expense at the given index from a draft claim.
+func (c *Claim) RemoveItem(index int, at time.Time) error {
+	if c.status != enum.ClaimDraft {
+		return errors.New("claim is not a draft")
+	}
+	if index < 0 || index >= len(c.items) {
+		return errors.New("claim item not found")
+	}
+	c.items = append(c.items[:index:index], c.items[index+1:]...)
+	c.touch(at)
+	return nil
+}
+
+// Submit sends a draft claim with at least one expense for approval.
+func (c *Claim) Submit(at time.Time) error {
+	if c.status != enum.ClaimDraft {
+		return errors.New("claim is not a draft")
+	}
+	if len(c.items) == 0 {
+		return errors.New("claim has no items")
+	}
+	at = c.touch(at)
+	c.status = enum.ClaimSubmitted
+	c.submittedAt = &at
+	return nil
+}
+
+// Approve marks a submitted claim as approved.
+func (c *Claim) Approve(approverID uuid.UUID, at time.Time) error {
+	return c.decide(enum.ClaimApproved, approverID, "", at)
+}
+
+// Reject marks a submitted claim as rejected with a reason.
+func (c *Claim) Reject(approverID uuid.UUID, note string, at time.Time) error {
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return errors.New("rejection note cannot be empty")
+	}
+	return c.decide(enum.ClaimRejected, approverID, note, at)
+}
+
+// Cancel withdraws a draft or submitted claim.
+func (c *Claim) Cancel(at time.Time) error {
+	if c.status != enum.ClaimDraft && c.status != enum.ClaimSubmitted {
+		return errors.New("claim is not cancellable")
+	}
+	c.status = enum.ClaimCancelled
+	c.touch(at)
+	return nil
+}
+
+// MarkPaid records the payment of an approved claim. Claims paid through payroll need
+// the run that paid them; claims paid separately must not have one.
+func (c *Claim) MarkPaid(runID *uuid.UUID, at time.Time) error {
+	if c.status != enum.ClaimApproved {
+		return errors.New("claim is not approved")
+	}
+	if c.payout == enum.PayoutPayroll && (runID == nil || *runID == uuid.Nil) {
+		return errors.New("payroll run cannot be empty")
+	}
+	if c.payout == enum.PayoutSeparate && runID != nil {
+		return errors.New("claim is not paid through payroll")
+	}
+	at = c.touch(at)
+	c.status = enum.ClaimPaid
+	c.paidAt = &at
+	c.payrollRunID = runID
+	return nil
+}
+
+func (c *Claim) decide(status enum.ClaimStatus, approverID uuid.UUID, note string, at time.Time) error {
+	if c.status != enum.ClaimSubmitted {
+		return errors.New("claim is not submitted")
+	}
+	if approverID == uuid.Nil {
+		return errors.New("approver cannot be empty")
+	}
+	if approverID == c.employeeID {
+		return errors.New("employee cannot decide on their own claim")
+	}
+	at = c.touch(at)
+	c.status = status
+	c.decidedBy = &approverID
+	c.decidedAt = &at
+	c.decisionNote = note
+	return nil
+}
+
+func (c *Claim) touch(at time.Time) time.Time {
+	if at.IsZero() {
+		at = time.Now()
+	}
+	c.updatedAt = at
+	return at
+}
@@ -0,0 +1,67 @@
+package reimbursement_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"strings"
+	"time"
+)
+
+// ClaimFactory is a factory type for creating reimbursement Claims.
+type ClaimFactory struct {
+	ID           string
+	EmployeeID   string
+	Category     string
+	PayoutMethod string
+	Items        []ClaimItem
+	CreatedAt    time.Time
+}
+
+// Create validates the factory data and returns a new draft Claim. The payout method
+// defaults to payroll.
+func (f ClaimFactory) Create() (*Claim, error) {
+	id, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	employeeID, err := uuid.Parse(f.EmployeeID)
+	if err != nil {
+		return nil, errors.New("invalid employee id")
+	}
+
+	category, err := enum.ParseReimbursementCategory(f.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(f.PayoutMethod) == "" {
+		f.PayoutMethod = string(enum.PayoutPayroll)
+	}
+	payout, err := enum.ParsePayoutMethod(f.PayoutMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range f.Items {
+		if item.amount <= 0 {
+			return nil, errors.New("invalid claim item")
+		}
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	return &Claim{
+		id:         id,
+		employeeID: employeeID,
+		category:   category,
+		payout:     payout,
+		items:      append([]ClaimItem(nil), f.Items...),
+		status:     enum.ClaimDraft,
+		createdAt:  f.CreatedAt,
+		updatedAt:  f.CreatedAt,
+	}, nil
+}
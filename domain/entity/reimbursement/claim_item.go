@@ -0,0 +1,53 @@
+package reimbursement_entity
+
+import (
+	"errors"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"strings"
+	"time"
+)
+
+// ClaimItem is one expense of a reimbursement claim, backed by a receipt.
+type ClaimItem struct {
+	date        time.Time
+	description string
+	amount      int64
+	receipt     valueobject.FileReference
+}
+
+// NewClaimItem constructs a ClaimItem with validation. Receipts must be images or PDFs.
+func NewClaimItem(date time.Time, description string, amount int64, receipt *valueobject.FileReference) (*ClaimItem, error) {
+	if date.IsZero() {
+		return nil, errors.New("expense date cannot be empty")
+	}
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return nil, errors.New("expense description cannot be empty")
+	}
+	if amount <= 0 {
+		return nil, errors.New("expense amount must be positive")
+	}
+	if receipt == nil {
+		return nil, errors.New("receipt cannot be empty")
+	}
+	if mt := receipt.MimeType(); !strings.HasPrefix(mt, "image/") && mt != "application/pdf" {
+		return nil, errors.New("receipt must be an image or a PDF")
+	}
+	return &ClaimItem{date: dateOf(date), description: description, amount: amount, receipt: *receipt}, nil
+}
+
+// Date returns the date of the expense.
+func (i ClaimItem) Date() time.Time { return i.date }
+
+// Description returns what the expense was for.
+func (i ClaimItem) Description() string { return i.description }
+
+// Amount returns the amount claimed.
+func (i ClaimItem) Amount() int64 { return i.amount }
+
+// Receipt returns the uploaded receipt.
+func (i ClaimItem) Receipt() valueobject.FileReference { return i.receipt }
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
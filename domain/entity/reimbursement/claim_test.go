@@ -0,0 +1,159 @@
+package reimbursement_entity_test
+
+import (
+	"github.com/google/uuid"
+	reimbursement_entity "github.com/rfanazhari/hris/domain/entity/reimbursement"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func receipt(t *testing.T, mimeType string) *valueobject.FileReference {
+	ref, err := valueobject.NewFileReference("https://storage.example.com/receipts/r-1", "r-1", mimeType)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return ref
+}
+
+func item(t *testing.T, at time.Time, amount int64) reimbursement_entity.ClaimItem {
+	i, err := reimbursement_entity.NewClaimItem(at, "Konsultasi dokter", amount, receipt(t, "image/jpeg"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return *i
+}
+
+func newClaim(t *testing.T, payout string) *reimbursement_entity.Claim {
+	claim, err := reimbursement_entity.ClaimFactory{ID: uuid.NewString(), EmployeeID: uuid.NewString(), Category: "medical", PayoutMethod: payout}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return claim
+}
+
+func TestNewClaimItem(t *testing.T) {
+	_, err := reimbursement_entity.NewClaimItem(date(2025, 3, 1), " ", 1, receipt(t, "image/png"))
+	assert.EqualError(t, err, "expense description cannot be empty")
+	_, err = reimbursement_entity.NewClaimItem(date(2025, 3, 1), "Obat", 0, receipt(t, "image/png"))
+	assert.EqualError(t, err, "expense amount must be positive")
+	_, err = reimbursement_entity.NewClaimItem(date(2025, 3, 1), "Obat", 1, nil)
+	assert.EqualError(t, err, "receipt cannot be empty")
+	_, err = reimbursement_entity.NewClaimItem(date(2025, 3, 1), "Obat", 1, receipt(t, "text/plain"))
+	assert.EqualError(t, err, "receipt must be an image or a PDF")
+
+	i, err := reimbursement_entity.NewClaimItem(time.Date(2025, 3, 1, 15, 0, 0, 0, time.UTC), "Obat", 1, receipt(t, "application/pdf"))
+	assert.Nil(t, err)
+	assert.Equal(t, date(2025, 3, 1), i.Date())
+}
+
+func TestClaimFactory_Create(t *testing.T) {
+	t.Run("ValidInput", func(t *testing.T) {
+		claim := newClaim(t, "")
+
+		assert.Equal(t, enum.ClaimDraft, claim.Status())
+		assert.Equal(t, enum.PayoutPayroll, claim.PayoutMethod())
+		assert.Empty(t, claim.Items())
+	})
+	t.Run("InvalidInput", func(t *testing.T) {
+		_, err := reimbursement_entity.ClaimFactory{ID: "uuid"}.Create()
+		assert.EqualError(t, err, "invalid format uuid")
+		_, err = reimbursement_entity.ClaimFactory{ID: uuid.NewString(), EmployeeID: "x"}.Create()
+		assert.EqualError(t, err, "invalid employee id")
+		_, err = reimbursement_entity.ClaimFactory{ID: uuid.NewString(), EmployeeID: uuid.NewString(), Category: "dental"}.Create()
+		assert.EqualError(t, err, `invalid ReimbursementCategory: "dental"`)
+		_, err = reimbursement_entity.ClaimFactory{ID: uuid.NewString(), EmployeeID: uuid.NewString(), Category: "travel", PayoutMethod: "cash"}.Create()
+		assert.EqualError(t, err, `invalid PayoutMethod: "cash"`)
+		_, err = reimbursement_entity.ClaimFactory{ID: uuid.NewString(), EmployeeID: uuid.NewString(), Category: "travel", Items: []reimbursement_entity.ClaimItem{{}}}.Create()
+		assert.EqualError(t, err, "invalid claim item")
+	})
+}
+
+func TestClaim_Lifecycle(t *testing.T) {
+	at := date(2025, 4, 2)
+	approver := uuid.New()
+
+	t.Run("ApproveAndPayThroughPayroll", func(t *testing.T) {
+		claim := newClaim(t, "payroll")
+		assert.EqualError(t, claim.Submit(at), "claim has no items")
+
+		assert.Nil(t, claim.AddItem(item(t, date(2024, 12, 30), 300_000), at))
+		assert.Nil(t, claim.AddItem(item(t, date(2025, 1, 3), 200_000), at))
+		assert.Nil(t, claim.AddItem(item(t, date(2025, 1, 4), 100_000), at))
+		assert.Nil(t, claim.RemoveItem(2, at))
+		assert.EqualError(t, claim.RemoveItem(2, at), "claim item not found")
+		assert.Equal(t, int64(500_000), claim.Total())
+		assert.Equal(t, int64(200_000), claim.TotalIn(2025))
+		assert.Equal(t, []int{2024, 2025}, claim.Years())
+		assert.False(t, claim.CountsTowardsLimit())
+
+		assert.Nil(t, claim.Submit(at))
+		assert.Equal(t, at, *claim.SubmittedAt())
+		assert.True(t, claim.CountsTowardsLimit())
+		assert.EqualError(t, claim.AddItem(item(t, at, 1), at), "claim is not a draft")
+		assert.EqualError(t, claim.MarkPaid(nil, at), "claim is not approved")
+		assert.EqualError(t, claim.Approve(claim.EmployeeID(), at), "employee cannot decide on their own claim")
+
+		assert.Nil(t, claim.Approve(approver, at.Add(time.Hour)))
+		assert.Equal(t, at, *claim.SubmittedAt())
+		assert.Equal(t, approver, *claim.DecidedBy())
+		assert.EqualError(t, claim.Cancel(at), "claim is not cancellable")
+		assert.EqualError(t, claim.MarkPaid(nil, at), "payroll run cannot be empty")
+
+		runID := uuid.New()
+		assert.Nil(t, claim.MarkPaid(&runID, date(2025, 4, 25)))
+		assert.Equal(t, enum.ClaimPaid, claim.Status())
+		assert.Equal(t, runID, *claim.PayrollRunID())
+		assert.Equal(t, at.Add(time.Hour), *claim.DecidedAt())
+	})
+	t.Run("SeparatePayout", func(t *testing.T) {
+		claim := newClaim(t, "separate")
+		_ = claim.AddItem(item(t, at, 150_000), at)
+		_ = claim.Submit(at)
+		_ = claim.Approve(approver, at)
+
+		runID := uuid.New()
+		assert.EqualError(t, claim.MarkPaid(&runID, at), "claim is not paid through payroll")
+		assert.Nil(t, claim.MarkPaid(nil, at))
+		assert.Nil(t, claim.PayrollRunID())
+	})
+	t.Run("RejectAndCancel", func(t *testing.T) {
+		claim := newClaim(t, "")
+		_ = claim.AddItem(item(t, at, 150_000), at)
+		assert.EqualError(t, claim.Approve(approver, at), "claim is not submitted")
+		_ = claim.Submit(at)
+		assert.EqualError(t, claim.Reject(approver, " ", at), "rejection note cannot be empty")
+		assert.Nil(t, claim.Reject(approver, "kuitansi tidak terbaca", at))
+		assert.Equal(t, "kuitansi tidak terbaca", claim.DecisionNote())
+		assert.False(t, claim.CountsTowardsLimit())
+
+		other := newClaim(t, "")
+		assert.Nil(t, other.Cancel(at))
+		assert.Equal(t, enum.ClaimCancelled, other.Status())
+	})
+}
+
+func TestLimitPolicy(t *testing.T) {
+	senior, _ := reimbursement_entity.NewLimit(enum.ReimbursementMedical, enum.GradeSenior, 5_000_000)
+	junior, _ := reimbursement_entity.NewLimit(enum.ReimbursementMedical, enum.GradeJunior, 3_000_000)
+	policy, err := reimbursement_entity.NewLimitPolicy(*senior, *junior)
+	assert.Nil(t, err)
+
+	_, err = reimbursement_entity.NewLimit(enum.ReimbursementMedical, enum.GradeSenior, 0)
+	assert.EqualError(t, err, "annual limit must be positive")
+	_, err = reimbursement_entity.NewLimitPolicy(*senior, *senior)
+	assert.EqualError(t, err, "duplicate medical limit for grade senior")
+
+	annual, ok := policy.Limit(enum.ReimbursementMedical, enum.GradeJunior)
+	assert.True(t, ok)
+	assert.Equal(t, int64(3_000_000), annual)
+	assert.Nil(t, policy.Check(enum.ReimbursementMedical, enum.GradeJunior, 2025, 2_000_000, 1_000_000))
+	assert.EqualError(t, policy.Check(enum.ReimbursementMedical, enum.GradeJunior, 2025, 2_500_000, 1_000_000), "medical reimbursement exceeds the 2025 limit: 500000 of 3000000 remaining")
+	assert.EqualError(t, policy.Check(enum.ReimbursementOptical, enum.GradeJunior, 2025, 0, 1), "optical reimbursement is not available for grade junior")
+}
@@ -0,0 +1,79 @@
+package reimbursement_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rfanazhari/hris/domain/enum"
+)
+
+// Limit is the amount an employee of a grade level may claim in a category per calendar
+// year.
+type Limit struct {
+	category enum.ReimbursementCategory
+	grade    enum.GradeLevel
+	annual   int64
+}
+
+// NewLimit constructs a Limit with validation.
+func NewLimit(category enum.ReimbursementCategory, grade enum.GradeLevel, annual int64) (*Limit, error) {
+	if !category.Valid() {
+		return nil, fmt.Errorf("invalid ReimbursementCategory: %q", category)
+	}
+	if !grade.Valid() {
+		return nil, fmt.Errorf("invalid GradeLevel: %q", grade)
+	}
+	if annual <= 0 {
+		return nil, errors.New("annual limit must be positive")
+	}
+	return &Limit{category: category, grade: grade, annual: annual}, nil
+}
+
+// Category returns the expense category the limit applies to.
+func (l Limit) Category() enum.ReimbursementCategory { return l.category }
+
+// GradeLevel returns the grade level the limit applies to.
+func (l Limit) GradeLevel() enum.GradeLevel { return l.grade }
+
+// Annual returns the amount that may be claimed per calendar year.
+func (l Limit) Annual() int64 { return l.annual }
+
+// LimitPolicy holds the annual limits per category and grade level. A category without a
+// limit for a grade cannot be claimed by employees of that grade.
+type LimitPolicy struct {
+	limits map[enum.ReimbursementCategory]map[enum.GradeLevel]int64
+}
+
+// NewLimitPolicy builds a policy from the given limits; each category and grade pair may
+// appear once.
+func NewLimitPolicy(limits ...Limit) (*LimitPolicy, error) {
+	p := &LimitPolicy{limits: map[enum.ReimbursementCategory]map[enum.GradeLevel]int64{}}
+	for _, l := range limits {
+		if p.limits[l.category] == nil {
+			p.limits[l.category] = map[enum.GradeLevel]int64{}
+		}
+		if _, ok := p.limits[l.category][l.grade]; ok {
+			return nil, fmt.Errorf("duplicate %s limit for grade %s", l.category, l.grade)
+		}
+		p.limits[l.category][l.grade] = l.annual
+	}
+	return p, nil
+}
+
+// Limit returns the annual limit of the category for the grade level.
+func (p *LimitPolicy) Limit(category enum.ReimbursementCategory, grade enum.GradeLevel) (int64, bool) {
+	annual, ok := p.limits[category][grade]
+	return annual, ok
+}
+
+// Check returns an error when claiming amount in the category on top of used would exceed
+// the grade level's annual limit of the year.
+func (p *LimitPolicy) Check(category enum.ReimbursementCategory, grade enum.GradeLevel, year int, used, amount int64) error {
+	annual, ok := p.Limit(category, grade)
+	if !ok {
+		return fmt.Errorf("%s reimbursement is not available for grade %s", category, grade)
+	}
+	if used+amount > annual {
+		return fmt.Errorf("%s reimbursement exceeds the %d limit: %d of %d remaining", category, year, max(annual-used, 0), annual)
+	}
+	return nil
+}
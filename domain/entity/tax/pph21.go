@@ -0,0 +1,97 @@
+package tax_entity
+
+import "errors"
+
+// Biaya jabatan of PMK No. 250/PMK.03/2008: 5% of gross income, at most 500.000 a month.
+const (
+	JobExpenseRate       int64 = 5
+	MaxMonthlyJobExpense int64 = 500_000
+)
+
+// progressiveBracket taxes taxable income up to upTo at rate percent; upTo 0 is unbounded.
+type progressiveBracket struct {
+	upTo int64
+	rate int64
+}
+
+// progressiveBrackets are the rates of UU PPh Pasal 17 ayat (1) huruf a as amended by UU HPP.
+var progressiveBrackets = []progressiveBracket{
+	{60_000_000, 5},
+	{250_000_000, 15},
+	{500_000_000, 25},
+	{5_000_000_000, 30},
+	{0, 35},
+}
+
+// MonthlyWithholding returns the PPh 21 withheld from a permanent employee for January to
+// November, or for a month that is not the last of the tax year: the monthly gross income
+// times the TER rate of the employee's category, PMK No. 168/2023 Pasal 13. Fractions of a
+// rupiah are dropped.
+func MonthlyWithholding(status PTKPStatus, monthlyGross int64) int64 {
+	if monthlyGross <= 0 {
+		return 0
+	}
+	return monthlyGross * status.Category().Rate(monthlyGross) / 10_000
+}
+
+// AnnualIncome is the income of a permanent employee over the months worked in a tax year.
+type AnnualIncome struct {
+	// Gross is the gross income including employer paid JKK, JKM and BPJS Kesehatan premiums.
+	Gross int64
+	// PensionContributions are the employee's JHT and JP contributions, which are deductible.
+	PensionContributions int64
+	// Months is the number of months of the tax period, 1 to 12.
+	Months int
+}
+
+// TaxableIncome returns the penghasilan kena pajak: gross income less biaya jabatan,
+// pension contributions and PTKP, rounded down to whole thousands.
+func (a AnnualIncome) TaxableIncome(status PTKPStatus) (int64, error) {
+	if a.Months < 1 || a.Months > 12 {
+		return 0, errors.New("tax period must be 1 to 12 months")
+	}
+	if a.Gross < 0 || a.PensionContributions < 0 {
+		return 0, errors.New("income cannot be negative")
+	}
+	jobExpense := a.Gross * JobExpenseRate / 100
+	if limit := MaxMonthlyJobExpense * int64(a.Months); jobExpense > limit {
+		jobExpense = limit
+	}
+	taxable := a.Gross - jobExpense - a.PensionContributions - status.Allowance()
+	if taxable <= 0 {
+		return 0, nil
+	}
+	return taxable / 1_000 * 1_000, nil
+}
+
+// AnnualPPh21 returns the PPh 21 due on the income of the tax period under the progressive
+// rates. It is calculated in the last month of the tax year or of employment, and the
+// difference with the TER withheld earlier is withheld (or refunded) in that month,
+// PMK No. 168/2023 Pasal 14.
+func AnnualPPh21(status PTKPStatus, income AnnualIncome) (int64, error) {
+	taxable, err := income.TaxableIncome(status)
+	if err != nil {
+		return 0, err
+	}
+	return ProgressiveTax(taxable), nil
+}
+
+// ProgressiveTax applies the Pasal 17 rates to taxable income.
+func ProgressiveTax(taxable int64) int64 {
+	var tax, from int64
+	for _, b := range progressiveBrackets {
+		if taxable <= from {
+			break
+		}
+		upTo := taxable
+		if b.upTo != 0 && b.upTo < upTo {
+			upTo = b.upTo
+		}
+		tax += (upTo - from) * b.rate / 100
+		from = b.upTo
+		if b.upTo == 0 {
+			break
+		}
+	}
+	return tax
+}
@@ -0,0 +1,197 @@
+package tax_entity_test
+
+import (
+	tax_entity "github.com/rfanazhari/hris/domain/entity/tax"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func status(t *testing.T, married bool, dependents int) tax_entity.PTKPStatus {
+	s, err := tax_entity.NewPTKPStatus(married, dependents)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return *s
+}
+
+func TestPTKPStatus(t *testing.T) {
+	tests := []struct {
+		married    bool
+		dependents int
+		want       string
+		category   tax_entity.TERCategory
+		allowance  int64
+	}{
+		{false, 0, "TK/0", tax_entity.TERCategoryA, 54_000_000},
+		{false, 1, "TK/1", tax_entity.TERCategoryA, 58_500_000},
+		{true, 0, "K/0", tax_entity.TERCategoryA, 58_500_000},
+		{false, 2, "TK/2", tax_entity.TERCategoryB, 63_000_000},
+		{false, 3, "TK/3", tax_entity.TERCategoryB, 67_500_000},
+		{true, 1, "K/1", tax_entity.TERCategoryB, 63_000_000},
+		{true, 2, "K/2", tax_entity.TERCategoryB, 67_500_000},
+		{true, 3, "K/3", tax_entity.TERCategoryC, 72_000_000},
+		{true, 5, "K/3", tax_entity.TERCategoryC, 72_000_000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			s := status(t, tt.married, tt.dependents)
+
+			assert.Equal(t, tt.want, s.String())
+			assert.Equal(t, tt.category, s.Category())
+			assert.Equal(t, tt.allowance, s.Allowance())
+		})
+	}
+
+	t.Run("FromPersonalInfo", func(t *testing.T) {
+		s, err := tax_entity.PTKPStatusOf(enum.MaritalMarried, enum.GenderMale, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, "K/2", s.String())
+		s, _ = tax_entity.PTKPStatusOf(enum.MaritalMarried, enum.GenderFemale, 2)
+		assert.Equal(t, "TK/0", s.String())
+		s, _ = tax_entity.PTKPStatusOf(enum.MaritalDivorced, enum.GenderFemale, 2)
+		assert.Equal(t, "TK/2", s.String())
+
+		_, err = tax_entity.PTKPStatusOf("engaged", enum.GenderMale, 0)
+		assert.EqualError(t, err, `invalid MaritalStatus: "engaged"`)
+		_, err = tax_entity.NewPTKPStatus(false, -1)
+		assert.EqualError(t, err, "dependents cannot be negative")
+	})
+}
+
+// The cases sit on the bracket edges of the TER tables in the annex to PP No. 58/2023.
+func TestTERCategory_Rate(t *testing.T) {
+	tests := []struct {
+		name     string
+		category tax_entity.TERCategory
+		gross    int64
+		want     int64
+	}{
+		{"ABelowThreshold", tax_entity.TERCategoryA, 5_400_000, 0},
+		{"AFirstBracket", tax_entity.TERCategoryA, 5_400_001, 25},
+		{"ATenMillion", tax_entity.TERCategoryA, 10_000_000, 200},
+		{"ABracketEdge", tax_entity.TERCategoryA, 1_400_000_000, 3300},
+		{"ATopBracket", tax_entity.TERCategoryA, 2_000_000_000, 3400},
+		{"BBelowThreshold", tax_entity.TERCategoryB, 6_200_000, 0},
+		{"BFirstBracket", tax_entity.TERCategoryB, 6_200_001, 25},
+		{"BTenMillion", tax_entity.TERCategoryB, 10_000_000, 150},
+		{"BTwentyMillion", tax_entity.TERCategoryB, 20_000_000, 800},
+		{"BBracketEdge", tax_entity.TERCategoryB, 1_405_000_000, 3300},
+		{"BTopBracket", tax_entity.TERCategoryB, 1_405_000_001, 3400},
+		{"CBelowThreshold", tax_entity.TERCategoryC, 6_600_000, 0},
+		{"CFirstBracket", tax_entity.TERCategoryC, 6_600_001, 25},
+		{"CTenMillion", tax_entity.TERCategoryC, 10_000_000, 150},
+		{"CBracketEdge", tax_entity.TERCategoryC, 1_419_000_000, 3300},
+		{"CTopBracket", tax_entity.TERCategoryC, 1_419_000_001, 3400},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.category.Rate(tt.gross))
+		})
+	}
+	assert.False(t, tax_entity.TERCategory("D").Valid())
+}
+
+func TestMonthlyWithholding(t *testing.T) {
+	assert.Equal(t, int64(200_000), tax_entity.MonthlyWithholding(status(t, false, 0), 10_000_000))
+	assert.Equal(t, int64(1_600_000), tax_entity.MonthlyWithholding(status(t, true, 1), 20_000_000))
+	assert.Equal(t, int64(56_250), tax_entity.MonthlyWithholding(status(t, true, 3), 7_500_000))
+	assert.Equal(t, int64(0), tax_entity.MonthlyWithholding(status(t, true, 3), 6_000_000))
+	assert.Equal(t, int64(0), tax_entity.MonthlyWithholding(status(t, false, 0), -1))
+}
+
+func TestAnnualPPh21(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  tax_entity.PTKPStatus
+		income  tax_entity.AnnualIncome
+		taxable int64
+		want    int64
+	}{
+		{
+			// 120.000.000 - 6.000.000 biaya jabatan - 54.000.000 PTKP = 60.000.000 x 5%
+			name:    "FullYearTK0",
+			status:  status(t, false, 0),
+			income:  tax_entity.AnnualIncome{Gross: 120_000_000, Months: 12},
+			taxable: 60_000_000,
+			want:    3_000_000,
+		},
+		{
+			// 240.000.000 - 6.000.000 - 4.800.000 JHT/JP - 63.000.000 = 166.200.000
+			name:    "FullYearK1WithPension",
+			status:  status(t, true, 1),
+			income:  tax_entity.AnnualIncome{Gross: 240_000_000, PensionContributions: 4_800_000, Months: 12},
+			taxable: 166_200_000,
+			want:    18_930_000,
+		},
+		{
+			// biaya jabatan limited to 3 x 500.000; income stays below PTKP
+			name:    "ThreeMonthsBelowPTKP",
+			status:  status(t, false, 0),
+			income:  tax_entity.AnnualIncome{Gross: 30_000_000, Months: 3},
+			taxable: 0,
+			want:    0,
+		},
+		{
+			// 5% of 100.000.000 exceeds 6 x 500.000: 100.000.000 - 3.000.000 - 58.500.000
+			name:    "SixMonthsCappedJobExpense",
+			status:  status(t, true, 0),
+			income:  tax_entity.AnnualIncome{Gross: 100_000_000, Months: 6},
+			taxable: 38_500_000,
+			want:    1_925_000,
+		},
+		{
+			// rounded down to whole thousands before applying the rates
+			name:    "RoundsDownTaxableIncome",
+			status:  status(t, false, 0),
+			income:  tax_entity.AnnualIncome{Gross: 80_000_999, Months: 12},
+			taxable: 22_000_000,
+			want:    1_100_000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taxable, err := tt.income.TaxableIncome(tt.status)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.taxable, taxable)
+
+			got, err := tax_entity.AnnualPPh21(tt.status, tt.income)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("InvalidMonths", func(t *testing.T) {
+		_, err := tax_entity.AnnualPPh21(status(t, false, 0), tax_entity.AnnualIncome{Gross: 1, Months: 13})
+		assert.EqualError(t, err, "tax period must be 1 to 12 months")
+	})
+}
+
+// The bracket edges of UU PPh Pasal 17 ayat (1) huruf a as amended by UU No. 7/2021 (HPP):
+// 5% up to 60.000.000, 15% up to 250.000.000, 25% up to 500.000.000, 30% up to
+// 5.000.000.000 and 35% above.
+func TestProgressiveTax(t *testing.T) {
+	tests := []struct {
+		name    string
+		taxable int64
+		want    int64
+	}{
+		{"Zero", 0, 0},
+		{"FirstBracketTop", 60_000_000, 3_000_000},
+		{"SecondBracketStart", 60_001_000, 3_000_150},
+		{"SecondBracketTop", 250_000_000, 31_500_000},
+		{"ThirdBracketTop", 500_000_000, 94_000_000},
+		{"FourthBracket", 600_000_000, 124_000_000},
+		{"FourthBracketTop", 5_000_000_000, 1_444_000_000},
+		{"TopBracket", 6_000_000_000, 1_794_000_000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tax_entity.ProgressiveTax(tt.taxable))
+		})
+	}
+}
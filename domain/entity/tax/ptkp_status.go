@@ -0,0 +1,89 @@
+package tax_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rfanazhari/hris/domain/enum"
+)
+
+// PTKP (penghasilan tidak kena pajak) amounts of PMK No. 101/PMK.010/2016, per year.
+const (
+	PTKPSelf      int64 = 54_000_000
+	PTKPMarried   int64 = 4_500_000
+	PTKPDependent int64 = 4_500_000
+)
+
+// MaxPTKPDependents is the number of dependents that count towards PTKP, UU PPh Pasal 7 ayat (1).
+const MaxPTKPDependents = 3
+
+// PTKPStatus is the tax status of an employee, written as TK/n (tidak kawin) or K/n (kawin)
+// where n is the number of dependents.
+type PTKPStatus struct {
+	married    bool
+	dependents int
+}
+
+// NewPTKPStatus constructs a PTKPStatus. Dependents above MaxPTKPDependents are ignored.
+func NewPTKPStatus(married bool, dependents int) (*PTKPStatus, error) {
+	if dependents < 0 {
+		return nil, errors.New("dependents cannot be negative")
+	}
+	if dependents > MaxPTKPDependents {
+		dependents = MaxPTKPDependents
+	}
+	return &PTKPStatus{married: married, dependents: dependents}, nil
+}
+
+// PTKPStatusOf derives the tax status from the employee's marital status, gender and number
+// of dependents. A married woman is taxed as TK/0 because her husband claims the family
+// allowance (PER-16/PJ/2016 Pasal 10); she is only K/n on proof that her husband has no
+// income, which is entered with NewPTKPStatus.
+func PTKPStatusOf(marital enum.MaritalStatus, gender enum.Gender, dependents int) (*PTKPStatus, error) {
+	if !marital.Valid() {
+		return nil, fmt.Errorf("invalid MaritalStatus: %q", marital)
+	}
+	married := marital == enum.MaritalMarried
+	if married && gender == enum.GenderFemale {
+		return NewPTKPStatus(false, 0)
+	}
+	return NewPTKPStatus(married, dependents)
+}
+
+// Married reports whether the K (kawin) allowance applies.
+func (p PTKPStatus) Married() bool {
+	return p.married
+}
+
+// Dependents returns the number of dependents counted, at most MaxPTKPDependents.
+func (p PTKPStatus) Dependents() int {
+	return p.dependents
+}
+
+// Allowance returns the yearly PTKP amount.
+func (p PTKPStatus) Allowance() int64 {
+	total := PTKPSelf + PTKPDependent*int64(p.dependents)
+	if p.married {
+		total += PTKPMarried
+	}
+	return total
+}
+
+// Category returns the TER category of PP No. 58/2023 Pasal 3 for the status.
+func (p PTKPStatus) Category() TERCategory {
+	switch {
+	case !p.married && p.dependents <= 1, p.married && p.dependents == 0:
+		return TERCategoryA
+	case p.married && p.dependents == MaxPTKPDependents:
+		return TERCategoryC
+	default:
+		return TERCategoryB
+	}
+}
+
+// String returns the status in DJP notation, e.g. "K/2".
+func (p PTKPStatus) String() string {
+	if p.married {
+		return fmt.Sprintf("K/%d", p.dependents)
+	}
+	return fmt.Sprintf("TK/%d", p.dependents)
+}
@@ -0,0 +1,72 @@
+package tax_entity
+
+// TERCategory is the effective rate (tarif efektif rata-rata) table that applies to a
+// PTKP status, PP No. 58/2023 Pasal 3.
+type TERCategory string
+
+const (
+	TERCategoryA TERCategory = "A" // TK/0, TK/1, K/0
+	TERCategoryB TERCategory = "B" // TK/2, TK/3, K/1, K/2
+	TERCategoryC TERCategory = "C" // K/3
+)
+
+// terBracket applies rate (in basis points) to a monthly gross up to and including upTo.
+// The last bracket of a table has upTo 0 and applies to everything above.
+type terBracket struct {
+	upTo int64
+	rate int64
+}
+
+// terTables are the monthly TER tables of the annex to PP No. 58/2023.
+var terTables = map[TERCategory][]terBracket{
+	TERCategoryA: {
+		{5_400_000, 0}, {5_650_000, 25}, {5_950_000, 50}, {6_300_000, 75}, {6_750_000, 100},
+		{7_500_000, 125}, {8_550_000, 150}, {9_650_000, 175}, {10_050_000, 200}, {10_350_000, 225},
+		{10_700_000, 250}, {11_050_000, 300}, {11_600_000, 350}, {12_500_000, 400}, {13_750_000, 500},
+		{15_100_000, 600}, {16_950_000, 700}, {19_750_000, 800}, {24_150_000, 900}, {26_450_000, 1000},
+		{28_000_000, 1100}, {30_050_000, 1200}, {32_400_000, 1300}, {35_400_000, 1400}, {39_100_000, 1500},
+		{43_850_000, 1600}, {47_800_000, 1700}, {51_400_000, 1800}, {56_300_000, 1900}, {62_200_000, 2000},
+		{68_600_000, 2100}, {77_500_000, 2200}, {89_000_000, 2300}, {103_000_000, 2400}, {125_000_000, 2500},
+		{157_000_000, 2600}, {206_000_000, 2700}, {337_000_000, 2800}, {454_000_000, 2900}, {550_000_000, 3000},
+		{695_000_000, 3100}, {910_000_000, 3200}, {1_400_000_000, 3300}, {0, 3400},
+	},
+	TERCategoryB: {
+		{6_200_000, 0}, {6_500_000, 25}, {6_850_000, 50}, {7_300_000, 75}, {9_200_000, 100},
+		{10_750_000, 150}, {11_250_000, 200}, {11_600_000, 250}, {12_600_000, 300}, {13_600_000, 400},
+		{14_950_000, 500}, {16_400_000, 600}, {18_450_000, 700}, {21_850_000, 800}, {26_000_000, 900},
+		{27_700_000, 1000}, {29_350_000, 1100}, {31_450_000, 1200}, {33_950_000, 1300}, {37_100_000, 1400},
+		{41_100_000, 1500}, {45_800_000, 1600}, {49_500_000, 1700}, {53_800_000, 1800}, {58_500_000, 1900},
+		{64_000_000, 2000}, {71_000_000, 2100}, {80_000_000, 2200}, {93_000_000, 2300}, {109_000_000, 2400},
+		{129_000_000, 2500}, {163_000_000, 2600}, {211_000_000, 2700}, {374_000_000, 2800}, {459_000_000, 2900},
+		{555_000_000, 3000}, {704_000_000, 3100}, {957_000_000, 3200}, {1_405_000_000, 3300}, {0, 3400},
+	},
+	TERCategoryC: {
+		{6_600_000, 0}, {6_950_000, 25}, {7_350_000, 50}, {7_800_000, 75}, {8_850_000, 100},
+		{9_800_000, 125}, {10_950_000, 150}, {11_200_000, 175}, {12_050_000, 200}, {12_950_000, 300},
+		{14_150_000, 400}, {15_550_000, 500}, {17_050_000, 600}, {19_500_000, 700}, {22_700_000, 800},
+		{26_600_000, 900}, {28_100_000, 1000}, {30_100_000, 1100}, {32_600_000, 1200}, {35_400_000, 1300},
+		{38_900_000, 1400}, {43_000_000, 1500}, {47_400_000, 1600}, {51_200_000, 1700}, {55_800_000, 1800},
+		{60_400_000, 1900}, {66_700_000, 2000}, {74_500_000, 2100}, {83_200_000, 2200}, {95_600_000, 2300},
+		{110_000_000, 2400}, {134_000_000, 2500}, {169_000_000, 2600}, {221_000_000, 2700}, {390_000_000, 2800},
+		{463_000_000, 2900}, {561_000_000, 3000}, {709_000_000, 3100}, {965_000_000, 3200}, {1_419_000_000, 3300},
+		{0, 3400},
+	},
+}
+
+// Valid reports whether c is a known category.
+func (c TERCategory) Valid() bool {
+	_, ok := terTables[c]
+	return ok
+}
+
+// Rate returns the effective rate for the monthly gross income in basis points
+// (hundredths of a percent), e.g. 175 for 1,75%.
+func (c TERCategory) Rate(monthlyGross int64) int64 {
+	table := terTables[c]
+	for _, b := range table {
+		if b.upTo == 0 || monthlyGross <= b.upTo {
+			return b.rate
+		}
+	}
+	return 0
+}
@@ -0,0 +1,373 @@
+package workflow_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"strings"
+	"time"
+)
+
+// Approval is one run of a workflow for a request, identified by its request type and
+// subject, e.g. the id of a leave request. It copies the steps of the definition it was
+// started from, so changing a definition does not affect approvals in progress. Steps are
+// opened one at a time with the approvers found for them; every action is kept in the
+// history.
+type Approval struct {
+	id          uuid.UUID
+	requestType string
+	subjectID   uuid.UUID
+	requesterID uuid.UUID
+	steps       []Step
+	step        int
+	status      enum.ApprovalStatus
+	assignments []Assignment
+	history     []Event
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// ID returns the unique identifier of the approval.
+func (a *Approval) ID() uuid.UUID {
+	return a.id
+}
+
+// RequestType returns the kind of request being approved.
+func (a *Approval) RequestType() string {
+	return a.requestType
+}
+
+// SubjectID returns the id of the request being approved.
+func (a *Approval) SubjectID() uuid.UUID {
+	return a.subjectID
+}
+
+// RequesterID returns the employee who made the request.
+func (a *Approval) RequesterID() uuid.UUID {
+	return a.requesterID
+}
+
+// Steps returns the steps of the workflow.
+func (a *Approval) Steps() []Step {
+	return append([]Step(nil), a.steps...)
+}
+
+// Status returns the outcome of the workflow so far.
+func (a *Approval) Status() enum.ApprovalStatus {
+	return a.status
+}
+
+// Assignments returns every assignment made, including decided ones.
+func (a *Approval) Assignments() []Assignment {
+	return append([]Assignment(nil), a.assignments...)
+}
+
+// History returns the actions taken, oldest first.
+func (a *Approval) History() []Event {
+	return append([]Event(nil), a.history...)
+}
+
+// CreatedAt returns when the request was submitted.
+func (a *Approval) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+// UpdatedAt returns when the approval was last changed.
+func (a *Approval) UpdatedAt() time.Time {
+	return a.updatedAt
+}
+
+// CurrentStep returns the open step, if any.
+func (a *Approval) CurrentStep() (Step, bool) {
+	if a.step < 0 || a.status != enum.ApprovalPending {
+		return Step{}, false
+	}
+	return a.steps[a.step], true
+}
+
+// NextStep returns the step Open starts, if any.
+func (a *Approval) NextStep() (Step, bool) {
+	if a.status != enum.ApprovalPending || a.step+1 >= len(a.steps) || (a.step >= 0 && !a.stepComplete()) {
+		return Step{}, false
+	}
+	return a.steps[a.step+1], true
+}
+
+// Pending returns the pending assignments of the open step.
+func (a *Approval) Pending() []Assignment {
+	var out []Assignment
+	for _, as := range a.assignments {
+		if as.step == a.step && as.IsPending() {
+			out = append(out, as)
+		}
+	}
+	return out
+}
+
+// IsAwaiting reports whether the approver has a pending assignment.
+func (a *Approval) IsAwaiting(approverID uuid.UUID) bool {
+	for _, as := range a.Pending() {
+		if as.approverID == approverID {
+			return true
+		}
+	}
+	return false
+}
+
+// Overdue returns the pending assignments past their due time.
+func (a *Approval) Overdue(at time.Time) []Assignment {
+	var out []Assignment
+	for _, as := range a.Pending() {
+		if as.IsOverdue(at) {
+			out = append(out, as)
+		}
+	}
+	return out
+}
+
+// Open starts the next step. candidates holds, for each approver rule of the step in
+// order, the people who may decide for it; the requester may not be among them.
+func (a *Approval) Open(candidates [][]uuid.UUID, at time.Time) error {
+	step, ok := a.NextStep()
+	if !ok {
+		return errors.New("approval has no step to open")
+	}
+	if len(candidates) != len(step.approvers) {
+		return fmt.Errorf("step %s needs approvers for %d rules", step.name, len(step.approvers))
+	}
+	for i, ids := range candidates {
+		if len(ids) == 0 {
+			return fmt.Errorf("no approver for %s in step %s", step.approvers[i], step.name)
+		}
+		for _, id := range ids {
+			if id == uuid.Nil {
+				return errors.New("approver cannot be empty")
+			}
+			if id == a.requesterID {
+				return errors.New("requester cannot approve their own request")
+			}
+		}
+	}
+
+	at = a.touch(at)
+	a.step++
+	for slot, ids := range candidates {
+		seen := map[uuid.UUID]bool{}
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				a.assignments = append(a.assignments, a.assignment(slot, id, nil, at))
+			}
+		}
+	}
+	a.log(uuid.Nil, enum.WorkflowAssigned, "", at)
+	return nil
+}
+
+// Approve records the approver's approval on each of their pending assignments. It
+// reports whether the approval completed the open step; the workflow is approved when
+// that was the last step.
+func (a *Approval) Approve(approverID uuid.UUID, note string, at time.Time) (bool, error) {
+	if err := a.checkAwaiting(approverID); err != nil {
+		return false, err
+	}
+	at = a.touch(at)
+	for i := range a.assignments {
+		if as := &a.assignments[i]; as.step == a.step && as.IsPending() && as.approverID == approverID {
+			a.close(i, enum.WorkflowApproved)
+			a.closeSlot(as.slot)
+		}
+	}
+	a.log(approverID, enum.WorkflowApproved, note, at)
+
+	if !a.stepComplete() {
+		return false, nil
+	}
+	a.closePending()
+	if a.step == len(a.steps)-1 {
+		a.status = enum.ApprovalApproved
+	}
+	return true, nil
+}
+
+// Reject rejects the request on behalf of the approver, which ends the workflow.
+func (a *Approval) Reject(approverID uuid.UUID, note string, at time.Time) error {
+	if err := a.checkAwaiting(approverID); err != nil {
+		return err
+	}
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return errors.New("rejection note cannot be empty")
+	}
+	at = a.touch(at)
+	for i := range a.assignments {
+		if as := a.assignments[i]; as.step == a.step && as.IsPending() && as.approverID == approverID {
+			a.close(i, enum.WorkflowRejected)
+		}
+	}
+	a.closePending()
+	a.status = enum.ApprovalRejected
+	a.log(approverID, enum.WorkflowRejected, note, at)
+	return nil
+}
+
+// Delegate hands the approver's pending assignments to someone else, who decides on
+// their behalf with the same due time.
+func (a *Approval) Delegate(approverID, delegateID uuid.UUID, note string, at time.Time) error {
+	return a.reassign(approverID, delegateID, enum.WorkflowDelegated, note, at)
+}
+
+// Escalate moves the approver's overdue assignments to the given person, usually the
+// approver's manager, who gets a fresh timeout.
+func (a *Approval) Escalate(approverID, toID uuid.UUID, at time.Time) error {
+	if at.IsZero() {
+		at = time.Now()
+	}
+	overdue := false
+	for _, as := range a.Overdue(at) {
+		overdue = overdue || as.approverID == approverID
+	}
+	if !overdue {
+		return errors.New("approver has no overdue decision")
+	}
+	return a.reassign(approverID, toID, enum.WorkflowEscalated, "", at)
+}
+
+// Cancel withdraws a pending request; only the requester may do so.
+func (a *Approval) Cancel(requesterID uuid.UUID, note string, at time.Time) error {
+	if a.status != enum.ApprovalPending {
+		return errors.New("approval is not pending")
+	}
+	if requesterID != a.requesterID {
+		return errors.New("only the requester can cancel the request")
+	}
+	at = a.touch(at)
+	a.closePending()
+	a.status = enum.ApprovalCancelled
+	a.log(requesterID, enum.WorkflowCancelled, strings.TrimSpace(note), at)
+	return nil
+}
+
+func (a *Approval) reassign(approverID, toID uuid.UUID, action enum.WorkflowAction, note string, at time.Time) error {
+	if err := a.checkAwaiting(approverID); err != nil {
+		return err
+	}
+	if toID == uuid.Nil {
+		return errors.New("approver cannot be empty")
+	}
+	if toID == approverID {
+		return errors.New("cannot reassign a decision to the same approver")
+	}
+	if toID == a.requesterID {
+		return errors.New("requester cannot approve their own request")
+	}
+	at = a.touch(at)
+	for i := range a.assignments {
+		as := a.assignments[i]
+		if as.step != a.step || !as.IsPending() || as.approverID != approverID {
+			continue
+		}
+		a.close(i, action)
+		if a.slotHas(as.slot, toID) {
+			continue
+		}
+		original := as.approverID
+		if as.onBehalfOf != nil {
+			original = *as.onBehalfOf
+		}
+		next := a.assignment(as.slot, toID, &original, at)
+		if action == enum.WorkflowDelegated {
+			next.dueAt = as.dueAt
+		}
+		a.assignments = append(a.assignments, next)
+	}
+	actorID := approverID
+	if action == enum.WorkflowEscalated {
+		actorID = uuid.Nil
+	}
+	a.log(actorID, action, strings.TrimSpace(note), at)
+	a.history[len(a.history)-1].target = &toID
+	return nil
+}
+
+func (a *Approval) checkAwaiting(approverID uuid.UUID) error {
+	if a.status != enum.ApprovalPending {
+		return errors.New("approval is not pending")
+	}
+	if !a.IsAwaiting(approverID) {
+		return errors.New("approver has no pending decision")
+	}
+	return nil
+}
+
+// stepComplete reports whether the open step has the approvals its mode requires.
+func (a *Approval) stepComplete() bool {
+	approved := map[int]bool{}
+	for _, as := range a.assignments {
+		if as.step == a.step && as.outcome != nil && *as.outcome == enum.WorkflowApproved {
+			approved[as.slot] = true
+		}
+	}
+	if a.steps[a.step].mode == enum.ApprovalAny {
+		return len(approved) > 0
+	}
+	return len(approved) == len(a.steps[a.step].approvers)
+}
+
+// slotHas reports whether the person has a pending assignment in the slot of the open step.
+func (a *Approval) slotHas(slot int, approverID uuid.UUID) bool {
+	for _, as := range a.Pending() {
+		if as.slot == slot && as.approverID == approverID {
+			return true
+		}
+	}
+	return false
+}
+
+// closeSlot withdraws the other pending assignments of a decided slot.
+func (a *Approval) closeSlot(slot int) {
+	for i := range a.assignments {
+		if as := a.assignments[i]; as.step == a.step && as.slot == slot && as.IsPending() {
+			a.close(i, enum.WorkflowCancelled)
+		}
+	}
+}
+
+// closePending withdraws every pending assignment of the open step.
+func (a *Approval) closePending() {
+	for i := range a.assignments {
+		if as := a.assignments[i]; as.step == a.step && as.IsPending() {
+			a.close(i, enum.WorkflowCancelled)
+		}
+	}
+}
+
+func (a *Approval) close(i int, outcome enum.WorkflowAction) {
+	a.assignments[i].outcome = &outcome
+}
+
+func (a *Approval) assignment(slot int, approverID uuid.UUID, onBehalfOf *uuid.UUID, at time.Time) Assignment {
+	as := Assignment{step: a.step, slot: slot, approverID: approverID, onBehalfOf: onBehalfOf, assignedAt: at}
+	if timeout := a.steps[a.step].timeout; timeout > 0 {
+		due := at.Add(timeout)
+		as.dueAt = &due
+	}
+	return as
+}
+
+func (a *Approval) log(actorID uuid.UUID, action enum.WorkflowAction, note string, at time.Time) {
+	var step string
+	if a.step >= 0 {
+		step = a.steps[a.step].name
+	}
+	a.history = append(a.history, Event{at: at, actorID: actorID, action: action, step: step, note: note})
+}
+
+func (a *Approval) touch(at time.Time) time.Time {
+	if at.IsZero() {
+		at = time.Now()
+	}
+	a.updatedAt = at
+	return at
+}
@@ -0,0 +1,58 @@
+package workflow_entity
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// ApprovalFactory is a factory type for starting Approvals from a workflow Definition.
+type ApprovalFactory struct {
+	ID          string
+	SubjectID   string
+	RequesterID string
+	Definition  *Definition
+	CreatedAt   time.Time
+}
+
+// Create validates the factory data and returns a pending Approval whose first step is
+// not opened yet.
+func (f ApprovalFactory) Create() (*Approval, error) {
+	id, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+
+	subjectID, err := uuid.Parse(f.SubjectID)
+	if err != nil {
+		return nil, errors.New("invalid subject id")
+	}
+
+	requesterID, err := uuid.Parse(f.RequesterID)
+	if err != nil {
+		return nil, errors.New("invalid requester id")
+	}
+
+	if f.Definition == nil || len(f.Definition.steps) == 0 {
+		return nil, errors.New("workflow definition cannot be empty")
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	a := &Approval{
+		id:          id,
+		requestType: f.Definition.requestType,
+		subjectID:   subjectID,
+		requesterID: requesterID,
+		steps:       f.Definition.Steps(),
+		step:        -1,
+		status:      enum.ApprovalPending,
+		createdAt:   f.CreatedAt,
+		updatedAt:   f.CreatedAt,
+	}
+	a.log(requesterID, enum.WorkflowSubmitted, "", f.CreatedAt)
+	return a, nil
+}
@@ -0,0 +1,182 @@
+package workflow_entity_test
+
+import (
+	"github.com/google/uuid"
+	workflow_entity "github.com/rfanazhari/hris/domain/entity/workflow"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func approver(t *testing.T, rule enum.ApproverRule, role string) workflow_entity.Approver {
+	a, err := workflow_entity.NewApprover(rule, role)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return *a
+}
+
+func step(t *testing.T, name string, mode enum.ApprovalMode, timeout time.Duration, approvers ...workflow_entity.Approver) workflow_entity.Step {
+	s, err := workflow_entity.NewStep(name, mode, timeout, approvers...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return *s
+}
+
+// newApproval starts a two-step approval: the manager and HR decide in parallel, then
+// finance (any of its holders) within a day.
+func newApproval(t *testing.T, requesterID uuid.UUID) *workflow_entity.Approval {
+	definition, err := workflow_entity.DefinitionFactory{RequestType: "Reimbursement", Steps: []workflow_entity.Step{
+		step(t, "Review", enum.ApprovalAll, 0, approver(t, enum.ApproverDirectManager, ""), approver(t, enum.ApproverRole, "hr_admin")),
+		step(t, "Finance", enum.ApprovalAny, 24*time.Hour, approver(t, enum.ApproverRole, "finance")),
+	}}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	approval, err := workflow_entity.ApprovalFactory{ID: uuid.NewString(), SubjectID: uuid.NewString(), RequesterID: requesterID.String(), Definition: definition}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return approval
+}
+
+func TestDefinitionFactory_Create(t *testing.T) {
+	_, err := workflow_entity.NewApprover(enum.ApproverRole, " ")
+	assert.EqualError(t, err, "approver role cannot be empty")
+	_, err = workflow_entity.NewApprover(enum.ApproverUnitHead, "hr")
+	assert.EqualError(t, err, "approver role applies to role rules only")
+	_, err = workflow_entity.NewStep("Manager", enum.ApprovalAll, 0)
+	assert.EqualError(t, err, "step needs at least one approver")
+	manager := approver(t, enum.ApproverDirectManager, "")
+	_, err = workflow_entity.NewStep("Manager", enum.ApprovalAll, 0, manager, manager)
+	assert.EqualError(t, err, "duplicate approver direct_manager")
+
+	_, err = workflow_entity.DefinitionFactory{RequestType: "leave request"}.Create()
+	assert.EqualError(t, err, `invalid request type: "leave request"`)
+	_, err = workflow_entity.DefinitionFactory{RequestType: "leave"}.Create()
+	assert.EqualError(t, err, "workflow needs at least one step")
+	s := step(t, "Manager", enum.ApprovalAll, 0, manager)
+	_, err = workflow_entity.DefinitionFactory{RequestType: "leave", Steps: []workflow_entity.Step{s, s}}.Create()
+	assert.EqualError(t, err, "duplicate step Manager")
+
+	definition, err := workflow_entity.DefinitionFactory{RequestType: " Leave ", Steps: []workflow_entity.Step{s}}.Create()
+	assert.Nil(t, err)
+	assert.Equal(t, "leave", definition.RequestType())
+}
+
+func TestApproval_Steps(t *testing.T) {
+	at := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	requester, manager, hr1, hr2, finance := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	t.Run("ParallelThenSequential", func(t *testing.T) {
+		approval := newApproval(t, requester)
+		assert.Equal(t, "reimbursement", approval.RequestType())
+		assert.EqualError(t, approval.Open([][]uuid.UUID{{manager}}, at), "step Review needs approvers for 2 rules")
+		assert.EqualError(t, approval.Open([][]uuid.UUID{{manager}, {}}, at), "no approver for role:hr_admin in step Review")
+		assert.EqualError(t, approval.Open([][]uuid.UUID{{requester}, {hr1}}, at), "requester cannot approve their own request")
+		assert.Nil(t, approval.Open([][]uuid.UUID{{manager}, {hr1, hr2}}, at))
+		assert.Len(t, approval.Pending(), 3)
+		assert.Nil(t, approval.Pending()[0].DueAt())
+		_, ok := approval.NextStep()
+		assert.False(t, ok)
+
+		completed, err := approval.Approve(hr2, "", at)
+		assert.Nil(t, err)
+		assert.False(t, completed)
+		assert.False(t, approval.IsAwaiting(hr1))
+		_, err = approval.Approve(hr1, "", at)
+		assert.EqualError(t, err, "approver has no pending decision")
+
+		completed, err = approval.Approve(manager, "ok", at)
+		assert.Nil(t, err)
+		assert.True(t, completed)
+		assert.Equal(t, enum.ApprovalPending, approval.Status())
+		next, ok := approval.NextStep()
+		assert.True(t, ok)
+		assert.Equal(t, "Finance", next.Name())
+
+		assert.Nil(t, approval.Open([][]uuid.UUID{{finance, hr1}}, at.Add(time.Hour)))
+		assert.Equal(t, at.Add(25*time.Hour), *approval.Pending()[0].DueAt())
+		completed, err = approval.Approve(finance, "", at.Add(2*time.Hour))
+		assert.Nil(t, err)
+		assert.True(t, completed)
+		assert.Equal(t, enum.ApprovalApproved, approval.Status())
+		assert.Empty(t, approval.Pending())
+
+		var actions []enum.WorkflowAction
+		for _, e := range approval.History() {
+			actions = append(actions, e.Action())
+		}
+		assert.Equal(t, []enum.WorkflowAction{
+			enum.WorkflowSubmitted, enum.WorkflowAssigned, enum.WorkflowApproved, enum.WorkflowApproved,
+			enum.WorkflowAssigned, enum.WorkflowApproved,
+		}, actions)
+		assert.Equal(t, "Review", approval.History()[3].Step())
+		assert.Equal(t, "ok", approval.History()[3].Note())
+	})
+	t.Run("Reject", func(t *testing.T) {
+		approval := newApproval(t, requester)
+		_ = approval.Open([][]uuid.UUID{{manager}, {hr1}}, at)
+
+		assert.EqualError(t, approval.Reject(manager, "", at), "rejection note cannot be empty")
+		assert.Nil(t, approval.Reject(manager, "budget", at))
+		assert.Equal(t, enum.ApprovalRejected, approval.Status())
+		assert.Equal(t, enum.WorkflowCancelled, *approval.Assignments()[1].Outcome())
+		_, err := approval.Approve(hr1, "", at)
+		assert.EqualError(t, err, "approval is not pending")
+	})
+	t.Run("DelegateAndEscalate", func(t *testing.T) {
+		approval := newApproval(t, requester)
+		_ = approval.Open([][]uuid.UUID{{manager}, {hr1}}, at)
+		deputy, boss := uuid.New(), uuid.New()
+
+		assert.EqualError(t, approval.Delegate(manager, requester, "", at), "requester cannot approve their own request")
+		assert.Nil(t, approval.Delegate(manager, deputy, "cuti", at))
+		assert.True(t, approval.IsAwaiting(deputy))
+		assert.Equal(t, manager, *approval.Pending()[1].OnBehalfOf())
+		assert.Equal(t, deputy, *approval.History()[2].Target())
+		_, _ = approval.Approve(deputy, "", at)
+		_, _ = approval.Approve(hr1, "", at)
+
+		_ = approval.Open([][]uuid.UUID{{finance}}, at)
+		assert.EqualError(t, approval.Escalate(finance, boss, at.Add(time.Hour)), "approver has no overdue decision")
+		assert.Len(t, approval.Overdue(at.Add(25*time.Hour)), 1)
+		assert.Nil(t, approval.Escalate(finance, boss, at.Add(25*time.Hour)))
+		pending := approval.Pending()
+		assert.Len(t, pending, 1)
+		assert.Equal(t, boss, pending[0].ApproverID())
+		assert.Equal(t, at.Add(49*time.Hour), *pending[0].DueAt())
+
+		_, err := approval.Approve(boss, "", at.Add(26*time.Hour))
+		assert.Nil(t, err)
+		assert.Equal(t, enum.ApprovalApproved, approval.Status())
+	})
+	t.Run("Cancel", func(t *testing.T) {
+		approval := newApproval(t, requester)
+		_ = approval.Open([][]uuid.UUID{{manager}, {hr1}}, at)
+
+		assert.EqualError(t, approval.Cancel(manager, "", at), "only the requester can cancel the request")
+		assert.Nil(t, approval.Cancel(requester, "tidak jadi", at))
+		assert.Equal(t, enum.ApprovalCancelled, approval.Status())
+		assert.False(t, approval.IsAwaiting(manager))
+	})
+}
+
+func TestDelegation_Covers(t *testing.T) {
+	d, err := workflow_entity.DelegationFactory{
+		ID: uuid.NewString(), DelegatorID: uuid.NewString(), DelegateID: uuid.NewString(),
+		StartDate: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC),
+		RequestTypes: []string{"Leave"},
+	}.Create()
+	assert.Nil(t, err)
+
+	assert.True(t, d.Covers("leave", time.Date(2025, 4, 10, 17, 0, 0, 0, time.UTC)))
+	assert.False(t, d.Covers("leave", time.Date(2025, 4, 11, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, d.Covers("overtime", time.Date(2025, 4, 5, 0, 0, 0, 0, time.UTC)))
+
+	id := uuid.NewString()
+	_, err = workflow_entity.DelegationFactory{ID: uuid.NewString(), DelegatorID: id, DelegateID: id}.Create()
+	assert.EqualError(t, err, "approver cannot delegate to themselves")
+}
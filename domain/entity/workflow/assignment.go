@@ -0,0 +1,78 @@
+package workflow_entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+// Assignment asks an approver to decide on one approver rule of a step. Several
+// assignments share a slot when a rule finds several people, e.g. every holder of an HR
+// role; the first of them to decide decides for the slot.
+type Assignment struct {
+	step       int
+	slot       int
+	approverID uuid.UUID
+	onBehalfOf *uuid.UUID
+	assignedAt time.Time
+	dueAt      *time.Time
+	outcome    *enum.WorkflowAction
+}
+
+// Step returns the index of the step the assignment belongs to.
+func (a Assignment) Step() int { return a.step }
+
+// Slot returns the index of the approver rule within the step.
+func (a Assignment) Slot() int { return a.slot }
+
+// ApproverID returns the person asked to decide.
+func (a Assignment) ApproverID() uuid.UUID { return a.approverID }
+
+// OnBehalfOf returns the original approver when the decision was delegated or escalated.
+func (a Assignment) OnBehalfOf() *uuid.UUID { return a.onBehalfOf }
+
+// AssignedAt returns when the approver was asked.
+func (a Assignment) AssignedAt() time.Time { return a.assignedAt }
+
+// DueAt returns when the decision is escalated, nil when the step has no timeout.
+func (a Assignment) DueAt() *time.Time { return a.dueAt }
+
+// Outcome returns what happened to the assignment, nil while it is pending.
+func (a Assignment) Outcome() *enum.WorkflowAction { return a.outcome }
+
+// IsPending reports whether the approver has yet to act.
+func (a Assignment) IsPending() bool { return a.outcome == nil }
+
+// IsOverdue reports whether the assignment is pending past its due time.
+func (a Assignment) IsOverdue(at time.Time) bool {
+	return a.IsPending() && a.dueAt != nil && at.After(*a.dueAt)
+}
+
+// Event is an entry of an approval's decision history. The actor is uuid.Nil for
+// actions taken by the system, such as assigning approvers or escalating.
+type Event struct {
+	at      time.Time
+	actorID uuid.UUID
+	action  enum.WorkflowAction
+	step    string
+	note    string
+	target  *uuid.UUID
+}
+
+// At returns when the action happened.
+func (e Event) At() time.Time { return e.at }
+
+// ActorID returns who acted, uuid.Nil for the system.
+func (e Event) ActorID() uuid.UUID { return e.actorID }
+
+// Action returns what happened.
+func (e Event) Action() enum.WorkflowAction { return e.action }
+
+// Step returns the name of the step acted on, empty for submission.
+func (e Event) Step() string { return e.step }
+
+// Note returns the comment given with the action.
+func (e Event) Note() string { return e.note }
+
+// Target returns who a decision was delegated or escalated to.
+func (e Event) Target() *uuid.UUID { return e.target }
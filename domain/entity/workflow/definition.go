@@ -0,0 +1,118 @@
+package workflow_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rfanazhari/hris/domain/enum"
+	"strings"
+	"time"
+)
+
+// Approver is a rule that finds the approvers of a step. Role rules name the HR role
+// whose holders may decide.
+type Approver struct {
+	rule enum.ApproverRule
+	role string
+}
+
+// NewApprover constructs an Approver. A role is required for role rules and not allowed
+// for the others.
+func NewApprover(rule enum.ApproverRule, role string) (*Approver, error) {
+	if !rule.Valid() {
+		return nil, fmt.Errorf("invalid ApproverRule: %q", rule)
+	}
+	role = strings.ToLower(strings.TrimSpace(role))
+	if rule == enum.ApproverRole && role == "" {
+		return nil, errors.New("approver role cannot be empty")
+	}
+	if rule != enum.ApproverRole && role != "" {
+		return nil, errors.New("approver role applies to role rules only")
+	}
+	return &Approver{rule: rule, role: role}, nil
+}
+
+// Rule returns how the approvers are found.
+func (a Approver) Rule() enum.ApproverRule { return a.rule }
+
+// Role returns the HR role of role rules.
+func (a Approver) Role() string { return a.role }
+
+// String returns the rule, with the role of role rules, e.g. "role:hr_admin".
+func (a Approver) String() string {
+	if a.rule == enum.ApproverRole {
+		return string(a.rule) + ":" + a.role
+	}
+	return string(a.rule)
+}
+
+// Step is one stage of a workflow. Its approvers decide in parallel: in ApprovalAll mode
+// each of them must approve, in ApprovalAny mode the first approval completes the step.
+// A decision still pending after the timeout is escalated; a zero timeout never escalates.
+type Step struct {
+	name      string
+	approvers []Approver
+	mode      enum.ApprovalMode
+	timeout   time.Duration
+}
+
+// NewStep constructs a Step with at least one approver.
+func NewStep(name string, mode enum.ApprovalMode, timeout time.Duration, approvers ...Approver) (*Step, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("step name cannot be empty")
+	}
+	if !mode.Valid() {
+		return nil, fmt.Errorf("invalid ApprovalMode: %q", mode)
+	}
+	if timeout < 0 {
+		return nil, errors.New("step timeout cannot be negative")
+	}
+	if len(approvers) == 0 {
+		return nil, errors.New("step needs at least one approver")
+	}
+	seen := map[string]bool{}
+	for _, a := range approvers {
+		if !a.rule.Valid() {
+			return nil, errors.New("invalid approver")
+		}
+		if seen[a.String()] {
+			return nil, fmt.Errorf("duplicate approver %s", a)
+		}
+		seen[a.String()] = true
+	}
+	return &Step{name: name, approvers: append([]Approver(nil), approvers...), mode: mode, timeout: timeout}, nil
+}
+
+// Name returns the name of the step, e.g. "Manager".
+func (s Step) Name() string { return s.name }
+
+// Approvers returns the approver rules deciding in parallel.
+func (s Step) Approvers() []Approver { return append([]Approver(nil), s.approvers...) }
+
+// Mode returns whether every approver or any approver must approve.
+func (s Step) Mode() enum.ApprovalMode { return s.mode }
+
+// Timeout returns how long a decision may stay pending before it is escalated.
+func (s Step) Timeout() time.Duration { return s.timeout }
+
+// Definition is the approval workflow of a request type: steps that run one after another.
+type Definition struct {
+	requestType string
+	steps       []Step
+	createdAt   time.Time
+}
+
+// RequestType returns the kind of request the workflow approves, e.g. "leave".
+func (d *Definition) RequestType() string {
+	return d.requestType
+}
+
+// Steps returns the steps in the order they run.
+func (d *Definition) Steps() []Step {
+	return append([]Step(nil), d.steps...)
+}
+
+// CreatedAt returns when the definition was created.
+func (d *Definition) CreatedAt() time.Time {
+	return d.createdAt
+}
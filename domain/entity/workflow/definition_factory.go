@@ -0,0 +1,50 @@
+package workflow_entity
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// requestTypePattern matches request types such as "leave" or "data_change".
+var requestTypePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,39}$`)
+
+// DefinitionFactory is a factory type for creating workflow Definitions.
+type DefinitionFactory struct {
+	RequestType string
+	Steps       []Step
+	CreatedAt   time.Time
+}
+
+// Create validates the factory data and returns a Definition. Step names must be unique.
+func (f DefinitionFactory) Create() (*Definition, error) {
+	requestType := strings.ToLower(strings.TrimSpace(f.RequestType))
+	if !requestTypePattern.MatchString(requestType) {
+		return nil, fmt.Errorf("invalid request type: %q", f.RequestType)
+	}
+	if len(f.Steps) == 0 {
+		return nil, errors.New("workflow needs at least one step")
+	}
+	seen := map[string]bool{}
+	for _, s := range f.Steps {
+		if len(s.approvers) == 0 {
+			return nil, errors.New("invalid step")
+		}
+		if seen[strings.ToLower(s.name)] {
+			return nil, fmt.Errorf("duplicate step %s", s.name)
+		}
+		seen[strings.ToLower(s.name)] = true
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	return &Definition{
+		requestType: requestType,
+		steps:       append([]Step(nil), f.Steps...),
+		createdAt:   f.CreatedAt,
+	}, nil
+}
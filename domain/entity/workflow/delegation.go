@@ -0,0 +1,72 @@
+package workflow_entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Delegation lets a delegate decide in place of an approver between two dates, e.g.
+// while the approver is on leave. Request types limit it to those kinds of requests;
+// without them it covers every request.
+type Delegation struct {
+	id           uuid.UUID
+	delegatorID  uuid.UUID
+	delegateID   uuid.UUID
+	startDate    time.Time
+	endDate      time.Time
+	requestTypes []string
+	createdAt    time.Time
+}
+
+// ID returns the unique identifier of the delegation.
+func (d *Delegation) ID() uuid.UUID {
+	return d.id
+}
+
+// DelegatorID returns the approver being replaced.
+func (d *Delegation) DelegatorID() uuid.UUID {
+	return d.delegatorID
+}
+
+// DelegateID returns the person deciding instead.
+func (d *Delegation) DelegateID() uuid.UUID {
+	return d.delegateID
+}
+
+// StartDate returns the first day of the delegation.
+func (d *Delegation) StartDate() time.Time {
+	return d.startDate
+}
+
+// EndDate returns the last day of the delegation (inclusive).
+func (d *Delegation) EndDate() time.Time {
+	return d.endDate
+}
+
+// RequestTypes returns the request types covered, empty for all.
+func (d *Delegation) RequestTypes() []string {
+	return append([]string(nil), d.requestTypes...)
+}
+
+// CreatedAt returns when the delegation was created.
+func (d *Delegation) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+// Covers reports whether the delegation applies to the request type on the calendar
+// date of at.
+func (d *Delegation) Covers(requestType string, at time.Time) bool {
+	day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+	if day.Before(d.startDate) || day.After(d.endDate) {
+		return false
+	}
+	if len(d.requestTypes) == 0 {
+		return true
+	}
+	for _, t := range d.requestTypes {
+		if t == requestType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package workflow_entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+// DelegationFactory is a factory type for creating Delegations.
+type DelegationFactory struct {
+	ID           string
+	DelegatorID  string
+	DelegateID   string
+	StartDate    time.Time
+	EndDate      time.Time
+	RequestTypes []string
+	CreatedAt    time.Time
+}
+
+// Create validates the factory data and returns a Delegation.
+func (f DelegationFactory) Create() (*Delegation, error) {
+	id, err := uuid.Parse(f.ID)
+	if err != nil {
+		return nil, errors.New("invalid format uuid")
+	}
+	delegatorID, err := uuid.Parse(f.DelegatorID)
+	if err != nil {
+		return nil, errors.New("invalid delegator id")
+	}
+	delegateID, err := uuid.Parse(f.DelegateID)
+	if err != nil {
+		return nil, errors.New("invalid delegate id")
+	}
+	if delegatorID == delegateID {
+		return nil, errors.New("approver cannot delegate to themselves")
+	}
+
+	if f.StartDate.IsZero() || f.EndDate.IsZero() {
+		return nil, errors.New("delegation dates cannot be empty")
+	}
+	start := time.Date(f.StartDate.Year(), f.StartDate.Month(), f.StartDate.Day(), 0, 0, 0, 0, time.UTC)
+	end := time.Date(f.EndDate.Year(), f.EndDate.Month(), f.EndDate.Day(), 0, 0, 0, 0, time.UTC)
+	if end.Before(start) {
+		return nil, errors.New("delegation end date cannot be before start date")
+	}
+
+	var requestTypes []string
+	for _, t := range f.RequestTypes {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if !requestTypePattern.MatchString(t) {
+			return nil, fmt.Errorf("invalid request type: %q", t)
+		}
+		requestTypes = append(requestTypes, t)
+	}
+
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	return &Delegation{
+		id:           id,
+		delegatorID:  delegatorID,
+		delegateID:   delegateID,
+		startDate:    start,
+		endDate:      end,
+		requestTypes: requestTypes,
+		createdAt:    f.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ApprovalMode represents how the parallel approvers of a workflow step decide.
+// Allowed values (string representation):
+// - "all"  // every approver must approve
+// - "any"  // the first approval completes the step
+// Use ParseApprovalMode to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type ApprovalMode string
+
+const (
+	ApprovalAll ApprovalMode = "all"
+	ApprovalAny ApprovalMode = "any"
+)
+
+func (am ApprovalMode) Valid() bool {
+	switch am {
+	case ApprovalAll, ApprovalAny:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseApprovalMode(s string) (ApprovalMode, error) {
+	v := ApprovalMode(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid ApprovalMode: %q", s)
+	}
+	return v, nil
+}
+
+func (am ApprovalMode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(am))
+}
+
+func (am *ApprovalMode) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseApprovalMode(s)
+	if err != nil {
+		return err
+	}
+	*am = v
+	return nil
+}
+
+func (am ApprovalMode) Value() (driver.Value, error) {
+	if !am.Valid() {
+		return nil, fmt.Errorf("invalid ApprovalMode: %q", am)
+	}
+	return string(am), nil
+}
+
+func (am *ApprovalMode) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseApprovalMode(v)
+		if err != nil {
+			return err
+		}
+		*am = parsed
+		return nil
+	case []byte:
+		return am.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for ApprovalMode: %T", src)
+	}
+}
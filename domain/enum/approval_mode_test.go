@@ -0,0 +1,151 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestApprovalMode_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.ApprovalMode
+		valid bool
+	}{
+		{"all valid", enum.ApprovalAll, true},
+		{"any valid", enum.ApprovalAny, true},
+		{"invalid value", enum.ApprovalMode("unknown"), false},
+		{"empty value", enum.ApprovalMode(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseApprovalMode(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.ApprovalMode
+		wantErr bool
+		name    string
+	}{
+		{"ALL", enum.ApprovalAll, false, "upper all"},
+		{" any ", enum.ApprovalAny, false, "trimmed any"},
+		{"most", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseApprovalMode(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApprovalMode_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.ApprovalAny
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"any\"" {
+		t.Fatalf("Marshal got %s, want \"any\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.ApprovalMode
+	if err := json.Unmarshal([]byte("\" All \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.ApprovalAll {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.ApprovalAll)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.ApprovalMode
+	if err := json.Unmarshal([]byte("\"most\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid approval mode, got nil")
+	}
+}
+
+func TestApprovalMode_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.ApprovalAll.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "all" {
+		t.Fatalf("Value() got %#v, want 'all' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.ApprovalMode = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestApprovalMode_Scan(t *testing.T) {
+	// From string
+	var s1 enum.ApprovalMode
+	if err := s1.Scan("any"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.ApprovalAny {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.ApprovalAny)
+	}
+
+	// From []byte
+	var s2 enum.ApprovalMode
+	if err := s2.Scan([]byte("all")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.ApprovalAll {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.ApprovalAll)
+	}
+
+	// Invalid string value
+	var s3 enum.ApprovalMode
+	if err := s3.Scan("most"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.ApprovalMode
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestApprovalMode_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.ApprovalMode
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
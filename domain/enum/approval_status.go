@@ -0,0 +1,82 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ApprovalStatus represents the outcome of an approval workflow.
+// Allowed values (string representation):
+// - "pending"    // waiting for the approvers of the current step
+// - "approved"   // every step approved
+// - "rejected"   // rejected by an approver
+// - "cancelled"  // withdrawn by the requester
+// Use ParseApprovalStatus to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type ApprovalStatus string
+
+const (
+	ApprovalPending   ApprovalStatus = "pending"
+	ApprovalApproved  ApprovalStatus = "approved"
+	ApprovalRejected  ApprovalStatus = "rejected"
+	ApprovalCancelled ApprovalStatus = "cancelled"
+)
+
+func (as ApprovalStatus) Valid() bool {
+	switch as {
+	case ApprovalPending, ApprovalApproved, ApprovalRejected, ApprovalCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseApprovalStatus(s string) (ApprovalStatus, error) {
+	v := ApprovalStatus(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid ApprovalStatus: %q", s)
+	}
+	return v, nil
+}
+
+func (as ApprovalStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(as))
+}
+
+func (as *ApprovalStatus) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseApprovalStatus(s)
+	if err != nil {
+		return err
+	}
+	*as = v
+	return nil
+}
+
+func (as ApprovalStatus) Value() (driver.Value, error) {
+	if !as.Valid() {
+		return nil, fmt.Errorf("invalid ApprovalStatus: %q", as)
+	}
+	return string(as), nil
+}
+
+func (as *ApprovalStatus) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseApprovalStatus(v)
+		if err != nil {
+			return err
+		}
+		*as = parsed
+		return nil
+	case []byte:
+		return as.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for ApprovalStatus: %T", src)
+	}
+}
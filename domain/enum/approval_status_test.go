@@ -0,0 +1,154 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestApprovalStatus_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.ApprovalStatus
+		valid bool
+	}{
+		{"pending valid", enum.ApprovalPending, true},
+		{"approved valid", enum.ApprovalApproved, true},
+		{"rejected valid", enum.ApprovalRejected, true},
+		{"cancelled valid", enum.ApprovalCancelled, true},
+		{"invalid value", enum.ApprovalStatus("unknown"), false},
+		{"empty value", enum.ApprovalStatus(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseApprovalStatus(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.ApprovalStatus
+		wantErr bool
+		name    string
+	}{
+		{"PENDING", enum.ApprovalPending, false, "upper pending"},
+		{" approved ", enum.ApprovalApproved, false, "trimmed approved"},
+		{"Rejected", enum.ApprovalRejected, false, "mixed rejected"},
+		{"done", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseApprovalStatus(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApprovalStatus_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.ApprovalCancelled
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"cancelled\"" {
+		t.Fatalf("Marshal got %s, want \"cancelled\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.ApprovalStatus
+	if err := json.Unmarshal([]byte("\" APPROVED \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.ApprovalApproved {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.ApprovalApproved)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.ApprovalStatus
+	if err := json.Unmarshal([]byte("\"done\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid approval status, got nil")
+	}
+}
+
+func TestApprovalStatus_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.ApprovalPending.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "pending" {
+		t.Fatalf("Value() got %#v, want 'pending' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.ApprovalStatus = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestApprovalStatus_Scan(t *testing.T) {
+	// From string
+	var s1 enum.ApprovalStatus
+	if err := s1.Scan("rejected"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.ApprovalRejected {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.ApprovalRejected)
+	}
+
+	// From []byte
+	var s2 enum.ApprovalStatus
+	if err := s2.Scan([]byte("pending")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.ApprovalPending {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.ApprovalPending)
+	}
+
+	// Invalid string value
+	var s3 enum.ApprovalStatus
+	if err := s3.Scan("done"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.ApprovalStatus
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestApprovalStatus_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.ApprovalStatus
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
@@ -0,0 +1,80 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ApproverRule represents how the approvers of a workflow step are found.
+// Allowed values (string representation):
+// - "direct_manager"  // the requester's direct manager
+// - "unit_head"       // the head of the requester's organization unit
+// - "role"            // any holder of an HR role, e.g. hr_admin
+// Use ParseApproverRule to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type ApproverRule string
+
+const (
+	ApproverDirectManager ApproverRule = "direct_manager"
+	ApproverUnitHead      ApproverRule = "unit_head"
+	ApproverRole          ApproverRule = "role"
+)
+
+func (ar ApproverRule) Valid() bool {
+	switch ar {
+	case ApproverDirectManager, ApproverUnitHead, ApproverRole:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseApproverRule(s string) (ApproverRule, error) {
+	v := ApproverRule(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid ApproverRule: %q", s)
+	}
+	return v, nil
+}
+
+func (ar ApproverRule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(ar))
+}
+
+func (ar *ApproverRule) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseApproverRule(s)
+	if err != nil {
+		return err
+	}
+	*ar = v
+	return nil
+}
+
+func (ar ApproverRule) Value() (driver.Value, error) {
+	if !ar.Valid() {
+		return nil, fmt.Errorf("invalid ApproverRule: %q", ar)
+	}
+	return string(ar), nil
+}
+
+func (ar *ApproverRule) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseApproverRule(v)
+		if err != nil {
+			return err
+		}
+		*ar = parsed
+		return nil
+	case []byte:
+		return ar.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for ApproverRule: %T", src)
+	}
+}
@@ -0,0 +1,153 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestApproverRule_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.ApproverRule
+		valid bool
+	}{
+		{"direct_manager valid", enum.ApproverDirectManager, true},
+		{"unit_head valid", enum.ApproverUnitHead, true},
+		{"role valid", enum.ApproverRole, true},
+		{"invalid value", enum.ApproverRule("unknown"), false},
+		{"empty value", enum.ApproverRule(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseApproverRule(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.ApproverRule
+		wantErr bool
+		name    string
+	}{
+		{"DIRECT_MANAGER", enum.ApproverDirectManager, false, "upper direct manager"},
+		{" unit_head ", enum.ApproverUnitHead, false, "trimmed unit head"},
+		{"Role", enum.ApproverRole, false, "mixed role"},
+		{"ceo", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseApproverRule(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApproverRule_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.ApproverUnitHead
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"unit_head\"" {
+		t.Fatalf("Marshal got %s, want \"unit_head\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.ApproverRule
+	if err := json.Unmarshal([]byte("\" ROLE \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.ApproverRole {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.ApproverRole)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.ApproverRule
+	if err := json.Unmarshal([]byte("\"ceo\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid approver rule, got nil")
+	}
+}
+
+func TestApproverRule_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.ApproverDirectManager.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "direct_manager" {
+		t.Fatalf("Value() got %#v, want 'direct_manager' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.ApproverRule = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestApproverRule_Scan(t *testing.T) {
+	// From string
+	var s1 enum.ApproverRule
+	if err := s1.Scan("direct_manager"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.ApproverDirectManager {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.ApproverDirectManager)
+	}
+
+	// From []byte
+	var s2 enum.ApproverRule
+	if err := s2.Scan([]byte("role")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.ApproverRole {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.ApproverRole)
+	}
+
+	// Invalid string value
+	var s3 enum.ApproverRule
+	if err := s3.Scan("ceo"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.ApproverRule
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestApproverRule_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.ApproverRule
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
@@ -0,0 +1,88 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// AttendanceStatus represents the outcome of an employee's working day.
+// Allowed values (string representation):
+// - "present"
+// - "late"
+// - "early_leave"
+// - "absent"
+// - "missing_punch"  // clock-in or clock-out is missing
+// Use ParseAttendanceStatus to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type AttendanceStatus string
+
+const (
+	AttendancePresent      AttendanceStatus = "present"
+	AttendanceLate         AttendanceStatus = "late"
+	AttendanceEarlyLeave   AttendanceStatus = "early_leave"
+	AttendanceAbsent       AttendanceStatus = "absent"
+	AttendanceMissingPunch AttendanceStatus = "missing_punch"
+)
+
+func (a AttendanceStatus) Valid() bool {
+	switch a {
+	case AttendancePresent,
+		AttendanceLate,
+		AttendanceEarlyLeave,
+		AttendanceAbsent,
+		AttendanceMissingPunch:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseAttendanceStatus(s string) (AttendanceStatus, error) {
+	v := AttendanceStatus(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid AttendanceStatus: %q", s)
+	}
+	return v, nil
+}
+
+func (a AttendanceStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(a))
+}
+
+func (a *AttendanceStatus) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseAttendanceStatus(s)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+func (a AttendanceStatus) Value() (driver.Value, error) {
+	if !a.Valid() {
+		return nil, fmt.Errorf("invalid AttendanceStatus: %q", a)
+	}
+	return string(a), nil
+}
+
+func (a *AttendanceStatus) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseAttendanceStatus(v)
+		if err != nil {
+			return err
+		}
+		*a = parsed
+		return nil
+	case []byte:
+		return a.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for AttendanceStatus: %T", src)
+	}
+}
@@ -0,0 +1,157 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestAttendanceStatus_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.AttendanceStatus
+		valid bool
+	}{
+		{"present valid", enum.AttendancePresent, true},
+		{"late valid", enum.AttendanceLate, true},
+		{"early_leave valid", enum.AttendanceEarlyLeave, true},
+		{"absent valid", enum.AttendanceAbsent, true},
+		{"missing_punch valid", enum.AttendanceMissingPunch, true},
+		{"invalid value", enum.AttendanceStatus("unknown"), false},
+		{"empty value", enum.AttendanceStatus(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseAttendanceStatus(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.AttendanceStatus
+		wantErr bool
+		name    string
+	}{
+		{"PRESENT", enum.AttendancePresent, false, "upper present"},
+		{" late ", enum.AttendanceLate, false, "trimmed late"},
+		{"Early_Leave", enum.AttendanceEarlyLeave, false, "mixed early_leave"},
+		{"absent", enum.AttendanceAbsent, false, "lower absent"},
+		{"MISSING_PUNCH", enum.AttendanceMissingPunch, false, "upper missing_punch"},
+		{"early leave", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseAttendanceStatus(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAttendanceStatus_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.AttendanceLate
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"late\"" {
+		t.Fatalf("Marshal got %s, want \"late\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.AttendanceStatus
+	if err := json.Unmarshal([]byte("\" ABSENT \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.AttendanceAbsent {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.AttendanceAbsent)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.AttendanceStatus
+	if err := json.Unmarshal([]byte("\"early leave\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid attendance status, got nil")
+	}
+}
+
+func TestAttendanceStatus_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.AttendancePresent.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "present" {
+		t.Fatalf("Value() got %#v, want 'present' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.AttendanceStatus = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestAttendanceStatus_Scan(t *testing.T) {
+	// From string
+	var s1 enum.AttendanceStatus
+	if err := s1.Scan("PRESENT"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.AttendancePresent {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.AttendancePresent)
+	}
+
+	// From []byte
+	var s2 enum.AttendanceStatus
+	if err := s2.Scan([]byte("missing_punch")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.AttendanceMissingPunch {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.AttendanceMissingPunch)
+	}
+
+	// Invalid string value
+	var s3 enum.AttendanceStatus
+	if err := s3.Scan("early leave"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.AttendanceStatus
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestAttendanceStatus_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.AttendanceStatus
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
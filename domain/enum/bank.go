@@ -0,0 +1,88 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Bank represents an Indonesian bank that holds employee salary accounts.
+// Allowed values (string representation):
+// - "bca"         // PT Bank Central Asia Tbk
+// - "mandiri"     // PT Bank Mandiri (Persero) Tbk
+// - "bni"         // PT Bank Negara Indonesia (Persero) Tbk
+// - "bri"         // PT Bank Rakyat Indonesia (Persero) Tbk
+// - "bsi"         // PT Bank Syariah Indonesia Tbk
+// - "cimb_niaga"  // PT Bank CIMB Niaga Tbk
+// - "permata"     // PT Bank Permata Tbk
+// Use ParseBank to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type Bank string
+
+const (
+	BankBCA       Bank = "bca"
+	BankMandiri   Bank = "mandiri"
+	BankBNI       Bank = "bni"
+	BankBRI       Bank = "bri"
+	BankBSI       Bank = "bsi"
+	BankCIMBNiaga Bank = "cimb_niaga"
+	BankPermata   Bank = "permata"
+)
+
+func (bk Bank) Valid() bool {
+	switch bk {
+	case BankBCA, BankMandiri, BankBNI, BankBRI, BankBSI, BankCIMBNiaga, BankPermata:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseBank(s string) (Bank, error) {
+	v := Bank(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid Bank: %q", s)
+	}
+	return v, nil
+}
+
+func (bk Bank) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(bk))
+}
+
+func (bk *Bank) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseBank(s)
+	if err != nil {
+		return err
+	}
+	*bk = v
+	return nil
+}
+
+func (bk Bank) Value() (driver.Value, error) {
+	if !bk.Valid() {
+		return nil, fmt.Errorf("invalid Bank: %q", bk)
+	}
+	return string(bk), nil
+}
+
+func (bk *Bank) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseBank(v)
+		if err != nil {
+			return err
+		}
+		*bk = parsed
+		return nil
+	case []byte:
+		return bk.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for Bank: %T", src)
+	}
+}
@@ -0,0 +1,157 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestBank_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.Bank
+		valid bool
+	}{
+		{"bca valid", enum.BankBCA, true},
+		{"mandiri valid", enum.BankMandiri, true},
+		{"bni valid", enum.BankBNI, true},
+		{"bri valid", enum.BankBRI, true},
+		{"bsi valid", enum.BankBSI, true},
+		{"cimb_niaga valid", enum.BankCIMBNiaga, true},
+		{"permata valid", enum.BankPermata, true},
+		{"invalid value", enum.Bank("unknown"), false},
+		{"empty value", enum.Bank(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseBank(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.Bank
+		wantErr bool
+		name    string
+	}{
+		{"BCA", enum.BankBCA, false, "upper bca"},
+		{" mandiri ", enum.BankMandiri, false, "trimmed mandiri"},
+		{"Cimb_Niaga", enum.BankCIMBNiaga, false, "mixed cimb niaga"},
+		{"jago", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseBank(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBank_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.BankBNI
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"bni\"" {
+		t.Fatalf("Marshal got %s, want \"bni\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.Bank
+	if err := json.Unmarshal([]byte("\" BRI \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.BankBRI {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.BankBRI)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.Bank
+	if err := json.Unmarshal([]byte("\"jago\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid bank, got nil")
+	}
+}
+
+func TestBank_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.BankBCA.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "bca" {
+		t.Fatalf("Value() got %#v, want 'bca' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.Bank = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestBank_Scan(t *testing.T) {
+	// From string
+	var s1 enum.Bank
+	if err := s1.Scan("bsi"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.BankBSI {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.BankBSI)
+	}
+
+	// From []byte
+	var s2 enum.Bank
+	if err := s2.Scan([]byte("permata")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.BankPermata {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.BankPermata)
+	}
+
+	// Invalid string value
+	var s3 enum.Bank
+	if err := s3.Scan("jago"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.Bank
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestBank_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.Bank
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
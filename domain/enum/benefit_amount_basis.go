@@ -0,0 +1,78 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// BenefitAmountBasis represents how the amount of a benefit is determined.
+// Allowed values (string representation):
+// - "fixed"           // a fixed monthly amount, prorated for partial months
+// - "attendance_day"  // an amount per day the employee attended work
+// Use ParseBenefitAmountBasis to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type BenefitAmountBasis string
+
+const (
+	BenefitFixed            BenefitAmountBasis = "fixed"
+	BenefitPerAttendanceDay BenefitAmountBasis = "attendance_day"
+)
+
+func (ab BenefitAmountBasis) Valid() bool {
+	switch ab {
+	case BenefitFixed, BenefitPerAttendanceDay:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseBenefitAmountBasis(s string) (BenefitAmountBasis, error) {
+	v := BenefitAmountBasis(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid BenefitAmountBasis: %q", s)
+	}
+	return v, nil
+}
+
+func (ab BenefitAmountBasis) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(ab))
+}
+
+func (ab *BenefitAmountBasis) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseBenefitAmountBasis(s)
+	if err != nil {
+		return err
+	}
+	*ab = v
+	return nil
+}
+
+func (ab BenefitAmountBasis) Value() (driver.Value, error) {
+	if !ab.Valid() {
+		return nil, fmt.Errorf("invalid BenefitAmountBasis: %q", ab)
+	}
+	return string(ab), nil
+}
+
+func (ab *BenefitAmountBasis) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseBenefitAmountBasis(v)
+		if err != nil {
+			return err
+		}
+		*ab = parsed
+		return nil
+	case []byte:
+		return ab.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for BenefitAmountBasis: %T", src)
+	}
+}
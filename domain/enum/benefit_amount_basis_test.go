@@ -0,0 +1,151 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestBenefitAmountBasis_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.BenefitAmountBasis
+		valid bool
+	}{
+		{"fixed valid", enum.BenefitFixed, true},
+		{"attendance_day valid", enum.BenefitPerAttendanceDay, true},
+		{"invalid value", enum.BenefitAmountBasis("unknown"), false},
+		{"empty value", enum.BenefitAmountBasis(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseBenefitAmountBasis(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.BenefitAmountBasis
+		wantErr bool
+		name    string
+	}{
+		{"FIXED", enum.BenefitFixed, false, "upper fixed"},
+		{" attendance_day ", enum.BenefitPerAttendanceDay, false, "trimmed attendance day"},
+		{"hourly", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseBenefitAmountBasis(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBenefitAmountBasis_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.BenefitPerAttendanceDay
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"attendance_day\"" {
+		t.Fatalf("Marshal got %s, want \"attendance_day\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.BenefitAmountBasis
+	if err := json.Unmarshal([]byte("\" Fixed \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.BenefitFixed {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.BenefitFixed)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.BenefitAmountBasis
+	if err := json.Unmarshal([]byte("\"hourly\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid benefit amount basis, got nil")
+	}
+}
+
+func TestBenefitAmountBasis_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.BenefitFixed.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "fixed" {
+		t.Fatalf("Value() got %#v, want 'fixed' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.BenefitAmountBasis = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestBenefitAmountBasis_Scan(t *testing.T) {
+	// From string
+	var s1 enum.BenefitAmountBasis
+	if err := s1.Scan("fixed"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.BenefitFixed {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.BenefitFixed)
+	}
+
+	// From []byte
+	var s2 enum.BenefitAmountBasis
+	if err := s2.Scan([]byte("attendance_day")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.BenefitPerAttendanceDay {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.BenefitPerAttendanceDay)
+	}
+
+	// Invalid string value
+	var s3 enum.BenefitAmountBasis
+	if err := s3.Scan("hourly"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.BenefitAmountBasis
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestBenefitAmountBasis_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.BenefitAmountBasis
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
@@ -0,0 +1,78 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// BenefitType represents what a benefit plan provides.
+// Allowed values (string representation):
+// - "allowance"  // cash allowance paid with salary, e.g. transport, meal, position, family
+// - "insurance"  // insurance plan whose premium the employer pays, optionally shared by the employee
+// Use ParseBenefitType to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type BenefitType string
+
+const (
+	BenefitAllowance BenefitType = "allowance"
+	BenefitInsurance BenefitType = "insurance"
+)
+
+func (bt BenefitType) Valid() bool {
+	switch bt {
+	case BenefitAllowance, BenefitInsurance:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseBenefitType(s string) (BenefitType, error) {
+	v := BenefitType(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid BenefitType: %q", s)
+	}
+	return v, nil
+}
+
+func (bt BenefitType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(bt))
+}
+
+func (bt *BenefitType) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseBenefitType(s)
+	if err != nil {
+		return err
+	}
+	*bt = v
+	return nil
+}
+
+func (bt BenefitType) Value() (driver.Value, error) {
+	if !bt.Valid() {
+		return nil, fmt.Errorf("invalid BenefitType: %q", bt)
+	}
+	return string(bt), nil
+}
+
+func (bt *BenefitType) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseBenefitType(v)
+		if err != nil {
+			return err
+		}
+		*bt = parsed
+		return nil
+	case []byte:
+		return bt.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for BenefitType: %T", src)
+	}
+}
@@ -0,0 +1,151 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestBenefitType_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.BenefitType
+		valid bool
+	}{
+		{"allowance valid", enum.BenefitAllowance, true},
+		{"insurance valid", enum.BenefitInsurance, true},
+		{"invalid value", enum.BenefitType("unknown"), false},
+		{"empty value", enum.BenefitType(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseBenefitType(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.BenefitType
+		wantErr bool
+		name    string
+	}{
+		{"ALLOWANCE", enum.BenefitAllowance, false, "upper allowance"},
+		{" insurance ", enum.BenefitInsurance, false, "trimmed insurance"},
+		{"bonus", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseBenefitType(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBenefitType_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.BenefitAllowance
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"allowance\"" {
+		t.Fatalf("Marshal got %s, want \"allowance\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.BenefitType
+	if err := json.Unmarshal([]byte("\" Insurance \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.BenefitInsurance {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.BenefitInsurance)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.BenefitType
+	if err := json.Unmarshal([]byte("\"bonus\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid benefit type, got nil")
+	}
+}
+
+func TestBenefitType_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.BenefitAllowance.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "allowance" {
+		t.Fatalf("Value() got %#v, want 'allowance' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.BenefitType = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestBenefitType_Scan(t *testing.T) {
+	// From string
+	var s1 enum.BenefitType
+	if err := s1.Scan("allowance"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.BenefitAllowance {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.BenefitAllowance)
+	}
+
+	// From []byte
+	var s2 enum.BenefitType
+	if err := s2.Scan([]byte("insurance")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.BenefitInsurance {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.BenefitInsurance)
+	}
+
+	// Invalid string value
+	var s3 enum.BenefitType
+	if err := s3.Scan("bonus"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.BenefitType
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestBenefitType_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.BenefitType
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
@@ -0,0 +1,84 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// BPJSProgram represents a BPJS social security programme an employee is enrolled in.
+// Allowed values (string representation):
+// - "kesehatan"  // BPJS Kesehatan, jaminan kesehatan nasional
+// - "jht"        // BPJS Ketenagakerjaan jaminan hari tua
+// - "jp"         // BPJS Ketenagakerjaan jaminan pensiun
+// - "jkk"        // BPJS Ketenagakerjaan jaminan kecelakaan kerja
+// - "jkm"        // BPJS Ketenagakerjaan jaminan kematian
+// Use ParseBPJSProgram to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type BPJSProgram string
+
+const (
+	BPJSKesehatan BPJSProgram = "kesehatan"
+	BPJSJHT       BPJSProgram = "jht"
+	BPJSJP        BPJSProgram = "jp"
+	BPJSJKK       BPJSProgram = "jkk"
+	BPJSJKM       BPJSProgram = "jkm"
+)
+
+func (p BPJSProgram) Valid() bool {
+	switch p {
+	case BPJSKesehatan, BPJSJHT, BPJSJP, BPJSJKK, BPJSJKM:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseBPJSProgram(s string) (BPJSProgram, error) {
+	v := BPJSProgram(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid BPJSProgram: %q", s)
+	}
+	return v, nil
+}
+
+func (p BPJSProgram) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(p))
+}
+
+func (p *BPJSProgram) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseBPJSProgram(s)
+	if err != nil {
+		return err
+	}
+	*p = v
+	return nil
+}
+
+func (p BPJSProgram) Value() (driver.Value, error) {
+	if !p.Valid() {
+		return nil, fmt.Errorf("invalid BPJSProgram: %q", p)
+	}
+	return string(p), nil
+}
+
+func (p *BPJSProgram) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseBPJSProgram(v)
+		if err != nil {
+			return err
+		}
+		*p = parsed
+		return nil
+	case []byte:
+		return p.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for BPJSProgram: %T", src)
+	}
+}
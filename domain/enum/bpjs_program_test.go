@@ -0,0 +1,155 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestBPJSProgram_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.BPJSProgram
+		valid bool
+	}{
+		{"kesehatan valid", enum.BPJSKesehatan, true},
+		{"jht valid", enum.BPJSJHT, true},
+		{"jp valid", enum.BPJSJP, true},
+		{"jkk valid", enum.BPJSJKK, true},
+		{"jkm valid", enum.BPJSJKM, true},
+		{"invalid value", enum.BPJSProgram("unknown"), false},
+		{"empty value", enum.BPJSProgram(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseBPJSProgram(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.BPJSProgram
+		wantErr bool
+		name    string
+	}{
+		{"KESEHATAN", enum.BPJSKesehatan, false, "upper kesehatan"},
+		{" jht ", enum.BPJSJHT, false, "trimmed jht"},
+		{"Jkm", enum.BPJSJKM, false, "mixed jkm"},
+		{"jkp", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseBPJSProgram(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBPJSProgram_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.BPJSJP
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"jp\"" {
+		t.Fatalf("Marshal got %s, want \"jp\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.BPJSProgram
+	if err := json.Unmarshal([]byte("\" JKK \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.BPJSJKK {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.BPJSJKK)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.BPJSProgram
+	if err := json.Unmarshal([]byte("\"jkp\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid BPJS program, got nil")
+	}
+}
+
+func TestBPJSProgram_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.BPJSKesehatan.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "kesehatan" {
+		t.Fatalf("Value() got %#v, want 'kesehatan' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.BPJSProgram = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestBPJSProgram_Scan(t *testing.T) {
+	// From string
+	var s1 enum.BPJSProgram
+	if err := s1.Scan("jp"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.BPJSJP {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.BPJSJP)
+	}
+
+	// From []byte
+	var s2 enum.BPJSProgram
+	if err := s2.Scan([]byte("kesehatan")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.BPJSKesehatan {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.BPJSKesehatan)
+	}
+
+	// Invalid string value
+	var s3 enum.BPJSProgram
+	if err := s3.Scan("jkp"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.BPJSProgram
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestBPJSProgram_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.BPJSProgram
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
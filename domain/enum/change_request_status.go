@@ -0,0 +1,82 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ChangeRequestStatus represents the lifecycle of an employee's request to change their own data.
+// Allowed values (string representation):
+// - "pending"    // waiting for HR review
+// - "approved"   // approved and applied to the employee
+// - "rejected"   // rejected by HR
+// - "cancelled"  // withdrawn by the employee
+// Use ParseChangeRequestStatus to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type ChangeRequestStatus string
+
+const (
+	ChangeRequestPending   ChangeRequestStatus = "pending"
+	ChangeRequestApproved  ChangeRequestStatus = "approved"
+	ChangeRequestRejected  ChangeRequestStatus = "rejected"
+	ChangeRequestCancelled ChangeRequestStatus = "cancelled"
+)
+
+func (cr ChangeRequestStatus) Valid() bool {
+	switch cr {
+	case ChangeRequestPending, ChangeRequestApproved, ChangeRequestRejected, ChangeRequestCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseChangeRequestStatus(s string) (ChangeRequestStatus, error) {
+	v := ChangeRequestStatus(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid ChangeRequestStatus: %q", s)
+	}
+	return v, nil
+}
+
+func (cr ChangeRequestStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(cr))
+}
+
+func (cr *ChangeRequestStatus) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseChangeRequestStatus(s)
+	if err != nil {
+		return err
+	}
+	*cr = v
+	return nil
+}
+
+func (cr ChangeRequestStatus) Value() (driver.Value, error) {
+	if !cr.Valid() {
+		return nil, fmt.Errorf("invalid ChangeRequestStatus: %q", cr)
+	}
+	return string(cr), nil
+}
+
+func (cr *ChangeRequestStatus) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseChangeRequestStatus(v)
+		if err != nil {
+			return err
+		}
+		*cr = parsed
+		return nil
+	case []byte:
+		return cr.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for ChangeRequestStatus: %T", src)
+	}
+}
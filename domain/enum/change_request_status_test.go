@@ -0,0 +1,153 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestChangeRequestStatus_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.ChangeRequestStatus
+		valid bool
+	}{
+		{"pending valid", enum.ChangeRequestPending, true},
+		{"approved valid", enum.ChangeRequestApproved, true},
+		{"rejected valid", enum.ChangeRequestRejected, true},
+		{"cancelled valid", enum.ChangeRequestCancelled, true},
+		{"invalid value", enum.ChangeRequestStatus("unknown"), false},
+		{"empty value", enum.ChangeRequestStatus(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseChangeRequestStatus(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.ChangeRequestStatus
+		wantErr bool
+		name    string
+	}{
+		{"PENDING", enum.ChangeRequestPending, false, "upper pending"},
+		{" approved ", enum.ChangeRequestApproved, false, "trimmed approved"},
+		{"applied", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseChangeRequestStatus(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChangeRequestStatus_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.ChangeRequestRejected
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"rejected\"" {
+		t.Fatalf("Marshal got %s, want \"rejected\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.ChangeRequestStatus
+	if err := json.Unmarshal([]byte("\" Cancelled \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.ChangeRequestCancelled {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.ChangeRequestCancelled)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.ChangeRequestStatus
+	if err := json.Unmarshal([]byte("\"applied\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid change request status, got nil")
+	}
+}
+
+func TestChangeRequestStatus_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.ChangeRequestPending.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "pending" {
+		t.Fatalf("Value() got %#v, want 'pending' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.ChangeRequestStatus = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestChangeRequestStatus_Scan(t *testing.T) {
+	// From string
+	var s1 enum.ChangeRequestStatus
+	if err := s1.Scan("approved"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.ChangeRequestApproved {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.ChangeRequestApproved)
+	}
+
+	// From []byte
+	var s2 enum.ChangeRequestStatus
+	if err := s2.Scan([]byte("pending")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.ChangeRequestPending {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.ChangeRequestPending)
+	}
+
+	// Invalid string value
+	var s3 enum.ChangeRequestStatus
+	if err := s3.Scan("applied"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.ChangeRequestStatus
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestChangeRequestStatus_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.ChangeRequestStatus
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
@@ -0,0 +1,86 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ClaimStatus represents the lifecycle of a reimbursement claim.
+// Allowed values (string representation):
+// - "draft"      // being prepared by the employee
+// - "submitted"  // waiting for approval
+// - "approved"   // approved, waiting to be paid
+// - "rejected"   // rejected by the approver
+// - "cancelled"  // withdrawn by the employee
+// - "paid"       // paid through payroll or a separate payout
+// Use ParseClaimStatus to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type ClaimStatus string
+
+const (
+	ClaimDraft     ClaimStatus = "draft"
+	ClaimSubmitted ClaimStatus = "submitted"
+	ClaimApproved  ClaimStatus = "approved"
+	ClaimRejected  ClaimStatus = "rejected"
+	ClaimCancelled ClaimStatus = "cancelled"
+	ClaimPaid      ClaimStatus = "paid"
+)
+
+func (cs ClaimStatus) Valid() bool {
+	switch cs {
+	case ClaimDraft, ClaimSubmitted, ClaimApproved, ClaimRejected, ClaimCancelled, ClaimPaid:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseClaimStatus(s string) (ClaimStatus, error) {
+	v := ClaimStatus(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid ClaimStatus: %q", s)
+	}
+	return v, nil
+}
+
+func (cs ClaimStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(cs))
+}
+
+func (cs *ClaimStatus) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseClaimStatus(s)
+	if err != nil {
+		return err
+	}
+	*cs = v
+	return nil
+}
+
+func (cs ClaimStatus) Value() (driver.Value, error) {
+	if !cs.Valid() {
+		return nil, fmt.Errorf("invalid ClaimStatus: %q", cs)
+	}
+	return string(cs), nil
+}
+
+func (cs *ClaimStatus) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseClaimStatus(v)
+		if err != nil {
+			return err
+		}
+		*cs = parsed
+		return nil
+	case []byte:
+		return cs.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for ClaimStatus: %T", src)
+	}
+}
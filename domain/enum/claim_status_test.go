@@ -0,0 +1,156 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestClaimStatus_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.ClaimStatus
+		valid bool
+	}{
+		{"draft valid", enum.ClaimDraft, true},
+		{"submitted valid", enum.ClaimSubmitted, true},
+		{"approved valid", enum.ClaimApproved, true},
+		{"rejected valid", enum.ClaimRejected, true},
+		{"cancelled valid", enum.ClaimCancelled, true},
+		{"paid valid", enum.ClaimPaid, true},
+		{"invalid value", enum.ClaimStatus("unknown"), false},
+		{"empty value", enum.ClaimStatus(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseClaimStatus(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.ClaimStatus
+		wantErr bool
+		name    string
+	}{
+		{"DRAFT", enum.ClaimDraft, false, "upper draft"},
+		{" submitted ", enum.ClaimSubmitted, false, "trimmed submitted"},
+		{"Paid", enum.ClaimPaid, false, "mixed paid"},
+		{"closed", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseClaimStatus(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClaimStatus_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.ClaimApproved
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"approved\"" {
+		t.Fatalf("Marshal got %s, want \"approved\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.ClaimStatus
+	if err := json.Unmarshal([]byte("\" REJECTED \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.ClaimRejected {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.ClaimRejected)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.ClaimStatus
+	if err := json.Unmarshal([]byte("\"closed\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid claim status, got nil")
+	}
+}
+
+func TestClaimStatus_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.ClaimDraft.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "draft" {
+		t.Fatalf("Value() got %#v, want 'draft' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.ClaimStatus = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestClaimStatus_Scan(t *testing.T) {
+	// From string
+	var s1 enum.ClaimStatus
+	if err := s1.Scan("cancelled"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.ClaimCancelled {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.ClaimCancelled)
+	}
+
+	// From []byte
+	var s2 enum.ClaimStatus
+	if err := s2.Scan([]byte("paid")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.ClaimPaid {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.ClaimPaid)
+	}
+
+	// Invalid string value
+	var s3 enum.ClaimStatus
+	if err := s3.Scan("closed"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.ClaimStatus
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestClaimStatus_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.ClaimStatus
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
@@ -0,0 +1,78 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ClockEventType represents the direction of an attendance punch.
+// Allowed values (string representation):
+// - "in"
+// - "out"
+// Use ParseClockEventType to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type ClockEventType string
+
+const (
+	ClockIn  ClockEventType = "in"
+	ClockOut ClockEventType = "out"
+)
+
+func (c ClockEventType) Valid() bool {
+	switch c {
+	case ClockIn, ClockOut:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseClockEventType(s string) (ClockEventType, error) {
+	v := ClockEventType(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid ClockEventType: %q", s)
+	}
+	return v, nil
+}
+
+func (c ClockEventType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(c))
+}
+
+func (c *ClockEventType) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseClockEventType(s)
+	if err != nil {
+		return err
+	}
+	*c = v
+	return nil
+}
+
+func (c ClockEventType) Value() (driver.Value, error) {
+	if !c.Valid() {
+		return nil, fmt.Errorf("invalid ClockEventType: %q", c)
+	}
+	return string(c), nil
+}
+
+func (c *ClockEventType) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseClockEventType(v)
+		if err != nil {
+			return err
+		}
+		*c = parsed
+		return nil
+	case []byte:
+		return c.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for ClockEventType: %T", src)
+	}
+}
@@ -0,0 +1,151 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestClockEventType_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.ClockEventType
+		valid bool
+	}{
+		{"in valid", enum.ClockIn, true},
+		{"out valid", enum.ClockOut, true},
+		{"invalid value", enum.ClockEventType("unknown"), false},
+		{"empty value", enum.ClockEventType(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseClockEventType(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.ClockEventType
+		wantErr bool
+		name    string
+	}{
+		{"IN", enum.ClockIn, false, "upper in"},
+		{" out ", enum.ClockOut, false, "trimmed out"},
+		{"break", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseClockEventType(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClockEventType_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.ClockOut
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"out\"" {
+		t.Fatalf("Marshal got %s, want \"out\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.ClockEventType
+	if err := json.Unmarshal([]byte("\" IN \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.ClockIn {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.ClockIn)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.ClockEventType
+	if err := json.Unmarshal([]byte("\"break\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid clock event type, got nil")
+	}
+}
+
+func TestClockEventType_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.ClockIn.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "in" {
+		t.Fatalf("Value() got %#v, want 'in' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.ClockEventType = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestClockEventType_Scan(t *testing.T) {
+	// From string
+	var s1 enum.ClockEventType
+	if err := s1.Scan("OUT"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.ClockOut {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.ClockOut)
+	}
+
+	// From []byte
+	var s2 enum.ClockEventType
+	if err := s2.Scan([]byte("in")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.ClockIn {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.ClockIn)
+	}
+
+	// Invalid string value
+	var s3 enum.ClockEventType
+	if err := s3.Scan("break"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.ClockEventType
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestClockEventType_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.ClockEventType
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
@@ -0,0 +1,88 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ClockSource represents where an attendance punch was captured.
+// Allowed values (string representation):
+// - "mobile"            // mobile app with geolocation
+// - "web"
+// - "fingerprint"       // biometric terminal
+// - "face_recognition"  // biometric terminal
+// - "manual"            // entered by HR/administrator
+// Use ParseClockSource to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type ClockSource string
+
+const (
+	ClockSourceMobile          ClockSource = "mobile"
+	ClockSourceWeb             ClockSource = "web"
+	ClockSourceFingerprint     ClockSource = "fingerprint"
+	ClockSourceFaceRecognition ClockSource = "face_recognition"
+	ClockSourceManual          ClockSource = "manual"
+)
+
+func (c ClockSource) Valid() bool {
+	switch c {
+	case ClockSourceMobile,
+		ClockSourceWeb,
+		ClockSourceFingerprint,
+		ClockSourceFaceRecognition,
+		ClockSourceManual:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseClockSource(s string) (ClockSource, error) {
+	v := ClockSource(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid ClockSource: %q", s)
+	}
+	return v, nil
+}
+
+func (c ClockSource) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(c))
+}
+
+func (c *ClockSource) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseClockSource(s)
+	if err != nil {
+		return err
+	}
+	*c = v
+	return nil
+}
+
+func (c ClockSource) Value() (driver.Value, error) {
+	if !c.Valid() {
+		return nil, fmt.Errorf("invalid ClockSource: %q", c)
+	}
+	return string(c), nil
+}
+
+func (c *ClockSource) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseClockSource(v)
+		if err != nil {
+			return err
+		}
+		*c = parsed
+		return nil
+	case []byte:
+		return c.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for ClockSource: %T", src)
+	}
+}
@@ -0,0 +1,157 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestClockSource_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.ClockSource
+		valid bool
+	}{
+		{"mobile valid", enum.ClockSourceMobile, true},
+		{"web valid", enum.ClockSourceWeb, true},
+		{"fingerprint valid", enum.ClockSourceFingerprint, true},
+		{"face_recognition valid", enum.ClockSourceFaceRecognition, true},
+		{"manual valid", enum.ClockSourceManual, true},
+		{"invalid value", enum.ClockSource("unknown"), false},
+		{"empty value", enum.ClockSource(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseClockSource(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.ClockSource
+		wantErr bool
+		name    string
+	}{
+		{"MOBILE", enum.ClockSourceMobile, false, "upper mobile"},
+		{" web ", enum.ClockSourceWeb, false, "trimmed web"},
+		{"Fingerprint", enum.ClockSourceFingerprint, false, "mixed fingerprint"},
+		{"face_recognition", enum.ClockSourceFaceRecognition, false, "lower face_recognition"},
+		{"Manual", enum.ClockSourceManual, false, "mixed manual"},
+		{"face recognition", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseClockSource(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClockSource_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.ClockSourceFingerprint
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"fingerprint\"" {
+		t.Fatalf("Marshal got %s, want \"fingerprint\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.ClockSource
+	if err := json.Unmarshal([]byte("\" MOBILE \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.ClockSourceMobile {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.ClockSourceMobile)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.ClockSource
+	if err := json.Unmarshal([]byte("\"face recognition\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid clock source, got nil")
+	}
+}
+
+func TestClockSource_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.ClockSourceMobile.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "mobile" {
+		t.Fatalf("Value() got %#v, want 'mobile' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.ClockSource = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestClockSource_Scan(t *testing.T) {
+	// From string
+	var s1 enum.ClockSource
+	if err := s1.Scan("MANUAL"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.ClockSourceManual {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.ClockSourceManual)
+	}
+
+	// From []byte
+	var s2 enum.ClockSource
+	if err := s2.Scan([]byte("web")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.ClockSourceWeb {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.ClockSourceWeb)
+	}
+
+	// Invalid string value
+	var s3 enum.ClockSource
+	if err := s3.Scan("face recognition"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.ClockSource
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestClockSource_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.ClockSource
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
@@ -9,22 +9,33 @@ import (
 
 // EmploymentStatus represents the status of an employment/employee.
 // Allowed values (string representation):
+// - "probation"   // serving the probation period of a PKWTT or permanent contract
 // - "active"
-// - "resigned"
 // - "on_leave"
+// - "suspended"   // temporarily barred from work, e.g. pending a misconduct investigation
+// - "inactive"    // still employed but not working or paid, e.g. unpaid sabbatical
+// - "resigned"
+// - "terminated"  // employment ended by the company or at the end of a contract
+// - "retired"
 // Use ParseEmploymentStatus to safely convert from string (case-insensitive, trims spaces).
 // Implements json (un)marshaling and database/sql interfaces.
 type EmploymentStatus string
 
 const (
-	EmploymentActive   EmploymentStatus = "active"
-	EmploymentResigned EmploymentStatus = "resigned"
-	EmploymentOnLeave  EmploymentStatus = "on_leave"
+	EmploymentProbation  EmploymentStatus = "probation"
+	EmploymentActive     EmploymentStatus = "active"
+	EmploymentOnLeave    EmploymentStatus = "on_leave"
+	EmploymentSuspended  EmploymentStatus = "suspended"
+	EmploymentInactive   EmploymentStatus = "inactive"
+	EmploymentResigned   EmploymentStatus = "resigned"
+	EmploymentTerminated EmploymentStatus = "terminated"
+	EmploymentRetired    EmploymentStatus = "retired"
 )
 
 func (e EmploymentStatus) Valid() bool {
 	switch e {
-	case EmploymentActive, EmploymentResigned, EmploymentOnLeave:
+	case EmploymentProbation, EmploymentActive, EmploymentOnLeave, EmploymentSuspended, EmploymentInactive,
+		EmploymentResigned, EmploymentTerminated, EmploymentRetired:
 		return true
 	default:
 		return false
@@ -18,6 +18,11 @@ func TestEmploymentStatus_Valid(t *testing.T) {
 		{"active valid", enum.EmploymentActive, true},
 		{"resigned valid", enum.EmploymentResigned, true},
 		{"on_leave valid", enum.EmploymentOnLeave, true},
+		{"terminated valid", enum.EmploymentTerminated, true},
+		{"retired valid", enum.EmploymentRetired, true},
+		{"probation valid", enum.EmploymentProbation, true},
+		{"suspended valid", enum.EmploymentSuspended, true},
+		{"inactive valid", enum.EmploymentInactive, true},
 		{"invalid value", enum.EmploymentStatus("unknown"), false},
 		{"empty value", enum.EmploymentStatus(""), false},
 	}
@@ -41,6 +46,11 @@ func TestParseEmploymentStatus(t *testing.T) {
 		{"ACTIVE", enum.EmploymentActive, false, "upper active"},
 		{" resigned ", enum.EmploymentResigned, false, "trimmed resigned"},
 		{"On_Leave", enum.EmploymentOnLeave, false, "mixed on_leave with underscore"},
+		{"TERMINATED", enum.EmploymentTerminated, false, "upper terminated"},
+		{" retired ", enum.EmploymentRetired, false, "trimmed retired"},
+		{"Probation", enum.EmploymentProbation, false, "title probation"},
+		{"SUSPENDED", enum.EmploymentSuspended, false, "upper suspended"},
+		{"inactive", enum.EmploymentInactive, false, "lower inactive"},
 		{"On Leave", "", true, "invalid with space instead of underscore"},
 		{"", "", true, "empty"},
 	}
@@ -0,0 +1,82 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// HolidayType represents the origin of a non-working day in a work calendar.
+// Allowed values (string representation):
+// - "national"          // hari libur nasional
+// - "collective_leave"  // cuti bersama set by the SKB 3 Menteri
+// - "regional"          // regional holiday of an organization unit's area
+// - "company"           // company specific holiday
+// Use ParseHolidayType to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type HolidayType string
+
+const (
+	HolidayNational        HolidayType = "national"
+	HolidayCollectiveLeave HolidayType = "collective_leave"
+	HolidayRegional        HolidayType = "regional"
+	HolidayCompany         HolidayType = "company"
+)
+
+func (h HolidayType) Valid() bool {
+	switch h {
+	case HolidayNational, HolidayCollectiveLeave, HolidayRegional, HolidayCompany:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseHolidayType(s string) (HolidayType, error) {
+	v := HolidayType(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid HolidayType: %q", s)
+	}
+	return v, nil
+}
+
+func (h HolidayType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(h))
+}
+
+func (h *HolidayType) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseHolidayType(s)
+	if err != nil {
+		return err
+	}
+	*h = v
+	return nil
+}
+
+func (h HolidayType) Value() (driver.Value, error) {
+	if !h.Valid() {
+		return nil, fmt.Errorf("invalid HolidayType: %q", h)
+	}
+	return string(h), nil
+}
+
+func (h *HolidayType) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseHolidayType(v)
+		if err != nil {
+			return err
+		}
+		*h = parsed
+		return nil
+	case []byte:
+		return h.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for HolidayType: %T", src)
+	}
+}
@@ -0,0 +1,154 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestHolidayType_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.HolidayType
+		valid bool
+	}{
+		{"national valid", enum.HolidayNational, true},
+		{"collective_leave valid", enum.HolidayCollectiveLeave, true},
+		{"regional valid", enum.HolidayRegional, true},
+		{"company valid", enum.HolidayCompany, true},
+		{"invalid value", enum.HolidayType("unknown"), false},
+		{"empty value", enum.HolidayType(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseHolidayType(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.HolidayType
+		wantErr bool
+		name    string
+	}{
+		{"NATIONAL", enum.HolidayNational, false, "upper national"},
+		{" collective_leave ", enum.HolidayCollectiveLeave, false, "trimmed collective leave"},
+		{"Company", enum.HolidayCompany, false, "mixed company"},
+		{"weekend", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseHolidayType(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHolidayType_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.HolidayRegional
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"regional\"" {
+		t.Fatalf("Marshal got %s, want \"regional\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.HolidayType
+	if err := json.Unmarshal([]byte("\" REGIONAL \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.HolidayRegional {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.HolidayRegional)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.HolidayType
+	if err := json.Unmarshal([]byte("\"weekend\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid holiday type, got nil")
+	}
+}
+
+func TestHolidayType_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.HolidayNational.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "national" {
+		t.Fatalf("Value() got %#v, want 'national' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.HolidayType = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestHolidayType_Scan(t *testing.T) {
+	// From string
+	var s1 enum.HolidayType
+	if err := s1.Scan("national"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.HolidayNational {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.HolidayNational)
+	}
+
+	// From []byte
+	var s2 enum.HolidayType
+	if err := s2.Scan([]byte("company")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.HolidayCompany {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.HolidayCompany)
+	}
+
+	// Invalid string value
+	var s3 enum.HolidayType
+	if err := s3.Scan("weekend"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.HolidayType
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestHolidayType_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.HolidayType
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
@@ -0,0 +1,85 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// JKKRiskClass represents the work accident risk group of a workplace that sets the
+// BPJS Ketenagakerjaan JKK (jaminan kecelakaan kerja) rate, PP No. 44/2015 Lampiran I.
+// Allowed values (string representation):
+// - "very_low"   // tingkat risiko sangat rendah, 0,24%
+// - "low"        // tingkat risiko rendah, 0,54%
+// - "medium"     // tingkat risiko sedang, 0,89%
+// - "high"       // tingkat risiko tinggi, 1,27%
+// - "very_high"  // tingkat risiko sangat tinggi, 1,74%
+// Use ParseJKKRiskClass to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type JKKRiskClass string
+
+const (
+	JKKRiskVeryLow  JKKRiskClass = "very_low"
+	JKKRiskLow      JKKRiskClass = "low"
+	JKKRiskMedium   JKKRiskClass = "medium"
+	JKKRiskHigh     JKKRiskClass = "high"
+	JKKRiskVeryHigh JKKRiskClass = "very_high"
+)
+
+func (r JKKRiskClass) Valid() bool {
+	switch r {
+	case JKKRiskVeryLow, JKKRiskLow, JKKRiskMedium, JKKRiskHigh, JKKRiskVeryHigh:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseJKKRiskClass(s string) (JKKRiskClass, error) {
+	v := JKKRiskClass(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid JKKRiskClass: %q", s)
+	}
+	return v, nil
+}
+
+func (r JKKRiskClass) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(r))
+}
+
+func (r *JKKRiskClass) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseJKKRiskClass(s)
+	if err != nil {
+		return err
+	}
+	*r = v
+	return nil
+}
+
+func (r JKKRiskClass) Value() (driver.Value, error) {
+	if !r.Valid() {
+		return nil, fmt.Errorf("invalid JKKRiskClass: %q", r)
+	}
+	return string(r), nil
+}
+
+func (r *JKKRiskClass) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseJKKRiskClass(v)
+		if err != nil {
+			return err
+		}
+		*r = parsed
+		return nil
+	case []byte:
+		return r.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for JKKRiskClass: %T", src)
+	}
+}
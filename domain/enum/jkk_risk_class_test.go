@@ -0,0 +1,155 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestJKKRiskClass_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.JKKRiskClass
+		valid bool
+	}{
+		{"very_low valid", enum.JKKRiskVeryLow, true},
+		{"low valid", enum.JKKRiskLow, true},
+		{"medium valid", enum.JKKRiskMedium, true},
+		{"high valid", enum.JKKRiskHigh, true},
+		{"very_high valid", enum.JKKRiskVeryHigh, true},
+		{"invalid value", enum.JKKRiskClass("unknown"), false},
+		{"empty value", enum.JKKRiskClass(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseJKKRiskClass(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.JKKRiskClass
+		wantErr bool
+		name    string
+	}{
+		{"VERY_LOW", enum.JKKRiskVeryLow, false, "upper very low"},
+		{" medium ", enum.JKKRiskMedium, false, "trimmed medium"},
+		{"Very_High", enum.JKKRiskVeryHigh, false, "mixed very high"},
+		{"extreme", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseJKKRiskClass(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJKKRiskClass_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.JKKRiskHigh
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"high\"" {
+		t.Fatalf("Marshal got %s, want \"high\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.JKKRiskClass
+	if err := json.Unmarshal([]byte("\" LOW \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.JKKRiskLow {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.JKKRiskLow)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.JKKRiskClass
+	if err := json.Unmarshal([]byte("\"extreme\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid JKK risk class, got nil")
+	}
+}
+
+func TestJKKRiskClass_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.JKKRiskVeryLow.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "very_low" {
+		t.Fatalf("Value() got %#v, want 'very_low' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.JKKRiskClass = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestJKKRiskClass_Scan(t *testing.T) {
+	// From string
+	var s1 enum.JKKRiskClass
+	if err := s1.Scan("high"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.JKKRiskHigh {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.JKKRiskHigh)
+	}
+
+	// From []byte
+	var s2 enum.JKKRiskClass
+	if err := s2.Scan([]byte("very_low")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.JKKRiskVeryLow {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.JKKRiskVeryLow)
+	}
+
+	// Invalid string value
+	var s3 enum.JKKRiskClass
+	if err := s3.Scan("extreme"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.JKKRiskClass
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestJKKRiskClass_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.JKKRiskClass
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
@@ -0,0 +1,91 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// LeaveEntryType represents the kind of movement recorded in a leave balance ledger.
+// Allowed values (string representation):
+// - "accrual"     // entitlement granted
+// - "usage"       // days taken by an approved request
+// - "reversal"    // days returned by a cancelled request
+// - "carry_over"  // unused days brought into the next year
+// - "expiry"      // days forfeited
+// - "adjustment"  // manual correction by HR
+// Use ParseLeaveEntryType to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type LeaveEntryType string
+
+const (
+	LeaveEntryAccrual    LeaveEntryType = "accrual"
+	LeaveEntryUsage      LeaveEntryType = "usage"
+	LeaveEntryReversal   LeaveEntryType = "reversal"
+	LeaveEntryCarryOver  LeaveEntryType = "carry_over"
+	LeaveEntryExpiry     LeaveEntryType = "expiry"
+	LeaveEntryAdjustment LeaveEntryType = "adjustment"
+)
+
+func (l LeaveEntryType) Valid() bool {
+	switch l {
+	case LeaveEntryAccrual,
+		LeaveEntryUsage,
+		LeaveEntryReversal,
+		LeaveEntryCarryOver,
+		LeaveEntryExpiry,
+		LeaveEntryAdjustment:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseLeaveEntryType(s string) (LeaveEntryType, error) {
+	v := LeaveEntryType(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid LeaveEntryType: %q", s)
+	}
+	return v, nil
+}
+
+func (l LeaveEntryType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(l))
+}
+
+func (l *LeaveEntryType) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseLeaveEntryType(s)
+	if err != nil {
+		return err
+	}
+	*l = v
+	return nil
+}
+
+func (l LeaveEntryType) Value() (driver.Value, error) {
+	if !l.Valid() {
+		return nil, fmt.Errorf("invalid LeaveEntryType: %q", l)
+	}
+	return string(l), nil
+}
+
+func (l *LeaveEntryType) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseLeaveEntryType(v)
+		if err != nil {
+			return err
+		}
+		*l = parsed
+		return nil
+	case []byte:
+		return l.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for LeaveEntryType: %T", src)
+	}
+}
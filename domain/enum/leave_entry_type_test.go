@@ -0,0 +1,159 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestLeaveEntryType_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.LeaveEntryType
+		valid bool
+	}{
+		{"accrual valid", enum.LeaveEntryAccrual, true},
+		{"usage valid", enum.LeaveEntryUsage, true},
+		{"reversal valid", enum.LeaveEntryReversal, true},
+		{"carry_over valid", enum.LeaveEntryCarryOver, true},
+		{"expiry valid", enum.LeaveEntryExpiry, true},
+		{"adjustment valid", enum.LeaveEntryAdjustment, true},
+		{"invalid value", enum.LeaveEntryType("unknown"), false},
+		{"empty value", enum.LeaveEntryType(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseLeaveEntryType(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.LeaveEntryType
+		wantErr bool
+		name    string
+	}{
+		{"ACCRUAL", enum.LeaveEntryAccrual, false, "upper accrual"},
+		{" usage ", enum.LeaveEntryUsage, false, "trimmed usage"},
+		{"Reversal", enum.LeaveEntryReversal, false, "mixed reversal"},
+		{"carry_over", enum.LeaveEntryCarryOver, false, "lower carry_over"},
+		{"EXPIRY", enum.LeaveEntryExpiry, false, "upper expiry"},
+		{"Adjustment", enum.LeaveEntryAdjustment, false, "mixed adjustment"},
+		{"carry over", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseLeaveEntryType(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLeaveEntryType_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.LeaveEntryCarryOver
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"carry_over\"" {
+		t.Fatalf("Marshal got %s, want \"carry_over\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.LeaveEntryType
+	if err := json.Unmarshal([]byte("\" USAGE \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.LeaveEntryUsage {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.LeaveEntryUsage)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.LeaveEntryType
+	if err := json.Unmarshal([]byte("\"carry over\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid leave entry type, got nil")
+	}
+}
+
+func TestLeaveEntryType_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.LeaveEntryAccrual.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "accrual" {
+		t.Fatalf("Value() got %#v, want 'accrual' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.LeaveEntryType = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestLeaveEntryType_Scan(t *testing.T) {
+	// From string
+	var s1 enum.LeaveEntryType
+	if err := s1.Scan("EXPIRY"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.LeaveEntryExpiry {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.LeaveEntryExpiry)
+	}
+
+	// From []byte
+	var s2 enum.LeaveEntryType
+	if err := s2.Scan([]byte("accrual")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.LeaveEntryAccrual {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.LeaveEntryAccrual)
+	}
+
+	// Invalid string value
+	var s3 enum.LeaveEntryType
+	if err := s3.Scan("carry over"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.LeaveEntryType
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestLeaveEntryType_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.LeaveEntryType
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
@@ -0,0 +1,82 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// LeaveRequestStatus represents the approval state of a leave request.
+// Allowed values (string representation):
+// - "pending"
+// - "approved"
+// - "rejected"
+// - "cancelled"
+// Use ParseLeaveRequestStatus to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type LeaveRequestStatus string
+
+const (
+	LeaveRequestPending   LeaveRequestStatus = "pending"
+	LeaveRequestApproved  LeaveRequestStatus = "approved"
+	LeaveRequestRejected  LeaveRequestStatus = "rejected"
+	LeaveRequestCancelled LeaveRequestStatus = "cancelled"
+)
+
+func (l LeaveRequestStatus) Valid() bool {
+	switch l {
+	case LeaveRequestPending, LeaveRequestApproved, LeaveRequestRejected, LeaveRequestCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseLeaveRequestStatus(s string) (LeaveRequestStatus, error) {
+	v := LeaveRequestStatus(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid LeaveRequestStatus: %q", s)
+	}
+	return v, nil
+}
+
+func (l LeaveRequestStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(l))
+}
+
+func (l *LeaveRequestStatus) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseLeaveRequestStatus(s)
+	if err != nil {
+		return err
+	}
+	*l = v
+	return nil
+}
+
+func (l LeaveRequestStatus) Value() (driver.Value, error) {
+	if !l.Valid() {
+		return nil, fmt.Errorf("invalid LeaveRequestStatus: %q", l)
+	}
+	return string(l), nil
+}
+
+func (l *LeaveRequestStatus) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseLeaveRequestStatus(v)
+		if err != nil {
+			return err
+		}
+		*l = parsed
+		return nil
+	case []byte:
+		return l.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for LeaveRequestStatus: %T", src)
+	}
+}
@@ -0,0 +1,155 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestLeaveRequestStatus_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.LeaveRequestStatus
+		valid bool
+	}{
+		{"pending valid", enum.LeaveRequestPending, true},
+		{"approved valid", enum.LeaveRequestApproved, true},
+		{"rejected valid", enum.LeaveRequestRejected, true},
+		{"cancelled valid", enum.LeaveRequestCancelled, true},
+		{"invalid value", enum.LeaveRequestStatus("unknown"), false},
+		{"empty value", enum.LeaveRequestStatus(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseLeaveRequestStatus(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.LeaveRequestStatus
+		wantErr bool
+		name    string
+	}{
+		{"PENDING", enum.LeaveRequestPending, false, "upper pending"},
+		{" approved ", enum.LeaveRequestApproved, false, "trimmed approved"},
+		{"Rejected", enum.LeaveRequestRejected, false, "mixed rejected"},
+		{"cancelled", enum.LeaveRequestCancelled, false, "lower cancelled"},
+		{"canceled", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseLeaveRequestStatus(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLeaveRequestStatus_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.LeaveRequestApproved
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"approved\"" {
+		t.Fatalf("Marshal got %s, want \"approved\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.LeaveRequestStatus
+	if err := json.Unmarshal([]byte("\" REJECTED \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.LeaveRequestRejected {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.LeaveRequestRejected)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.LeaveRequestStatus
+	if err := json.Unmarshal([]byte("\"canceled\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid leave request status, got nil")
+	}
+}
+
+func TestLeaveRequestStatus_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.LeaveRequestPending.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "pending" {
+		t.Fatalf("Value() got %#v, want 'pending' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.LeaveRequestStatus = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestLeaveRequestStatus_Scan(t *testing.T) {
+	// From string
+	var s1 enum.LeaveRequestStatus
+	if err := s1.Scan("CANCELLED"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.LeaveRequestCancelled {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.LeaveRequestCancelled)
+	}
+
+	// From []byte
+	var s2 enum.LeaveRequestStatus
+	if err := s2.Scan([]byte("pending")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.LeaveRequestPending {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.LeaveRequestPending)
+	}
+
+	// Invalid string value
+	var s3 enum.LeaveRequestStatus
+	if err := s3.Scan("canceled"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.LeaveRequestStatus
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestLeaveRequestStatus_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.LeaveRequestStatus
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
@@ -0,0 +1,104 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// LeaveType represents the kinds of leave recognised under UU No. 13/2003 (Ketenagakerjaan)
+// plus unpaid leave.
+// Allowed values (string representation):
+// - "annual"                  // cuti tahunan, Pasal 79
+// - "sick"                    // sakit dengan surat dokter, Pasal 93
+// - "maternity"               // cuti melahirkan, Pasal 82
+// - "paternity"               // istri melahirkan/keguguran, Pasal 93
+// - "marriage"                // pekerja menikah, Pasal 93
+// - "child_marriage"          // menikahkan anak, Pasal 93
+// - "child_circumcision"      // mengkhitankan/membaptiskan anak, Pasal 93
+// - "bereavement"             // suami/istri, orang tua/mertua, anak/menantu meninggal, Pasal 93
+// - "household_bereavement"   // anggota keluarga dalam satu rumah meninggal, Pasal 93
+// - "unpaid"                  // cuti di luar tanggungan
+// Use ParseLeaveType to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type LeaveType string
+
+const (
+	LeaveAnnual               LeaveType = "annual"
+	LeaveSick                 LeaveType = "sick"
+	LeaveMaternity            LeaveType = "maternity"
+	LeavePaternity            LeaveType = "paternity"
+	LeaveMarriage             LeaveType = "marriage"
+	LeaveChildMarriage        LeaveType = "child_marriage"
+	LeaveChildCircumcision    LeaveType = "child_circumcision"
+	LeaveBereavement          LeaveType = "bereavement"
+	LeaveHouseholdBereavement LeaveType = "household_bereavement"
+	LeaveUnpaid               LeaveType = "unpaid"
+)
+
+func (l LeaveType) Valid() bool {
+	switch l {
+	case LeaveAnnual,
+		LeaveSick,
+		LeaveMaternity,
+		LeavePaternity,
+		LeaveMarriage,
+		LeaveChildMarriage,
+		LeaveChildCircumcision,
+		LeaveBereavement,
+		LeaveHouseholdBereavement,
+		LeaveUnpaid:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseLeaveType(s string) (LeaveType, error) {
+	v := LeaveType(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid LeaveType: %q", s)
+	}
+	return v, nil
+}
+
+func (l LeaveType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(l))
+}
+
+func (l *LeaveType) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseLeaveType(s)
+	if err != nil {
+		return err
+	}
+	*l = v
+	return nil
+}
+
+func (l LeaveType) Value() (driver.Value, error) {
+	if !l.Valid() {
+		return nil, fmt.Errorf("invalid LeaveType: %q", l)
+	}
+	return string(l), nil
+}
+
+func (l *LeaveType) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseLeaveType(v)
+		if err != nil {
+			return err
+		}
+		*l = parsed
+		return nil
+	case []byte:
+		return l.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for LeaveType: %T", src)
+	}
+}
@@ -0,0 +1,167 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestLeaveType_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.LeaveType
+		valid bool
+	}{
+		{"annual valid", enum.LeaveAnnual, true},
+		{"sick valid", enum.LeaveSick, true},
+		{"maternity valid", enum.LeaveMaternity, true},
+		{"paternity valid", enum.LeavePaternity, true},
+		{"marriage valid", enum.LeaveMarriage, true},
+		{"child_marriage valid", enum.LeaveChildMarriage, true},
+		{"child_circumcision valid", enum.LeaveChildCircumcision, true},
+		{"bereavement valid", enum.LeaveBereavement, true},
+		{"household_bereavement valid", enum.LeaveHouseholdBereavement, true},
+		{"unpaid valid", enum.LeaveUnpaid, true},
+		{"invalid value", enum.LeaveType("unknown"), false},
+		{"empty value", enum.LeaveType(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseLeaveType(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.LeaveType
+		wantErr bool
+		name    string
+	}{
+		{"ANNUAL", enum.LeaveAnnual, false, "upper annual"},
+		{" sick ", enum.LeaveSick, false, "trimmed sick"},
+		{"Maternity", enum.LeaveMaternity, false, "mixed maternity"},
+		{"paternity", enum.LeavePaternity, false, "lower paternity"},
+		{"MARRIAGE", enum.LeaveMarriage, false, "upper marriage"},
+		{"child_marriage", enum.LeaveChildMarriage, false, "lower child_marriage"},
+		{"Child_Circumcision", enum.LeaveChildCircumcision, false, "mixed child_circumcision"},
+		{"bereavement", enum.LeaveBereavement, false, "lower bereavement"},
+		{"household_bereavement", enum.LeaveHouseholdBereavement, false, "lower household_bereavement"},
+		{"Unpaid", enum.LeaveUnpaid, false, "mixed unpaid"},
+		{"vacation", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseLeaveType(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLeaveType_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.LeaveAnnual
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"annual\"" {
+		t.Fatalf("Marshal got %s, want \"annual\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.LeaveType
+	if err := json.Unmarshal([]byte("\" MATERNITY \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.LeaveMaternity {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.LeaveMaternity)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.LeaveType
+	if err := json.Unmarshal([]byte("\"vacation\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid leave type, got nil")
+	}
+}
+
+func TestLeaveType_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.LeaveAnnual.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "annual" {
+		t.Fatalf("Value() got %#v, want 'annual' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.LeaveType = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestLeaveType_Scan(t *testing.T) {
+	// From string
+	var s1 enum.LeaveType
+	if err := s1.Scan("SICK"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.LeaveSick {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.LeaveSick)
+	}
+
+	// From []byte
+	var s2 enum.LeaveType
+	if err := s2.Scan([]byte("marriage")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.LeaveMarriage {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.LeaveMarriage)
+	}
+
+	// Invalid string value
+	var s3 enum.LeaveType
+	if err := s3.Scan("vacation"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.LeaveType
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestLeaveType_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.LeaveType
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
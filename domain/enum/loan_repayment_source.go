@@ -0,0 +1,80 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// LoanRepaymentSource represents how a loan repayment was made.
+// Allowed values (string representation):
+// - "payroll"           // deducted from a payslip
+// - "early_settlement"  // the remaining principal paid off before the schedule ends
+// - "receivable"        // principal the final payslip could not cover, owed by the former employee
+// Use ParseLoanRepaymentSource to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type LoanRepaymentSource string
+
+const (
+	RepaymentPayroll         LoanRepaymentSource = "payroll"
+	RepaymentEarlySettlement LoanRepaymentSource = "early_settlement"
+	RepaymentReceivable      LoanRepaymentSource = "receivable"
+)
+
+func (rs LoanRepaymentSource) Valid() bool {
+	switch rs {
+	case RepaymentPayroll, RepaymentEarlySettlement, RepaymentReceivable:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseLoanRepaymentSource(s string) (LoanRepaymentSource, error) {
+	v := LoanRepaymentSource(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid LoanRepaymentSource: %q", s)
+	}
+	return v, nil
+}
+
+func (rs LoanRepaymentSource) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(rs))
+}
+
+func (rs *LoanRepaymentSource) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseLoanRepaymentSource(s)
+	if err != nil {
+		return err
+	}
+	*rs = v
+	return nil
+}
+
+func (rs LoanRepaymentSource) Value() (driver.Value, error) {
+	if !rs.Valid() {
+		return nil, fmt.Errorf("invalid LoanRepaymentSource: %q", rs)
+	}
+	return string(rs), nil
+}
+
+func (rs *LoanRepaymentSource) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseLoanRepaymentSource(v)
+		if err != nil {
+			return err
+		}
+		*rs = parsed
+		return nil
+	case []byte:
+		return rs.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for LoanRepaymentSource: %T", src)
+	}
+}
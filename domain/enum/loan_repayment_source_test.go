@@ -0,0 +1,152 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestLoanRepaymentSource_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.LoanRepaymentSource
+		valid bool
+	}{
+		{"payroll valid", enum.RepaymentPayroll, true},
+		{"early_settlement valid", enum.RepaymentEarlySettlement, true},
+		{"receivable valid", enum.RepaymentReceivable, true},
+		{"invalid value", enum.LoanRepaymentSource("unknown"), false},
+		{"empty value", enum.LoanRepaymentSource(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseLoanRepaymentSource(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.LoanRepaymentSource
+		wantErr bool
+		name    string
+	}{
+		{"PAYROLL", enum.RepaymentPayroll, false, "upper payroll"},
+		{" early_settlement ", enum.RepaymentEarlySettlement, false, "trimmed early settlement"},
+		{"cash", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseLoanRepaymentSource(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoanRepaymentSource_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.RepaymentPayroll
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"payroll\"" {
+		t.Fatalf("Marshal got %s, want \"payroll\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.LoanRepaymentSource
+	if err := json.Unmarshal([]byte("\" Payroll \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.RepaymentPayroll {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.RepaymentPayroll)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.LoanRepaymentSource
+	if err := json.Unmarshal([]byte("\"cash\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid loan repayment source, got nil")
+	}
+}
+
+func TestLoanRepaymentSource_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.RepaymentPayroll.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "payroll" {
+		t.Fatalf("Value() got %#v, want 'payroll' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.LoanRepaymentSource = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestLoanRepaymentSource_Scan(t *testing.T) {
+	// From string
+	var s1 enum.LoanRepaymentSource
+	if err := s1.Scan("payroll"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.RepaymentPayroll {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.RepaymentPayroll)
+	}
+
+	// From []byte
+	var s2 enum.LoanRepaymentSource
+	if err := s2.Scan([]byte("early_settlement")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.RepaymentEarlySettlement {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.RepaymentEarlySettlement)
+	}
+
+	// Invalid string value
+	var s3 enum.LoanRepaymentSource
+	if err := s3.Scan("cash"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.LoanRepaymentSource
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestLoanRepaymentSource_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.LoanRepaymentSource
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
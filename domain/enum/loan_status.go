@@ -0,0 +1,80 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// LoanStatus represents the repayment state of a loan.
+// Allowed values (string representation):
+// - "active"   // installments are deducted from payroll
+// - "paused"   // deductions are suspended until the loan is resumed
+// - "settled"  // the loan is fully repaid
+// Use ParseLoanStatus to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type LoanStatus string
+
+const (
+	LoanActive  LoanStatus = "active"
+	LoanPaused  LoanStatus = "paused"
+	LoanSettled LoanStatus = "settled"
+)
+
+func (ls LoanStatus) Valid() bool {
+	switch ls {
+	case LoanActive, LoanPaused, LoanSettled:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseLoanStatus(s string) (LoanStatus, error) {
+	v := LoanStatus(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid LoanStatus: %q", s)
+	}
+	return v, nil
+}
+
+func (ls LoanStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(ls))
+}
+
+func (ls *LoanStatus) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseLoanStatus(s)
+	if err != nil {
+		return err
+	}
+	*ls = v
+	return nil
+}
+
+func (ls LoanStatus) Value() (driver.Value, error) {
+	if !ls.Valid() {
+		return nil, fmt.Errorf("invalid LoanStatus: %q", ls)
+	}
+	return string(ls), nil
+}
+
+func (ls *LoanStatus) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseLoanStatus(v)
+		if err != nil {
+			return err
+		}
+		*ls = parsed
+		return nil
+	case []byte:
+		return ls.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for LoanStatus: %T", src)
+	}
+}
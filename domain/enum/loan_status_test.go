@@ -0,0 +1,152 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestLoanStatus_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.LoanStatus
+		valid bool
+	}{
+		{"active valid", enum.LoanActive, true},
+		{"paused valid", enum.LoanPaused, true},
+		{"settled valid", enum.LoanSettled, true},
+		{"invalid value", enum.LoanStatus("unknown"), false},
+		{"empty value", enum.LoanStatus(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseLoanStatus(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.LoanStatus
+		wantErr bool
+		name    string
+	}{
+		{"ACTIVE", enum.LoanActive, false, "upper active"},
+		{" paused ", enum.LoanPaused, false, "trimmed paused"},
+		{"defaulted", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseLoanStatus(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoanStatus_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.LoanSettled
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"settled\"" {
+		t.Fatalf("Marshal got %s, want \"settled\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.LoanStatus
+	if err := json.Unmarshal([]byte("\" Active \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.LoanActive {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.LoanActive)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.LoanStatus
+	if err := json.Unmarshal([]byte("\"defaulted\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid loan status, got nil")
+	}
+}
+
+func TestLoanStatus_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.LoanActive.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "active" {
+		t.Fatalf("Value() got %#v, want 'active' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.LoanStatus = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestLoanStatus_Scan(t *testing.T) {
+	// From string
+	var s1 enum.LoanStatus
+	if err := s1.Scan("paused"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.LoanPaused {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.LoanPaused)
+	}
+
+	// From []byte
+	var s2 enum.LoanStatus
+	if err := s2.Scan([]byte("settled")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.LoanSettled {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.LoanSettled)
+	}
+
+	// Invalid string value
+	var s3 enum.LoanStatus
+	if err := s3.Scan("defaulted"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.LoanStatus
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestLoanStatus_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.LoanStatus
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
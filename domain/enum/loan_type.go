@@ -0,0 +1,78 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// LoanType represents the kind of money lent to an employee.
+// Allowed values (string representation):
+// - "loan"            // company loan repaid in installments, optionally with interest
+// - "salary_advance"  // kasbon, an interest free advance on salary
+// Use ParseLoanType to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type LoanType string
+
+const (
+	LoanCompany       LoanType = "loan"
+	LoanSalaryAdvance LoanType = "salary_advance"
+)
+
+func (lt LoanType) Valid() bool {
+	switch lt {
+	case LoanCompany, LoanSalaryAdvance:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseLoanType(s string) (LoanType, error) {
+	v := LoanType(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid LoanType: %q", s)
+	}
+	return v, nil
+}
+
+func (lt LoanType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(lt))
+}
+
+func (lt *LoanType) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseLoanType(s)
+	if err != nil {
+		return err
+	}
+	*lt = v
+	return nil
+}
+
+func (lt LoanType) Value() (driver.Value, error) {
+	if !lt.Valid() {
+		return nil, fmt.Errorf("invalid LoanType: %q", lt)
+	}
+	return string(lt), nil
+}
+
+func (lt *LoanType) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseLoanType(v)
+		if err != nil {
+			return err
+		}
+		*lt = parsed
+		return nil
+	case []byte:
+		return lt.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for LoanType: %T", src)
+	}
+}
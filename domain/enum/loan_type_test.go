@@ -0,0 +1,151 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestLoanType_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.LoanType
+		valid bool
+	}{
+		{"loan valid", enum.LoanCompany, true},
+		{"salary_advance valid", enum.LoanSalaryAdvance, true},
+		{"invalid value", enum.LoanType("unknown"), false},
+		{"empty value", enum.LoanType(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseLoanType(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.LoanType
+		wantErr bool
+		name    string
+	}{
+		{"LOAN", enum.LoanCompany, false, "upper loan"},
+		{" salary_advance ", enum.LoanSalaryAdvance, false, "trimmed salary advance"},
+		{"mortgage", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseLoanType(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoanType_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.LoanSalaryAdvance
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"salary_advance\"" {
+		t.Fatalf("Marshal got %s, want \"salary_advance\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.LoanType
+	if err := json.Unmarshal([]byte("\" Loan \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.LoanCompany {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.LoanCompany)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.LoanType
+	if err := json.Unmarshal([]byte("\"mortgage\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid loan type, got nil")
+	}
+}
+
+func TestLoanType_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.LoanCompany.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "loan" {
+		t.Fatalf("Value() got %#v, want 'loan' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.LoanType = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestLoanType_Scan(t *testing.T) {
+	// From string
+	var s1 enum.LoanType
+	if err := s1.Scan("loan"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.LoanCompany {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.LoanCompany)
+	}
+
+	// From []byte
+	var s2 enum.LoanType
+	if err := s2.Scan([]byte("salary_advance")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.LoanSalaryAdvance {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.LoanSalaryAdvance)
+	}
+
+	// Invalid string value
+	var s3 enum.LoanType
+	if err := s3.Scan("mortgage"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.LoanType
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestLoanType_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.LoanType
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
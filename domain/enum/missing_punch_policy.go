@@ -0,0 +1,80 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// MissingPunchPolicy decides how a working day with only a clock-in or only a clock-out is treated.
+// Allowed values (string representation):
+// - "flag"          // keep the day as missing_punch for HR follow-up
+// - "use_schedule"  // assume the scheduled start/end for the missing punch
+// - "absent"        // treat the day as absent
+// Use ParseMissingPunchPolicy to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type MissingPunchPolicy string
+
+const (
+	MissingPunchFlag        MissingPunchPolicy = "flag"
+	MissingPunchUseSchedule MissingPunchPolicy = "use_schedule"
+	MissingPunchAbsent      MissingPunchPolicy = "absent"
+)
+
+func (m MissingPunchPolicy) Valid() bool {
+	switch m {
+	case MissingPunchFlag, MissingPunchUseSchedule, MissingPunchAbsent:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseMissingPunchPolicy(s string) (MissingPunchPolicy, error) {
+	v := MissingPunchPolicy(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid MissingPunchPolicy: %q", s)
+	}
+	return v, nil
+}
+
+func (m MissingPunchPolicy) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(m))
+}
+
+func (m *MissingPunchPolicy) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseMissingPunchPolicy(s)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+func (m MissingPunchPolicy) Value() (driver.Value, error) {
+	if !m.Valid() {
+		return nil, fmt.Errorf("invalid MissingPunchPolicy: %q", m)
+	}
+	return string(m), nil
+}
+
+func (m *MissingPunchPolicy) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseMissingPunchPolicy(v)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	case []byte:
+		return m.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for MissingPunchPolicy: %T", src)
+	}
+}
@@ -0,0 +1,153 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestMissingPunchPolicy_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.MissingPunchPolicy
+		valid bool
+	}{
+		{"flag valid", enum.MissingPunchFlag, true},
+		{"use_schedule valid", enum.MissingPunchUseSchedule, true},
+		{"absent valid", enum.MissingPunchAbsent, true},
+		{"invalid value", enum.MissingPunchPolicy("unknown"), false},
+		{"empty value", enum.MissingPunchPolicy(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseMissingPunchPolicy(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.MissingPunchPolicy
+		wantErr bool
+		name    string
+	}{
+		{"FLAG", enum.MissingPunchFlag, false, "upper flag"},
+		{" use_schedule ", enum.MissingPunchUseSchedule, false, "trimmed use_schedule"},
+		{"Absent", enum.MissingPunchAbsent, false, "mixed absent"},
+		{"ignore", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseMissingPunchPolicy(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMissingPunchPolicy_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.MissingPunchUseSchedule
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"use_schedule\"" {
+		t.Fatalf("Marshal got %s, want \"use_schedule\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.MissingPunchPolicy
+	if err := json.Unmarshal([]byte("\" FLAG \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.MissingPunchFlag {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.MissingPunchFlag)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.MissingPunchPolicy
+	if err := json.Unmarshal([]byte("\"ignore\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid missing punch policy, got nil")
+	}
+}
+
+func TestMissingPunchPolicy_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.MissingPunchFlag.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "flag" {
+		t.Fatalf("Value() got %#v, want 'flag' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.MissingPunchPolicy = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestMissingPunchPolicy_Scan(t *testing.T) {
+	// From string
+	var s1 enum.MissingPunchPolicy
+	if err := s1.Scan("ABSENT"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.MissingPunchAbsent {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.MissingPunchAbsent)
+	}
+
+	// From []byte
+	var s2 enum.MissingPunchPolicy
+	if err := s2.Scan([]byte("flag")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.MissingPunchFlag {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.MissingPunchFlag)
+	}
+
+	// Invalid string value
+	var s3 enum.MissingPunchPolicy
+	if err := s3.Scan("ignore"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.MissingPunchPolicy
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestMissingPunchPolicy_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.MissingPunchPolicy
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
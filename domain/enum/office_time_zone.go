@@ -0,0 +1,96 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// OfficeTimeZone represents the Indonesian time zone an office operates in.
+// Allowed values (string representation):
+// - "wib"   // Waktu Indonesia Barat, UTC+7
+// - "wita"  // Waktu Indonesia Tengah, UTC+8
+// - "wit"   // Waktu Indonesia Timur, UTC+9
+// Use ParseOfficeTimeZone to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type OfficeTimeZone string
+
+const (
+	TimeZoneWIB  OfficeTimeZone = "wib"
+	TimeZoneWITA OfficeTimeZone = "wita"
+	TimeZoneWIT  OfficeTimeZone = "wit"
+)
+
+func (o OfficeTimeZone) Valid() bool {
+	switch o {
+	case TimeZoneWIB, TimeZoneWITA, TimeZoneWIT:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseOfficeTimeZone(s string) (OfficeTimeZone, error) {
+	v := OfficeTimeZone(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid OfficeTimeZone: %q", s)
+	}
+	return v, nil
+}
+
+// Location returns the fixed-offset location of the time zone. Indonesia does not observe
+// daylight saving time, so a fixed offset is exact and needs no tzdata. An invalid value yields UTC.
+func (o OfficeTimeZone) Location() *time.Location {
+	switch o {
+	case TimeZoneWIB:
+		return time.FixedZone("WIB", 7*60*60)
+	case TimeZoneWITA:
+		return time.FixedZone("WITA", 8*60*60)
+	case TimeZoneWIT:
+		return time.FixedZone("WIT", 9*60*60)
+	default:
+		return time.UTC
+	}
+}
+
+func (o OfficeTimeZone) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(o))
+}
+
+func (o *OfficeTimeZone) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseOfficeTimeZone(s)
+	if err != nil {
+		return err
+	}
+	*o = v
+	return nil
+}
+
+func (o OfficeTimeZone) Value() (driver.Value, error) {
+	if !o.Valid() {
+		return nil, fmt.Errorf("invalid OfficeTimeZone: %q", o)
+	}
+	return string(o), nil
+}
+
+func (o *OfficeTimeZone) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseOfficeTimeZone(v)
+		if err != nil {
+			return err
+		}
+		*o = parsed
+		return nil
+	case []byte:
+		return o.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for OfficeTimeZone: %T", src)
+	}
+}
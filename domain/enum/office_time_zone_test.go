@@ -0,0 +1,176 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestOfficeTimeZone_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.OfficeTimeZone
+		valid bool
+	}{
+		{"wib valid", enum.TimeZoneWIB, true},
+		{"wita valid", enum.TimeZoneWITA, true},
+		{"wit valid", enum.TimeZoneWIT, true},
+		{"invalid value", enum.OfficeTimeZone("unknown"), false},
+		{"empty value", enum.OfficeTimeZone(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseOfficeTimeZone(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.OfficeTimeZone
+		wantErr bool
+		name    string
+	}{
+		{"WIB", enum.TimeZoneWIB, false, "upper wib"},
+		{" wita ", enum.TimeZoneWITA, false, "trimmed wita"},
+		{"Wit", enum.TimeZoneWIT, false, "mixed wit"},
+		{"wet", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseOfficeTimeZone(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOfficeTimeZone_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.TimeZoneWITA
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"wita\"" {
+		t.Fatalf("Marshal got %s, want \"wita\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.OfficeTimeZone
+	if err := json.Unmarshal([]byte("\" WIT \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.TimeZoneWIT {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.TimeZoneWIT)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.OfficeTimeZone
+	if err := json.Unmarshal([]byte("\"wet\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid office time zone, got nil")
+	}
+}
+
+func TestOfficeTimeZone_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.TimeZoneWIB.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "wib" {
+		t.Fatalf("Value() got %#v, want 'wib' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.OfficeTimeZone = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestOfficeTimeZone_Scan(t *testing.T) {
+	// From string
+	var s1 enum.OfficeTimeZone
+	if err := s1.Scan("WIB"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.TimeZoneWIB {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.TimeZoneWIB)
+	}
+
+	// From []byte
+	var s2 enum.OfficeTimeZone
+	if err := s2.Scan([]byte("wita")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.TimeZoneWITA {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.TimeZoneWITA)
+	}
+
+	// Invalid string value
+	var s3 enum.OfficeTimeZone
+	if err := s3.Scan("wet"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.OfficeTimeZone
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestOfficeTimeZone_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.OfficeTimeZone
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
+
+func TestOfficeTimeZone_Location(t *testing.T) {
+	tests := []struct {
+		zone   enum.OfficeTimeZone
+		name   string
+		offset int
+	}{
+		{enum.TimeZoneWIB, "WIB", 7 * 60 * 60},
+		{enum.TimeZoneWITA, "WITA", 8 * 60 * 60},
+		{enum.TimeZoneWIT, "WIT", 9 * 60 * 60},
+		{enum.OfficeTimeZone("invalid"), "UTC", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, offset := time.Date(2025, 1, 1, 0, 0, 0, 0, tt.zone.Location()).Zone()
+			if name != tt.name || offset != tt.offset {
+				t.Fatalf("Location() got %s%+d, want %s%+d", name, offset, tt.name, tt.offset)
+			}
+		})
+	}
+}
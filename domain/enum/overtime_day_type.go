@@ -0,0 +1,81 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// OvertimeDayType represents the kind of day overtime is worked on, which determines
+// the pay multipliers of Kepmenakertrans No. KEP.102/MEN/VI/2004 Pasal 11.
+// Allowed values (string representation):
+// - "workday"         // overtime after normal working hours
+// - "rest_day"        // weekly rest day or cuti bersama
+// - "public_holiday"  // hari libur resmi
+// Use ParseOvertimeDayType to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type OvertimeDayType string
+
+const (
+	OvertimeWorkday       OvertimeDayType = "workday"
+	OvertimeRestDay       OvertimeDayType = "rest_day"
+	OvertimePublicHoliday OvertimeDayType = "public_holiday"
+)
+
+func (d OvertimeDayType) Valid() bool {
+	switch d {
+	case OvertimeWorkday, OvertimeRestDay, OvertimePublicHoliday:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseOvertimeDayType(s string) (OvertimeDayType, error) {
+	v := OvertimeDayType(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid OvertimeDayType: %q", s)
+	}
+	return v, nil
+}
+
+func (d OvertimeDayType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(d))
+}
+
+func (d *OvertimeDayType) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseOvertimeDayType(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+func (d OvertimeDayType) Value() (driver.Value, error) {
+	if !d.Valid() {
+		return nil, fmt.Errorf("invalid OvertimeDayType: %q", d)
+	}
+	return string(d), nil
+}
+
+func (d *OvertimeDayType) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseOvertimeDayType(v)
+		if err != nil {
+			return err
+		}
+		*d = parsed
+		return nil
+	case []byte:
+		return d.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for OvertimeDayType: %T", src)
+	}
+}
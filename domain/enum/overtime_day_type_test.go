@@ -0,0 +1,153 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestOvertimeDayType_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.OvertimeDayType
+		valid bool
+	}{
+		{"workday valid", enum.OvertimeWorkday, true},
+		{"rest_day valid", enum.OvertimeRestDay, true},
+		{"public_holiday valid", enum.OvertimePublicHoliday, true},
+		{"invalid value", enum.OvertimeDayType("unknown"), false},
+		{"empty value", enum.OvertimeDayType(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseOvertimeDayType(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.OvertimeDayType
+		wantErr bool
+		name    string
+	}{
+		{"WORKDAY", enum.OvertimeWorkday, false, "upper workday"},
+		{" rest_day ", enum.OvertimeRestDay, false, "trimmed rest day"},
+		{"Public_Holiday", enum.OvertimePublicHoliday, false, "mixed public holiday"},
+		{"weekend", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseOvertimeDayType(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOvertimeDayType_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.OvertimeRestDay
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"rest_day\"" {
+		t.Fatalf("Marshal got %s, want \"rest_day\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.OvertimeDayType
+	if err := json.Unmarshal([]byte("\" WORKDAY \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.OvertimeWorkday {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.OvertimeWorkday)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.OvertimeDayType
+	if err := json.Unmarshal([]byte("\"weekend\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid overtime day type, got nil")
+	}
+}
+
+func TestOvertimeDayType_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.OvertimeWorkday.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "workday" {
+		t.Fatalf("Value() got %#v, want 'workday' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.OvertimeDayType = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestOvertimeDayType_Scan(t *testing.T) {
+	// From string
+	var s1 enum.OvertimeDayType
+	if err := s1.Scan("rest_day"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.OvertimeRestDay {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.OvertimeRestDay)
+	}
+
+	// From []byte
+	var s2 enum.OvertimeDayType
+	if err := s2.Scan([]byte("public_holiday")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.OvertimePublicHoliday {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.OvertimePublicHoliday)
+	}
+
+	// Invalid string value
+	var s3 enum.OvertimeDayType
+	if err := s3.Scan("weekend"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.OvertimeDayType
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestOvertimeDayType_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.OvertimeDayType
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
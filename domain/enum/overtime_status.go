@@ -0,0 +1,84 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// OvertimeStatus represents the lifecycle of an overtime request.
+// Allowed values (string representation):
+// - "pending"    // waiting for approval
+// - "approved"   // approved, overtime may be worked
+// - "rejected"   // rejected by the approver
+// - "cancelled"  // withdrawn before it was worked
+// - "completed"  // actual hours recorded, ready for payroll
+// Use ParseOvertimeStatus to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type OvertimeStatus string
+
+const (
+	OvertimePending   OvertimeStatus = "pending"
+	OvertimeApproved  OvertimeStatus = "approved"
+	OvertimeRejected  OvertimeStatus = "rejected"
+	OvertimeCancelled OvertimeStatus = "cancelled"
+	OvertimeCompleted OvertimeStatus = "completed"
+)
+
+func (o OvertimeStatus) Valid() bool {
+	switch o {
+	case OvertimePending, OvertimeApproved, OvertimeRejected, OvertimeCancelled, OvertimeCompleted:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseOvertimeStatus(s string) (OvertimeStatus, error) {
+	v := OvertimeStatus(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid OvertimeStatus: %q", s)
+	}
+	return v, nil
+}
+
+func (o OvertimeStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(o))
+}
+
+func (o *OvertimeStatus) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseOvertimeStatus(s)
+	if err != nil {
+		return err
+	}
+	*o = v
+	return nil
+}
+
+func (o OvertimeStatus) Value() (driver.Value, error) {
+	if !o.Valid() {
+		return nil, fmt.Errorf("invalid OvertimeStatus: %q", o)
+	}
+	return string(o), nil
+}
+
+func (o *OvertimeStatus) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseOvertimeStatus(v)
+		if err != nil {
+			return err
+		}
+		*o = parsed
+		return nil
+	case []byte:
+		return o.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for OvertimeStatus: %T", src)
+	}
+}
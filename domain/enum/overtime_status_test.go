@@ -0,0 +1,155 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestOvertimeStatus_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.OvertimeStatus
+		valid bool
+	}{
+		{"pending valid", enum.OvertimePending, true},
+		{"approved valid", enum.OvertimeApproved, true},
+		{"rejected valid", enum.OvertimeRejected, true},
+		{"cancelled valid", enum.OvertimeCancelled, true},
+		{"completed valid", enum.OvertimeCompleted, true},
+		{"invalid value", enum.OvertimeStatus("unknown"), false},
+		{"empty value", enum.OvertimeStatus(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseOvertimeStatus(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.OvertimeStatus
+		wantErr bool
+		name    string
+	}{
+		{"PENDING", enum.OvertimePending, false, "upper pending"},
+		{" approved ", enum.OvertimeApproved, false, "trimmed approved"},
+		{"Completed", enum.OvertimeCompleted, false, "mixed completed"},
+		{"paid", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseOvertimeStatus(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOvertimeStatus_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.OvertimeRejected
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"rejected\"" {
+		t.Fatalf("Marshal got %s, want \"rejected\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.OvertimeStatus
+	if err := json.Unmarshal([]byte("\" CANCELLED \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.OvertimeCancelled {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.OvertimeCancelled)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.OvertimeStatus
+	if err := json.Unmarshal([]byte("\"paid\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid overtime status, got nil")
+	}
+}
+
+func TestOvertimeStatus_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.OvertimePending.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "pending" {
+		t.Fatalf("Value() got %#v, want 'pending' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.OvertimeStatus = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestOvertimeStatus_Scan(t *testing.T) {
+	// From string
+	var s1 enum.OvertimeStatus
+	if err := s1.Scan("approved"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.OvertimeApproved {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.OvertimeApproved)
+	}
+
+	// From []byte
+	var s2 enum.OvertimeStatus
+	if err := s2.Scan([]byte("completed")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.OvertimeCompleted {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.OvertimeCompleted)
+	}
+
+	// Invalid string value
+	var s3 enum.OvertimeStatus
+	if err := s3.Scan("paid"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.OvertimeStatus
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestOvertimeStatus_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.OvertimeStatus
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
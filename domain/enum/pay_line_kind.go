@@ -0,0 +1,80 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// PayLineKind represents how a payslip line affects pay.
+// Allowed values (string representation):
+// - "earning"                // added to gross pay
+// - "deduction"              // withheld from gross pay
+// - "employer_contribution"  // paid by the employer on top of pay (e.g. BPJS), shown for information
+// Use ParsePayLineKind to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type PayLineKind string
+
+const (
+	PayLineEarning              PayLineKind = "earning"
+	PayLineDeduction            PayLineKind = "deduction"
+	PayLineEmployerContribution PayLineKind = "employer_contribution"
+)
+
+func (k PayLineKind) Valid() bool {
+	switch k {
+	case PayLineEarning, PayLineDeduction, PayLineEmployerContribution:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParsePayLineKind(s string) (PayLineKind, error) {
+	v := PayLineKind(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid PayLineKind: %q", s)
+	}
+	return v, nil
+}
+
+func (k PayLineKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(k))
+}
+
+func (k *PayLineKind) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParsePayLineKind(s)
+	if err != nil {
+		return err
+	}
+	*k = v
+	return nil
+}
+
+func (k PayLineKind) Value() (driver.Value, error) {
+	if !k.Valid() {
+		return nil, fmt.Errorf("invalid PayLineKind: %q", k)
+	}
+	return string(k), nil
+}
+
+func (k *PayLineKind) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParsePayLineKind(v)
+		if err != nil {
+			return err
+		}
+		*k = parsed
+		return nil
+	case []byte:
+		return k.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for PayLineKind: %T", src)
+	}
+}
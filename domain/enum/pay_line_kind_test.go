@@ -0,0 +1,153 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestPayLineKind_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.PayLineKind
+		valid bool
+	}{
+		{"earning valid", enum.PayLineEarning, true},
+		{"deduction valid", enum.PayLineDeduction, true},
+		{"employer_contribution valid", enum.PayLineEmployerContribution, true},
+		{"invalid value", enum.PayLineKind("unknown"), false},
+		{"empty value", enum.PayLineKind(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParsePayLineKind(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.PayLineKind
+		wantErr bool
+		name    string
+	}{
+		{"EARNING", enum.PayLineEarning, false, "upper earning"},
+		{" deduction ", enum.PayLineDeduction, false, "trimmed deduction"},
+		{"Employer_Contribution", enum.PayLineEmployerContribution, false, "mixed employer contribution"},
+		{"bonus", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParsePayLineKind(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPayLineKind_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.PayLineDeduction
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"deduction\"" {
+		t.Fatalf("Marshal got %s, want \"deduction\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.PayLineKind
+	if err := json.Unmarshal([]byte("\" EARNING \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.PayLineEarning {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.PayLineEarning)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.PayLineKind
+	if err := json.Unmarshal([]byte("\"bonus\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid pay line kind, got nil")
+	}
+}
+
+func TestPayLineKind_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.PayLineEarning.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "earning" {
+		t.Fatalf("Value() got %#v, want 'earning' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.PayLineKind = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestPayLineKind_Scan(t *testing.T) {
+	// From string
+	var s1 enum.PayLineKind
+	if err := s1.Scan("deduction"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.PayLineDeduction {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.PayLineDeduction)
+	}
+
+	// From []byte
+	var s2 enum.PayLineKind
+	if err := s2.Scan([]byte("employer_contribution")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.PayLineEmployerContribution {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.PayLineEmployerContribution)
+	}
+
+	// Invalid string value
+	var s3 enum.PayLineKind
+	if err := s3.Scan("bonus"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.PayLineKind
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestPayLineKind_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.PayLineKind
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
@@ -0,0 +1,78 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// PayoutMethod represents how an approved reimbursement is paid.
+// Allowed values (string representation):
+// - "payroll"   // added to the next payroll run
+// - "separate"  // paid outside payroll, e.g. by a direct transfer
+// Use ParsePayoutMethod to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type PayoutMethod string
+
+const (
+	PayoutPayroll  PayoutMethod = "payroll"
+	PayoutSeparate PayoutMethod = "separate"
+)
+
+func (pm PayoutMethod) Valid() bool {
+	switch pm {
+	case PayoutPayroll, PayoutSeparate:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParsePayoutMethod(s string) (PayoutMethod, error) {
+	v := PayoutMethod(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid PayoutMethod: %q", s)
+	}
+	return v, nil
+}
+
+func (pm PayoutMethod) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(pm))
+}
+
+func (pm *PayoutMethod) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParsePayoutMethod(s)
+	if err != nil {
+		return err
+	}
+	*pm = v
+	return nil
+}
+
+func (pm PayoutMethod) Value() (driver.Value, error) {
+	if !pm.Valid() {
+		return nil, fmt.Errorf("invalid PayoutMethod: %q", pm)
+	}
+	return string(pm), nil
+}
+
+func (pm *PayoutMethod) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParsePayoutMethod(v)
+		if err != nil {
+			return err
+		}
+		*pm = parsed
+		return nil
+	case []byte:
+		return pm.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for PayoutMethod: %T", src)
+	}
+}
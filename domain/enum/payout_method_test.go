@@ -0,0 +1,151 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestPayoutMethod_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.PayoutMethod
+		valid bool
+	}{
+		{"payroll valid", enum.PayoutPayroll, true},
+		{"separate valid", enum.PayoutSeparate, true},
+		{"invalid value", enum.PayoutMethod("unknown"), false},
+		{"empty value", enum.PayoutMethod(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParsePayoutMethod(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.PayoutMethod
+		wantErr bool
+		name    string
+	}{
+		{"PAYROLL", enum.PayoutPayroll, false, "upper payroll"},
+		{" separate ", enum.PayoutSeparate, false, "trimmed separate"},
+		{"cash", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParsePayoutMethod(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPayoutMethod_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.PayoutSeparate
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"separate\"" {
+		t.Fatalf("Marshal got %s, want \"separate\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.PayoutMethod
+	if err := json.Unmarshal([]byte("\" Payroll \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.PayoutPayroll {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.PayoutPayroll)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.PayoutMethod
+	if err := json.Unmarshal([]byte("\"cash\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid payout method, got nil")
+	}
+}
+
+func TestPayoutMethod_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.PayoutPayroll.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "payroll" {
+		t.Fatalf("Value() got %#v, want 'payroll' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.PayoutMethod = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestPayoutMethod_Scan(t *testing.T) {
+	// From string
+	var s1 enum.PayoutMethod
+	if err := s1.Scan("separate"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.PayoutSeparate {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.PayoutSeparate)
+	}
+
+	// From []byte
+	var s2 enum.PayoutMethod
+	if err := s2.Scan([]byte("payroll")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.PayoutPayroll {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.PayoutPayroll)
+	}
+
+	// Invalid string value
+	var s3 enum.PayoutMethod
+	if err := s3.Scan("cash"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.PayoutMethod
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestPayoutMethod_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.PayoutMethod
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
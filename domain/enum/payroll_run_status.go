@@ -0,0 +1,82 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// PayrollRunStatus represents the lifecycle of a payroll run.
+// Allowed values (string representation):
+// - "draft"       // created, not calculated yet
+// - "calculated"  // payslips computed, may be re-run
+// - "approved"    // locked, payslips can no longer change
+// - "paid"        // salaries transferred
+// Use ParsePayrollRunStatus to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type PayrollRunStatus string
+
+const (
+	PayrollDraft      PayrollRunStatus = "draft"
+	PayrollCalculated PayrollRunStatus = "calculated"
+	PayrollApproved   PayrollRunStatus = "approved"
+	PayrollPaid       PayrollRunStatus = "paid"
+)
+
+func (p PayrollRunStatus) Valid() bool {
+	switch p {
+	case PayrollDraft, PayrollCalculated, PayrollApproved, PayrollPaid:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParsePayrollRunStatus(s string) (PayrollRunStatus, error) {
+	v := PayrollRunStatus(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid PayrollRunStatus: %q", s)
+	}
+	return v, nil
+}
+
+func (p PayrollRunStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(p))
+}
+
+func (p *PayrollRunStatus) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParsePayrollRunStatus(s)
+	if err != nil {
+		return err
+	}
+	*p = v
+	return nil
+}
+
+func (p PayrollRunStatus) Value() (driver.Value, error) {
+	if !p.Valid() {
+		return nil, fmt.Errorf("invalid PayrollRunStatus: %q", p)
+	}
+	return string(p), nil
+}
+
+func (p *PayrollRunStatus) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParsePayrollRunStatus(v)
+		if err != nil {
+			return err
+		}
+		*p = parsed
+		return nil
+	case []byte:
+		return p.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for PayrollRunStatus: %T", src)
+	}
+}
@@ -0,0 +1,154 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestPayrollRunStatus_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.PayrollRunStatus
+		valid bool
+	}{
+		{"draft valid", enum.PayrollDraft, true},
+		{"calculated valid", enum.PayrollCalculated, true},
+		{"approved valid", enum.PayrollApproved, true},
+		{"paid valid", enum.PayrollPaid, true},
+		{"invalid value", enum.PayrollRunStatus("unknown"), false},
+		{"empty value", enum.PayrollRunStatus(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParsePayrollRunStatus(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.PayrollRunStatus
+		wantErr bool
+		name    string
+	}{
+		{"DRAFT", enum.PayrollDraft, false, "upper draft"},
+		{" calculated ", enum.PayrollCalculated, false, "trimmed calculated"},
+		{"Paid", enum.PayrollPaid, false, "mixed paid"},
+		{"closed", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParsePayrollRunStatus(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPayrollRunStatus_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.PayrollApproved
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"approved\"" {
+		t.Fatalf("Marshal got %s, want \"approved\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.PayrollRunStatus
+	if err := json.Unmarshal([]byte("\" PAID \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.PayrollPaid {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.PayrollPaid)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.PayrollRunStatus
+	if err := json.Unmarshal([]byte("\"closed\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid payroll run status, got nil")
+	}
+}
+
+func TestPayrollRunStatus_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.PayrollDraft.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "draft" {
+		t.Fatalf("Value() got %#v, want 'draft' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.PayrollRunStatus = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestPayrollRunStatus_Scan(t *testing.T) {
+	// From string
+	var s1 enum.PayrollRunStatus
+	if err := s1.Scan("draft"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.PayrollDraft {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.PayrollDraft)
+	}
+
+	// From []byte
+	var s2 enum.PayrollRunStatus
+	if err := s2.Scan([]byte("approved")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.PayrollApproved {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.PayrollApproved)
+	}
+
+	// Invalid string value
+	var s3 enum.PayrollRunStatus
+	if err := s3.Scan("closed"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.PayrollRunStatus
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestPayrollRunStatus_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.PayrollRunStatus
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
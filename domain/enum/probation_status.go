@@ -0,0 +1,80 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ProbationStatus represents the state of the probation period of a PKWTT or permanent contract.
+// Allowed values (string representation):
+// - "ongoing"    // the employee is still on probation
+// - "confirmed"  // the employee passed probation
+// - "failed"     // the employee did not pass probation and is let go
+// Use ParseProbationStatus to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type ProbationStatus string
+
+const (
+	ProbationOngoing   ProbationStatus = "ongoing"
+	ProbationConfirmed ProbationStatus = "confirmed"
+	ProbationFailed    ProbationStatus = "failed"
+)
+
+func (ps ProbationStatus) Valid() bool {
+	switch ps {
+	case ProbationOngoing, ProbationConfirmed, ProbationFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseProbationStatus(s string) (ProbationStatus, error) {
+	v := ProbationStatus(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid ProbationStatus: %q", s)
+	}
+	return v, nil
+}
+
+func (ps ProbationStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(ps))
+}
+
+func (ps *ProbationStatus) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseProbationStatus(s)
+	if err != nil {
+		return err
+	}
+	*ps = v
+	return nil
+}
+
+func (ps ProbationStatus) Value() (driver.Value, error) {
+	if !ps.Valid() {
+		return nil, fmt.Errorf("invalid ProbationStatus: %q", ps)
+	}
+	return string(ps), nil
+}
+
+func (ps *ProbationStatus) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseProbationStatus(v)
+		if err != nil {
+			return err
+		}
+		*ps = parsed
+		return nil
+	case []byte:
+		return ps.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for ProbationStatus: %T", src)
+	}
+}
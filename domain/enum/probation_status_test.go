@@ -0,0 +1,153 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestProbationStatus_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.ProbationStatus
+		valid bool
+	}{
+		{"ongoing valid", enum.ProbationOngoing, true},
+		{"confirmed valid", enum.ProbationConfirmed, true},
+		{"failed valid", enum.ProbationFailed, true},
+		{"invalid value", enum.ProbationStatus("unknown"), false},
+		{"empty value", enum.ProbationStatus(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseProbationStatus(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.ProbationStatus
+		wantErr bool
+		name    string
+	}{
+		{"ONGOING", enum.ProbationOngoing, false, "upper ongoing"},
+		{" confirmed ", enum.ProbationConfirmed, false, "trimmed confirmed"},
+		{"Failed", enum.ProbationFailed, false, "mixed failed"},
+		{"commented", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseProbationStatus(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProbationStatus_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.ProbationConfirmed
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"confirmed\"" {
+		t.Fatalf("Marshal got %s, want \"confirmed\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.ProbationStatus
+	if err := json.Unmarshal([]byte("\" FAILED \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.ProbationFailed {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.ProbationFailed)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.ProbationStatus
+	if err := json.Unmarshal([]byte("\"commented\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid probation status, got nil")
+	}
+}
+
+func TestProbationStatus_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.ProbationOngoing.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "ongoing" {
+		t.Fatalf("Value() got %#v, want 'ongoing' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.ProbationStatus = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestProbationStatus_Scan(t *testing.T) {
+	// From string
+	var s1 enum.ProbationStatus
+	if err := s1.Scan("ongoing"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.ProbationOngoing {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.ProbationOngoing)
+	}
+
+	// From []byte
+	var s2 enum.ProbationStatus
+	if err := s2.Scan([]byte("confirmed")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.ProbationConfirmed {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.ProbationConfirmed)
+	}
+
+	// Invalid string value
+	var s3 enum.ProbationStatus
+	if err := s3.Scan("commented"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.ProbationStatus
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestProbationStatus_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.ProbationStatus
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
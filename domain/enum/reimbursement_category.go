@@ -0,0 +1,80 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ReimbursementCategory represents the kind of expense an employee claims back.
+// Allowed values (string representation):
+// - "medical"  // doctor, hospital and pharmacy bills
+// - "travel"   // business trips: transport, lodging and per diem
+// - "optical"  // glasses, frames and contact lenses
+// Use ParseReimbursementCategory to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type ReimbursementCategory string
+
+const (
+	ReimbursementMedical ReimbursementCategory = "medical"
+	ReimbursementTravel  ReimbursementCategory = "travel"
+	ReimbursementOptical ReimbursementCategory = "optical"
+)
+
+func (rc ReimbursementCategory) Valid() bool {
+	switch rc {
+	case ReimbursementMedical, ReimbursementTravel, ReimbursementOptical:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseReimbursementCategory(s string) (ReimbursementCategory, error) {
+	v := ReimbursementCategory(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid ReimbursementCategory: %q", s)
+	}
+	return v, nil
+}
+
+func (rc ReimbursementCategory) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(rc))
+}
+
+func (rc *ReimbursementCategory) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseReimbursementCategory(s)
+	if err != nil {
+		return err
+	}
+	*rc = v
+	return nil
+}
+
+func (rc ReimbursementCategory) Value() (driver.Value, error) {
+	if !rc.Valid() {
+		return nil, fmt.Errorf("invalid ReimbursementCategory: %q", rc)
+	}
+	return string(rc), nil
+}
+
+func (rc *ReimbursementCategory) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseReimbursementCategory(v)
+		if err != nil {
+			return err
+		}
+		*rc = parsed
+		return nil
+	case []byte:
+		return rc.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for ReimbursementCategory: %T", src)
+	}
+}
@@ -0,0 +1,153 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestReimbursementCategory_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.ReimbursementCategory
+		valid bool
+	}{
+		{"medical valid", enum.ReimbursementMedical, true},
+		{"travel valid", enum.ReimbursementTravel, true},
+		{"optical valid", enum.ReimbursementOptical, true},
+		{"invalid value", enum.ReimbursementCategory("unknown"), false},
+		{"empty value", enum.ReimbursementCategory(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseReimbursementCategory(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.ReimbursementCategory
+		wantErr bool
+		name    string
+	}{
+		{"MEDICAL", enum.ReimbursementMedical, false, "upper medical"},
+		{" travel ", enum.ReimbursementTravel, false, "trimmed travel"},
+		{"Optical", enum.ReimbursementOptical, false, "mixed optical"},
+		{"dental", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseReimbursementCategory(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReimbursementCategory_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.ReimbursementOptical
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"optical\"" {
+		t.Fatalf("Marshal got %s, want \"optical\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.ReimbursementCategory
+	if err := json.Unmarshal([]byte("\" Travel \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.ReimbursementTravel {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.ReimbursementTravel)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.ReimbursementCategory
+	if err := json.Unmarshal([]byte("\"dental\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid reimbursement category, got nil")
+	}
+}
+
+func TestReimbursementCategory_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.ReimbursementMedical.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "medical" {
+		t.Fatalf("Value() got %#v, want 'medical' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.ReimbursementCategory = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestReimbursementCategory_Scan(t *testing.T) {
+	// From string
+	var s1 enum.ReimbursementCategory
+	if err := s1.Scan("medical"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.ReimbursementMedical {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.ReimbursementMedical)
+	}
+
+	// From []byte
+	var s2 enum.ReimbursementCategory
+	if err := s2.Scan([]byte("optical")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.ReimbursementOptical {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.ReimbursementOptical)
+	}
+
+	// Invalid string value
+	var s3 enum.ReimbursementCategory
+	if err := s3.Scan("dental"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.ReimbursementCategory
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestReimbursementCategory_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.ReimbursementCategory
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
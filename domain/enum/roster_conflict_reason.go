@@ -0,0 +1,78 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// RosterConflictReason explains why a roster slot could not be assigned.
+// Allowed values (string representation):
+// - "on_leave"           // the employee is on leave that day
+// - "insufficient_rest"  // the minimum rest period since the previous shift is not met
+// Use ParseRosterConflictReason to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type RosterConflictReason string
+
+const (
+	RosterConflictOnLeave          RosterConflictReason = "on_leave"
+	RosterConflictInsufficientRest RosterConflictReason = "insufficient_rest"
+)
+
+func (r RosterConflictReason) Valid() bool {
+	switch r {
+	case RosterConflictOnLeave, RosterConflictInsufficientRest:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseRosterConflictReason(s string) (RosterConflictReason, error) {
+	v := RosterConflictReason(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid RosterConflictReason: %q", s)
+	}
+	return v, nil
+}
+
+func (r RosterConflictReason) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(r))
+}
+
+func (r *RosterConflictReason) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseRosterConflictReason(s)
+	if err != nil {
+		return err
+	}
+	*r = v
+	return nil
+}
+
+func (r RosterConflictReason) Value() (driver.Value, error) {
+	if !r.Valid() {
+		return nil, fmt.Errorf("invalid RosterConflictReason: %q", r)
+	}
+	return string(r), nil
+}
+
+func (r *RosterConflictReason) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseRosterConflictReason(v)
+		if err != nil {
+			return err
+		}
+		*r = parsed
+		return nil
+	case []byte:
+		return r.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for RosterConflictReason: %T", src)
+	}
+}
@@ -0,0 +1,151 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestRosterConflictReason_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.RosterConflictReason
+		valid bool
+	}{
+		{"on_leave valid", enum.RosterConflictOnLeave, true},
+		{"insufficient_rest valid", enum.RosterConflictInsufficientRest, true},
+		{"invalid value", enum.RosterConflictReason("unknown"), false},
+		{"empty value", enum.RosterConflictReason(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseRosterConflictReason(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.RosterConflictReason
+		wantErr bool
+		name    string
+	}{
+		{"ON_LEAVE", enum.RosterConflictOnLeave, false, "upper on_leave"},
+		{" insufficient_rest ", enum.RosterConflictInsufficientRest, false, "trimmed insufficient_rest"},
+		{"on leave", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseRosterConflictReason(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRosterConflictReason_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.RosterConflictOnLeave
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"on_leave\"" {
+		t.Fatalf("Marshal got %s, want \"on_leave\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.RosterConflictReason
+	if err := json.Unmarshal([]byte("\" INSUFFICIENT_REST \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.RosterConflictInsufficientRest {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.RosterConflictInsufficientRest)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.RosterConflictReason
+	if err := json.Unmarshal([]byte("\"on leave\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid roster conflict reason, got nil")
+	}
+}
+
+func TestRosterConflictReason_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.RosterConflictOnLeave.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "on_leave" {
+		t.Fatalf("Value() got %#v, want 'on_leave' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.RosterConflictReason = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestRosterConflictReason_Scan(t *testing.T) {
+	// From string
+	var s1 enum.RosterConflictReason
+	if err := s1.Scan("On_Leave"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.RosterConflictOnLeave {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.RosterConflictOnLeave)
+	}
+
+	// From []byte
+	var s2 enum.RosterConflictReason
+	if err := s2.Scan([]byte("insufficient_rest")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.RosterConflictInsufficientRest {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.RosterConflictInsufficientRest)
+	}
+
+	// Invalid string value
+	var s3 enum.RosterConflictReason
+	if err := s3.Scan("on leave"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.RosterConflictReason
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestRosterConflictReason_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.RosterConflictReason
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
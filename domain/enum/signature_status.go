@@ -0,0 +1,80 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SignatureStatus represents the state of a signer's signature on a document version.
+// Allowed values (string representation):
+// - "pending"
+// - "signed"
+// - "rejected"
+// Use ParseSignatureStatus to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type SignatureStatus string
+
+const (
+	SignaturePending  SignatureStatus = "pending"
+	SignatureSigned   SignatureStatus = "signed"
+	SignatureRejected SignatureStatus = "rejected"
+)
+
+func (st SignatureStatus) Valid() bool {
+	switch st {
+	case SignaturePending, SignatureSigned, SignatureRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseSignatureStatus(s string) (SignatureStatus, error) {
+	v := SignatureStatus(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid SignatureStatus: %q", s)
+	}
+	return v, nil
+}
+
+func (st SignatureStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(st))
+}
+
+func (st *SignatureStatus) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseSignatureStatus(s)
+	if err != nil {
+		return err
+	}
+	*st = v
+	return nil
+}
+
+func (st SignatureStatus) Value() (driver.Value, error) {
+	if !st.Valid() {
+		return nil, fmt.Errorf("invalid SignatureStatus: %q", st)
+	}
+	return string(st), nil
+}
+
+func (st *SignatureStatus) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseSignatureStatus(v)
+		if err != nil {
+			return err
+		}
+		*st = parsed
+		return nil
+	case []byte:
+		return st.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for SignatureStatus: %T", src)
+	}
+}
@@ -0,0 +1,153 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestSignatureStatus_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.SignatureStatus
+		valid bool
+	}{
+		{"pending valid", enum.SignaturePending, true},
+		{"signed valid", enum.SignatureSigned, true},
+		{"rejected valid", enum.SignatureRejected, true},
+		{"invalid value", enum.SignatureStatus("unknown"), false},
+		{"empty value", enum.SignatureStatus(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseSignatureStatus(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.SignatureStatus
+		wantErr bool
+		name    string
+	}{
+		{"PENDING", enum.SignaturePending, false, "upper pending"},
+		{" signed ", enum.SignatureSigned, false, "trimmed signed"},
+		{"Rejected", enum.SignatureRejected, false, "mixed rejected"},
+		{"signd", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseSignatureStatus(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignatureStatus_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.SignatureSigned
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"signed\"" {
+		t.Fatalf("Marshal got %s, want \"signed\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.SignatureStatus
+	if err := json.Unmarshal([]byte("\" REJECTED \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.SignatureRejected {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.SignatureRejected)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.SignatureStatus
+	if err := json.Unmarshal([]byte("\"signd\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid signature status, got nil")
+	}
+}
+
+func TestSignatureStatus_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.SignaturePending.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "pending" {
+		t.Fatalf("Value() got %#v, want 'pending' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.SignatureStatus = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestSignatureStatus_Scan(t *testing.T) {
+	// From string
+	var s1 enum.SignatureStatus
+	if err := s1.Scan("SIGNED"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.SignatureSigned {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.SignatureSigned)
+	}
+
+	// From []byte
+	var s2 enum.SignatureStatus
+	if err := s2.Scan([]byte("pending")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.SignaturePending {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.SignaturePending)
+	}
+
+	// Invalid string value
+	var s3 enum.SignatureStatus
+	if err := s3.Scan("signd"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.SignatureStatus
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestSignatureStatus_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.SignatureStatus
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
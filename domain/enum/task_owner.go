@@ -0,0 +1,91 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// TaskOwner represents the party responsible for an onboarding task.
+// Allowed values (string representation):
+// - "hr"               // human resources, e.g. collecting documents
+// - "it"               // accounts and equipment
+// - "manager"          // the new hire's direct manager, e.g. orientation
+// - "employee"         // the new hire, e.g. uploading their KTP
+// - "finance"          // payroll and bank account set-up
+// - "general_affairs"  // desk, access card and uniforms
+// Use ParseTaskOwner to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type TaskOwner string
+
+const (
+	TaskOwnerHR             TaskOwner = "hr"
+	TaskOwnerIT             TaskOwner = "it"
+	TaskOwnerManager        TaskOwner = "manager"
+	TaskOwnerEmployee       TaskOwner = "employee"
+	TaskOwnerFinance        TaskOwner = "finance"
+	TaskOwnerGeneralAffairs TaskOwner = "general_affairs"
+)
+
+func (to TaskOwner) Valid() bool {
+	switch to {
+	case TaskOwnerHR,
+		TaskOwnerIT,
+		TaskOwnerManager,
+		TaskOwnerEmployee,
+		TaskOwnerFinance,
+		TaskOwnerGeneralAffairs:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseTaskOwner(s string) (TaskOwner, error) {
+	v := TaskOwner(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid TaskOwner: %q", s)
+	}
+	return v, nil
+}
+
+func (to TaskOwner) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(to))
+}
+
+func (to *TaskOwner) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseTaskOwner(s)
+	if err != nil {
+		return err
+	}
+	*to = v
+	return nil
+}
+
+func (to TaskOwner) Value() (driver.Value, error) {
+	if !to.Valid() {
+		return nil, fmt.Errorf("invalid TaskOwner: %q", to)
+	}
+	return string(to), nil
+}
+
+func (to *TaskOwner) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseTaskOwner(v)
+		if err != nil {
+			return err
+		}
+		*to = parsed
+		return nil
+	case []byte:
+		return to.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for TaskOwner: %T", src)
+	}
+}
@@ -0,0 +1,156 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestTaskOwner_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.TaskOwner
+		valid bool
+	}{
+		{"hr valid", enum.TaskOwnerHR, true},
+		{"it valid", enum.TaskOwnerIT, true},
+		{"manager valid", enum.TaskOwnerManager, true},
+		{"employee valid", enum.TaskOwnerEmployee, true},
+		{"finance valid", enum.TaskOwnerFinance, true},
+		{"general_affairs valid", enum.TaskOwnerGeneralAffairs, true},
+		{"invalid value", enum.TaskOwner("unknown"), false},
+		{"empty value", enum.TaskOwner(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseTaskOwner(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.TaskOwner
+		wantErr bool
+		name    string
+	}{
+		{"HR", enum.TaskOwnerHR, false, "upper hr"},
+		{" it ", enum.TaskOwnerIT, false, "trimmed it"},
+		{"General_Affairs", enum.TaskOwnerGeneralAffairs, false, "mixed general affairs"},
+		{"commented", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseTaskOwner(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskOwner_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.TaskOwnerManager
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"manager\"" {
+		t.Fatalf("Marshal got %s, want \"manager\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.TaskOwner
+	if err := json.Unmarshal([]byte("\" EMPLOYEE \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.TaskOwnerEmployee {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.TaskOwnerEmployee)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.TaskOwner
+	if err := json.Unmarshal([]byte("\"commented\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid task owner, got nil")
+	}
+}
+
+func TestTaskOwner_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.TaskOwnerHR.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "hr" {
+		t.Fatalf("Value() got %#v, want 'hr' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.TaskOwner = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestTaskOwner_Scan(t *testing.T) {
+	// From string
+	var s1 enum.TaskOwner
+	if err := s1.Scan("finance"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.TaskOwnerFinance {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.TaskOwnerFinance)
+	}
+
+	// From []byte
+	var s2 enum.TaskOwner
+	if err := s2.Scan([]byte("hr")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.TaskOwnerHR {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.TaskOwnerHR)
+	}
+
+	// Invalid string value
+	var s3 enum.TaskOwner
+	if err := s3.Scan("commented"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.TaskOwner
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestTaskOwner_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.TaskOwner
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
@@ -0,0 +1,80 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// TaskStatus represents the state of a checklist task.
+// Allowed values (string representation):
+// - "pending"    // still to be done
+// - "completed"  // done by its owner
+// - "skipped"    // does not apply to this employee, with a note why
+// Use ParseTaskStatus to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type TaskStatus string
+
+const (
+	TaskPending   TaskStatus = "pending"
+	TaskCompleted TaskStatus = "completed"
+	TaskSkipped   TaskStatus = "skipped"
+)
+
+func (ts TaskStatus) Valid() bool {
+	switch ts {
+	case TaskPending, TaskCompleted, TaskSkipped:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseTaskStatus(s string) (TaskStatus, error) {
+	v := TaskStatus(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid TaskStatus: %q", s)
+	}
+	return v, nil
+}
+
+func (ts TaskStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(ts))
+}
+
+func (ts *TaskStatus) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseTaskStatus(s)
+	if err != nil {
+		return err
+	}
+	*ts = v
+	return nil
+}
+
+func (ts TaskStatus) Value() (driver.Value, error) {
+	if !ts.Valid() {
+		return nil, fmt.Errorf("invalid TaskStatus: %q", ts)
+	}
+	return string(ts), nil
+}
+
+func (ts *TaskStatus) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseTaskStatus(v)
+		if err != nil {
+			return err
+		}
+		*ts = parsed
+		return nil
+	case []byte:
+		return ts.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for TaskStatus: %T", src)
+	}
+}
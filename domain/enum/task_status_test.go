@@ -0,0 +1,153 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestTaskStatus_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.TaskStatus
+		valid bool
+	}{
+		{"pending valid", enum.TaskPending, true},
+		{"completed valid", enum.TaskCompleted, true},
+		{"skipped valid", enum.TaskSkipped, true},
+		{"invalid value", enum.TaskStatus("unknown"), false},
+		{"empty value", enum.TaskStatus(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseTaskStatus(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.TaskStatus
+		wantErr bool
+		name    string
+	}{
+		{"PENDING", enum.TaskPending, false, "upper pending"},
+		{" completed ", enum.TaskCompleted, false, "trimmed completed"},
+		{"Skipped", enum.TaskSkipped, false, "mixed skipped"},
+		{"commented", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseTaskStatus(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskStatus_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.TaskCompleted
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"completed\"" {
+		t.Fatalf("Marshal got %s, want \"completed\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.TaskStatus
+	if err := json.Unmarshal([]byte("\" SKIPPED \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.TaskSkipped {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.TaskSkipped)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.TaskStatus
+	if err := json.Unmarshal([]byte("\"commented\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid task status, got nil")
+	}
+}
+
+func TestTaskStatus_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.TaskPending.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "pending" {
+		t.Fatalf("Value() got %#v, want 'pending' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.TaskStatus = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestTaskStatus_Scan(t *testing.T) {
+	// From string
+	var s1 enum.TaskStatus
+	if err := s1.Scan("pending"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.TaskPending {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.TaskPending)
+	}
+
+	// From []byte
+	var s2 enum.TaskStatus
+	if err := s2.Scan([]byte("completed")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.TaskCompleted {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.TaskCompleted)
+	}
+
+	// Invalid string value
+	var s3 enum.TaskStatus
+	if err := s3.Scan("commented"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.TaskStatus
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestTaskStatus_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.TaskStatus
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
@@ -0,0 +1,91 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// TerminationReason represents why employment ends, which decides the severance under PP No. 35/2021.
+// Allowed values (string representation):
+// - "resignation"      // the employee resigns, Pasal 50
+// - "end_of_contract"  // a PKWT contract reaches its end date, Pasal 15
+// - "layoff"           // efficiency to prevent losses, Pasal 43 ayat (2)
+// - "retirement"       // the employee reaches retirement age, Pasal 56
+// - "misconduct"       // violation after warning letters, Pasal 52 ayat (1)
+// - "failed_probation" // the employee does not pass the probation of a PKWTT or permanent contract
+// Use ParseTerminationReason to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type TerminationReason string
+
+const (
+	TerminationResignation     TerminationReason = "resignation"
+	TerminationEndOfContract   TerminationReason = "end_of_contract"
+	TerminationLayoff          TerminationReason = "layoff"
+	TerminationRetirement      TerminationReason = "retirement"
+	TerminationMisconduct      TerminationReason = "misconduct"
+	TerminationFailedProbation TerminationReason = "failed_probation"
+)
+
+func (tr TerminationReason) Valid() bool {
+	switch tr {
+	case TerminationResignation,
+		TerminationEndOfContract,
+		TerminationLayoff,
+		TerminationRetirement,
+		TerminationMisconduct,
+		TerminationFailedProbation:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseTerminationReason(s string) (TerminationReason, error) {
+	v := TerminationReason(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid TerminationReason: %q", s)
+	}
+	return v, nil
+}
+
+func (tr TerminationReason) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(tr))
+}
+
+func (tr *TerminationReason) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseTerminationReason(s)
+	if err != nil {
+		return err
+	}
+	*tr = v
+	return nil
+}
+
+func (tr TerminationReason) Value() (driver.Value, error) {
+	if !tr.Valid() {
+		return nil, fmt.Errorf("invalid TerminationReason: %q", tr)
+	}
+	return string(tr), nil
+}
+
+func (tr *TerminationReason) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseTerminationReason(v)
+		if err != nil {
+			return err
+		}
+		*tr = parsed
+		return nil
+	case []byte:
+		return tr.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for TerminationReason: %T", src)
+	}
+}
@@ -0,0 +1,157 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestTerminationReason_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.TerminationReason
+		valid bool
+	}{
+		{"resignation valid", enum.TerminationResignation, true},
+		{"end_of_contract valid", enum.TerminationEndOfContract, true},
+		{"layoff valid", enum.TerminationLayoff, true},
+		{"retirement valid", enum.TerminationRetirement, true},
+		{"misconduct valid", enum.TerminationMisconduct, true},
+		{"failed_probation valid", enum.TerminationFailedProbation, true},
+		{"invalid value", enum.TerminationReason("unknown"), false},
+		{"empty value", enum.TerminationReason(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseTerminationReason(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.TerminationReason
+		wantErr bool
+		name    string
+	}{
+		{"RESIGNATION", enum.TerminationResignation, false, "upper resignation"},
+		{" layoff ", enum.TerminationLayoff, false, "trimmed layoff"},
+		{"End_Of_Contract", enum.TerminationEndOfContract, false, "mixed end of contract"},
+		{"Failed_Probation", enum.TerminationFailedProbation, false, "mixed failed probation"},
+		{"commented", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseTerminationReason(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTerminationReason_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.TerminationRetirement
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"retirement\"" {
+		t.Fatalf("Marshal got %s, want \"retirement\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.TerminationReason
+	if err := json.Unmarshal([]byte("\" MISCONDUCT \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.TerminationMisconduct {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.TerminationMisconduct)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.TerminationReason
+	if err := json.Unmarshal([]byte("\"commented\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid termination reason, got nil")
+	}
+}
+
+func TestTerminationReason_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.TerminationResignation.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "resignation" {
+		t.Fatalf("Value() got %#v, want 'resignation' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.TerminationReason = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestTerminationReason_Scan(t *testing.T) {
+	// From string
+	var s1 enum.TerminationReason
+	if err := s1.Scan("layoff"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.TerminationLayoff {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.TerminationLayoff)
+	}
+
+	// From []byte
+	var s2 enum.TerminationReason
+	if err := s2.Scan([]byte("retirement")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.TerminationRetirement {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.TerminationRetirement)
+	}
+
+	// Invalid string value
+	var s3 enum.TerminationReason
+	if err := s3.Scan("commented"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.TerminationReason
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestTerminationReason_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.TerminationReason
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
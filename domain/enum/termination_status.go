@@ -0,0 +1,80 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// TerminationStatus represents the state of an employee's offboarding.
+// Allowed values (string representation):
+// - "scheduled"  // the final working day is set, offboarding is in progress
+// - "completed"  // the employee has left and the contract is closed
+// - "cancelled"  // the termination was withdrawn before it took effect
+// Use ParseTerminationStatus to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type TerminationStatus string
+
+const (
+	TerminationScheduled TerminationStatus = "scheduled"
+	TerminationCompleted TerminationStatus = "completed"
+	TerminationCancelled TerminationStatus = "cancelled"
+)
+
+func (st TerminationStatus) Valid() bool {
+	switch st {
+	case TerminationScheduled, TerminationCompleted, TerminationCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseTerminationStatus(s string) (TerminationStatus, error) {
+	v := TerminationStatus(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid TerminationStatus: %q", s)
+	}
+	return v, nil
+}
+
+func (st TerminationStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(st))
+}
+
+func (st *TerminationStatus) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseTerminationStatus(s)
+	if err != nil {
+		return err
+	}
+	*st = v
+	return nil
+}
+
+func (st TerminationStatus) Value() (driver.Value, error) {
+	if !st.Valid() {
+		return nil, fmt.Errorf("invalid TerminationStatus: %q", st)
+	}
+	return string(st), nil
+}
+
+func (st *TerminationStatus) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseTerminationStatus(v)
+		if err != nil {
+			return err
+		}
+		*st = parsed
+		return nil
+	case []byte:
+		return st.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for TerminationStatus: %T", src)
+	}
+}
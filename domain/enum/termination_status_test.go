@@ -0,0 +1,153 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestTerminationStatus_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.TerminationStatus
+		valid bool
+	}{
+		{"scheduled valid", enum.TerminationScheduled, true},
+		{"completed valid", enum.TerminationCompleted, true},
+		{"cancelled valid", enum.TerminationCancelled, true},
+		{"invalid value", enum.TerminationStatus("unknown"), false},
+		{"empty value", enum.TerminationStatus(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseTerminationStatus(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.TerminationStatus
+		wantErr bool
+		name    string
+	}{
+		{"SCHEDULED", enum.TerminationScheduled, false, "upper scheduled"},
+		{" completed ", enum.TerminationCompleted, false, "trimmed completed"},
+		{"Cancelled", enum.TerminationCancelled, false, "mixed cancelled"},
+		{"commented", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseTerminationStatus(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTerminationStatus_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.TerminationCompleted
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"completed\"" {
+		t.Fatalf("Marshal got %s, want \"completed\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.TerminationStatus
+	if err := json.Unmarshal([]byte("\" CANCELLED \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.TerminationCancelled {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.TerminationCancelled)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.TerminationStatus
+	if err := json.Unmarshal([]byte("\"commented\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid termination status, got nil")
+	}
+}
+
+func TestTerminationStatus_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.TerminationScheduled.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "scheduled" {
+		t.Fatalf("Value() got %#v, want 'scheduled' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.TerminationStatus = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestTerminationStatus_Scan(t *testing.T) {
+	// From string
+	var s1 enum.TerminationStatus
+	if err := s1.Scan("scheduled"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.TerminationScheduled {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.TerminationScheduled)
+	}
+
+	// From []byte
+	var s2 enum.TerminationStatus
+	if err := s2.Scan([]byte("completed")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.TerminationCompleted {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.TerminationCompleted)
+	}
+
+	// Invalid string value
+	var s3 enum.TerminationStatus
+	if err := s3.Scan("commented"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.TerminationStatus
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestTerminationStatus_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.TerminationStatus
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
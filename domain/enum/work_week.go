@@ -0,0 +1,97 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WorkWeek represents the working time arrangement of UU No. 13/2003 Pasal 77.
+// Allowed values (string representation):
+// - "five_day"  // 8 hours a day, Monday to Friday
+// - "six_day"   // 7 hours a day, Monday to Saturday
+// Use ParseWorkWeek to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type WorkWeek string
+
+const (
+	WorkWeekFiveDay WorkWeek = "five_day"
+	WorkWeekSixDay  WorkWeek = "six_day"
+)
+
+func (w WorkWeek) Valid() bool {
+	switch w {
+	case WorkWeekFiveDay, WorkWeekSixDay:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseWorkWeek(s string) (WorkWeek, error) {
+	v := WorkWeek(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid WorkWeek: %q", s)
+	}
+	return v, nil
+}
+
+// DaysPerWeek returns the number of working days in the week, 0 for an invalid value.
+func (w WorkWeek) DaysPerWeek() int {
+	switch w {
+	case WorkWeekFiveDay:
+		return 5
+	case WorkWeekSixDay:
+		return 6
+	default:
+		return 0
+	}
+}
+
+// IsRestDay reports whether the weekday is a weekly rest day. Sunday is always a rest day
+// and Saturday only in a five day week.
+func (w WorkWeek) IsRestDay(day time.Weekday) bool {
+	return day == time.Sunday || (day == time.Saturday && w != WorkWeekSixDay)
+}
+
+func (w WorkWeek) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(w))
+}
+
+func (w *WorkWeek) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseWorkWeek(s)
+	if err != nil {
+		return err
+	}
+	*w = v
+	return nil
+}
+
+func (w WorkWeek) Value() (driver.Value, error) {
+	if !w.Valid() {
+		return nil, fmt.Errorf("invalid WorkWeek: %q", w)
+	}
+	return string(w), nil
+}
+
+func (w *WorkWeek) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseWorkWeek(v)
+		if err != nil {
+			return err
+		}
+		*w = parsed
+		return nil
+	case []byte:
+		return w.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for WorkWeek: %T", src)
+	}
+}
@@ -0,0 +1,177 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestWorkWeek_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.WorkWeek
+		valid bool
+	}{
+		{"five_day valid", enum.WorkWeekFiveDay, true},
+		{"six_day valid", enum.WorkWeekSixDay, true},
+		{"invalid value", enum.WorkWeek("unknown"), false},
+		{"empty value", enum.WorkWeek(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseWorkWeek(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.WorkWeek
+		wantErr bool
+		name    string
+	}{
+		{"FIVE_DAY", enum.WorkWeekFiveDay, false, "upper five day"},
+		{" six_day ", enum.WorkWeekSixDay, false, "trimmed six day"},
+		{"four_day", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseWorkWeek(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkWeek_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.WorkWeekSixDay
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"six_day\"" {
+		t.Fatalf("Marshal got %s, want \"six_day\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.WorkWeek
+	if err := json.Unmarshal([]byte("\" Five_Day \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.WorkWeekFiveDay {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.WorkWeekFiveDay)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.WorkWeek
+	if err := json.Unmarshal([]byte("\"four_day\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid work week, got nil")
+	}
+}
+
+func TestWorkWeek_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.WorkWeekFiveDay.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "five_day" {
+		t.Fatalf("Value() got %#v, want 'five_day' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.WorkWeek = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestWorkWeek_Scan(t *testing.T) {
+	// From string
+	var s1 enum.WorkWeek
+	if err := s1.Scan("six_day"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.WorkWeekSixDay {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.WorkWeekSixDay)
+	}
+
+	// From []byte
+	var s2 enum.WorkWeek
+	if err := s2.Scan([]byte("five_day")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.WorkWeekFiveDay {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.WorkWeekFiveDay)
+	}
+
+	// Invalid string value
+	var s3 enum.WorkWeek
+	if err := s3.Scan("four_day"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.WorkWeek
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestWorkWeek_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.WorkWeek
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
+
+func TestWorkWeek_RestDays(t *testing.T) {
+	tests := []struct {
+		week     enum.WorkWeek
+		day      time.Weekday
+		restDay  bool
+		daysWeek int
+	}{
+		{enum.WorkWeekFiveDay, time.Saturday, true, 5},
+		{enum.WorkWeekFiveDay, time.Friday, false, 5},
+		{enum.WorkWeekSixDay, time.Saturday, false, 6},
+		{enum.WorkWeekSixDay, time.Sunday, true, 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.week)+"_"+tt.day.String(), func(t *testing.T) {
+			if got := tt.week.IsRestDay(tt.day); got != tt.restDay {
+				t.Fatalf("IsRestDay(%s) got %v, want %v", tt.day, got, tt.restDay)
+			}
+			if got := tt.week.DaysPerWeek(); got != tt.daysWeek {
+				t.Fatalf("DaysPerWeek() got %d, want %d", got, tt.daysWeek)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// WorkflowAction represents an entry of an approval workflow's decision history.
+// Allowed values (string representation):
+// - "submitted"  // the requester started the workflow
+// - "assigned"   // approvers were assigned to a step
+// - "approved"   // an approver approved
+// - "rejected"   // an approver rejected
+// - "delegated"  // an approver handed the decision to someone else
+// - "escalated"  // an overdue decision moved to the approver's manager
+// - "cancelled"  // the requester withdrew the request
+// Use ParseWorkflowAction to safely convert from string (case-insensitive, trims spaces).
+// Implements json (un)marshaling and database/sql interfaces.
+type WorkflowAction string
+
+const (
+	WorkflowSubmitted WorkflowAction = "submitted"
+	WorkflowAssigned  WorkflowAction = "assigned"
+	WorkflowApproved  WorkflowAction = "approved"
+	WorkflowRejected  WorkflowAction = "rejected"
+	WorkflowDelegated WorkflowAction = "delegated"
+	WorkflowEscalated WorkflowAction = "escalated"
+	WorkflowCancelled WorkflowAction = "cancelled"
+)
+
+func (wa WorkflowAction) Valid() bool {
+	switch wa {
+	case WorkflowSubmitted,
+		WorkflowAssigned,
+		WorkflowApproved,
+		WorkflowRejected,
+		WorkflowDelegated,
+		WorkflowEscalated,
+		WorkflowCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseWorkflowAction(s string) (WorkflowAction, error) {
+	v := WorkflowAction(strings.ToLower(strings.TrimSpace(s)))
+	if !v.Valid() {
+		return "", fmt.Errorf("invalid WorkflowAction: %q", s)
+	}
+	return v, nil
+}
+
+func (wa WorkflowAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(wa))
+}
+
+func (wa *WorkflowAction) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseWorkflowAction(s)
+	if err != nil {
+		return err
+	}
+	*wa = v
+	return nil
+}
+
+func (wa WorkflowAction) Value() (driver.Value, error) {
+	if !wa.Valid() {
+		return nil, fmt.Errorf("invalid WorkflowAction: %q", wa)
+	}
+	return string(wa), nil
+}
+
+func (wa *WorkflowAction) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParseWorkflowAction(v)
+		if err != nil {
+			return err
+		}
+		*wa = parsed
+		return nil
+	case []byte:
+		return wa.Scan(string(v))
+	default:
+		return fmt.Errorf("unsupported scan type for WorkflowAction: %T", src)
+	}
+}
@@ -0,0 +1,157 @@
+package enum_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enum "github.com/rfanazhari/hris/domain/enum"
+)
+
+func TestWorkflowAction_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   enum.WorkflowAction
+		valid bool
+	}{
+		{"submitted valid", enum.WorkflowSubmitted, true},
+		{"assigned valid", enum.WorkflowAssigned, true},
+		{"approved valid", enum.WorkflowApproved, true},
+		{"rejected valid", enum.WorkflowRejected, true},
+		{"delegated valid", enum.WorkflowDelegated, true},
+		{"escalated valid", enum.WorkflowEscalated, true},
+		{"cancelled valid", enum.WorkflowCancelled, true},
+		{"invalid value", enum.WorkflowAction("unknown"), false},
+		{"empty value", enum.WorkflowAction(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.val.Valid(); got != tt.valid {
+				t.Fatalf("Valid() = %v, want %v for %q", got, tt.valid, string(tt.val))
+			}
+		})
+	}
+}
+
+func TestParseWorkflowAction(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    enum.WorkflowAction
+		wantErr bool
+		name    string
+	}{
+		{"SUBMITTED", enum.WorkflowSubmitted, false, "upper submitted"},
+		{" delegated ", enum.WorkflowDelegated, false, "trimmed delegated"},
+		{"Escalated", enum.WorkflowEscalated, false, "mixed escalated"},
+		{"commented", "", true, "invalid"},
+		{"", "", true, "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseWorkflowAction(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for input %q, got nil", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkflowAction_JSON_MarshalUnmarshal(t *testing.T) {
+	// Marshal
+	v := enum.WorkflowApproved
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(b) != "\"approved\"" {
+		t.Fatalf("Marshal got %s, want \"approved\"", string(b))
+	}
+
+	// Unmarshal valid with different case and spaces
+	var u enum.WorkflowAction
+	if err := json.Unmarshal([]byte("\" REJECTED \""), &u); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if u != enum.WorkflowRejected {
+		t.Fatalf("Unmarshal got %q, want %q", u, enum.WorkflowRejected)
+	}
+
+	// Unmarshal invalid
+	var u2 enum.WorkflowAction
+	if err := json.Unmarshal([]byte("\"commented\""), &u2); err == nil {
+		t.Fatalf("expected error unmarshalling invalid workflow action, got nil")
+	}
+}
+
+func TestWorkflowAction_Value(t *testing.T) {
+	// Valid value
+	v, err := enum.WorkflowSubmitted.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if s, ok := v.(string); !ok || s != "submitted" {
+		t.Fatalf("Value() got %#v, want 'submitted' string", v)
+	}
+
+	// Invalid value
+	var invalid enum.WorkflowAction = "invalid"
+	if _, err := invalid.Value(); err == nil {
+		t.Fatalf("expected error for invalid Value(), got nil")
+	}
+}
+
+func TestWorkflowAction_Scan(t *testing.T) {
+	// From string
+	var s1 enum.WorkflowAction
+	if err := s1.Scan("assigned"); err != nil {
+		t.Fatalf("Scan(string) error: %v", err)
+	}
+	if s1 != enum.WorkflowAssigned {
+		t.Fatalf("Scan(string) got %q, want %q", s1, enum.WorkflowAssigned)
+	}
+
+	// From []byte
+	var s2 enum.WorkflowAction
+	if err := s2.Scan([]byte("cancelled")); err != nil {
+		t.Fatalf("Scan([]byte) error: %v", err)
+	}
+	if s2 != enum.WorkflowCancelled {
+		t.Fatalf("Scan([]byte) got %q, want %q", s2, enum.WorkflowCancelled)
+	}
+
+	// Invalid string value
+	var s3 enum.WorkflowAction
+	if err := s3.Scan("commented"); err == nil {
+		t.Fatalf("expected error for invalid string scan, got nil")
+	}
+
+	// Unsupported type
+	var s4 enum.WorkflowAction
+	var src any = 42
+	if err := s4.Scan(src); err == nil {
+		t.Fatalf("expected error for unsupported type scan, got nil")
+	}
+}
+
+func TestWorkflowAction_ImplementsDriverValuerAndScannerLike(t *testing.T) {
+	// Ensure the Value() type satisfies driver.Valuer contract shape at compile time
+	var _ driver.Valuer
+	var k enum.WorkflowAction
+	// reflect check that method Scan exists
+	m, ok := reflect.TypeOf(&k).MethodByName("Scan")
+	if !ok || m.Type.NumIn() != 2 { // receiver + 1 arg
+		t.Fatalf("Scan method not found or has unexpected signature")
+	}
+}
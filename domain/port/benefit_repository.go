@@ -0,0 +1,22 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	benefit_entity "github.com/rfanazhari/hris/domain/entity/benefit"
+)
+
+// BenefitPlanRepository is the port for persisting allowance and insurance plans.
+type BenefitPlanRepository interface {
+	Save(ctx context.Context, plan *benefit_entity.BenefitPlan) error
+	FindByID(ctx context.Context, id uuid.UUID) (*benefit_entity.BenefitPlan, error)
+	List(ctx context.Context) ([]benefit_entity.BenefitPlan, error)
+}
+
+// BenefitEnrollmentRepository is the port for persisting benefit plan enrollments.
+type BenefitEnrollmentRepository interface {
+	Save(ctx context.Context, enrollment *benefit_entity.Enrollment) error
+	FindByID(ctx context.Context, id uuid.UUID) (*benefit_entity.Enrollment, error)
+	// ListByEmployee returns every enrollment of the employee, including ended ones.
+	ListByEmployee(ctx context.Context, employeeID uuid.UUID) ([]benefit_entity.Enrollment, error)
+}
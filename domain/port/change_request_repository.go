@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+)
+
+// ChangeRequestRepository is the port for persisting employee data change requests.
+type ChangeRequestRepository interface {
+	Save(ctx context.Context, request *employee_entity.ChangeRequest) error
+	FindByID(ctx context.Context, id uuid.UUID) (*employee_entity.ChangeRequest, error)
+	// ListByEmployee returns every change request of the employee, including decided ones.
+	ListByEmployee(ctx context.Context, employeeID uuid.UUID) ([]employee_entity.ChangeRequest, error)
+}
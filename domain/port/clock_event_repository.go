@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	attendance_entity "github.com/rfanazhari/hris/domain/entity/attendance"
+	"time"
+)
+
+// ClockEventRepository is the port for persisting and querying attendance punches.
+type ClockEventRepository interface {
+	Save(ctx context.Context, event *attendance_entity.ClockEvent) error
+	// LastByEmployee returns the most recent event of the employee, or nil if there is none.
+	LastByEmployee(ctx context.Context, employeeID uuid.UUID) (*attendance_entity.ClockEvent, error)
+	// ListByEmployee returns the employee's events that occurred within [from, to].
+	ListByEmployee(ctx context.Context, employeeID uuid.UUID, from, to time.Time) ([]attendance_entity.ClockEvent, error)
+}
@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	dependent_entity "github.com/rfanazhari/hris/domain/entity/dependent"
+)
+
+// DependentRepository is the port for persisting employees' dependents.
+type DependentRepository interface {
+	Save(ctx context.Context, dependent *dependent_entity.Dependent) error
+	FindByID(ctx context.Context, id uuid.UUID) (*dependent_entity.Dependent, error)
+	// ListByEmployee returns every dependent of the employee, including ended ones.
+	ListByEmployee(ctx context.Context, employeeID uuid.UUID) ([]dependent_entity.Dependent, error)
+}
@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"time"
+)
+
+// EmployeeRepository is the port for loading and persisting Employee aggregates.
+type EmployeeRepository interface {
+	Save(ctx context.Context, employee *employee_entity.Employee) error
+	FindByID(ctx context.Context, id uuid.UUID) (*employee_entity.Employee, error)
+	// ListByOrganizationUnit returns the employees currently assigned to the organization unit.
+	ListByOrganizationUnit(ctx context.Context, unitID uuid.UUID) ([]employee_entity.Employee, error)
+	// ListByEmploymentPeriod returns the employees with a contract overlapping [from, to].
+	ListByEmploymentPeriod(ctx context.Context, from, to time.Time) ([]employee_entity.Employee, error)
+}
@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"time"
+)
+
+// ExchangeRateRepository is the port for persisting effective-dated exchange rates.
+type ExchangeRateRepository interface {
+	// Save stores the rate, replacing a rate of the same pair and effective date.
+	Save(ctx context.Context, rate valueobject.ExchangeRate) error
+	// FindEffective returns the latest rate from base to quote effective on or before at,
+	// or nil if there is none.
+	FindEffective(ctx context.Context, base, quote string, at time.Time) (*valueobject.ExchangeRate, error)
+}
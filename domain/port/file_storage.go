@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+	"github.com/rfanazhari/hris/domain/valueobject"
+)
+
+// FileStorage is the port used by the domain to persist generated or uploaded files.
+// Implementations (object storage, local disk, ...) live in the infrastructure layer
+// and return a FileReference pointing to the stored content.
+type FileStorage interface {
+	Store(ctx context.Context, filename, mimeType string, content []byte) (*valueobject.FileReference, error)
+}
@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	calendar_entity "github.com/rfanazhari/hris/domain/entity/calendar"
+	"time"
+)
+
+// HolidayRepository is the port for regional and company specific holidays.
+// National holidays and cuti bersama come from the bundled calendar data instead.
+type HolidayRepository interface {
+	Save(ctx context.Context, holiday *calendar_entity.Holiday) error
+	// ListByOrganizationUnit returns the holidays within [from, to] that apply to the unit,
+	// including company wide holidays. A nil unit returns company wide holidays only.
+	ListByOrganizationUnit(ctx context.Context, unitID *uuid.UUID, from, to time.Time) ([]calendar_entity.Holiday, error)
+}
@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+// LeaveChecker answers whether an employee is on leave on a calendar date.
+// It lets scheduling rules consult leave data without depending on the leave model.
+type LeaveChecker interface {
+	IsOnLeave(ctx context.Context, employeeID uuid.UUID, date time.Time) (bool, error)
+}
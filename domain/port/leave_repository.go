@@ -0,0 +1,23 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	leave_entity "github.com/rfanazhari/hris/domain/entity/leave"
+	"time"
+)
+
+// LeaveRequestRepository is the port for persisting and querying leave requests.
+type LeaveRequestRepository interface {
+	Save(ctx context.Context, request *leave_entity.LeaveRequest) error
+	FindByID(ctx context.Context, id uuid.UUID) (*leave_entity.LeaveRequest, error)
+	// ListByEmployee returns the employee's requests whose leave overlaps [from, to].
+	ListByEmployee(ctx context.Context, employeeID uuid.UUID, from, to time.Time) ([]leave_entity.LeaveRequest, error)
+}
+
+// LeaveLedgerRepository is the port for persisting leave balance ledgers.
+type LeaveLedgerRepository interface {
+	Save(ctx context.Context, ledger *leave_entity.LeaveLedger) error
+	// FindByEmployee returns the employee's ledger, or nil if none has been stored yet.
+	FindByEmployee(ctx context.Context, employeeID uuid.UUID) (*leave_entity.LeaveLedger, error)
+}
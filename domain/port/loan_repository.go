@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	loan_entity "github.com/rfanazhari/hris/domain/entity/loan"
+)
+
+// LoanRepository is the port for persisting employee loans and salary advances.
+type LoanRepository interface {
+	Save(ctx context.Context, loan *loan_entity.Loan) error
+	FindByID(ctx context.Context, id uuid.UUID) (*loan_entity.Loan, error)
+	// ListByEmployee returns every loan of the employee, including settled ones.
+	ListByEmployee(ctx context.Context, employeeID uuid.UUID) ([]loan_entity.Loan, error)
+}
@@ -0,0 +1,23 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	onboarding_entity "github.com/rfanazhari/hris/domain/entity/onboarding"
+)
+
+// OnboardingTemplateRepository is the port for persisting onboarding templates.
+type OnboardingTemplateRepository interface {
+	Save(ctx context.Context, template *onboarding_entity.Template) error
+	List(ctx context.Context) ([]onboarding_entity.Template, error)
+}
+
+// OnboardingChecklistRepository is the port for persisting new hires' onboarding checklists.
+type OnboardingChecklistRepository interface {
+	Save(ctx context.Context, checklist *onboarding_entity.Checklist) error
+	FindByID(ctx context.Context, id uuid.UUID) (*onboarding_entity.Checklist, error)
+	// FindByEmployee returns the employee's checklist, or nil if there is none.
+	FindByEmployee(ctx context.Context, employeeID uuid.UUID) (*onboarding_entity.Checklist, error)
+	// ListOpen returns the checklists with pending tasks.
+	ListOpen(ctx context.Context) ([]onboarding_entity.Checklist, error)
+}
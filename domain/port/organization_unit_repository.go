@@ -0,0 +1,12 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/entity"
+)
+
+// OrganizationUnitRepository is the port for reading organization units.
+type OrganizationUnitRepository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.OrganizationUnit, error)
+}
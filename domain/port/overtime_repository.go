@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	overtime_entity "github.com/rfanazhari/hris/domain/entity/overtime"
+	"time"
+)
+
+// OvertimeRepository is the port for persisting and querying overtime requests.
+type OvertimeRepository interface {
+	Save(ctx context.Context, request *overtime_entity.OvertimeRequest) error
+	FindByID(ctx context.Context, id uuid.UUID) (*overtime_entity.OvertimeRequest, error)
+	// ListByEmployee returns the employee's requests whose overtime starts within [from, to].
+	ListByEmployee(ctx context.Context, employeeID uuid.UUID, from, to time.Time) ([]overtime_entity.OvertimeRequest, error)
+}
@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+	compensation_entity "github.com/rfanazhari/hris/domain/entity/compensation"
+)
+
+// PayBandRepository is the port for persisting the grade pay bands.
+type PayBandRepository interface {
+	Save(ctx context.Context, band *compensation_entity.PayBand) error
+	// List returns every band of every grade, including bands no longer in effect.
+	List(ctx context.Context) ([]compensation_entity.PayBand, error)
+}
@@ -0,0 +1,25 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"time"
+)
+
+// PayrollRunRepository is the port for persisting payroll runs together with their payslips.
+type PayrollRunRepository interface {
+	Save(ctx context.Context, run *payroll_entity.PayrollRun) error
+	FindByID(ctx context.Context, id uuid.UUID) (*payroll_entity.PayrollRun, error)
+	// FindByPeriod returns the run paying the period, or nil if there is none.
+	FindByPeriod(ctx context.Context, period payroll_entity.PayPeriod) (*payroll_entity.PayrollRun, error)
+	// ListByYear returns the runs whose period starts in the given year.
+	ListByYear(ctx context.Context, year int) ([]payroll_entity.PayrollRun, error)
+}
+
+// PayrollAdjustmentRepository is the port for one-off earnings and deductions.
+type PayrollAdjustmentRepository interface {
+	Save(ctx context.Context, adjustment *payroll_entity.PayrollAdjustment) error
+	// ListByEmployee returns the employee's adjustments dated within [from, to].
+	ListByEmployee(ctx context.Context, employeeID uuid.UUID, from, to time.Time) ([]payroll_entity.PayrollAdjustment, error)
+}
@@ -0,0 +1,49 @@
+package porttest
+
+import (
+	"context"
+	"github.com/google/uuid"
+	attendance_entity "github.com/rfanazhari/hris/domain/entity/attendance"
+	"time"
+)
+
+// ClockEvents is an in-memory port.ClockEventRepository.
+type ClockEvents struct {
+	Events []attendance_entity.ClockEvent
+	// Err, when set, is returned by every method.
+	Err error
+}
+
+func (m *ClockEvents) Save(_ context.Context, event *attendance_entity.ClockEvent) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	m.Events = append(m.Events, *event)
+	return nil
+}
+
+func (m *ClockEvents) LastByEmployee(_ context.Context, employeeID uuid.UUID) (*attendance_entity.ClockEvent, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	for i := len(m.Events) - 1; i >= 0; i-- {
+		if m.Events[i].EmployeeID() == employeeID {
+			e := m.Events[i]
+			return &e, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *ClockEvents) ListByEmployee(_ context.Context, employeeID uuid.UUID, from, to time.Time) ([]attendance_entity.ClockEvent, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	var out []attendance_entity.ClockEvent
+	for _, e := range m.Events {
+		if e.EmployeeID() == employeeID && !e.OccurredAt().Before(from) && !e.OccurredAt().After(to) {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,150 @@
+// Package porttest provides in-memory implementations of the domain ports and builders for
+// the entities they store, for use in service tests.
+package porttest
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"testing"
+	"time"
+)
+
+// Employees is an in-memory port.EmployeeRepository. FindByID hands out the stored
+// pointers, so a test sees the changes a service makes to them.
+type Employees struct {
+	employees []*employee_entity.Employee
+	// Saved counts the calls to Save.
+	Saved int
+	// Err, when set, is returned by the list methods.
+	Err error
+}
+
+// NewEmployees returns a repository holding the given employees.
+func NewEmployees(employees ...*employee_entity.Employee) *Employees {
+	return &Employees{employees: employees}
+}
+
+func (m *Employees) Save(_ context.Context, employee *employee_entity.Employee) error {
+	m.Saved++
+	for i := range m.employees {
+		if m.employees[i].ID() == employee.ID() {
+			m.employees[i] = employee
+			return nil
+		}
+	}
+	m.employees = append(m.employees, employee)
+	return nil
+}
+
+func (m *Employees) FindByID(_ context.Context, id uuid.UUID) (*employee_entity.Employee, error) {
+	for _, e := range m.employees {
+		if e.ID() == id {
+			return e, nil
+		}
+	}
+	return nil, errors.New("employee not found")
+}
+
+func (m *Employees) ListByOrganizationUnit(_ context.Context, unitID uuid.UUID) ([]employee_entity.Employee, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	var out []employee_entity.Employee
+	for _, e := range m.employees {
+		if e.OrganizationUnitID() != nil && *e.OrganizationUnitID() == unitID {
+			out = append(out, *e)
+		}
+	}
+	return out, nil
+}
+
+func (m *Employees) ListByEmploymentPeriod(_ context.Context, from, to time.Time) ([]employee_entity.Employee, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	var out []employee_entity.Employee
+	for _, e := range m.employees {
+		for _, c := range e.EmploymentContracts() {
+			if !c.StartDate().After(to) && (c.EndDate() == nil || !c.EndDate().Before(from)) {
+				out = append(out, *e)
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+// Hire describes an employee built by NewEmployee. Without a start date the employee has
+// no contract, and without an amount no salary record.
+type Hire struct {
+	Gender        string // defaults to "F"
+	MaritalStatus string // defaults to "single"
+	Religion      string // defaults to "islam"
+	UnitID        *uuid.UUID
+	// Start begins an active contract: PKWT when End is set, PKWTT otherwise.
+	Start time.Time
+	End   *time.Time
+	// Salary takes effect on SalaryFrom, or on Start when SalaryFrom is zero.
+	Salary     int64
+	Currency   string // defaults to "IDR"
+	SalaryFrom time.Time
+}
+
+// NewEmployee builds an active employee, Rina Wijaya, as described by the hire.
+func NewEmployee(t testing.TB, h Hire) *employee_entity.Employee {
+	t.Helper()
+	personalInfo, err := employee_entity.PersonalInfoFactory{
+		FirstName: "Rina", LastName: "Wijaya", PlaceOfBirth: "medan", Nationality: "wni",
+		Gender:        or(h.Gender, "F"),
+		MaritalStatus: or(h.MaritalStatus, "single"),
+		Religion:      or(h.Religion, "islam"),
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	factory := employee_entity.EmployeeFactory{ID: uuid.NewString(), PersonalInfo: personalInfo, Status: "active"}
+	if h.UnitID != nil {
+		factory.OrganizationUnitID = h.UnitID.String()
+	}
+	employee, err := factory.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !h.Start.IsZero() {
+		contractType := "pkwtt"
+		if h.End != nil {
+			contractType = "pkwt"
+		}
+		contract, err := employee_entity.EmploymentContractFactory{ID: uuid.NewString(), ContractType: contractType, StartDate: h.Start, EndDate: h.End, Status: "active"}.Create()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := employee.AddEmploymentContract(*contract, time.Time{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if h.Salary > 0 {
+		from := h.SalaryFrom
+		if from.IsZero() {
+			from = h.Start
+		}
+		record, err := employee_entity.SalaryRecordFactory{ID: uuid.NewString(), Amount: h.Salary, Currency: or(h.Currency, "IDR"), EffectiveDate: from}.Create()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := employee.AddSalaryRecord(*record, time.Time{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	return employee
+}
+
+func or(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,18 @@
+package porttest
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/entity"
+)
+
+// OrganizationUnits is an in-memory port.OrganizationUnitRepository keyed by unit ID.
+type OrganizationUnits map[uuid.UUID]*entity.OrganizationUnit
+
+func (m OrganizationUnits) FindByID(_ context.Context, id uuid.UUID) (*entity.OrganizationUnit, error) {
+	if u, ok := m[id]; ok {
+		return u, nil
+	}
+	return nil, errors.New("organization unit not found")
+}
@@ -0,0 +1,57 @@
+package porttest
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+)
+
+// PayrollRuns is an in-memory port.PayrollRunRepository.
+type PayrollRuns struct {
+	runs []*payroll_entity.PayrollRun
+}
+
+// NewPayrollRuns returns a repository holding the given runs.
+func NewPayrollRuns(runs ...*payroll_entity.PayrollRun) *PayrollRuns {
+	return &PayrollRuns{runs: runs}
+}
+
+func (m *PayrollRuns) Save(_ context.Context, run *payroll_entity.PayrollRun) error {
+	for i := range m.runs {
+		if m.runs[i].ID() == run.ID() {
+			m.runs[i] = run
+			return nil
+		}
+	}
+	m.runs = append(m.runs, run)
+	return nil
+}
+
+func (m *PayrollRuns) FindByID(_ context.Context, id uuid.UUID) (*payroll_entity.PayrollRun, error) {
+	for _, r := range m.runs {
+		if r.ID() == id {
+			return r, nil
+		}
+	}
+	return nil, errors.New("payroll run not found")
+}
+
+func (m *PayrollRuns) FindByPeriod(_ context.Context, period payroll_entity.PayPeriod) (*payroll_entity.PayrollRun, error) {
+	for _, r := range m.runs {
+		if r.Period() == period {
+			return r, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *PayrollRuns) ListByYear(_ context.Context, year int) ([]payroll_entity.PayrollRun, error) {
+	var out []payroll_entity.PayrollRun
+	for _, r := range m.runs {
+		if r.Period().Year() == year {
+			out = append(out, *r)
+		}
+	}
+	return out, nil
+}
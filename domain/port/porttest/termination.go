@@ -0,0 +1,43 @@
+package porttest
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	offboarding_entity "github.com/rfanazhari/hris/domain/entity/offboarding"
+)
+
+// Terminations is an in-memory port.TerminationRepository.
+type Terminations struct {
+	terminations []*offboarding_entity.Termination
+}
+
+func (m *Terminations) Save(_ context.Context, termination *offboarding_entity.Termination) error {
+	for i, t := range m.terminations {
+		if t.ID() == termination.ID() {
+			m.terminations[i] = termination
+			return nil
+		}
+	}
+	m.terminations = append(m.terminations, termination)
+	return nil
+}
+
+func (m *Terminations) FindByID(_ context.Context, id uuid.UUID) (*offboarding_entity.Termination, error) {
+	for _, t := range m.terminations {
+		if t.ID() == id {
+			return t, nil
+		}
+	}
+	return nil, errors.New("termination not found")
+}
+
+func (m *Terminations) ListByEmployee(_ context.Context, employeeID uuid.UUID) ([]offboarding_entity.Termination, error) {
+	var out []offboarding_entity.Termination
+	for _, t := range m.terminations {
+		if t.EmployeeID() == employeeID {
+			out = append(out, *t)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	reimbursement_entity "github.com/rfanazhari/hris/domain/entity/reimbursement"
+)
+
+// ReimbursementClaimRepository is the port for persisting reimbursement claims.
+type ReimbursementClaimRepository interface {
+	Save(ctx context.Context, claim *reimbursement_entity.Claim) error
+	FindByID(ctx context.Context, id uuid.UUID) (*reimbursement_entity.Claim, error)
+	// ListByEmployee returns every claim of the employee, in any status.
+	ListByEmployee(ctx context.Context, employeeID uuid.UUID) ([]reimbursement_entity.Claim, error)
+}
@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	offboarding_entity "github.com/rfanazhari/hris/domain/entity/offboarding"
+)
+
+// TerminationRepository is the port for persisting terminations of employment.
+type TerminationRepository interface {
+	Save(ctx context.Context, termination *offboarding_entity.Termination) error
+	FindByID(ctx context.Context, id uuid.UUID) (*offboarding_entity.Termination, error)
+	ListByEmployee(ctx context.Context, employeeID uuid.UUID) ([]offboarding_entity.Termination, error)
+}
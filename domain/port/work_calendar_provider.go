@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	calendar_entity "github.com/rfanazhari/hris/domain/entity/calendar"
+)
+
+// ErrNoHolidayData is returned, possibly wrapped, by a WorkCalendarProvider when the
+// holidays of the requested year are not known yet.
+var ErrNoHolidayData = errors.New("no holiday data")
+
+// WorkCalendarProvider resolves the work calendar that applies to an organization unit
+// in a year, including its work week and regional holidays.
+type WorkCalendarProvider interface {
+	WorkCalendar(ctx context.Context, unitID *uuid.UUID, year int) (*calendar_entity.WorkCalendar, error)
+}
@@ -0,0 +1,32 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	workflow_entity "github.com/rfanazhari/hris/domain/entity/workflow"
+)
+
+// WorkflowDefinitionRepository is the port for persisting approval workflow definitions,
+// one per request type.
+type WorkflowDefinitionRepository interface {
+	// Save stores the definition, replacing the one of the same request type.
+	Save(ctx context.Context, definition *workflow_entity.Definition) error
+	// FindByRequestType returns the definition of the request type, or nil if there is none.
+	FindByRequestType(ctx context.Context, requestType string) (*workflow_entity.Definition, error)
+}
+
+// ApprovalRepository is the port for persisting approvals and their history.
+type ApprovalRepository interface {
+	Save(ctx context.Context, approval *workflow_entity.Approval) error
+	FindByID(ctx context.Context, id uuid.UUID) (*workflow_entity.Approval, error)
+	// ListBySubject returns every approval of the request, oldest first.
+	ListBySubject(ctx context.Context, requestType string, subjectID uuid.UUID) ([]workflow_entity.Approval, error)
+	// ListPending returns the approvals still waiting for a decision.
+	ListPending(ctx context.Context) ([]workflow_entity.Approval, error)
+}
+
+// DelegationRepository is the port for persisting approver delegations.
+type DelegationRepository interface {
+	Save(ctx context.Context, delegation *workflow_entity.Delegation) error
+	ListByDelegator(ctx context.Context, delegatorID uuid.UUID) ([]workflow_entity.Delegation, error)
+}
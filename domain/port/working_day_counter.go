@@ -0,0 +1,8 @@
+package port
+
+import "time"
+
+// WorkingDayCounter counts the working days within an inclusive range of calendar dates.
+type WorkingDayCounter interface {
+	WorkingDaysBetween(from, to time.Time) int
+}
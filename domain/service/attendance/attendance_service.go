@@ -0,0 +1,101 @@
+package attendance_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	attendance_entity "github.com/rfanazhari/hris/domain/entity/attendance"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"time"
+)
+
+// maxOpenSession is how long a clock-in stays open. A clock-in older than this no longer
+// blocks a new clock-in, so a forgotten clock-out does not lock the employee out.
+const maxOpenSession = 24 * time.Hour
+
+// PunchRequest holds the data captured with a clock-in or clock-out.
+type PunchRequest struct {
+	EmployeeID uuid.UUID
+	Source     enum.ClockSource
+	Location   *valueobject.GeoLocation
+	DeviceID   string
+	Note       string
+}
+
+// AttendanceService records clock events and computes daily attendance records.
+type AttendanceService struct {
+	events port.ClockEventRepository
+	clock  clock.Clock
+}
+
+// NewAttendanceService returns an AttendanceService. A nil clock falls back to the system clock.
+func NewAttendanceService(events port.ClockEventRepository, clk clock.Clock) *AttendanceService {
+	if clk == nil {
+		clk = clock.System{}
+	}
+	return &AttendanceService{events: events, clock: clk}
+}
+
+// ClockIn records a clock-in at the current time. It fails if the employee still has an open clock-in.
+func (s *AttendanceService) ClockIn(ctx context.Context, req PunchRequest) (*attendance_entity.ClockEvent, error) {
+	return s.punch(ctx, enum.ClockIn, req)
+}
+
+// ClockOut records a clock-out at the current time. It fails if the employee has no open clock-in.
+func (s *AttendanceService) ClockOut(ctx context.Context, req PunchRequest) (*attendance_entity.ClockEvent, error) {
+	return s.punch(ctx, enum.ClockOut, req)
+}
+
+// DailySummary computes the attendance record of the employee for the calendar date of date.
+func (s *AttendanceService) DailySummary(ctx context.Context, employeeID uuid.UUID, date time.Time, schedule attendance_entity.WorkSchedule, policy attendance_entity.AttendancePolicy) (*attendance_entity.DailyAttendance, error) {
+	start, end := schedule.Window(date)
+	events, err := s.events.ListByEmployee(ctx, employeeID, start.Add(-policy.PunchWindow()), end.Add(policy.PunchWindow()))
+	if err != nil {
+		return nil, fmt.Errorf("list clock events: %w", err)
+	}
+	return attendance_entity.DailyAttendanceFactory{
+		EmployeeID: employeeID.String(),
+		Date:       date,
+		Schedule:   schedule,
+		Policy:     policy,
+		Events:     events,
+	}.Create()
+}
+
+func (s *AttendanceService) punch(ctx context.Context, eventType enum.ClockEventType, req PunchRequest) (*attendance_entity.ClockEvent, error) {
+	now := s.clock.Now()
+
+	last, err := s.events.LastByEmployee(ctx, req.EmployeeID)
+	if err != nil {
+		return nil, fmt.Errorf("load last clock event: %w", err)
+	}
+	open := last != nil && last.Type() == enum.ClockIn && now.Sub(last.OccurredAt()) < maxOpenSession
+	if eventType == enum.ClockIn && open {
+		return nil, errors.New("employee is already clocked in")
+	}
+	if eventType == enum.ClockOut && !open {
+		return nil, errors.New("employee is not clocked in")
+	}
+
+	event, err := attendance_entity.ClockEventFactory{
+		ID:         uuid.NewString(),
+		EmployeeID: req.EmployeeID.String(),
+		Type:       string(eventType),
+		Source:     string(req.Source),
+		OccurredAt: now,
+		Location:   req.Location,
+		DeviceID:   req.DeviceID,
+		Note:       req.Note,
+	}.Create()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.events.Save(ctx, event); err != nil {
+		return nil, fmt.Errorf("save clock event: %w", err)
+	}
+	return event, nil
+}
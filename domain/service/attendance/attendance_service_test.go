@@ -0,0 +1,80 @@
+package attendance_service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	attendance_entity "github.com/rfanazhari/hris/domain/entity/attendance"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	attendance_service "github.com/rfanazhari/hris/domain/service/attendance"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestAttendanceService_ClockInOut(t *testing.T) {
+	wita := enum.TimeZoneWITA.Location()
+	employeeID := uuid.New()
+	req := attendance_service.PunchRequest{EmployeeID: employeeID, Source: enum.ClockSourceFingerprint, DeviceID: "fp-01"}
+
+	t.Run("RecordsAndSummarizes", func(t *testing.T) {
+		repo := &porttest.ClockEvents{}
+		clk := &clock.Fixed{At: time.Date(2025, 3, 3, 8, 25, 0, 0, wita)}
+		service := attendance_service.NewAttendanceService(repo, clk)
+
+		in, err := service.ClockIn(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, clk.At, in.OccurredAt())
+
+		_, err = service.ClockIn(context.Background(), req)
+		assert.EqualError(t, err, "employee is already clocked in")
+
+		clk.At = time.Date(2025, 3, 3, 17, 5, 0, 0, wita)
+		out, err := service.ClockOut(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, enum.ClockOut, out.Type())
+
+		schedule, _ := attendance_entity.NewWorkSchedule("08:00", "17:00", enum.TimeZoneWITA)
+		policy, _ := attendance_entity.NewAttendancePolicy(15*time.Minute, 0, 4*time.Hour, enum.MissingPunchFlag)
+		record, err := service.DailySummary(context.Background(), employeeID, time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), *schedule, *policy)
+
+		assert.Nil(t, err)
+		assert.Equal(t, enum.AttendanceLate, record.Status())
+		assert.Equal(t, 25*time.Minute, record.LateBy())
+	})
+	t.Run("ClockOutWithoutClockIn", func(t *testing.T) {
+		service := attendance_service.NewAttendanceService(&porttest.ClockEvents{}, clock.Fixed{At: time.Now()})
+
+		_, err := service.ClockOut(context.Background(), req)
+
+		assert.EqualError(t, err, "employee is not clocked in")
+	})
+	t.Run("StaleClockInDoesNotBlock", func(t *testing.T) {
+		repo := &porttest.ClockEvents{}
+		clk := &clock.Fixed{At: time.Date(2025, 3, 3, 8, 0, 0, 0, wita)}
+		service := attendance_service.NewAttendanceService(repo, clk)
+		_, _ = service.ClockIn(context.Background(), req)
+
+		clk.At = clk.At.Add(25 * time.Hour)
+		_, err := service.ClockIn(context.Background(), req)
+
+		assert.Nil(t, err)
+		assert.Len(t, repo.Events, 2)
+	})
+	t.Run("InvalidPunch", func(t *testing.T) {
+		service := attendance_service.NewAttendanceService(&porttest.ClockEvents{}, nil)
+
+		_, err := service.ClockIn(context.Background(), attendance_service.PunchRequest{EmployeeID: employeeID, Source: enum.ClockSourceMobile})
+
+		assert.EqualError(t, err, "mobile punch requires a location")
+	})
+	t.Run("RepositoryError", func(t *testing.T) {
+		service := attendance_service.NewAttendanceService(&porttest.ClockEvents{Err: errors.New("db down")}, nil)
+
+		_, err := service.ClockIn(context.Background(), req)
+
+		assert.EqualError(t, err, "load last clock event: db down")
+	})
+}
@@ -0,0 +1,134 @@
+package attendance_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	attendance_entity "github.com/rfanazhari/hris/domain/entity/attendance"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"sort"
+	"time"
+)
+
+// RosterRequest describes the roster to generate.
+//
+// Fields:
+//   - Anchor: the date the rotation is counted from, defaults to PeriodStart
+//   - StaggerDays: employee i starts the pattern i*StaggerDays days later, spreading coverage
+//   - MinRest: minimum rest between two shifts of the same employee
+type RosterRequest struct {
+	OrganizationUnitID uuid.UUID
+	PeriodStart        time.Time
+	PeriodEnd          time.Time
+	Pattern            attendance_entity.ShiftPattern
+	Shifts             []attendance_entity.Shift
+	Anchor             time.Time
+	StaggerDays        int
+	MinRest            time.Duration
+}
+
+// RosterService generates shift rosters for organization units.
+type RosterService struct {
+	employees port.EmployeeRepository
+	leaves    port.LeaveChecker
+	clock     clock.Clock
+}
+
+// NewRosterService returns a RosterService. A nil leave checker disables leave checks
+// and a nil clock falls back to the system clock.
+func NewRosterService(employees port.EmployeeRepository, leaves port.LeaveChecker, clk clock.Clock) *RosterService {
+	if clk == nil {
+		clk = clock.System{}
+	}
+	return &RosterService{employees: employees, leaves: leaves, clock: clk}
+}
+
+// Generate assigns every current employee of the organization unit to the shifts of the
+// rotating pattern for each date of the period, staggered in order of employee ID. Slots
+// that fall on the employee's leave or that would break the minimum rest period are not
+// assigned and are recorded as conflicts on the roster instead.
+func (s *RosterService) Generate(ctx context.Context, req RosterRequest) (*attendance_entity.Roster, error) {
+	if req.Pattern.Length() == 0 {
+		return nil, errors.New("shift pattern cannot be empty")
+	}
+	shifts := make(map[uuid.UUID]attendance_entity.Shift, len(req.Shifts))
+	for _, sh := range req.Shifts {
+		shifts[sh.ID()] = sh
+	}
+	for _, id := range req.Pattern.Sequence() {
+		if _, ok := shifts[id]; id != uuid.Nil && !ok {
+			return nil, fmt.Errorf("shift %s of the pattern is not provided", id)
+		}
+	}
+
+	roster, err := attendance_entity.RosterFactory{
+		ID:                 uuid.NewString(),
+		OrganizationUnitID: req.OrganizationUnitID.String(),
+		PeriodStart:        req.PeriodStart,
+		PeriodEnd:          req.PeriodEnd,
+		MinRest:            req.MinRest,
+		CreatedAt:          s.clock.Now(),
+	}.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	listed, err := s.employees.ListByOrganizationUnit(ctx, req.OrganizationUnitID)
+	if err != nil {
+		return nil, fmt.Errorf("list employees: %w", err)
+	}
+	employees := make([]employee_entity.Employee, 0, len(listed))
+	for i := range listed {
+		if !listed[i].HasLeft() {
+			employees = append(employees, listed[i])
+		}
+	}
+	// Offsets follow the employee ID so the stagger does not depend on the repository order.
+	sort.Slice(employees, func(i, j int) bool { return employees[i].ID().String() < employees[j].ID().String() })
+
+	anchor := req.Anchor
+	if anchor.IsZero() {
+		anchor = roster.PeriodStart()
+	}
+
+	for date := roster.PeriodStart(); !date.After(roster.PeriodEnd()); date = date.AddDate(0, 0, 1) {
+		for i := range employees {
+			employeeID := employees[i].ID()
+			shiftID, working := req.Pattern.ShiftOn(anchor, i*req.StaggerDays, date)
+			if !working {
+				continue
+			}
+			shift := shifts[shiftID]
+
+			if s.leaves != nil {
+				onLeave, err := s.leaves.IsOnLeave(ctx, employeeID, date)
+				if err != nil {
+					return nil, fmt.Errorf("check leave: %w", err)
+				}
+				if onLeave {
+					if err := roster.AddConflict(employeeID, date, shiftID, enum.RosterConflictOnLeave); err != nil {
+						return nil, err
+					}
+					continue
+				}
+			}
+
+			start, end := shift.Window(date)
+			if !roster.HasSufficientRest(employeeID, start, end) {
+				if err := roster.AddConflict(employeeID, date, shiftID, enum.RosterConflictInsufficientRest); err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			if err := roster.Assign(employeeID, date, shift); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return roster, nil
+}
@@ -0,0 +1,131 @@
+package attendance_service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	attendance_entity "github.com/rfanazhari/hris/domain/entity/attendance"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	attendance_service "github.com/rfanazhari/hris/domain/service/attendance"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type leaveDays map[uuid.UUID][]time.Time
+
+func (l leaveDays) IsOnLeave(_ context.Context, employeeID uuid.UUID, date time.Time) (bool, error) {
+	for _, d := range l[employeeID] {
+		if d.Year() == date.Year() && d.YearDay() == date.YearDay() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func newUnitEmployee(t *testing.T, unitID uuid.UUID) *employee_entity.Employee {
+	return porttest.NewEmployee(t, porttest.Hire{UnitID: &unitID})
+}
+
+func TestRosterService_Generate(t *testing.T) {
+	unitID := uuid.New()
+	morning, _ := attendance_entity.ShiftFactory{ID: uuid.NewString(), Code: "P", Name: "Pagi", Start: "07:00", End: "15:00", TimeZone: "wib"}.Create()
+	night, _ := attendance_entity.ShiftFactory{ID: uuid.NewString(), Code: "M", Name: "Malam", Start: "23:00", End: "07:00", TimeZone: "wib"}.Create()
+	pattern, _ := attendance_entity.ShiftPatternFactory{
+		ID:       uuid.NewString(),
+		Name:     "2 pagi 2 malam 2 libur",
+		Sequence: []string{morning.ID().String(), morning.ID().String(), night.ID().String(), night.ID().String(), "", ""},
+	}.Create()
+	periodStart := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	request := attendance_service.RosterRequest{
+		OrganizationUnitID: unitID,
+		PeriodStart:        periodStart,
+		PeriodEnd:          periodStart.AddDate(0, 0, 11),
+		Pattern:            *pattern,
+		Shifts:             []attendance_entity.Shift{*morning, *night},
+		StaggerDays:        2,
+		MinRest:            11 * time.Hour,
+	}
+
+	t.Run("RotatesAndStaggers", func(t *testing.T) {
+		first, second := newUnitEmployee(t, unitID), newUnitEmployee(t, unitID)
+		if second.ID().String() < first.ID().String() {
+			first, second = second, first
+		}
+		left := newUnitEmployee(t, unitID)
+		_ = left.ChangeStatus(enum.EmploymentResigned, periodStart, "resignation", periodStart)
+		// Listed out of ID order: the stagger still follows the IDs.
+		repo := porttest.NewEmployees(second, left, first, newUnitEmployee(t, uuid.New()))
+		service := attendance_service.NewRosterService(repo, nil, clock.Fixed{At: periodStart})
+
+		roster, err := service.Generate(context.Background(), request)
+
+		assert.Nil(t, err)
+		assert.Equal(t, unitID, roster.OrganizationUnitID())
+		assert.Equal(t, periodStart, roster.CreatedAt())
+		assert.Empty(t, roster.Conflicts())
+		// 12 days = two full rotations of 4 working days each
+		firstEntries := roster.EntriesFor(first.ID())
+		assert.Len(t, firstEntries, 8)
+		assert.Equal(t, morning.ID(), firstEntries[0].ShiftID())
+		assert.Equal(t, night.ID(), firstEntries[2].ShiftID())
+		secondEntries := roster.EntriesFor(second.ID())
+		assert.Len(t, secondEntries, 8)
+		assert.Equal(t, night.ID(), secondEntries[0].ShiftID())
+		assert.Empty(t, roster.EntriesFor(left.ID()))
+		assert.Len(t, roster.Entries(), 16)
+	})
+	t.Run("LeaveConflict", func(t *testing.T) {
+		employee := newUnitEmployee(t, unitID)
+		leaves := leaveDays{employee.ID(): {periodStart.AddDate(0, 0, 1)}}
+		service := attendance_service.NewRosterService(porttest.NewEmployees(employee), leaves, nil)
+
+		roster, err := service.Generate(context.Background(), request)
+
+		assert.Nil(t, err)
+		assert.Len(t, roster.EntriesFor(employee.ID()), 7)
+		assert.Len(t, roster.Conflicts(), 1)
+		assert.Equal(t, enum.RosterConflictOnLeave, roster.Conflicts()[0].Reason())
+		assert.Equal(t, periodStart.AddDate(0, 0, 1), roster.Conflicts()[0].Date())
+	})
+	t.Run("RestConflict", func(t *testing.T) {
+		// night followed directly by morning leaves no rest at all
+		quick, _ := attendance_entity.ShiftPatternFactory{
+			ID:       uuid.NewString(),
+			Name:     "quick return",
+			Sequence: []string{night.ID().String(), morning.ID().String(), ""},
+		}.Create()
+		employee := newUnitEmployee(t, unitID)
+		service := attendance_service.NewRosterService(porttest.NewEmployees(employee), nil, nil)
+		req := request
+		req.Pattern = *quick
+		req.PeriodEnd = periodStart.AddDate(0, 0, 2)
+
+		roster, err := service.Generate(context.Background(), req)
+
+		assert.Nil(t, err)
+		assert.Len(t, roster.Entries(), 1)
+		assert.Len(t, roster.Conflicts(), 1)
+		assert.Equal(t, enum.RosterConflictInsufficientRest, roster.Conflicts()[0].Reason())
+		assert.Equal(t, morning.ID(), roster.Conflicts()[0].ShiftID())
+	})
+	t.Run("MissingShift", func(t *testing.T) {
+		service := attendance_service.NewRosterService(porttest.NewEmployees(), nil, nil)
+		req := request
+		req.Shifts = []attendance_entity.Shift{*morning}
+
+		_, err := service.Generate(context.Background(), req)
+
+		assert.EqualError(t, err, "shift "+night.ID().String()+" of the pattern is not provided")
+	})
+	t.Run("RepositoryError", func(t *testing.T) {
+		service := attendance_service.NewRosterService(&porttest.Employees{Err: errors.New("db down")}, nil, nil)
+
+		_, err := service.Generate(context.Background(), request)
+
+		assert.EqualError(t, err, "list employees: db down")
+	})
+}
@@ -0,0 +1,305 @@
+package benefit_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	benefit_entity "github.com/rfanazhari/hris/domain/entity/benefit"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"sort"
+	"time"
+)
+
+// GradeLevelSource returns the grade level of an employee's position on a date.
+type GradeLevelSource interface {
+	GradeLevel(ctx context.Context, employeeID uuid.UUID, at time.Time) (enum.GradeLevel, error)
+}
+
+// AttendanceDaySource counts the days an employee attended work within [from, to].
+type AttendanceDaySource interface {
+	AttendedDays(ctx context.Context, employeeID uuid.UUID, from, to time.Time) (int, error)
+}
+
+// EnrollRequest describes an enrollment. Amount overrides the plan amount for the employee.
+type EnrollRequest struct {
+	EmployeeID    uuid.UUID
+	PlanID        uuid.UUID
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+	Amount        *int64
+}
+
+// BenefitService manages allowance and insurance plans, checks eligibility, enrolls
+// employees and computes the benefit lines of their payslips.
+type BenefitService struct {
+	plans       port.BenefitPlanRepository
+	enrollments port.BenefitEnrollmentRepository
+	employees   port.EmployeeRepository
+	grades      GradeLevelSource
+	attendance  AttendanceDaySource
+	clock       clock.Clock
+}
+
+// NewBenefitService returns a BenefitService. grades is needed for plans restricted by
+// grade level and attendance for attendance based allowances; either may be nil when no
+// such plan exists. A nil clock falls back to the system clock.
+func NewBenefitService(plans port.BenefitPlanRepository, enrollments port.BenefitEnrollmentRepository, employees port.EmployeeRepository, grades GradeLevelSource, attendance AttendanceDaySource, clk clock.Clock) *BenefitService {
+	if clk == nil {
+		clk = clock.System{}
+	}
+	return &BenefitService{plans: plans, enrollments: enrollments, employees: employees, grades: grades, attendance: attendance, clock: clk}
+}
+
+// CreatePlan creates a plan. Plan codes must be unique.
+func (s *BenefitService) CreatePlan(ctx context.Context, f benefit_entity.BenefitPlanFactory) (*benefit_entity.BenefitPlan, error) {
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = s.clock.Now()
+	}
+	plan, err := f.Create()
+	if err != nil {
+		return nil, err
+	}
+	plans, err := s.plans.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list benefit plans: %w", err)
+	}
+	for _, p := range plans {
+		if p.Code() == plan.Code() {
+			return nil, fmt.Errorf("benefit plan %s already exists", plan.Code())
+		}
+	}
+	if err := s.plans.Save(ctx, plan); err != nil {
+		return nil, fmt.Errorf("save benefit plan: %w", err)
+	}
+	return plan, nil
+}
+
+// EligiblePlans returns the plans the employee is eligible for on the given date.
+func (s *BenefitService) EligiblePlans(ctx context.Context, employeeID uuid.UUID, at time.Time) ([]benefit_entity.BenefitPlan, error) {
+	employee, err := s.employees.FindByID(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("find employee: %w", err)
+	}
+	plans, err := s.plans.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list benefit plans: %w", err)
+	}
+	var out []benefit_entity.BenefitPlan
+	for _, plan := range plans {
+		candidate, err := s.candidate(ctx, employee, plan, at)
+		if err != nil {
+			return nil, err
+		}
+		if candidate != nil && plan.Eligibility().Check(*candidate) == nil {
+			out = append(out, plan)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Code() < out[j].Code() })
+	return out, nil
+}
+
+// Enroll enrolls the employee in the plan. The employee must be eligible on the effective
+// date and may not have another enrollment in the plan covering the same days.
+func (s *BenefitService) Enroll(ctx context.Context, req EnrollRequest) (*benefit_entity.Enrollment, error) {
+	employee, err := s.employees.FindByID(ctx, req.EmployeeID)
+	if err != nil {
+		return nil, fmt.Errorf("find employee: %w", err)
+	}
+	plan, err := s.plans.FindByID(ctx, req.PlanID)
+	if err != nil {
+		return nil, fmt.Errorf("find benefit plan: %w", err)
+	}
+
+	enrollment, err := benefit_entity.EnrollmentFactory{
+		ID:            uuid.NewString(),
+		EmployeeID:    req.EmployeeID.String(),
+		PlanID:        req.PlanID.String(),
+		EffectiveFrom: req.EffectiveFrom,
+		EffectiveTo:   req.EffectiveTo,
+		Amount:        req.Amount,
+		CreatedAt:     s.clock.Now(),
+	}.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	candidate, err := s.candidate(ctx, employee, *plan, enrollment.EffectiveFrom())
+	if err != nil {
+		return nil, err
+	}
+	if candidate == nil {
+		return nil, errors.New("employee has no active contract on the effective date")
+	}
+	if err := plan.Eligibility().Check(*candidate); err != nil {
+		return nil, fmt.Errorf("not eligible for %s: %w", plan.Code(), err)
+	}
+
+	existing, err := s.enrollments.ListByEmployee(ctx, req.EmployeeID)
+	if err != nil {
+		return nil, fmt.Errorf("list enrollments: %w", err)
+	}
+	for _, e := range existing {
+		if e.PlanID() == plan.ID() && e.Overlaps(enrollment.EffectiveFrom(), enrollment.EffectiveTo()) {
+			return nil, errors.New("employee is already enrolled in the plan")
+		}
+	}
+
+	if err := s.enrollments.Save(ctx, enrollment); err != nil {
+		return nil, fmt.Errorf("save enrollment: %w", err)
+	}
+	return enrollment, nil
+}
+
+// EndEnrollment ends the enrollment on the given date, inclusive.
+func (s *BenefitService) EndEnrollment(ctx context.Context, enrollmentID uuid.UUID, endDate time.Time) (*benefit_entity.Enrollment, error) {
+	enrollment, err := s.enrollments.FindByID(ctx, enrollmentID)
+	if err != nil {
+		return nil, fmt.Errorf("find enrollment: %w", err)
+	}
+	if err := enrollment.End(endDate, s.clock.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.enrollments.Save(ctx, enrollment); err != nil {
+		return nil, fmt.Errorf("save enrollment: %w", err)
+	}
+	return enrollment, nil
+}
+
+// BenefitLines returns the payslip lines of the employee's enrollments for the pay period
+// [from, to]; it is used by the payroll BenefitComponent. Fixed amounts are prorated by the
+// calendar days the enrollment covers, attendance based amounts are paid per attended day
+// within the covered days. Enrollments whose plan the employee is no longer eligible for
+// on the last covered day are skipped.
+func (s *BenefitService) BenefitLines(ctx context.Context, employeeID uuid.UUID, from, to time.Time) ([]payroll_entity.PayslipLine, error) {
+	employee, err := s.employees.FindByID(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("find employee: %w", err)
+	}
+	enrollments, err := s.enrollments.ListByEmployee(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("list enrollments: %w", err)
+	}
+
+	periodDays := int64(to.Sub(from).Hours()/24) + 1
+	amounts := map[uuid.UUID]int64{}
+	contributions := map[uuid.UUID]int64{}
+	plans := map[uuid.UUID]*benefit_entity.BenefitPlan{}
+	paid := map[uuid.UUID]bool{}
+	var order []uuid.UUID
+	for _, enrollment := range enrollments {
+		covered := enrollment.CoveredDays(from, to)
+		if covered == 0 {
+			continue
+		}
+		plan, ok := plans[enrollment.PlanID()]
+		if !ok {
+			if plan, err = s.plans.FindByID(ctx, enrollment.PlanID()); err != nil {
+				return nil, fmt.Errorf("find benefit plan: %w", err)
+			}
+			plans[plan.ID()] = plan
+		}
+
+		first, last := coveredWindow(enrollment, from, to)
+		candidate, err := s.candidate(ctx, employee, *plan, last)
+		if err != nil {
+			return nil, err
+		}
+		if candidate == nil || plan.Eligibility().Check(*candidate) != nil {
+			continue
+		}
+		if !paid[plan.ID()] {
+			paid[plan.ID()] = true
+			order = append(order, plan.ID())
+		}
+
+		amount := enrollment.AmountFor(*plan)
+		switch plan.Basis() {
+		case enum.BenefitPerAttendanceDay:
+			if s.attendance == nil {
+				return nil, fmt.Errorf("%s is attendance based but no attendance source is configured", plan.Code())
+			}
+			days, err := s.attendance.AttendedDays(ctx, employeeID, first, last)
+			if err != nil {
+				return nil, fmt.Errorf("attended days: %w", err)
+			}
+			amounts[plan.ID()] += amount * int64(days)
+		default:
+			amounts[plan.ID()] += prorate(amount, int64(covered), periodDays)
+			contributions[plan.ID()] += prorate(plan.EmployeeContribution(), int64(covered), periodDays)
+		}
+	}
+
+	var lines []payroll_entity.PayslipLine
+	for _, id := range order {
+		plan := plans[id]
+		kind := enum.PayLineEarning
+		if plan.Type() == enum.BenefitInsurance {
+			kind = enum.PayLineEmployerContribution
+		}
+		line, err := payroll_entity.NewPayslipLine(plan.Code(), plan.Name(), kind, amounts[id], plan.Taxable())
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, *line)
+		if contributions[id] > 0 {
+			share, err := payroll_entity.NewPayslipLine(plan.EmployeeContributionCode(), "Iuran "+plan.Name(), enum.PayLineDeduction, contributions[id], false)
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, *share)
+		}
+	}
+	return lines, nil
+}
+
+// candidate returns the employee's situation on the given date, or nil when no contract
+// is active then.
+func (s *BenefitService) candidate(ctx context.Context, employee *employee_entity.Employee, plan benefit_entity.BenefitPlan, at time.Time) (*benefit_entity.Candidate, error) {
+	contract, ok := employee.ActiveContract(at)
+	if !ok {
+		return nil, nil
+	}
+	info := employee.PersonalInfo()
+	candidate := &benefit_entity.Candidate{
+		ContractType:  contract.ContractType(),
+		MaritalStatus: info.MaritalStatus(),
+		HireDate:      employee.HireDate(),
+		At:            at,
+	}
+	if len(plan.Eligibility().GradeLevels()) > 0 {
+		if s.grades == nil {
+			return nil, fmt.Errorf("%s is restricted by grade level but no grade source is configured", plan.Code())
+		}
+		grade, err := s.grades.GradeLevel(ctx, employee.ID(), at)
+		if err != nil {
+			return nil, fmt.Errorf("grade level: %w", err)
+		}
+		candidate.GradeLevel = grade
+	}
+	return candidate, nil
+}
+
+// coveredWindow returns the first and last day of [from, to] the enrollment covers.
+func coveredWindow(enrollment benefit_entity.Enrollment, from, to time.Time) (time.Time, time.Time) {
+	first, last := from, to
+	if enrollment.EffectiveFrom().After(first) {
+		first = enrollment.EffectiveFrom()
+	}
+	if end := enrollment.EffectiveTo(); end != nil && end.Before(last) {
+		last = *end
+	}
+	return first, last
+}
+
+// prorate returns amount x days / periodDays, rounded half up.
+func prorate(amount, days, periodDays int64) int64 {
+	if days >= periodDays {
+		return amount
+	}
+	return (amount*days*2 + periodDays) / (2 * periodDays)
+}
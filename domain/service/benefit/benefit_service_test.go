@@ -0,0 +1,244 @@
+package benefit_service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	benefit_entity "github.com/rfanazhari/hris/domain/entity/benefit"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	benefit_service "github.com/rfanazhari/hris/domain/service/benefit"
+	payroll_service "github.com/rfanazhari/hris/domain/service/payroll"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type memoryPlans struct {
+	plans []*benefit_entity.BenefitPlan
+}
+
+func (m *memoryPlans) Save(_ context.Context, plan *benefit_entity.BenefitPlan) error {
+	m.plans = append(m.plans, plan)
+	return nil
+}
+
+func (m *memoryPlans) FindByID(_ context.Context, id uuid.UUID) (*benefit_entity.BenefitPlan, error) {
+	for _, p := range m.plans {
+		if p.ID() == id {
+			return p, nil
+		}
+	}
+	return nil, errors.New("benefit plan not found")
+}
+
+func (m *memoryPlans) List(context.Context) ([]benefit_entity.BenefitPlan, error) {
+	out := make([]benefit_entity.BenefitPlan, len(m.plans))
+	for i, p := range m.plans {
+		out[i] = *p
+	}
+	return out, nil
+}
+
+type memoryEnrollments struct {
+	enrollments map[uuid.UUID]*benefit_entity.Enrollment
+}
+
+func (m *memoryEnrollments) Save(_ context.Context, enrollment *benefit_entity.Enrollment) error {
+	m.enrollments[enrollment.ID()] = enrollment
+	return nil
+}
+
+func (m *memoryEnrollments) FindByID(_ context.Context, id uuid.UUID) (*benefit_entity.Enrollment, error) {
+	if e, ok := m.enrollments[id]; ok {
+		return e, nil
+	}
+	return nil, errors.New("enrollment not found")
+}
+
+func (m *memoryEnrollments) ListByEmployee(_ context.Context, employeeID uuid.UUID) ([]benefit_entity.Enrollment, error) {
+	var out []benefit_entity.Enrollment
+	for _, e := range m.enrollments {
+		if e.EmployeeID() == employeeID {
+			out = append(out, *e)
+		}
+	}
+	return out, nil
+}
+
+type grades map[uuid.UUID]enum.GradeLevel
+
+func (g grades) GradeLevel(_ context.Context, employeeID uuid.UUID, _ time.Time) (enum.GradeLevel, error) {
+	return g[employeeID], nil
+}
+
+type attendedDays map[uuid.UUID]int
+
+func (a attendedDays) AttendedDays(_ context.Context, employeeID uuid.UUID, _, _ time.Time) (int, error) {
+	return a[employeeID], nil
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newEmployee(t *testing.T, maritalStatus, contractType string, hired time.Time) *employee_entity.Employee {
+	hire := porttest.Hire{Gender: "M", MaritalStatus: maritalStatus, Start: hired, Salary: 8_000_000}
+	if contractType == "pkwt" {
+		end := hired.AddDate(2, 0, -1)
+		hire.End = &end
+	}
+	return porttest.NewEmployee(t, hire)
+}
+
+type fixture struct {
+	service    *benefit_service.BenefitService
+	married    *employee_entity.Employee
+	contractor *employee_entity.Employee
+	family     *benefit_entity.BenefitPlan
+	meal       *benefit_entity.BenefitPlan
+	health     *benefit_entity.BenefitPlan
+	car        *benefit_entity.BenefitPlan
+}
+
+func newFixture(t *testing.T) fixture {
+	ctx := context.Background()
+	married := newEmployee(t, "married", "pkwtt", date(2024, 1, 1))
+	contractor := newEmployee(t, "single", "pkwt", date(2025, 2, 1))
+	employees := porttest.NewEmployees(married, contractor)
+	enrollments := &memoryEnrollments{enrollments: map[uuid.UUID]*benefit_entity.Enrollment{}}
+	service := benefit_service.NewBenefitService(&memoryPlans{}, enrollments, employees,
+		grades{married.ID(): enum.GradeManager, contractor.ID(): enum.GradeJunior},
+		attendedDays{married.ID(): 20, contractor.ID(): 18},
+		clock.Fixed{At: date(2025, 4, 1)},
+	)
+
+	create := func(f benefit_entity.BenefitPlanFactory) *benefit_entity.BenefitPlan {
+		f.ID = uuid.NewString()
+		plan, err := service.CreatePlan(ctx, f)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return plan
+	}
+	return fixture{
+		service:    service,
+		married:    married,
+		contractor: contractor,
+		family:     create(benefit_entity.BenefitPlanFactory{Code: "FAMILY", Name: "Tunjangan Keluarga", Type: "allowance", Amount: 600_000, Taxable: true, MaritalStatuses: []string{"married"}}),
+		meal:       create(benefit_entity.BenefitPlanFactory{Code: "MEAL", Name: "Uang Makan", Type: "allowance", Basis: "attendance_day", Amount: 40_000, Taxable: true}),
+		health:     create(benefit_entity.BenefitPlanFactory{Code: "HEALTH", Name: "Asuransi Kesehatan", Type: "insurance", Amount: 900_000, EmployeeContribution: 150_000, Taxable: true, MinTenureMonths: 3}),
+		car:        create(benefit_entity.BenefitPlanFactory{Code: "CAR", Name: "Tunjangan Kendaraan", Type: "allowance", Amount: 3_000_000, Taxable: true, GradeLevels: []string{"manager", "director"}, ContractTypes: []string{"pkwtt"}}),
+	}
+}
+
+func TestBenefitService_Enrollment(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("DuplicateCode", func(t *testing.T) {
+		f := newFixture(t)
+		_, err := f.service.CreatePlan(ctx, benefit_entity.BenefitPlanFactory{ID: uuid.NewString(), Code: "meal", Name: "Makan", Type: "allowance", Amount: 1})
+		assert.EqualError(t, err, "benefit plan MEAL already exists")
+	})
+	t.Run("EligiblePlans", func(t *testing.T) {
+		f := newFixture(t)
+
+		plans, err := f.service.EligiblePlans(ctx, f.married.ID(), date(2025, 4, 1))
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"CAR", "FAMILY", "HEALTH", "MEAL"}, codes(plans))
+
+		plans, _ = f.service.EligiblePlans(ctx, f.contractor.ID(), date(2025, 4, 1))
+		assert.Equal(t, []string{"MEAL"}, codes(plans))
+		plans, _ = f.service.EligiblePlans(ctx, f.contractor.ID(), date(2025, 5, 1))
+		assert.Equal(t, []string{"HEALTH", "MEAL"}, codes(plans))
+	})
+	t.Run("Enroll", func(t *testing.T) {
+		f := newFixture(t)
+
+		enrollment, err := f.service.Enroll(ctx, benefit_service.EnrollRequest{EmployeeID: f.married.ID(), PlanID: f.family.ID(), EffectiveFrom: date(2025, 1, 1)})
+		assert.Nil(t, err)
+		assert.Equal(t, date(2025, 4, 1), enrollment.CreatedAt())
+
+		_, err = f.service.Enroll(ctx, benefit_service.EnrollRequest{EmployeeID: f.married.ID(), PlanID: f.family.ID(), EffectiveFrom: date(2025, 6, 1)})
+		assert.EqualError(t, err, "employee is already enrolled in the plan")
+		_, err = f.service.Enroll(ctx, benefit_service.EnrollRequest{EmployeeID: f.contractor.ID(), PlanID: f.car.ID(), EffectiveFrom: date(2025, 4, 1)})
+		assert.EqualError(t, err, `not eligible for CAR: grade level "junior" is not eligible`)
+		_, err = f.service.Enroll(ctx, benefit_service.EnrollRequest{EmployeeID: f.contractor.ID(), PlanID: f.health.ID(), EffectiveFrom: date(2025, 4, 1)})
+		assert.EqualError(t, err, "not eligible for HEALTH: requires 3 months of service")
+		_, err = f.service.Enroll(ctx, benefit_service.EnrollRequest{EmployeeID: f.contractor.ID(), PlanID: f.meal.ID(), EffectiveFrom: date(2024, 1, 1)})
+		assert.EqualError(t, err, "employee has no active contract on the effective date")
+
+		ended, err := f.service.EndEnrollment(ctx, enrollment.ID(), date(2025, 5, 31))
+		assert.Nil(t, err)
+		assert.Equal(t, date(2025, 5, 31), *ended.EffectiveTo())
+		_, err = f.service.Enroll(ctx, benefit_service.EnrollRequest{EmployeeID: f.married.ID(), PlanID: f.family.ID(), EffectiveFrom: date(2025, 6, 1)})
+		assert.Nil(t, err)
+	})
+}
+
+func TestBenefitService_BenefitLines(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	position := int64(3_500_000)
+	for _, req := range []benefit_service.EnrollRequest{
+		{EmployeeID: f.married.ID(), PlanID: f.family.ID(), EffectiveFrom: date(2025, 1, 1)},
+		{EmployeeID: f.married.ID(), PlanID: f.meal.ID(), EffectiveFrom: date(2025, 1, 1)},
+		{EmployeeID: f.married.ID(), PlanID: f.health.ID(), EffectiveFrom: date(2025, 4, 16)},
+		{EmployeeID: f.married.ID(), PlanID: f.car.ID(), EffectiveFrom: date(2025, 1, 1), Amount: &position},
+	} {
+		_, err := f.service.Enroll(ctx, req)
+		assert.Nil(t, err)
+	}
+
+	t.Run("Lines", func(t *testing.T) {
+		lines, err := f.service.BenefitLines(ctx, f.married.ID(), date(2025, 4, 1), date(2025, 4, 30))
+		assert.Nil(t, err)
+
+		amounts := map[string]int64{}
+		for _, l := range lines {
+			amounts[l.Code()] = l.Amount()
+		}
+		assert.Equal(t, map[string]int64{
+			"FAMILY":    600_000,
+			"MEAL":      20 * 40_000,
+			"HEALTH":    450_000, // 15 of 30 days
+			"HEALTH_EE": 75_000,
+			"CAR":       3_500_000,
+		}, amounts)
+	})
+	t.Run("NoLongerEligible", func(t *testing.T) {
+		_, err := f.service.Enroll(ctx, benefit_service.EnrollRequest{EmployeeID: f.contractor.ID(), PlanID: f.meal.ID(), EffectiveFrom: date(2025, 4, 1)})
+		assert.Nil(t, err)
+
+		lines, err := f.service.BenefitLines(ctx, f.contractor.ID(), date(2027, 2, 1), date(2027, 2, 28))
+		assert.Nil(t, err)
+		assert.Empty(t, lines)
+	})
+	t.Run("PayrollComponent", func(t *testing.T) {
+		runs := porttest.NewPayrollRuns()
+		payroll := payroll_service.NewPayrollService(porttest.NewEmployees(f.married), runs, nil, clock.Fixed{At: date(2025, 4, 25)},
+			payroll_service.NewBenefitComponent(f.service),
+		)
+		run, err := payroll.CreateRun(ctx, payroll_entity.MonthlyPayPeriod(2025, time.April), "IDR")
+		assert.Nil(t, err)
+		run, err = payroll.Calculate(ctx, run.ID())
+		assert.Nil(t, err)
+
+		slip, _ := run.Payslip(f.married.ID())
+		assert.Equal(t, int64(8_000_000+600_000+800_000+3_500_000), slip.GrossPay())
+		assert.Equal(t, int64(75_000), slip.TotalDeductions())
+		health, _ := slip.Line("HEALTH")
+		assert.Equal(t, enum.PayLineEmployerContribution, health.Kind())
+	})
+}
+
+func codes(plans []benefit_entity.BenefitPlan) []string {
+	var out []string
+	for _, p := range plans {
+		out = append(out, p.Code())
+	}
+	return out
+}
@@ -0,0 +1,108 @@
+package calendar_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	calendar_entity "github.com/rfanazhari/hris/domain/entity/calendar"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"time"
+)
+
+// CalendarRequest describes the work calendar to build.
+//
+// Fields:
+//   - OrganizationUnitID: includes the unit's regional and company holidays, nil for company wide holidays only
+//   - From, To: the first and last year the calendar covers
+//   - WorkWeek: five or six day work week, defaults to five days
+//   - WorkOnCollectiveLeave: treat cuti bersama as working days
+type CalendarRequest struct {
+	OrganizationUnitID    *uuid.UUID
+	WorkWeek              enum.WorkWeek
+	From                  int
+	To                    int
+	WorkOnCollectiveLeave bool
+}
+
+// CalendarService builds work calendars from the national holiday data and the
+// regional and company holidays of organization units.
+type CalendarService struct {
+	national map[int][]calendar_entity.Holiday
+	holidays port.HolidayRepository
+}
+
+// NewCalendarService returns a CalendarService using the bundled national holiday data.
+// A nil repository disables regional and company holidays.
+func NewCalendarService(holidays port.HolidayRepository) (*CalendarService, error) {
+	national, err := ParseHolidayData(bundledHolidays)
+	if err != nil {
+		return nil, err
+	}
+	return &CalendarService{national: national, holidays: holidays}, nil
+}
+
+// LoadHolidayData adds or replaces national holiday years from a data file, e.g. when
+// the SKB for a new year is published before the bundled data is updated.
+func (s *CalendarService) LoadHolidayData(data []byte) error {
+	years, err := ParseHolidayData(data)
+	if err != nil {
+		return err
+	}
+	for year, holidays := range years {
+		s.national[year] = holidays
+	}
+	return nil
+}
+
+// NationalHolidays returns the national holidays and cuti bersama of the year, or
+// port.ErrNoHolidayData when the year is not covered by the data.
+func (s *CalendarService) NationalHolidays(year int) ([]calendar_entity.Holiday, error) {
+	holidays, ok := s.national[year]
+	if !ok {
+		return nil, fmt.Errorf("%w for year %d", port.ErrNoHolidayData, year)
+	}
+	out := make([]calendar_entity.Holiday, len(holidays))
+	copy(out, holidays)
+	return out, nil
+}
+
+// Calendar builds the work calendar of the organization unit for the years From to To.
+func (s *CalendarService) Calendar(ctx context.Context, req CalendarRequest) (*calendar_entity.WorkCalendar, error) {
+	if req.To < req.From {
+		return nil, errors.New("calendar end year cannot be before start year")
+	}
+	if req.WorkWeek == "" {
+		req.WorkWeek = enum.WorkWeekFiveDay
+	}
+
+	var holidays []calendar_entity.Holiday
+	for year := req.From; year <= req.To; year++ {
+		national, err := s.NationalHolidays(year)
+		if err != nil {
+			return nil, err
+		}
+		holidays = append(holidays, national...)
+	}
+
+	if s.holidays != nil {
+		from := time.Date(req.From, time.January, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(req.To, time.December, 31, 0, 0, 0, 0, time.UTC)
+		custom, err := s.holidays.ListByOrganizationUnit(ctx, req.OrganizationUnitID, from, to)
+		if err != nil {
+			return nil, fmt.Errorf("list holidays: %w", err)
+		}
+		for _, h := range custom {
+			if h.AppliesTo(req.OrganizationUnitID) {
+				holidays = append(holidays, h)
+			}
+		}
+	}
+
+	return calendar_entity.WorkCalendarFactory{
+		WorkWeek:              string(req.WorkWeek),
+		Holidays:              holidays,
+		WorkOnCollectiveLeave: req.WorkOnCollectiveLeave,
+	}.Create()
+}
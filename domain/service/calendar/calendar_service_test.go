@@ -0,0 +1,98 @@
+package calendar_service_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	calendar_entity "github.com/rfanazhari/hris/domain/entity/calendar"
+	"github.com/rfanazhari/hris/domain/enum"
+	calendar_service "github.com/rfanazhari/hris/domain/service/calendar"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type memoryHolidays struct {
+	holidays []calendar_entity.Holiday
+}
+
+func (m *memoryHolidays) Save(_ context.Context, holiday *calendar_entity.Holiday) error {
+	m.holidays = append(m.holidays, *holiday)
+	return nil
+}
+
+func (m *memoryHolidays) ListByOrganizationUnit(_ context.Context, unitID *uuid.UUID, from, to time.Time) ([]calendar_entity.Holiday, error) {
+	var out []calendar_entity.Holiday
+	for _, h := range m.holidays {
+		if h.AppliesTo(unitID) && !h.Date().Before(from) && !h.Date().After(to) {
+			out = append(out, h)
+		}
+	}
+	return out, nil
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCalendarService_BundledData(t *testing.T) {
+	service, err := calendar_service.NewCalendarService(nil)
+	assert.Nil(t, err)
+
+	for _, year := range []int{2024, 2025, 2026} {
+		holidays, err := service.NationalHolidays(year)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, holidays)
+		for _, h := range holidays {
+			assert.Equal(t, year, h.Date().Year())
+		}
+	}
+	_, err = service.NationalHolidays(1999)
+	assert.EqualError(t, err, "no holiday data for year 1999")
+}
+
+func TestCalendarService_Calendar(t *testing.T) {
+	ctx := context.Background()
+	bali := uuid.New()
+	jakarta := uuid.New()
+	galungan, _ := calendar_entity.HolidayFactory{Date: date(2025, 4, 23), Name: "Hari Raya Galungan", Type: "regional", OrganizationUnitID: bali.String()}.Create()
+	anniversary, _ := calendar_entity.HolidayFactory{Date: date(2025, 7, 1), Name: "Company anniversary", Type: "company"}.Create()
+	repo := &memoryHolidays{holidays: []calendar_entity.Holiday{*galungan, *anniversary}}
+	service, _ := calendar_service.NewCalendarService(repo)
+
+	t.Run("Lebaran2025", func(t *testing.T) {
+		cal, err := service.Calendar(ctx, calendar_service.CalendarRequest{OrganizationUnitID: &jakarta, From: 2025, To: 2025})
+		assert.Nil(t, err)
+		assert.Equal(t, enum.WorkWeekFiveDay, cal.WorkWeek())
+
+		// Cuti bersama Nyepi 28 Mar, Idul Fitri 31 Mar - 1 Apr and cuti bersama 2 - 7 Apr 2025.
+		assert.Equal(t, date(2025, 4, 8), cal.NextWorkingDay(date(2025, 3, 27)))
+		assert.Equal(t, 2, cal.WorkingDaysBetween(date(2025, 3, 27), date(2025, 4, 8)))
+		assert.False(t, cal.IsWorkingDay(date(2025, 7, 1)))
+		assert.True(t, cal.IsWorkingDay(date(2025, 4, 23)))
+	})
+	t.Run("RegionalHoliday", func(t *testing.T) {
+		cal, err := service.Calendar(ctx, calendar_service.CalendarRequest{OrganizationUnitID: &bali, WorkWeek: enum.WorkWeekSixDay, From: 2025, To: 2025})
+		assert.Nil(t, err)
+
+		assert.False(t, cal.IsWorkingDay(date(2025, 4, 23)))
+		assert.True(t, cal.IsWorkingDay(date(2025, 4, 26)))
+	})
+	t.Run("MissingYear", func(t *testing.T) {
+		_, err := service.Calendar(ctx, calendar_service.CalendarRequest{From: 2025, To: 2030})
+		assert.EqualError(t, err, "no holiday data for year 2027")
+	})
+	t.Run("LoadHolidayData", func(t *testing.T) {
+		s, _ := calendar_service.NewCalendarService(nil)
+		err := s.LoadHolidayData([]byte(`[{"year": 2030, "source": "test", "holidays": [{"date": "2030-01-01", "name": "Tahun Baru 2030 Masehi", "type": "national"}]}]`))
+		assert.Nil(t, err)
+
+		cal, err := s.Calendar(ctx, calendar_service.CalendarRequest{From: 2030, To: 2030})
+		assert.Nil(t, err)
+		assert.Equal(t, date(2030, 1, 2), cal.NextWorkingDay(date(2029, 12, 31)))
+
+		err = s.LoadHolidayData([]byte(`[{"year": 2030, "holidays": [{"date": "2030-02-01", "name": "Libur", "type": "company"}]}]`))
+		assert.EqualError(t, err, "holiday 2030-02-01: only national holidays and cuti bersama are allowed")
+		err = s.LoadHolidayData([]byte(`[{"year": 2030, "holidays": [{"date": "2031-01-01", "name": "Libur", "type": "national"}]}]`))
+		assert.EqualError(t, err, "holiday 2031-01-01 does not belong to year 2030")
+	})
+}
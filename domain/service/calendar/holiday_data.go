@@ -0,0 +1,61 @@
+package calendar_service
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	calendar_entity "github.com/rfanazhari/hris/domain/entity/calendar"
+	"github.com/rfanazhari/hris/domain/enum"
+	"time"
+)
+
+//go:embed data/holidays_id.json
+var bundledHolidays []byte
+
+// holidayYear is one year of the holiday data file.
+type holidayYear struct {
+	Year     int    `json:"year"`
+	Source   string `json:"source"`
+	Holidays []struct {
+		Date string `json:"date"`
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"holidays"`
+}
+
+// ParseHolidayData parses a holiday data file into national holidays and cuti bersama
+// keyed by year. The format is the one of the bundled data/holidays_id.json; only
+// national and collective_leave entries are allowed.
+func ParseHolidayData(data []byte) (map[int][]calendar_entity.Holiday, error) {
+	var years []holidayYear
+	if err := json.Unmarshal(data, &years); err != nil {
+		return nil, fmt.Errorf("parse holiday data: %w", err)
+	}
+
+	out := make(map[int][]calendar_entity.Holiday, len(years))
+	for _, y := range years {
+		if _, ok := out[y.Year]; ok {
+			return nil, fmt.Errorf("duplicate holiday data for year %d", y.Year)
+		}
+		holidays := make([]calendar_entity.Holiday, 0, len(y.Holidays))
+		for _, entry := range y.Holidays {
+			date, err := time.Parse(time.DateOnly, entry.Date)
+			if err != nil {
+				return nil, fmt.Errorf("invalid holiday date %q", entry.Date)
+			}
+			if date.Year() != y.Year {
+				return nil, fmt.Errorf("holiday %s does not belong to year %d", entry.Date, y.Year)
+			}
+			holiday, err := calendar_entity.HolidayFactory{Date: date, Name: entry.Name, Type: entry.Type}.Create()
+			if err != nil {
+				return nil, fmt.Errorf("holiday %s: %w", entry.Date, err)
+			}
+			if holiday.Type() != enum.HolidayNational && holiday.Type() != enum.HolidayCollectiveLeave {
+				return nil, fmt.Errorf("holiday %s: only national holidays and cuti bersama are allowed", entry.Date)
+			}
+			holidays = append(holidays, *holiday)
+		}
+		out[y.Year] = holidays
+	}
+	return out, nil
+}
@@ -0,0 +1,128 @@
+package compensation_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	compensation_entity "github.com/rfanazhari/hris/domain/entity/compensation"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"time"
+)
+
+// CurrencyConverter converts amounts between currencies at the rate effective at a time.
+type CurrencyConverter interface {
+	Convert(ctx context.Context, amount int64, from, to string, at time.Time) (int64, error)
+}
+
+// SalaryPosition is where an employee's salary sits within the band of their grade.
+//
+// Fields:
+//   - Salary: the base salary converted to the band currency
+//   - CompaRatio: salary divided by the band midpoint, in basis points
+//   - RangePenetration: position between band minimum (0) and maximum (10000), in basis points
+type SalaryPosition struct {
+	EmployeeID       uuid.UUID
+	GradeLevel       enum.GradeLevel
+	Salary           int64
+	Currency         string
+	Band             compensation_entity.PayBand
+	CompaRatio       int64
+	RangePenetration int64
+}
+
+// BelowBand reports whether the salary is below the band minimum.
+func (p SalaryPosition) BelowBand() bool {
+	return p.Salary < p.Band.Min()
+}
+
+// AboveBand reports whether the salary is above the band maximum.
+func (p SalaryPosition) AboveBand() bool {
+	return p.Salary > p.Band.Max()
+}
+
+// CompensationService maintains the grade based pay structure and positions employee
+// salaries within it.
+type CompensationService struct {
+	bands     port.PayBandRepository
+	employees port.EmployeeRepository
+	converter CurrencyConverter
+}
+
+// NewCompensationService returns a CompensationService. converter may be nil, in which
+// case salaries paid in another currency than their band cannot be positioned.
+func NewCompensationService(bands port.PayBandRepository, employees port.EmployeeRepository, converter CurrencyConverter) *CompensationService {
+	return &CompensationService{bands: bands, employees: employees, converter: converter}
+}
+
+// AddBand records a new pay band. A grade may have only one band per effective date.
+func (s *CompensationService) AddBand(ctx context.Context, f compensation_entity.PayBandFactory) (*compensation_entity.PayBand, error) {
+	band, err := f.Create()
+	if err != nil {
+		return nil, err
+	}
+	bands, err := s.bands.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list pay bands: %w", err)
+	}
+	if _, err := compensation_entity.NewPayStructure(append(bands, *band)...); err != nil {
+		return nil, err
+	}
+	if err := s.bands.Save(ctx, band); err != nil {
+		return nil, fmt.Errorf("save pay band: %w", err)
+	}
+	return band, nil
+}
+
+// Structure returns the pay structure of all recorded bands, e.g. to validate job
+// positions through JobPositionFactory.PayStructure.
+func (s *CompensationService) Structure(ctx context.Context) (*compensation_entity.PayStructure, error) {
+	bands, err := s.bands.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list pay bands: %w", err)
+	}
+	return compensation_entity.NewPayStructure(bands...)
+}
+
+// SalaryPosition computes the compa-ratio and range penetration of the employee's base
+// salary at the given time against the band of the grade in effect then.
+func (s *CompensationService) SalaryPosition(ctx context.Context, employeeID uuid.UUID, grade enum.GradeLevel, at time.Time) (*SalaryPosition, error) {
+	structure, err := s.Structure(ctx)
+	if err != nil {
+		return nil, err
+	}
+	band, ok := structure.Band(grade, at)
+	if !ok {
+		return nil, fmt.Errorf("no pay band for grade %s", grade)
+	}
+
+	employee, err := s.employees.FindByID(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("find employee: %w", err)
+	}
+	record, ok := employee.SalaryAt(at)
+	if !ok {
+		return nil, errors.New("employee has no salary at the given date")
+	}
+
+	salary := record.Amount()
+	if record.Currency() != band.Currency() {
+		if s.converter == nil {
+			return nil, fmt.Errorf("salary currency %s does not match pay band currency %s", record.Currency(), band.Currency())
+		}
+		if salary, err = s.converter.Convert(ctx, salary, record.Currency(), band.Currency(), at); err != nil {
+			return nil, err
+		}
+	}
+
+	return &SalaryPosition{
+		EmployeeID:       employeeID,
+		GradeLevel:       grade,
+		Salary:           salary,
+		Currency:         band.Currency(),
+		Band:             *band,
+		CompaRatio:       band.CompaRatio(salary),
+		RangePenetration: band.RangePenetration(salary),
+	}, nil
+}
@@ -0,0 +1,98 @@
+package compensation_service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	compensation_entity "github.com/rfanazhari/hris/domain/entity/compensation"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	compensation_service "github.com/rfanazhari/hris/domain/service/compensation"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type memoryBands struct {
+	bands []compensation_entity.PayBand
+}
+
+func (m *memoryBands) Save(_ context.Context, band *compensation_entity.PayBand) error {
+	m.bands = append(m.bands, *band)
+	return nil
+}
+
+func (m *memoryBands) List(context.Context) ([]compensation_entity.PayBand, error) {
+	return append([]compensation_entity.PayBand{}, m.bands...), nil
+}
+
+// fixedRate converts USD to IDR at 16.000.
+type fixedRate struct{}
+
+func (fixedRate) Convert(_ context.Context, amount int64, from, to string, _ time.Time) (int64, error) {
+	if from == "USD" && to == "IDR" {
+		return amount * 16_000, nil
+	}
+	return 0, errors.New("no exchange rate")
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newEmployee(t *testing.T, salary int64, currency string) *employee_entity.Employee {
+	return porttest.NewEmployee(t, porttest.Hire{Salary: salary, Currency: currency, SalaryFrom: date(2024, 1, 1)})
+}
+
+func TestCompensationService(t *testing.T) {
+	ctx := context.Background()
+	local := newEmployee(t, 9_000_000, "IDR")
+	expat := newEmployee(t, 1_000, "USD")
+	employees := porttest.NewEmployees(local, expat)
+	bands := &memoryBands{}
+	service := compensation_service.NewCompensationService(bands, employees, fixedRate{})
+
+	_, err := service.AddBand(ctx, compensation_entity.PayBandFactory{ID: uuid.NewString(), GradeLevel: "mid", Min: 8_000_000, Midpoint: 10_000_000, Max: 12_000_000, EffectiveDate: date(2024, 1, 1)})
+	assert.Nil(t, err)
+	_, err = service.AddBand(ctx, compensation_entity.PayBandFactory{ID: uuid.NewString(), GradeLevel: "mid", Min: 8_500_000, Midpoint: 10_500_000, Max: 12_500_000, EffectiveDate: date(2025, 1, 1)})
+	assert.Nil(t, err)
+
+	t.Run("DuplicateBand", func(t *testing.T) {
+		_, err := service.AddBand(ctx, compensation_entity.PayBandFactory{ID: uuid.NewString(), GradeLevel: "mid", Min: 1, Midpoint: 1, Max: 1, EffectiveDate: date(2025, 1, 1)})
+		assert.EqualError(t, err, "duplicate mid pay band effective 2025-01-01")
+		assert.Len(t, bands.bands, 2)
+	})
+	t.Run("SalaryPosition", func(t *testing.T) {
+		position, err := service.SalaryPosition(ctx, local.ID(), enum.GradeMid, date(2024, 6, 1))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(9_000), position.CompaRatio)
+		assert.Equal(t, int64(2_500), position.RangePenetration)
+		assert.False(t, position.BelowBand())
+
+		position, err = service.SalaryPosition(ctx, local.ID(), enum.GradeMid, date(2025, 6, 1))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(8_571), position.CompaRatio)
+		assert.Equal(t, int64(1_250), position.RangePenetration)
+	})
+	t.Run("ConvertedSalary", func(t *testing.T) {
+		position, err := service.SalaryPosition(ctx, expat.ID(), enum.GradeMid, date(2025, 6, 1))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(16_000_000), position.Salary)
+		assert.Equal(t, "IDR", position.Currency)
+		assert.True(t, position.AboveBand())
+		assert.Equal(t, int64(18_750), position.RangePenetration)
+
+		withoutConverter := compensation_service.NewCompensationService(bands, employees, nil)
+		_, err = withoutConverter.SalaryPosition(ctx, expat.ID(), enum.GradeMid, date(2025, 6, 1))
+		assert.EqualError(t, err, "salary currency USD does not match pay band currency IDR")
+	})
+	t.Run("MissingData", func(t *testing.T) {
+		_, err := service.SalaryPosition(ctx, local.ID(), enum.GradeDirector, date(2025, 6, 1))
+		assert.EqualError(t, err, "no pay band for grade director")
+		_, err = service.SalaryPosition(ctx, local.ID(), enum.GradeMid, date(2024, 1, 1).AddDate(0, 0, -1))
+		assert.EqualError(t, err, "no pay band for grade mid")
+		_, err = service.SalaryPosition(ctx, uuid.New(), enum.GradeMid, date(2025, 6, 1))
+		assert.EqualError(t, err, "find employee: employee not found")
+	})
+}
@@ -0,0 +1,227 @@
+package currency_service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"io"
+	"strings"
+	"time"
+)
+
+// ReportingCurrency is the currency reports are presented in and the pivot for cross
+// rates between two foreign currencies.
+const ReportingCurrency = "IDR"
+
+// csvHeader is the expected header of exchange rate imports.
+var csvHeader = []string{"effective_date", "base", "quote", "rate"}
+
+// PayrollTotals are the totals of one or more payroll runs in a single currency.
+type PayrollTotals struct {
+	Currency     string
+	Runs         int
+	GrossPay     int64
+	NetPay       int64
+	EmployerCost int64
+}
+
+// CurrencyService maintains exchange rates and converts salaries and payroll amounts
+// between currencies.
+type CurrencyService struct {
+	rates port.ExchangeRateRepository
+}
+
+// NewCurrencyService returns a CurrencyService.
+func NewCurrencyService(rates port.ExchangeRateRepository) *CurrencyService {
+	return &CurrencyService{rates: rates}
+}
+
+// AddRate records a manually entered rate from base to quote, effective from the given date.
+func (s *CurrencyService) AddRate(ctx context.Context, base, quote, rate string, effectiveDate time.Time) (*valueobject.ExchangeRate, error) {
+	r, err := valueobject.NewExchangeRate(base, quote, rate, effectiveDate)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.rates.Save(ctx, *r); err != nil {
+		return nil, fmt.Errorf("save exchange rate: %w", err)
+	}
+	return r, nil
+}
+
+// ImportCSV records the rates of a CSV file with the header
+// "effective_date,base,quote,rate", dates written as 2006-01-02. The whole file is
+// validated before any rate is saved; it returns the number of rates saved.
+func (s *CurrencyService) ImportCSV(ctx context.Context, r io.Reader) (int, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(csvHeader)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return 0, fmt.Errorf("read header: %w", err)
+	}
+	for i, h := range header {
+		if strings.ToLower(strings.TrimSpace(h)) != csvHeader[i] {
+			return 0, fmt.Errorf("invalid header: expected %s", strings.Join(csvHeader, ","))
+		}
+	}
+
+	var rates []valueobject.ExchangeRate
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("line %d: %w", line, err)
+		}
+		date, err := time.Parse(time.DateOnly, strings.TrimSpace(record[0]))
+		if err != nil {
+			return 0, fmt.Errorf("line %d: invalid effective date: %q", line, record[0])
+		}
+		rate, err := valueobject.NewExchangeRate(record[1], record[2], record[3], date)
+		if err != nil {
+			return 0, fmt.Errorf("line %d: %w", line, err)
+		}
+		rates = append(rates, *rate)
+	}
+
+	for _, rate := range rates {
+		if err := s.rates.Save(ctx, rate); err != nil {
+			return 0, fmt.Errorf("save exchange rate: %w", err)
+		}
+	}
+	return len(rates), nil
+}
+
+// Rate returns the rate from base to quote effective at the given time. When no rate of
+// the pair is recorded it falls back to the inverse rate, then to a cross rate through
+// the reporting currency.
+func (s *CurrencyService) Rate(ctx context.Context, base, quote string, at time.Time) (*valueobject.ExchangeRate, error) {
+	b, err := valueobject.ParseCurrency(base)
+	if err != nil {
+		return nil, err
+	}
+	q, err := valueobject.ParseCurrency(quote)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := s.direct(ctx, b.Code(), q.Code(), at)
+	if err != nil || rate != nil {
+		return rate, err
+	}
+	if b.Code() != ReportingCurrency && q.Code() != ReportingCurrency {
+		toReporting, err := s.direct(ctx, b.Code(), ReportingCurrency, at)
+		if err != nil {
+			return nil, err
+		}
+		fromReporting, err := s.direct(ctx, ReportingCurrency, q.Code(), at)
+		if err != nil {
+			return nil, err
+		}
+		if toReporting != nil && fromReporting != nil {
+			return toReporting.Cross(*fromReporting)
+		}
+	}
+	return nil, fmt.Errorf("no exchange rate from %s to %s on %s", b, q, at.Format(time.DateOnly))
+}
+
+// Convert converts an amount in whole units between currencies at the rate effective at
+// the given time.
+func (s *CurrencyService) Convert(ctx context.Context, amount int64, from, to string, at time.Time) (int64, error) {
+	if strings.EqualFold(strings.TrimSpace(from), strings.TrimSpace(to)) {
+		return amount, nil
+	}
+	rate, err := s.Rate(ctx, from, to, at)
+	if err != nil {
+		return 0, err
+	}
+	return rate.Convert(amount), nil
+}
+
+// ConvertSalaryRange returns the salary range expressed in another currency.
+func (s *CurrencyService) ConvertSalaryRange(ctx context.Context, salaryRange valueobject.SalaryRange, to string, at time.Time) (*valueobject.SalaryRange, error) {
+	if strings.EqualFold(salaryRange.Currency, strings.TrimSpace(to)) {
+		return valueobject.NewSalaryRange(salaryRange.Min, salaryRange.Max, salaryRange.Currency)
+	}
+	rate, err := s.Rate(ctx, salaryRange.Currency, to, at)
+	if err != nil {
+		return nil, err
+	}
+	return salaryRange.Convert(*rate)
+}
+
+// CompareToRange compares a salary with a salary range in another currency, converting the
+// salary to the range's currency. It returns -1 below the range, 0 within and 1 above.
+func (s *CurrencyService) CompareToRange(ctx context.Context, salaryRange valueobject.SalaryRange, amount int64, currency string, at time.Time) (int, error) {
+	converted, err := s.Convert(ctx, amount, currency, salaryRange.Currency, at)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case converted < salaryRange.Min:
+		return -1, nil
+	case converted > salaryRange.Max:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// PayrollTotals sums the gross pay, net pay and employer cost of payroll runs in the given
+// currency. The amounts of each run are converted at the rate effective at the end of its
+// pay period.
+func (s *CurrencyService) PayrollTotals(ctx context.Context, currency string, runs ...payroll_entity.PayrollRun) (*PayrollTotals, error) {
+	c, err := valueobject.ParseCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+	totals := &PayrollTotals{Currency: c.Code(), Runs: len(runs)}
+	for _, run := range runs {
+		var employer int64
+		for _, slip := range run.Payslips() {
+			for _, line := range slip.EmployerContributions() {
+				employer += line.Amount()
+			}
+		}
+		at := run.Period().End()
+		for _, part := range []struct {
+			amount int64
+			total  *int64
+		}{
+			{run.TotalGrossPay(), &totals.GrossPay},
+			{run.TotalNetPay(), &totals.NetPay},
+			{run.TotalGrossPay() + employer, &totals.EmployerCost},
+		} {
+			converted, err := s.Convert(ctx, part.amount, run.Currency(), c.Code(), at)
+			if err != nil {
+				return nil, err
+			}
+			*part.total += converted
+		}
+	}
+	return totals, nil
+}
+
+// direct returns the recorded rate of the pair or the inverse of the recorded rate of the
+// opposite pair, whichever is effective later, or nil if neither exists.
+func (s *CurrencyService) direct(ctx context.Context, base, quote string, at time.Time) (*valueobject.ExchangeRate, error) {
+	rate, err := s.rates.FindEffective(ctx, base, quote, at)
+	if err != nil {
+		return nil, fmt.Errorf("find exchange rate: %w", err)
+	}
+	opposite, err := s.rates.FindEffective(ctx, quote, base, at)
+	if err != nil {
+		return nil, fmt.Errorf("find exchange rate: %w", err)
+	}
+	if opposite != nil && (rate == nil || opposite.EffectiveDate().After(rate.EffectiveDate())) {
+		inverse := opposite.Inverse()
+		return &inverse, nil
+	}
+	return rate, nil
+}
@@ -0,0 +1,145 @@
+package currency_service_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	currency_service "github.com/rfanazhari/hris/domain/service/currency"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+type memoryRates struct {
+	rates []valueobject.ExchangeRate
+}
+
+func (m *memoryRates) Save(_ context.Context, rate valueobject.ExchangeRate) error {
+	for i, r := range m.rates {
+		if r.Base() == rate.Base() && r.Quote() == rate.Quote() && r.EffectiveDate().Equal(rate.EffectiveDate()) {
+			m.rates[i] = rate
+			return nil
+		}
+	}
+	m.rates = append(m.rates, rate)
+	return nil
+}
+
+func (m *memoryRates) FindEffective(_ context.Context, base, quote string, at time.Time) (*valueobject.ExchangeRate, error) {
+	var found *valueobject.ExchangeRate
+	for i, r := range m.rates {
+		if r.Base().Code() == base && r.Quote().Code() == quote && !r.EffectiveDate().After(at) &&
+			(found == nil || r.EffectiveDate().After(found.EffectiveDate())) {
+			found = &m.rates[i]
+		}
+	}
+	return found, nil
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCurrencyService_Rates(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ImportCSV", func(t *testing.T) {
+		repo := &memoryRates{}
+		service := currency_service.NewCurrencyService(repo)
+
+		n, err := service.ImportCSV(ctx, strings.NewReader("effective_date,base,quote,rate\n2025-04-01,USD,IDR,16250.5\n2025-05-01, usd, idr, 16400\n2025-04-01,SGD,IDR,12100\n"))
+		assert.Nil(t, err)
+		assert.Equal(t, 3, n)
+
+		rate, err := service.Rate(ctx, "USD", "IDR", date(2025, 4, 30))
+		assert.Nil(t, err)
+		assert.Equal(t, "16250.5", rate.Rate())
+		rate, _ = service.Rate(ctx, "USD", "IDR", date(2025, 5, 1))
+		assert.Equal(t, "16400", rate.Rate())
+		_, err = service.Rate(ctx, "USD", "IDR", date(2025, 3, 31))
+		assert.EqualError(t, err, "no exchange rate from USD to IDR on 2025-03-31")
+	})
+	t.Run("InvalidCSV", func(t *testing.T) {
+		repo := &memoryRates{}
+		service := currency_service.NewCurrencyService(repo)
+
+		_, err := service.ImportCSV(ctx, strings.NewReader("date,from,to,rate\n"))
+		assert.EqualError(t, err, "invalid header: expected effective_date,base,quote,rate")
+		_, err = service.ImportCSV(ctx, strings.NewReader("effective_date,base,quote,rate\n2025-04-01,USD,IDR,16250\n01/04/2025,SGD,IDR,12100\n"))
+		assert.EqualError(t, err, `line 3: invalid effective date: "01/04/2025"`)
+		_, err = service.ImportCSV(ctx, strings.NewReader("effective_date,base,quote,rate\n2025-04-01,USD,XYZ,1\n"))
+		assert.EqualError(t, err, "line 2: unsupported currency: XYZ")
+		assert.Empty(t, repo.rates)
+	})
+	t.Run("InverseAndCross", func(t *testing.T) {
+		service := currency_service.NewCurrencyService(&memoryRates{})
+		_, _ = service.AddRate(ctx, "USD", "IDR", "16000", date(2025, 4, 1))
+		_, _ = service.AddRate(ctx, "SGD", "IDR", "12000", date(2025, 4, 1))
+		_, err := service.AddRate(ctx, "USD", "IDR", "0", date(2025, 4, 1))
+		assert.EqualError(t, err, "exchange rate must be positive")
+
+		amount, err := service.Convert(ctx, 32_000_000, "IDR", "USD", date(2025, 4, 15))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2_000), amount)
+
+		amount, err = service.Convert(ctx, 3_000, "USD", "SGD", date(2025, 4, 15))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(4_000), amount)
+
+		amount, _ = service.Convert(ctx, 1_000, "eur", "EUR", date(2025, 4, 15))
+		assert.Equal(t, int64(1_000), amount)
+		_, err = service.Convert(ctx, 1_000, "EUR", "USD", date(2025, 4, 15))
+		assert.EqualError(t, err, "no exchange rate from EUR to USD on 2025-04-15")
+	})
+}
+
+func TestCurrencyService_SalaryAndPayroll(t *testing.T) {
+	ctx := context.Background()
+	service := currency_service.NewCurrencyService(&memoryRates{})
+	_, _ = service.AddRate(ctx, "USD", "IDR", "16000", date(2025, 4, 1))
+	_, _ = service.AddRate(ctx, "USD", "IDR", "16500", date(2025, 5, 1))
+	salaryRange, _ := valueobject.NewSalaryRange(60_000_000, 90_000_000, "IDR")
+
+	t.Run("SalaryRange", func(t *testing.T) {
+		usd, err := service.ConvertSalaryRange(ctx, *salaryRange, "USD", date(2025, 4, 30))
+		assert.Nil(t, err)
+		assert.Equal(t, valueobject.SalaryRange{Min: 3_750, Max: 5_625, Currency: "USD"}, *usd)
+
+		position, err := service.CompareToRange(ctx, *salaryRange, 5_000, "USD", date(2025, 4, 30))
+		assert.Nil(t, err)
+		assert.Equal(t, 0, position)
+		position, _ = service.CompareToRange(ctx, *salaryRange, 5_500, "USD", date(2025, 5, 31))
+		assert.Equal(t, 1, position)
+		position, _ = service.CompareToRange(ctx, *salaryRange, 50_000_000, "IDR", date(2025, 5, 31))
+		assert.Equal(t, -1, position)
+	})
+	t.Run("PayrollTotals", func(t *testing.T) {
+		idrRun := calculatedRun(t, 4, "IDR", 32_000_000)
+		usdRun := calculatedRun(t, 5, "USD", 2_000)
+
+		totals, err := service.PayrollTotals(ctx, "idr", *idrRun, *usdRun)
+		assert.Nil(t, err)
+		assert.Equal(t, currency_service.PayrollTotals{
+			Currency: "IDR", Runs: 2,
+			GrossPay: 32_000_000 + 33_000_000, NetPay: 32_000_000 + 33_000_000, EmployerCost: 65_000_000,
+		}, *totals)
+
+		_, err = service.PayrollTotals(ctx, "EUR", *usdRun)
+		assert.EqualError(t, err, "no exchange rate from USD to EUR on 2025-05-31")
+	})
+}
+
+func calculatedRun(t *testing.T, month time.Month, currency string, basic int64) *payroll_entity.PayrollRun {
+	period := payroll_entity.MonthlyPayPeriod(2025, month)
+	run, err := payroll_entity.PayrollRunFactory{ID: uuid.NewString(), PeriodStart: period.Start(), PeriodEnd: period.End(), Currency: currency}.Create()
+	assert.Nil(t, err)
+	draft, _ := payroll_entity.NewPayslipDraft(uuid.New(), period, currency, basic, 30, 30)
+	line, _ := payroll_entity.NewPayslipLine(payroll_entity.LineBasic, "Gaji Pokok", enum.PayLineEarning, basic, true)
+	_ = draft.AddLine(*line)
+	slip, _ := draft.Finalize(uuid.New(), run.ID(), time.Time{})
+	assert.Nil(t, run.RecordCalculation([]payroll_entity.Payslip{*slip}, time.Time{}))
+	return run
+}
@@ -0,0 +1,182 @@
+package dependent_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	dependent_entity "github.com/rfanazhari/hris/domain/entity/dependent"
+	tax_entity "github.com/rfanazhari/hris/domain/entity/tax"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"time"
+)
+
+// DependentService keeps the register of employees' dependents and answers who counts
+// towards PTKP and who is covered by BPJS Kesehatan. It implements
+// payroll_service.TaxDependentSource.
+type DependentService struct {
+	dependents port.DependentRepository
+	employees  port.EmployeeRepository
+	clock      clock.Clock
+}
+
+// NewDependentService returns a DependentService. A nil clock falls back to the system
+// clock.
+func NewDependentService(dependents port.DependentRepository, employees port.EmployeeRepository, clk clock.Clock) *DependentService {
+	if clk == nil {
+		clk = clock.System{}
+	}
+	return &DependentService{dependents: dependents, employees: employees, clock: clk}
+}
+
+// Register adds a dependent to an employee. The NIK must be unique among the employee's
+// current dependents and BPJS Kesehatan coverage must stay within one membership.
+func (s *DependentService) Register(ctx context.Context, f dependent_entity.DependentFactory) (*dependent_entity.Dependent, error) {
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = s.clock.Now()
+	}
+	dependent, err := f.Create()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.employees.FindByID(ctx, dependent.EmployeeID()); err != nil {
+		return nil, fmt.Errorf("find employee: %w", err)
+	}
+	others, err := s.others(ctx, dependent)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.check(dependent, others); err != nil {
+		return nil, err
+	}
+	if err := s.dependents.Save(ctx, dependent); err != nil {
+		return nil, fmt.Errorf("save dependent: %w", err)
+	}
+	return dependent, nil
+}
+
+// SetStudent records whether the dependent is in formal education.
+func (s *DependentService) SetStudent(ctx context.Context, id uuid.UUID, student bool) (*dependent_entity.Dependent, error) {
+	return s.update(ctx, id, func(dependent *dependent_entity.Dependent) error {
+		return dependent.SetStudent(student, s.clock.Now())
+	})
+}
+
+// SetPTKP records whether the employee claims the dependent for PTKP.
+func (s *DependentService) SetPTKP(ctx context.Context, id uuid.UUID, claimed bool) (*dependent_entity.Dependent, error) {
+	return s.update(ctx, id, func(dependent *dependent_entity.Dependent) error {
+		return dependent.SetPTKP(claimed, s.clock.Now())
+	})
+}
+
+// SetBPJS records whether the dependent is registered on the employee's BPJS Kesehatan.
+func (s *DependentService) SetBPJS(ctx context.Context, id uuid.UUID, covered bool) (*dependent_entity.Dependent, error) {
+	return s.update(ctx, id, func(dependent *dependent_entity.Dependent) error {
+		return dependent.SetBPJS(covered, s.clock.Now())
+	})
+}
+
+// SetNIK records the dependent's NIK.
+func (s *DependentService) SetNIK(ctx context.Context, id uuid.UUID, nik string) (*dependent_entity.Dependent, error) {
+	value, err := valueobject.NewNIK(nik)
+	if err != nil {
+		return nil, err
+	}
+	return s.update(ctx, id, func(dependent *dependent_entity.Dependent) error {
+		return dependent.SetNIK(*value, s.clock.Now())
+	})
+}
+
+// End records the first day the person is no longer a dependent.
+func (s *DependentService) End(ctx context.Context, id uuid.UUID, date time.Time) (*dependent_entity.Dependent, error) {
+	return s.update(ctx, id, func(dependent *dependent_entity.Dependent) error {
+		return dependent.End(date, s.clock.Now())
+	})
+}
+
+// PTKPDependents implements payroll_service.TaxDependentSource. The PTKP status follows
+// the family situation at the start of the tax year (UU PPh Pasal 7 ayat (2)), so the
+// dependents are counted on 1 January of the year of at, at most
+// tax_entity.MaxPTKPDependents.
+func (s *DependentService) PTKPDependents(ctx context.Context, employeeID uuid.UUID, at time.Time) (int, error) {
+	dependents, err := s.dependents.ListByEmployee(ctx, employeeID)
+	if err != nil {
+		return 0, fmt.Errorf("list dependents: %w", err)
+	}
+	yearStart := time.Date(at.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	count := 0
+	for i := range dependents {
+		if dependents[i].CountsForPTKP(yearStart) {
+			count++
+		}
+	}
+	return min(count, tax_entity.MaxPTKPDependents), nil
+}
+
+// BPJSMembers returns the dependents covered by the employee's BPJS Kesehatan on at.
+func (s *DependentService) BPJSMembers(ctx context.Context, employeeID uuid.UUID, at time.Time) ([]dependent_entity.Dependent, error) {
+	dependents, err := s.dependents.ListByEmployee(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("list dependents: %w", err)
+	}
+	var out []dependent_entity.Dependent
+	for _, d := range dependents {
+		if d.CoveredByBPJS(at) {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+func (s *DependentService) update(ctx context.Context, id uuid.UUID, change func(*dependent_entity.Dependent) error) (*dependent_entity.Dependent, error) {
+	found, err := s.dependents.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find dependent: %w", err)
+	}
+	// Change a copy so a change rejected by the checks below leaves the dependent as it was.
+	dependent := new(dependent_entity.Dependent)
+	*dependent = *found
+	if err := change(dependent); err != nil {
+		return nil, err
+	}
+	others, err := s.others(ctx, dependent)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.check(dependent, others); err != nil {
+		return nil, err
+	}
+	if err := s.dependents.Save(ctx, dependent); err != nil {
+		return nil, fmt.Errorf("save dependent: %w", err)
+	}
+	return dependent, nil
+}
+
+// others returns the employee's other dependents.
+func (s *DependentService) others(ctx context.Context, dependent *dependent_entity.Dependent) ([]dependent_entity.Dependent, error) {
+	all, err := s.dependents.ListByEmployee(ctx, dependent.EmployeeID())
+	if err != nil {
+		return nil, fmt.Errorf("list dependents: %w", err)
+	}
+	var out []dependent_entity.Dependent
+	for _, d := range all {
+		if d.ID() != dependent.ID() {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+func (s *DependentService) check(dependent *dependent_entity.Dependent, others []dependent_entity.Dependent) error {
+	now := s.clock.Now()
+	if nik := dependent.NIK(); nik != nil && dependent.EndDate() == nil {
+		for _, d := range others {
+			if d.EndDate() == nil && d.NIK() != nil && *d.NIK() == *nik {
+				return errors.New("dependent with this nik is already registered")
+			}
+		}
+	}
+	return dependent_entity.CheckBPJSCoverage(append(others, *dependent), now)
+}
@@ -0,0 +1,147 @@
+package dependent_service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	dependent_entity "github.com/rfanazhari/hris/domain/entity/dependent"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	dependent_service "github.com/rfanazhari/hris/domain/service/dependent"
+	payroll_service "github.com/rfanazhari/hris/domain/service/payroll"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var _ payroll_service.TaxDependentSource = (*dependent_service.DependentService)(nil)
+
+type memoryDependents struct {
+	dependents []*dependent_entity.Dependent
+}
+
+func (m *memoryDependents) Save(_ context.Context, dependent *dependent_entity.Dependent) error {
+	for i, d := range m.dependents {
+		if d.ID() == dependent.ID() {
+			m.dependents[i] = dependent
+			return nil
+		}
+	}
+	m.dependents = append(m.dependents, dependent)
+	return nil
+}
+
+func (m *memoryDependents) FindByID(_ context.Context, id uuid.UUID) (*dependent_entity.Dependent, error) {
+	for _, d := range m.dependents {
+		if d.ID() == id {
+			return d, nil
+		}
+	}
+	return nil, errors.New("dependent not found")
+}
+
+func (m *memoryDependents) ListByEmployee(_ context.Context, employeeID uuid.UUID) ([]dependent_entity.Dependent, error) {
+	var out []dependent_entity.Dependent
+	for _, d := range m.dependents {
+		if d.EmployeeID() == employeeID {
+			out = append(out, *d)
+		}
+	}
+	return out, nil
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+type fixture struct {
+	service  *dependent_service.DependentService
+	employee *employee_entity.Employee
+	clock    *clock.Fixed
+}
+
+func newFixture(t *testing.T) fixture {
+	employee := porttest.NewEmployee(t, porttest.Hire{Gender: "M", MaritalStatus: "married"})
+	clk := &clock.Fixed{At: date(2025, 2, 1)}
+	service := dependent_service.NewDependentService(&memoryDependents{}, porttest.NewEmployees(employee), clk)
+	return fixture{service: service, employee: employee, clock: clk}
+}
+
+func (f fixture) register(t *testing.T, relationship string, birthDate time.Time, nik string, ptkp, bpjs bool) (*dependent_entity.Dependent, error) {
+	return f.service.Register(context.Background(), dependent_entity.DependentFactory{
+		ID:           uuid.NewString(),
+		EmployeeID:   f.employee.ID().String(),
+		Name:         "Anak " + relationship,
+		Relationship: relationship,
+		BirthDate:    birthDate,
+		NIK:          nik,
+		PTKP:         ptkp,
+		BPJS:         bpjs,
+	})
+}
+
+func TestDependentService_PTKPDependents(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	_, err := f.register(t, "wife", date(1990, 1, 1), "", false, true)
+	assert.Nil(t, err)
+	eldest, _ := f.register(t, "son", date(2004, 6, 1), "", true, true)
+	_, _ = f.register(t, "daughter", date(2010, 1, 1), "", true, true)
+	_, _ = f.register(t, "mother", date(1960, 1, 1), "", true, false)
+
+	n, err := f.service.PTKPDependents(ctx, f.employee.ID(), date(2025, 5, 31))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, n)
+
+	// A fourth dependent is registered but only three count.
+	_, _ = f.register(t, "father", date(1958, 1, 1), "", true, false)
+	n, _ = f.service.PTKPDependents(ctx, f.employee.ID(), date(2025, 5, 31))
+	assert.Equal(t, 3, n)
+
+	// The eldest turns 21 in June 2025; the status follows 1 January, so he still counts
+	// in 2025 but no longer in 2026.
+	_, _ = f.service.End(ctx, eldest.ID(), date(2025, 8, 1))
+	n, _ = f.service.PTKPDependents(ctx, f.employee.ID(), date(2026, 1, 31))
+	assert.Equal(t, 3, n)
+	f.clock.At = date(2025, 4, 1)
+	_, err = f.register(t, "son", date(2025, 3, 1), "", true, false)
+	assert.Nil(t, err)
+	n, _ = f.service.PTKPDependents(ctx, f.employee.ID(), date(2025, 12, 31))
+	assert.Equal(t, 3, n)
+}
+
+func TestDependentService_BPJS(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	for _, born := range []time.Time{date(2012, 1, 1), date(2014, 1, 1), date(2016, 1, 1)} {
+		_, err := f.register(t, "son", born, "", true, true)
+		assert.Nil(t, err)
+	}
+	wife, err := f.register(t, "wife", date(1990, 1, 1), "3273014101900001", false, true)
+	assert.Nil(t, err)
+
+	_, err = f.register(t, "daughter", date(2020, 1, 1), "", true, true)
+	assert.EqualError(t, err, "bpjs kesehatan covers at most 3 children")
+	baby, err := f.register(t, "daughter", date(2020, 1, 1), "", true, false)
+	assert.Nil(t, err)
+	_, err = f.service.SetBPJS(ctx, baby.ID(), true)
+	assert.EqualError(t, err, "bpjs kesehatan covers at most 3 children")
+
+	_, err = f.register(t, "wife", date(1992, 1, 1), "3273014101900001", false, false)
+	assert.EqualError(t, err, "dependent with this nik is already registered")
+
+	members, err := f.service.BPJSMembers(ctx, f.employee.ID(), f.clock.Now())
+	assert.Nil(t, err)
+	assert.Len(t, members, 4)
+
+	_, err = f.service.End(ctx, wife.ID(), date(2025, 3, 1))
+	assert.Nil(t, err)
+	members, _ = f.service.BPJSMembers(ctx, f.employee.ID(), date(2025, 3, 1))
+	assert.Len(t, members, 3)
+
+	_, err = f.service.Register(ctx, dependent_entity.DependentFactory{
+		ID: uuid.NewString(), EmployeeID: uuid.NewString(), Name: "Siti", Relationship: "wife", BirthDate: date(1990, 1, 1),
+	})
+	assert.EqualError(t, err, "find employee: employee not found")
+}
@@ -0,0 +1,54 @@
+package disbursement_service
+
+import (
+	"errors"
+	disbursement_entity "github.com/rfanazhari/hris/domain/entity/disbursement"
+	"github.com/rfanazhari/hris/domain/enum"
+	"io"
+	"strings"
+)
+
+// BCAExporter writes the fixed-width payroll file uploaded to KlikBCA Bisnis. Records are
+// separated by CRLF and amounts carry two implied decimals:
+//
+//	header   "0" company code(10) value date DDMMYYYY(8) debit account(10) currency(3) reference(20)
+//	detail   "1" account(10) amount(17) holder name(35) description(18)
+//	trailer  "9" transfer count(6) total amount(17)
+type BCAExporter struct {
+	CompanyCode string
+}
+
+// Export implements Exporter.
+func (e BCAExporter) Export(w io.Writer, batch disbursement_entity.TransferBatch) error {
+	if strings.TrimSpace(e.CompanyCode) == "" {
+		return errors.New("bca company code cannot be empty")
+	}
+	if err := checkBank(batch, enum.BankBCA); err != nil {
+		return err
+	}
+
+	debit := batch.DebitAccount()
+	records := []string{
+		"0" + text(e.CompanyCode, 10) + batch.ValueDate().Format("02012006") + debit.Number() + batch.Currency() + text(batch.Reference(), 20),
+	}
+	for _, t := range batch.Transfers() {
+		amount, err := number(t.Amount()*100, 17)
+		if err != nil {
+			return err
+		}
+		account := t.Account()
+		records = append(records, "1"+account.Number()+amount+text(account.HolderName(), 35)+text(t.Description(), 18))
+	}
+	count, err := number(int64(batch.Count()), 6)
+	if err != nil {
+		return err
+	}
+	total, err := number(batch.Total()*100, 17)
+	if err != nil {
+		return err
+	}
+	records = append(records, "9"+count+total)
+
+	_, err = io.WriteString(w, strings.Join(records, "\r\n")+"\r\n")
+	return err
+}
@@ -0,0 +1,36 @@
+package disbursement_service
+
+import (
+	"encoding/csv"
+	disbursement_entity "github.com/rfanazhari/hris/domain/entity/disbursement"
+	"github.com/rfanazhari/hris/domain/enum"
+	"io"
+	"strconv"
+)
+
+// BNIExporter writes the semicolon separated bulk payroll file uploaded to BNIDirect.
+// Amounts are whole rupiah:
+//
+//	H;reference;value date DD/MM/YYYY;debit account;currency
+//	D;account;holder name;amount;description
+//	T;transfer count;total amount
+type BNIExporter struct{}
+
+// Export implements Exporter.
+func (BNIExporter) Export(w io.Writer, batch disbursement_entity.TransferBatch) error {
+	if err := checkBank(batch, enum.BankBNI); err != nil {
+		return err
+	}
+
+	debit := batch.DebitAccount()
+	records := [][]string{{"H", batch.Reference(), batch.ValueDate().Format("02/01/2006"), debit.Number(), batch.Currency()}}
+	for _, t := range batch.Transfers() {
+		account := t.Account()
+		records = append(records, []string{"D", account.Number(), account.HolderName(), strconv.FormatInt(t.Amount(), 10), t.Description()})
+	}
+	records = append(records, []string{"T", strconv.Itoa(batch.Count()), strconv.FormatInt(batch.Total(), 10)})
+
+	writer := csv.NewWriter(w)
+	writer.Comma = ';'
+	return writer.WriteAll(records)
+}
@@ -0,0 +1,29 @@
+package disbursement_service
+
+import (
+	"encoding/csv"
+	disbursement_entity "github.com/rfanazhari/hris/domain/entity/disbursement"
+	"io"
+	"strconv"
+	"time"
+)
+
+// CSVExporter writes a bank-neutral CSV of the batch with a header row, one row per
+// transfer and a trailer row "TRAILER,transfer count,total amount". It accepts accounts at
+// any bank, e.g. for manual or host-to-host transfers.
+type CSVExporter struct{}
+
+// Export implements Exporter.
+func (CSVExporter) Export(w io.Writer, batch disbursement_entity.TransferBatch) error {
+	records := [][]string{{"reference", "value_date", "employee_id", "bank", "account_number", "account_holder", "currency", "amount", "description"}}
+	valueDate := batch.ValueDate().Format(time.DateOnly)
+	for _, t := range batch.Transfers() {
+		account := t.Account()
+		records = append(records, []string{
+			batch.Reference(), valueDate, t.EmployeeID().String(), string(account.Bank()), account.Number(),
+			account.HolderName(), batch.Currency(), strconv.FormatInt(t.Amount(), 10), t.Description(),
+		})
+	}
+	records = append(records, []string{"TRAILER", strconv.Itoa(batch.Count()), strconv.FormatInt(batch.Total(), 10)})
+	return csv.NewWriter(w).WriteAll(records)
+}
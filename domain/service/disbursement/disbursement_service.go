@@ -0,0 +1,95 @@
+package disbursement_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	disbursement_entity "github.com/rfanazhari/hris/domain/entity/disbursement"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/rfanazhari/hris/pkg/format"
+	"io"
+	"strings"
+	"time"
+)
+
+// BatchRequest describes the bulk transfer paying a payroll run.
+type BatchRequest struct {
+	RunID        uuid.UUID
+	Reference    string
+	DebitAccount valueobject.BankAccount
+	ValueDate    time.Time
+}
+
+// DisbursementService turns approved payroll runs into bulk transfer files.
+type DisbursementService struct {
+	runs      port.PayrollRunRepository
+	employees port.EmployeeRepository
+}
+
+// NewDisbursementService returns a DisbursementService.
+func NewDisbursementService(runs port.PayrollRunRepository, employees port.EmployeeRepository) *DisbursementService {
+	return &DisbursementService{runs: runs, employees: employees}
+}
+
+// Batch builds the transfers paying the net pay of every payslip of an approved run to the
+// employee's registered bank account. Payslips without net pay are left out; employees
+// without a bank account are reported together.
+func (s *DisbursementService) Batch(ctx context.Context, req BatchRequest) (*disbursement_entity.TransferBatch, error) {
+	run, err := s.runs.FindByID(ctx, req.RunID)
+	if err != nil {
+		return nil, fmt.Errorf("find payroll run: %w", err)
+	}
+	if run.Status() != enum.PayrollApproved && run.Status() != enum.PayrollPaid {
+		return nil, errors.New("payroll run is not approved")
+	}
+
+	description := "GAJI " + format.MonthYear(run.Period().End())
+	var transfers []disbursement_entity.Transfer
+	var missing []string
+	for _, slip := range run.Payslips() {
+		if slip.NetPay() <= 0 {
+			continue
+		}
+		employee, err := s.employees.FindByID(ctx, slip.EmployeeID())
+		if err != nil {
+			return nil, fmt.Errorf("find employee: %w", err)
+		}
+		account := employee.BankAccount()
+		if account == nil {
+			missing = append(missing, employee.ID().String())
+			continue
+		}
+		transfer, err := disbursement_entity.NewTransfer(employee.ID(), *account, slip.NetPay(), description)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, *transfer)
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("employees without bank account: %s", strings.Join(missing, ", "))
+	}
+
+	return disbursement_entity.TransferBatchFactory{
+		Reference:    req.Reference,
+		RunID:        run.ID().String(),
+		DebitAccount: req.DebitAccount,
+		ValueDate:    req.ValueDate,
+		Currency:     run.Currency(),
+		Transfers:    transfers,
+	}.Create()
+}
+
+// Export builds the batch of the request and writes it with the exporter.
+func (s *DisbursementService) Export(ctx context.Context, req BatchRequest, exporter Exporter, w io.Writer) (*disbursement_entity.TransferBatch, error) {
+	batch, err := s.Batch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := exporter.Export(w, *batch); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
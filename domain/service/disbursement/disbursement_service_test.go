@@ -0,0 +1,181 @@
+package disbursement_service_test
+
+import (
+	"bytes"
+	"context"
+	"github.com/google/uuid"
+	disbursement_entity "github.com/rfanazhari/hris/domain/entity/disbursement"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	disbursement_service "github.com/rfanazhari/hris/domain/service/disbursement"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func bankAccount(t *testing.T, bank enum.Bank, number, holder string) valueobject.BankAccount {
+	account, err := valueobject.NewBankAccount(bank, number, holder)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return *account
+}
+
+func newEmployee(t *testing.T, account *valueobject.BankAccount) *employee_entity.Employee {
+	employee := porttest.NewEmployee(t, porttest.Hire{})
+	if account != nil {
+		_ = employee.SetBankAccount(*account, time.Time{})
+	}
+	return employee
+}
+
+// newRun returns an April 2025 run paying each employee the given net pay.
+func newRun(t *testing.T, approve bool, pay map[*employee_entity.Employee]int64, order ...*employee_entity.Employee) *payroll_entity.PayrollRun {
+	run, _ := payroll_entity.PayrollRunFactory{
+		ID:          uuid.NewString(),
+		PeriodStart: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:   time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC),
+	}.Create()
+	var slips []payroll_entity.Payslip
+	for _, e := range order {
+		draft, _ := payroll_entity.NewPayslipDraft(e.ID(), run.Period(), "IDR", pay[e], 30, 30)
+		line, _ := payroll_entity.NewPayslipLine(payroll_entity.LineBasic, "Gaji Pokok", enum.PayLineEarning, pay[e], true)
+		_ = draft.AddLine(*line)
+		slip, _ := draft.Finalize(uuid.New(), run.ID(), time.Time{})
+		slips = append(slips, *slip)
+	}
+	if err := run.RecordCalculation(slips, time.Time{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if approve {
+		_ = run.Approve(uuid.New(), time.Time{})
+	}
+	return run
+}
+
+func TestDisbursementService_Export(t *testing.T) {
+	ctx := context.Background()
+	rinaAccount := bankAccount(t, enum.BankBCA, "1234567890", "Rina Wijaya")
+	budiAccount := bankAccount(t, enum.BankBCA, "2223334445", "Budi Santoso")
+	rina, budi := newEmployee(t, &rinaAccount), newEmployee(t, &budiAccount)
+	employees := porttest.NewEmployees(rina, budi)
+	run := newRun(t, true, map[*employee_entity.Employee]int64{rina: 7_500_000, budi: 4_250_000}, rina, budi)
+	runs := porttest.NewPayrollRuns(run)
+	service := disbursement_service.NewDisbursementService(runs, employees)
+	req := disbursement_service.BatchRequest{
+		RunID:        run.ID(),
+		Reference:    "PAYROLL-202504",
+		DebitAccount: bankAccount(t, enum.BankBCA, "0987654321", "PT Maju Jaya"),
+		ValueDate:    time.Date(2025, 4, 25, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("BCA", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		batch, err := service.Export(ctx, req, disbursement_service.BCAExporter{CompanyCode: "MAJUJAYA"}, &buf)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, batch.Count())
+		assert.Equal(t, ""+
+			"0MAJUJAYA  250420250987654321IDRPAYROLL-202504      \r\n"+
+			"1123456789000000000750000000RINA WIJAYA                        GAJI APRIL 2025   \r\n"+
+			"1222333444500000000425000000BUDI SANTOSO                       GAJI APRIL 2025   \r\n"+
+			"900000200000001175000000\r\n", buf.String())
+	})
+	t.Run("CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		_, err := service.Export(ctx, req, disbursement_service.CSVExporter{}, &buf)
+
+		assert.Nil(t, err)
+		assert.Equal(t, ""+
+			"reference,value_date,employee_id,bank,account_number,account_holder,currency,amount,description\n"+
+			"PAYROLL-202504,2025-04-25,"+rina.ID().String()+",bca,1234567890,RINA WIJAYA,IDR,7500000,GAJI April 2025\n"+
+			"PAYROLL-202504,2025-04-25,"+budi.ID().String()+",bca,2223334445,BUDI SANTOSO,IDR,4250000,GAJI April 2025\n"+
+			"TRAILER,2,11750000\n", buf.String())
+	})
+	t.Run("NotApproved", func(t *testing.T) {
+		draft := newRun(t, false, map[*employee_entity.Employee]int64{rina: 1}, rina)
+		_ = runs.Save(ctx, draft)
+		r := req
+		r.RunID = draft.ID()
+
+		_, err := service.Batch(ctx, r)
+
+		assert.EqualError(t, err, "payroll run is not approved")
+	})
+	t.Run("MissingBankAccount", func(t *testing.T) {
+		nobody := newEmployee(t, nil)
+		_ = employees.Save(ctx, nobody)
+		other := newRun(t, true, map[*employee_entity.Employee]int64{rina: 1, nobody: 1}, rina, nobody)
+		_ = runs.Save(ctx, other)
+		r := req
+		r.RunID = other.ID()
+
+		_, err := service.Batch(ctx, r)
+
+		assert.EqualError(t, err, "employees without bank account: "+nobody.ID().String())
+	})
+}
+
+func TestBankExporters(t *testing.T) {
+	mandiri := bankAccount(t, enum.BankMandiri, "1230001234567", "Rina Wijaya")
+	bni := bankAccount(t, enum.BankBNI, "0123456789", "Budi Santoso")
+	newBatch := func(debit valueobject.BankAccount, accounts ...valueobject.BankAccount) disbursement_entity.TransferBatch {
+		var transfers []disbursement_entity.Transfer
+		for i, a := range accounts {
+			tr, _ := disbursement_entity.NewTransfer(uuid.New(), a, int64(i+1)*1_000_000, "Gaji April 2025")
+			transfers = append(transfers, *tr)
+		}
+		batch, err := disbursement_entity.TransferBatchFactory{
+			Reference: "APR25", RunID: uuid.NewString(), DebitAccount: debit,
+			ValueDate: time.Date(2025, 4, 25, 0, 0, 0, 0, time.UTC), Transfers: transfers,
+		}.Create()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return *batch
+	}
+
+	t.Run("Mandiri", func(t *testing.T) {
+		var buf bytes.Buffer
+		batch := newBatch(bankAccount(t, enum.BankMandiri, "1370009999999", "PT Maju Jaya"), mandiri, bni)
+
+		err := disbursement_service.MandiriExporter{}.Export(&buf, batch)
+		assert.EqualError(t, err, "account 0123456789 at bni cannot be credited by a mandiri file")
+
+		err = disbursement_service.MandiriExporter{}.Export(&buf, batch.ForBank(enum.BankMandiri))
+		assert.Nil(t, err)
+		assert.Equal(t, ""+
+			"H,APR25,20250425,1370009999999,IDR\n"+
+			"D,1230001234567,RINA WIJAYA,IDR,1000000.00,Gaji April 2025\n"+
+			"T,1,1000000.00\n", buf.String())
+	})
+	t.Run("BNI", func(t *testing.T) {
+		var buf bytes.Buffer
+		batch := newBatch(bankAccount(t, enum.BankBNI, "9876543210", "PT Maju Jaya"), bni)
+
+		err := disbursement_service.BNIExporter{}.Export(&buf, batch)
+
+		assert.Nil(t, err)
+		assert.Equal(t, ""+
+			"H;APR25;25/04/2025;9876543210;IDR\n"+
+			"D;0123456789;BUDI SANTOSO;1000000;Gaji April 2025\n"+
+			"T;1;1000000\n", buf.String())
+	})
+	t.Run("DebitAccountAtOtherBank", func(t *testing.T) {
+		batch := newBatch(bankAccount(t, enum.BankMandiri, "1370009999999", "PT Maju Jaya"), bni)
+
+		err := disbursement_service.BNIExporter{}.Export(&bytes.Buffer{}, batch)
+
+		assert.EqualError(t, err, "debit account at mandiri cannot be used in a bni file")
+	})
+	t.Run("BCACompanyCode", func(t *testing.T) {
+		err := disbursement_service.BCAExporter{}.Export(&bytes.Buffer{}, newBatch(bankAccount(t, enum.BankBCA, "0987654321", "PT Maju Jaya"), bankAccount(t, enum.BankBCA, "1234567890", "Rina")))
+
+		assert.EqualError(t, err, "bca company code cannot be empty")
+	})
+}
@@ -0,0 +1,56 @@
+package disbursement_service
+
+import (
+	"fmt"
+	disbursement_entity "github.com/rfanazhari/hris/domain/entity/disbursement"
+	"github.com/rfanazhari/hris/domain/enum"
+	"io"
+	"strings"
+)
+
+// Exporter writes a transfer batch as a bulk payment file for upload to a bank.
+type Exporter interface {
+	Export(w io.Writer, batch disbursement_entity.TransferBatch) error
+}
+
+// checkBank verifies that a batch can be paid with a file of the given bank: the bank's
+// payroll service debits an account at the bank in rupiah and only credits accounts at the
+// same bank. Use TransferBatch.ForBank to split a batch.
+func checkBank(batch disbursement_entity.TransferBatch, bank enum.Bank) error {
+	if batch.Currency() != "IDR" {
+		return fmt.Errorf("%s payroll files only support IDR", bank)
+	}
+	if debit := batch.DebitAccount(); debit.Bank() != bank {
+		return fmt.Errorf("debit account at %s cannot be used in a %s file", debit.Bank(), bank)
+	}
+	for _, t := range batch.Transfers() {
+		if account := t.Account(); account.Bank() != bank {
+			return fmt.Errorf("account %s at %s cannot be credited by a %s file", account.Number(), account.Bank(), bank)
+		}
+	}
+	return nil
+}
+
+// text uppercases s, replaces characters banks reject with spaces and pads or truncates it
+// to width.
+func text(s string, width int) string {
+	clean := strings.Map(func(r rune) rune {
+		if r < ' ' || r > '~' || r == ',' || r == ';' || r == '"' {
+			return ' '
+		}
+		return r
+	}, strings.ToUpper(s))
+	if len(clean) > width {
+		return clean[:width]
+	}
+	return clean + strings.Repeat(" ", width-len(clean))
+}
+
+// number zero-pads n to width digits.
+func number(n int64, width int) (string, error) {
+	s := fmt.Sprintf("%0*d", width, n)
+	if n < 0 || len(s) > width {
+		return "", fmt.Errorf("%d does not fit in %d digits", n, width)
+	}
+	return s, nil
+}
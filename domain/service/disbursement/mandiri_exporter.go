@@ -0,0 +1,39 @@
+package disbursement_service
+
+import (
+	"encoding/csv"
+	"fmt"
+	disbursement_entity "github.com/rfanazhari/hris/domain/entity/disbursement"
+	"github.com/rfanazhari/hris/domain/enum"
+	"io"
+	"strconv"
+)
+
+// MandiriExporter writes the comma separated bulk payroll file uploaded to Mandiri Cash
+// Management (MCM). Amounts have two decimals:
+//
+//	H,reference,value date YYYYMMDD,debit account,currency
+//	D,account,holder name,currency,amount,description
+//	T,transfer count,total amount
+type MandiriExporter struct{}
+
+// Export implements Exporter.
+func (MandiriExporter) Export(w io.Writer, batch disbursement_entity.TransferBatch) error {
+	if err := checkBank(batch, enum.BankMandiri); err != nil {
+		return err
+	}
+
+	debit := batch.DebitAccount()
+	records := [][]string{{"H", batch.Reference(), batch.ValueDate().Format("20060102"), debit.Number(), batch.Currency()}}
+	for _, t := range batch.Transfers() {
+		account := t.Account()
+		records = append(records, []string{"D", account.Number(), account.HolderName(), batch.Currency(), decimal(t.Amount()), t.Description()})
+	}
+	records = append(records, []string{"T", strconv.Itoa(batch.Count()), decimal(batch.Total())})
+	return csv.NewWriter(w).WriteAll(records)
+}
+
+// decimal formats a whole rupiah amount with two decimals, e.g. "1500000.00".
+func decimal(amount int64) string {
+	return fmt.Sprintf("%d.00", amount)
+}
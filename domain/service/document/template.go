@@ -0,0 +1,104 @@
+package document_service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/pkg/format"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+// TemplateFormat describes how a template body is interpreted.
+type TemplateFormat string
+
+const (
+	TemplateText TemplateFormat = "text"
+	TemplateHTML TemplateFormat = "html"
+)
+
+// Template is a parsed document template for one of the generated document kinds.
+type Template struct {
+	kind   enum.DocumentType
+	name   string
+	format TemplateFormat
+	text   *texttemplate.Template
+	html   *htmltemplate.Template
+}
+
+// templateFuncs are available inside every template:
+//   - rupiah:         {{ rupiah .SalaryMin }}       -> "Rp 12.500.000"
+//   - tanggal:        {{ tanggal .StartDate }}      -> "1 Januari 2025"
+//   - tanggalPanjang: {{ tanggalPanjang .IssuedAt }} -> "Rabu, 1 Januari 2025"
+var templateFuncs = map[string]any{
+	"rupiah":         format.Rupiah,
+	"tanggal":        format.Date,
+	"tanggalPanjang": format.LongDate,
+}
+
+// NewTemplate parses body as a template for the given document kind.
+// Only offering letters, PKWT contracts and NDAs are generated from templates.
+func NewTemplate(kind enum.DocumentType, name string, templateFormat TemplateFormat, body string) (*Template, error) {
+	switch kind {
+	case enum.DocOfferingLetter, enum.DocPKWT, enum.DocNDA:
+	default:
+		return nil, fmt.Errorf("unsupported template document type: %q", kind)
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("template name cannot be empty")
+	}
+	if strings.TrimSpace(body) == "" {
+		return nil, errors.New("template body cannot be empty")
+	}
+
+	tpl := &Template{kind: kind, name: name, format: templateFormat}
+	var err error
+	switch templateFormat {
+	case TemplateText:
+		tpl.text, err = texttemplate.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(body)
+	case TemplateHTML:
+		tpl.html, err = htmltemplate.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(body)
+	default:
+		return nil, fmt.Errorf("unsupported template format: %q", templateFormat)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return tpl, nil
+}
+
+// Kind returns the document type produced by the template.
+func (t *Template) Kind() enum.DocumentType { return t.kind }
+
+// Name returns the template name.
+func (t *Template) Name() string { return t.name }
+
+// Format returns the template format.
+func (t *Template) Format() TemplateFormat { return t.format }
+
+// mimeType returns the MIME type and file extension of the rendered output.
+func (t *Template) mimeType() (string, string) {
+	if t.format == TemplateHTML {
+		return "text/html", "html"
+	}
+	return "text/plain", "txt"
+}
+
+// execute renders the template with the given data.
+func (t *Template) execute(data any) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	if t.html != nil {
+		err = t.html.Execute(&buf, data)
+	} else {
+		err = t.text.Execute(&buf, data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("render template %s: %w", t.name, err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,145 @@
+package document_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rfanazhari/hris/domain/entity"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"time"
+)
+
+// RenderInput holds the domain objects a template is filled from.
+// Contract is required for PKWT templates and optional otherwise.
+// IssuedAt defaults to time.Now() when zero.
+type RenderInput struct {
+	Employee *employee_entity.Employee
+	Position *entity.JobPosition
+	Contract *employee_entity.EmploymentContract
+	IssuedAt time.Time
+}
+
+// TemplateData is the flattened view passed to templates.
+type TemplateData struct {
+	EmployeeID     string
+	FullName       string
+	FirstName      string
+	LastName       string
+	PlaceOfBirth   string
+	BirthDate      time.Time
+	Gender         string
+	Nationality    string
+	MaritalStatus  string
+	JobTitle       string
+	JobDescription string
+	GradeLevel     string
+	SalaryMin      int64
+	SalaryMax      int64
+	SalaryCurrency string
+	ContractType   string
+	StartDate      time.Time
+	EndDate        time.Time
+	IssuedAt       time.Time
+}
+
+// TemplateRenderer fills document templates with employee, position and contract data
+// and stores the output through the FileStorage port.
+type TemplateRenderer struct {
+	storage port.FileStorage
+}
+
+// NewTemplateRenderer returns a TemplateRenderer that stores rendered files in storage.
+func NewTemplateRenderer(storage port.FileStorage) *TemplateRenderer {
+	return &TemplateRenderer{storage: storage}
+}
+
+// Render fills the template and returns the rendered bytes.
+func (r *TemplateRenderer) Render(tpl *Template, input RenderInput) ([]byte, error) {
+	if tpl == nil {
+		return nil, errors.New("template cannot be nil")
+	}
+	data, err := newTemplateData(tpl.kind, input)
+	if err != nil {
+		return nil, err
+	}
+	return tpl.execute(data)
+}
+
+// Generate renders the template, stores the output and returns it as a Document.
+// PKWT documents expire at the end of the contract; other documents have no expiry.
+func (r *TemplateRenderer) Generate(ctx context.Context, tpl *Template, input RenderInput) (*valueobject.Document, error) {
+	if r.storage == nil {
+		return nil, errors.New("file storage is not configured")
+	}
+	if input.IssuedAt.IsZero() {
+		input.IssuedAt = time.Now()
+	}
+	content, err := r.Render(tpl, input)
+	if err != nil {
+		return nil, err
+	}
+
+	mimeType, ext := tpl.mimeType()
+	filename := fmt.Sprintf("%s-%s-%s.%s", tpl.kind, input.Employee.ID(), input.IssuedAt.Format("20060102"), ext)
+	file, err := r.storage.Store(ctx, filename, mimeType, content)
+	if err != nil {
+		return nil, fmt.Errorf("store document: %w", err)
+	}
+
+	var expiry *time.Time
+	if tpl.kind == enum.DocPKWT {
+		expiry = input.Contract.EndDate()
+	}
+	validity, err := valueobject.NewValidityPeriodDocument(input.IssuedAt, expiry)
+	if err != nil {
+		return nil, err
+	}
+	return valueobject.NewDocument(tpl.kind, *file, *validity)
+}
+
+func newTemplateData(kind enum.DocumentType, input RenderInput) (TemplateData, error) {
+	if input.Employee == nil {
+		return TemplateData{}, errors.New("employee cannot be nil")
+	}
+	if input.Position == nil {
+		return TemplateData{}, errors.New("job position cannot be nil")
+	}
+	if kind == enum.DocPKWT && input.Contract == nil {
+		return TemplateData{}, errors.New("pkwt template requires an employment contract")
+	}
+	if input.IssuedAt.IsZero() {
+		input.IssuedAt = time.Now()
+	}
+
+	info := input.Employee.PersonalInfo()
+	salary := input.Position.SalaryRange()
+	data := TemplateData{
+		EmployeeID:     input.Employee.ID().String(),
+		FullName:       info.Name().FullName(),
+		FirstName:      info.Name().FirstName(),
+		LastName:       info.Name().LastName(),
+		PlaceOfBirth:   info.PlaceOfBirth(),
+		BirthDate:      info.BirthDate(),
+		Gender:         string(info.Gender()),
+		Nationality:    string(info.Nationality()),
+		MaritalStatus:  string(info.MaritalStatus()),
+		JobTitle:       input.Position.Title(),
+		JobDescription: input.Position.Description(),
+		GradeLevel:     string(input.Position.GradeLevel()),
+		SalaryMin:      salary.Min,
+		SalaryMax:      salary.Max,
+		SalaryCurrency: salary.Currency,
+		IssuedAt:       input.IssuedAt,
+	}
+	if input.Contract != nil {
+		data.ContractType = string(input.Contract.ContractType())
+		data.StartDate = input.Contract.StartDate()
+		if end := input.Contract.EndDate(); end != nil {
+			data.EndDate = *end
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,199 @@
+package document_service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/entity"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"github.com/rfanazhari/hris/domain/enum"
+	document_service "github.com/rfanazhari/hris/domain/service/document"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type memoryStorage struct {
+	files map[string][]byte
+	err   error
+}
+
+func (m *memoryStorage) Store(_ context.Context, filename, mimeType string, content []byte) (*valueobject.FileReference, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	if m.files == nil {
+		m.files = map[string][]byte{}
+	}
+	m.files[filename] = content
+	return valueobject.NewFileReference("https://storage.example.com/generated/"+filename, filename, mimeType)
+}
+
+func newRenderInput(t *testing.T) document_service.RenderInput {
+	personalInfo, err := employee_entity.PersonalInfoFactory{
+		FirstName:     "Budi",
+		LastName:      "Santoso",
+		BirthDate:     time.Date(1993, 8, 17, 0, 0, 0, 0, time.UTC),
+		PlaceOfBirth:  "Surabaya",
+		Gender:        "M",
+		Nationality:   "wni",
+		MaritalStatus: "married",
+		Religion:      "islam",
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	employee, err := employee_entity.EmployeeFactory{ID: uuid.NewString(), PersonalInfo: personalInfo, Status: "active"}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	position, err := entity.JobPositionFactory{
+		ID:             uuid.NewString(),
+		Title:          "Backend Engineer",
+		Description:    "Builds and maintains the services that power the HRIS platform.",
+		GradeLevel:     "senior",
+		SalaryMin:      15000000,
+		SalaryMax:      25000000,
+		SalaryCurrency: "IDR",
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	end := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+	contract, err := employee_entity.EmploymentContractFactory{
+		ID:           uuid.NewString(),
+		ContractType: "pkwt",
+		StartDate:    time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		EndDate:      &end,
+		Status:       "active",
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return document_service.RenderInput{
+		Employee: employee,
+		Position: position,
+		Contract: contract,
+		IssuedAt: time.Date(2024, 12, 20, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNewTemplate(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		tpl, err := document_service.NewTemplate(enum.DocNDA, "nda", document_service.TemplateText, "NDA {{ .FullName }}")
+
+		assert.Nil(t, err)
+		assert.Equal(t, enum.DocNDA, tpl.Kind())
+		assert.Equal(t, "nda", tpl.Name())
+		assert.Equal(t, document_service.TemplateText, tpl.Format())
+	})
+	t.Run("UnsupportedKind", func(t *testing.T) {
+		_, err := document_service.NewTemplate(enum.DocKTP, "ktp", document_service.TemplateText, "x")
+
+		assert.EqualError(t, err, `unsupported template document type: "ktp"`)
+	})
+	t.Run("EmptyName", func(t *testing.T) {
+		_, err := document_service.NewTemplate(enum.DocNDA, " ", document_service.TemplateText, "x")
+
+		assert.EqualError(t, err, "template name cannot be empty")
+	})
+	t.Run("EmptyBody", func(t *testing.T) {
+		_, err := document_service.NewTemplate(enum.DocNDA, "nda", document_service.TemplateText, "")
+
+		assert.EqualError(t, err, "template body cannot be empty")
+	})
+	t.Run("UnsupportedFormat", func(t *testing.T) {
+		_, err := document_service.NewTemplate(enum.DocNDA, "nda", "docx", "x")
+
+		assert.EqualError(t, err, `unsupported template format: "docx"`)
+	})
+	t.Run("InvalidSyntax", func(t *testing.T) {
+		_, err := document_service.NewTemplate(enum.DocNDA, "nda", document_service.TemplateHTML, "{{ .FullName ")
+
+		assert.NotNil(t, err)
+	})
+}
+
+func TestTemplateRenderer_Render(t *testing.T) {
+	renderer := document_service.NewTemplateRenderer(&memoryStorage{})
+
+	t.Run("TextWithIndonesianFormatting", func(t *testing.T) {
+		tpl, _ := document_service.NewTemplate(enum.DocOfferingLetter, "offer", document_service.TemplateText,
+			"{{ tanggal .IssuedAt }}: {{ .FullName }} ({{ .JobTitle }}, {{ .GradeLevel }}) {{ rupiah .SalaryMin }} - {{ rupiah .SalaryMax }} mulai {{ tanggalPanjang .StartDate }}")
+
+		out, err := renderer.Render(tpl, newRenderInput(t))
+
+		assert.Nil(t, err)
+		assert.Equal(t, "20 Desember 2024: Budi Santoso (Backend Engineer, senior) Rp 15.000.000 - Rp 25.000.000 mulai Kamis, 2 Januari 2025", string(out))
+	})
+	t.Run("HTMLEscapesData", func(t *testing.T) {
+		input := newRenderInput(t)
+		tpl, _ := document_service.NewTemplate(enum.DocNDA, "nda", document_service.TemplateHTML, "<p>{{ .JobTitle }} &amp; {{ printf \"%s\" \"<b>\" }}</p>")
+
+		out, err := renderer.Render(tpl, input)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "<p>Backend Engineer &amp; &lt;b&gt;</p>", string(out))
+	})
+	t.Run("UnknownField", func(t *testing.T) {
+		tpl, _ := document_service.NewTemplate(enum.DocNDA, "nda", document_service.TemplateText, "{{ .Unknown }}")
+
+		_, err := renderer.Render(tpl, newRenderInput(t))
+
+		assert.NotNil(t, err)
+	})
+	t.Run("PKWTRequiresContract", func(t *testing.T) {
+		input := newRenderInput(t)
+		input.Contract = nil
+		tpl, _ := document_service.NewTemplate(enum.DocPKWT, "pkwt", document_service.TemplateText, "x")
+
+		_, err := renderer.Render(tpl, input)
+
+		assert.EqualError(t, err, "pkwt template requires an employment contract")
+	})
+	t.Run("MissingEmployee", func(t *testing.T) {
+		input := newRenderInput(t)
+		input.Employee = nil
+		tpl, _ := document_service.NewTemplate(enum.DocNDA, "nda", document_service.TemplateText, "x")
+
+		_, err := renderer.Render(tpl, input)
+
+		assert.EqualError(t, err, "employee cannot be nil")
+	})
+}
+
+func TestTemplateRenderer_Generate(t *testing.T) {
+	t.Run("StoresPKWTDocument", func(t *testing.T) {
+		storage := &memoryStorage{}
+		renderer := document_service.NewTemplateRenderer(storage)
+		input := newRenderInput(t)
+		tpl, _ := document_service.NewTemplate(enum.DocPKWT, "pkwt", document_service.TemplateHTML, "<h1>PKWT {{ .FullName }}</h1><p>s.d. {{ tanggal .EndDate }}</p>")
+
+		doc, err := renderer.Generate(context.Background(), tpl, input)
+
+		assert.Nil(t, err)
+		assert.Equal(t, enum.DocPKWT, doc.Kind())
+		assert.Equal(t, "text/html", doc.File().MimeType())
+		assert.Equal(t, input.IssuedAt, doc.IssuedDate())
+		assert.Equal(t, *input.Contract.EndDate(), *doc.ExpiryDate())
+		assert.Equal(t, "<h1>PKWT Budi Santoso</h1><p>s.d. 31 Desember 2025</p>", string(storage.files[doc.File().Filename()]))
+	})
+	t.Run("StorageError", func(t *testing.T) {
+		renderer := document_service.NewTemplateRenderer(&memoryStorage{err: errors.New("bucket unavailable")})
+		tpl, _ := document_service.NewTemplate(enum.DocNDA, "nda", document_service.TemplateText, "NDA")
+
+		doc, err := renderer.Generate(context.Background(), tpl, newRenderInput(t))
+
+		assert.Nil(t, doc)
+		assert.EqualError(t, err, "store document: bucket unavailable")
+	})
+	t.Run("NoStorage", func(t *testing.T) {
+		renderer := document_service.NewTemplateRenderer(nil)
+		tpl, _ := document_service.NewTemplate(enum.DocNDA, "nda", document_service.TemplateText, "NDA")
+
+		_, err := renderer.Generate(context.Background(), tpl, newRenderInput(t))
+
+		assert.EqualError(t, err, "file storage is not configured")
+	})
+}
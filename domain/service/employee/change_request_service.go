@@ -0,0 +1,154 @@
+package employee_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	workflow_entity "github.com/rfanazhari/hris/domain/entity/workflow"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/pkg/clock"
+)
+
+// ChangeRequestType is the workflow request type of employee data change requests.
+const ChangeRequestType = "data_change"
+
+// ChangeRequestService lets employees propose changes to their own personal and contact
+// data and applies them once HR approves. It can decide requests directly or act as the
+// outcome handler of the data_change workflow.
+type ChangeRequestService struct {
+	requests  port.ChangeRequestRepository
+	employees port.EmployeeRepository
+	clock     clock.Clock
+}
+
+// NewChangeRequestService returns a ChangeRequestService. A nil clock falls back to the
+// system clock.
+func NewChangeRequestService(requests port.ChangeRequestRepository, employees port.EmployeeRepository, clk clock.Clock) *ChangeRequestService {
+	if clk == nil {
+		clk = clock.System{}
+	}
+	return &ChangeRequestService{requests: requests, employees: employees, clock: clk}
+}
+
+// Submit creates a change request for the employee. An employee has at most one pending
+// request at a time.
+func (s *ChangeRequestService) Submit(ctx context.Context, employeeID uuid.UUID, f employee_entity.ChangeRequestFactory) (*employee_entity.ChangeRequest, error) {
+	employee, err := s.employees.FindByID(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("find employee: %w", err)
+	}
+	existing, err := s.requests.ListByEmployee(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("list change requests: %w", err)
+	}
+	for _, r := range existing {
+		if r.Status() == enum.ChangeRequestPending {
+			return nil, errors.New("employee already has a pending change request")
+		}
+	}
+
+	f.Employee = employee
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = s.clock.Now()
+	}
+	request, err := f.Create()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requests.Save(ctx, request); err != nil {
+		return nil, fmt.Errorf("save change request: %w", err)
+	}
+	return request, nil
+}
+
+// Approve applies the request to the employee's data and saves both. The request is saved
+// first: once it is approved it cannot be approved again, so a failure to save the
+// employee can never apply the change twice.
+func (s *ChangeRequestService) Approve(ctx context.Context, requestID, reviewerID uuid.UUID) (*employee_entity.ChangeRequest, error) {
+	request, err := s.requests.FindByID(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("find change request: %w", err)
+	}
+	employee, err := s.employees.FindByID(ctx, request.EmployeeID())
+	if err != nil {
+		return nil, fmt.Errorf("find employee: %w", err)
+	}
+	if err := request.Approve(reviewerID, employee, s.clock.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.requests.Save(ctx, request); err != nil {
+		return nil, fmt.Errorf("save change request: %w", err)
+	}
+	if err := s.employees.Save(ctx, employee); err != nil {
+		return nil, fmt.Errorf("save employee: %w", err)
+	}
+	return request, nil
+}
+
+// Reject rejects the request with a reason; the employee's data is left as it is.
+func (s *ChangeRequestService) Reject(ctx context.Context, requestID, reviewerID uuid.UUID, note string) (*employee_entity.ChangeRequest, error) {
+	return s.update(ctx, requestID, func(request *employee_entity.ChangeRequest) error {
+		return request.Reject(reviewerID, note, s.clock.Now())
+	})
+}
+
+// Cancel withdraws a pending request.
+func (s *ChangeRequestService) Cancel(ctx context.Context, requestID uuid.UUID) (*employee_entity.ChangeRequest, error) {
+	return s.update(ctx, requestID, func(request *employee_entity.ChangeRequest) error {
+		return request.Cancel(s.clock.Now())
+	})
+}
+
+// ApprovalCompleted decides the change request an approval of the data_change workflow is
+// about, with the approver who made the final decision as reviewer.
+func (s *ChangeRequestService) ApprovalCompleted(ctx context.Context, approval *workflow_entity.Approval) error {
+	var err error
+	switch approval.Status() {
+	case enum.ApprovalApproved:
+		_, err = s.Approve(ctx, approval.SubjectID(), lastActor(approval, enum.WorkflowApproved))
+	case enum.ApprovalRejected:
+		note := ""
+		if event, ok := lastEvent(approval, enum.WorkflowRejected); ok {
+			note = event.Note()
+		}
+		if note == "" {
+			note = "rejected in approval workflow"
+		}
+		_, err = s.Reject(ctx, approval.SubjectID(), lastActor(approval, enum.WorkflowRejected), note)
+	case enum.ApprovalCancelled:
+		_, err = s.Cancel(ctx, approval.SubjectID())
+	}
+	return err
+}
+
+func (s *ChangeRequestService) update(ctx context.Context, id uuid.UUID, change func(*employee_entity.ChangeRequest) error) (*employee_entity.ChangeRequest, error) {
+	request, err := s.requests.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find change request: %w", err)
+	}
+	if err := change(request); err != nil {
+		return nil, err
+	}
+	if err := s.requests.Save(ctx, request); err != nil {
+		return nil, fmt.Errorf("save change request: %w", err)
+	}
+	return request, nil
+}
+
+func lastEvent(approval *workflow_entity.Approval, action enum.WorkflowAction) (workflow_entity.Event, bool) {
+	history := approval.History()
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Action() == action {
+			return history[i], true
+		}
+	}
+	return workflow_entity.Event{}, false
+}
+
+func lastActor(approval *workflow_entity.Approval, action enum.WorkflowAction) uuid.UUID {
+	event, _ := lastEvent(approval, action)
+	return event.ActorID()
+}
@@ -0,0 +1,173 @@
+package employee_service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	workflow_entity "github.com/rfanazhari/hris/domain/entity/workflow"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	employee_service "github.com/rfanazhari/hris/domain/service/employee"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type memoryRequests struct {
+	requests []*employee_entity.ChangeRequest
+	err      error
+}
+
+func (m *memoryRequests) Save(_ context.Context, request *employee_entity.ChangeRequest) error {
+	if m.err != nil {
+		return m.err
+	}
+	for i, r := range m.requests {
+		if r.ID() == request.ID() {
+			m.requests[i] = request
+			return nil
+		}
+	}
+	m.requests = append(m.requests, request)
+	return nil
+}
+
+func (m *memoryRequests) FindByID(_ context.Context, id uuid.UUID) (*employee_entity.ChangeRequest, error) {
+	for _, r := range m.requests {
+		if r.ID() == id {
+			return r, nil
+		}
+	}
+	return nil, errors.New("change request not found")
+}
+
+func (m *memoryRequests) ListByEmployee(_ context.Context, employeeID uuid.UUID) ([]employee_entity.ChangeRequest, error) {
+	var out []employee_entity.ChangeRequest
+	for _, r := range m.requests {
+		if r.EmployeeID() == employeeID {
+			out = append(out, *r)
+		}
+	}
+	return out, nil
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+type fixture struct {
+	service   *employee_service.ChangeRequestService
+	requests  *memoryRequests
+	employees *porttest.Employees
+	employee  *employee_entity.Employee
+	clock     *clock.Fixed
+}
+
+func newFixture(t *testing.T) fixture {
+	employee := porttest.NewEmployee(t, porttest.Hire{})
+	requests := &memoryRequests{}
+	employees := porttest.NewEmployees(employee)
+	clk := &clock.Fixed{At: time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)}
+	return fixture{
+		service:   employee_service.NewChangeRequestService(requests, employees, clk),
+		requests:  requests,
+		employees: employees,
+		employee:  employee,
+		clock:     clk,
+	}
+}
+
+func (f fixture) submit(t *testing.T) *employee_entity.ChangeRequest {
+	certificate, _ := valueobject.NewFileReference("https://files.example.com/akta-nikah.pdf", "akta-nikah.pdf", "application/pdf")
+	request, err := f.service.Submit(context.Background(), f.employee.ID(), employee_entity.ChangeRequestFactory{
+		ID:        uuid.NewString(),
+		Personal:  &employee_entity.PersonalInfoDiff{MaritalStatus: ptr("married")},
+		Contact:   &employee_entity.ContactInfoDiff{Phone: ptr("81234567890")},
+		Documents: []valueobject.FileReference{*certificate},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return request
+}
+
+func maritalStatus(employee *employee_entity.Employee) enum.MaritalStatus {
+	info := employee.PersonalInfo()
+	return info.MaritalStatus()
+}
+
+func TestChangeRequestService(t *testing.T) {
+	ctx := context.Background()
+	reviewer := uuid.New()
+
+	t.Run("SubmitAndApprove", func(t *testing.T) {
+		f := newFixture(t)
+		request := f.submit(t)
+		assert.Equal(t, f.clock.At, request.CreatedAt())
+
+		_, err := f.service.Submit(ctx, f.employee.ID(), employee_entity.ChangeRequestFactory{
+			ID: uuid.NewString(), Contact: &employee_entity.ContactInfoDiff{Email: ptr("dewi@example.com")},
+		})
+		assert.EqualError(t, err, "employee already has a pending change request")
+
+		request, err = f.service.Approve(ctx, request.ID(), reviewer)
+		assert.Nil(t, err)
+		assert.Equal(t, enum.ChangeRequestApproved, request.Status())
+		assert.Equal(t, enum.MaritalMarried, maritalStatus(f.employee))
+		contact := f.employee.ContactInfo()
+		assert.Equal(t, "81234567890", contact.Phone().Number())
+		assert.Equal(t, 1, f.employees.Saved)
+
+		_, err = f.service.Submit(ctx, f.employee.ID(), employee_entity.ChangeRequestFactory{
+			ID: uuid.NewString(), Contact: &employee_entity.ContactInfoDiff{Email: ptr("dewi@example.com")},
+		})
+		assert.Nil(t, err)
+	})
+	t.Run("RequestNotSaved", func(t *testing.T) {
+		f := newFixture(t)
+		request := f.submit(t)
+		f.requests.err = errors.New("connection reset")
+
+		_, err := f.service.Approve(ctx, request.ID(), reviewer)
+
+		assert.EqualError(t, err, "save change request: connection reset")
+		assert.Equal(t, 0, f.employees.Saved)
+	})
+	t.Run("RejectAndCancel", func(t *testing.T) {
+		f := newFixture(t)
+		request := f.submit(t)
+
+		request, err := f.service.Reject(ctx, request.ID(), reviewer, "certificate is unreadable")
+		assert.Nil(t, err)
+		assert.Equal(t, enum.ChangeRequestRejected, request.Status())
+		assert.Equal(t, enum.MaritalSingle, maritalStatus(f.employee))
+		assert.Equal(t, 0, f.employees.Saved)
+
+		request = f.submit(t)
+		request, err = f.service.Cancel(ctx, request.ID())
+		assert.Nil(t, err)
+		assert.Equal(t, enum.ChangeRequestCancelled, request.Status())
+	})
+	t.Run("ApprovalCompleted", func(t *testing.T) {
+		f := newFixture(t)
+		request := f.submit(t)
+		hrRule, _ := workflow_entity.NewApprover(enum.ApproverRole, "hr_admin")
+		step, _ := workflow_entity.NewStep("HR", enum.ApprovalAny, 0, *hrRule)
+		definition, _ := workflow_entity.DefinitionFactory{RequestType: employee_service.ChangeRequestType, Steps: []workflow_entity.Step{*step}}.Create()
+		approval, err := workflow_entity.ApprovalFactory{
+			ID: uuid.NewString(), SubjectID: request.ID().String(), RequesterID: f.employee.ID().String(), Definition: definition,
+		}.Create()
+		assert.Nil(t, err)
+		assert.Nil(t, approval.Open([][]uuid.UUID{{reviewer}}, f.clock.At))
+		assert.Nil(t, approval.Reject(reviewer, "wrong certificate", f.clock.At))
+
+		assert.Nil(t, f.service.ApprovalCompleted(ctx, approval))
+		request, _ = f.requests.FindByID(ctx, request.ID())
+		assert.Equal(t, enum.ChangeRequestRejected, request.Status())
+		assert.Equal(t, reviewer, *request.DecidedBy())
+		assert.Equal(t, "wrong certificate", request.DecisionNote())
+	})
+}
@@ -0,0 +1,66 @@
+package employee_service
+
+import (
+	"context"
+	"fmt"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/pkg/clock"
+)
+
+// HireHandler is told when an employee is hired, e.g. to start their onboarding.
+type HireHandler interface {
+	EmployeeHired(ctx context.Context, employee *employee_entity.Employee) error
+}
+
+// HiringService registers new hires with their first employment contract and tells the
+// registered HireHandlers about them.
+type HiringService struct {
+	employees port.EmployeeRepository
+	handlers  []HireHandler
+	clock     clock.Clock
+}
+
+// NewHiringService returns a HiringService notifying the handlers in order. A nil clock
+// falls back to the system clock.
+func NewHiringService(employees port.EmployeeRepository, clk clock.Clock, handlers ...HireHandler) *HiringService {
+	if clk == nil {
+		clk = clock.System{}
+	}
+	return &HiringService{employees: employees, handlers: handlers, clock: clk}
+}
+
+// Hire creates the employee with their employment contract, saves them and then notifies
+// the handlers. A contract with a probation period starts the employee on probation. The
+// employee stays hired when a handler fails; the error names the step that needs to be
+// retried.
+func (s *HiringService) Hire(ctx context.Context, f employee_entity.EmployeeFactory, contract employee_entity.EmploymentContractFactory) (*employee_entity.Employee, error) {
+	now := s.clock.Now()
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = now
+	}
+	c, err := contract.Create()
+	if err != nil {
+		return nil, err
+	}
+	if c.Probation() != nil {
+		f.Status = string(enum.EmploymentProbation)
+	}
+	employee, err := f.Create()
+	if err != nil {
+		return nil, err
+	}
+	if err := employee.AddEmploymentContract(*c, now); err != nil {
+		return nil, err
+	}
+	if err := s.employees.Save(ctx, employee); err != nil {
+		return nil, fmt.Errorf("save employee: %w", err)
+	}
+	for _, h := range s.handlers {
+		if err := h.EmployeeHired(ctx, employee); err != nil {
+			return employee, fmt.Errorf("employee hired: %w", err)
+		}
+	}
+	return employee, nil
+}
@@ -0,0 +1,74 @@
+package employee_service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	employee_service "github.com/rfanazhari/hris/domain/service/employee"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type hires struct {
+	employees []uuid.UUID
+	err       error
+}
+
+func (h *hires) EmployeeHired(_ context.Context, employee *employee_entity.Employee) error {
+	h.employees = append(h.employees, employee.ID())
+	return h.err
+}
+
+func TestHiringService_Hire(t *testing.T) {
+	ctx := context.Background()
+	personalInfo, _ := employee_entity.PersonalInfoFactory{
+		FirstName: "Dewi", LastName: "Lestari", PlaceOfBirth: "semarang",
+		Gender: "F", Nationality: "wni", MaritalStatus: "single", Religion: "islam",
+	}.Create()
+	newFactories := func() (employee_entity.EmployeeFactory, employee_entity.EmploymentContractFactory) {
+		return employee_entity.EmployeeFactory{ID: uuid.NewString(), PersonalInfo: personalInfo, Status: "active"},
+			employee_entity.EmploymentContractFactory{ID: uuid.NewString(), ContractType: "pkwtt", StartDate: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), Status: "active"}
+	}
+
+	t.Run("NotifiesHandlers", func(t *testing.T) {
+		employees := porttest.NewEmployees()
+		first, second := &hires{}, &hires{}
+		service := employee_service.NewHiringService(employees, nil, first, second)
+		f, contract := newFactories()
+
+		employee, err := service.Hire(ctx, f, contract)
+
+		assert.Nil(t, err)
+		assert.Equal(t, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), employee.HireDate())
+		assert.Equal(t, 1, employees.Saved)
+		assert.Equal(t, []uuid.UUID{employee.ID()}, first.employees)
+		assert.Equal(t, []uuid.UUID{employee.ID()}, second.employees)
+	})
+	t.Run("HandlerFails", func(t *testing.T) {
+		employees := porttest.NewEmployees()
+		failing, second := &hires{err: errors.New("no onboarding template applies")}, &hires{}
+		service := employee_service.NewHiringService(employees, nil, failing, second)
+		f, contract := newFactories()
+
+		employee, err := service.Hire(ctx, f, contract)
+
+		assert.EqualError(t, err, "employee hired: no onboarding template applies")
+		assert.NotNil(t, employee)
+		assert.Equal(t, 1, employees.Saved)
+		assert.Empty(t, second.employees)
+	})
+	t.Run("InvalidContract", func(t *testing.T) {
+		employees := porttest.NewEmployees()
+		service := employee_service.NewHiringService(employees, nil)
+		f, contract := newFactories()
+		contract.ContractType = "pkwt"
+
+		_, err := service.Hire(ctx, f, contract)
+
+		assert.EqualError(t, err, "pkwt contract requires an end date")
+		assert.Equal(t, 0, employees.Saved)
+	})
+}
@@ -0,0 +1,139 @@
+package employee_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	offboarding_entity "github.com/rfanazhari/hris/domain/entity/offboarding"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	offboarding_service "github.com/rfanazhari/hris/domain/service/offboarding"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"sort"
+	"time"
+)
+
+// Terminator schedules the termination of an employee's contract. It is satisfied by
+// offboarding_service.OffboardingService.
+type Terminator interface {
+	Initiate(ctx context.Context, req offboarding_service.InitiateRequest) (*offboarding_entity.Termination, error)
+}
+
+// ProbationReminder is an ongoing probation whose evaluation is due.
+type ProbationReminder struct {
+	EmployeeID uuid.UUID
+	ContractID uuid.UUID
+	EndDate    time.Time
+	// DaysLeft is negative once the probation has ended without a decision.
+	DaysLeft int
+}
+
+// ProbationService tracks the probation periods of PKWTT and permanent contracts: it
+// reminds reviewers of evaluations coming up and records the outcome, confirming the
+// employee, extending the probation within the legal maximum or ending the employment.
+type ProbationService struct {
+	employees  port.EmployeeRepository
+	terminator Terminator
+	clock      clock.Clock
+}
+
+// NewProbationService returns a ProbationService. A nil clock falls back to the system clock.
+func NewProbationService(employees port.EmployeeRepository, terminator Terminator, clk clock.Clock) *ProbationService {
+	if clk == nil {
+		clk = clock.System{}
+	}
+	return &ProbationService{employees: employees, terminator: terminator, clock: clk}
+}
+
+// Reminders returns the ongoing probations of current employees that end within the given
+// days, ProbationReminderDays if days is not positive, or have ended without a decision,
+// soonest first.
+func (s *ProbationService) Reminders(ctx context.Context, days int) ([]ProbationReminder, error) {
+	if days <= 0 {
+		days = employee_entity.ProbationReminderDays
+	}
+	now := s.clock.Now()
+	employees, err := s.employees.ListByEmploymentPeriod(ctx, now, now)
+	if err != nil {
+		return nil, fmt.Errorf("list employees: %w", err)
+	}
+
+	var out []ProbationReminder
+	for i := range employees {
+		for _, c := range employees[i].EmploymentContracts() {
+			probation := c.Probation()
+			if probation == nil || !probation.EvaluationDue(now, days) {
+				continue
+			}
+			out = append(out, ProbationReminder{
+				EmployeeID: employees[i].ID(),
+				ContractID: c.ID(),
+				EndDate:    probation.EndDate(),
+				DaysLeft:   probation.DaysLeft(now),
+			})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].EndDate.Before(out[j].EndDate) })
+	return out, nil
+}
+
+// Confirm records that the employee passed probation and makes them active.
+func (s *ProbationService) Confirm(ctx context.Context, employeeID, contractID, reviewerID uuid.UUID, note string) (*employee_entity.Employee, error) {
+	return s.update(ctx, employeeID, func(e *employee_entity.Employee) error {
+		return e.ConfirmProbation(contractID, reviewerID, note, s.clock.Now())
+	})
+}
+
+// Extend moves the end of the probation, which may not exceed MaxProbationMonths in total.
+func (s *ProbationService) Extend(ctx context.Context, employeeID, contractID uuid.UUID, endDate time.Time, reviewerID uuid.UUID, note string) (*employee_entity.Employee, error) {
+	return s.update(ctx, employeeID, func(e *employee_entity.Employee) error {
+		return e.ExtendProbation(contractID, endDate, reviewerID, note, s.clock.Now())
+	})
+}
+
+// Terminate records that the employee failed probation and then schedules the termination
+// of the contract on the final working day, which must fall within the probation. The
+// failure stays recorded when scheduling the termination fails; the error names the step
+// that needs to be retried.
+func (s *ProbationService) Terminate(ctx context.Context, employeeID, contractID uuid.UUID, lastWorkingDay time.Time, reviewerID uuid.UUID, note string) (*offboarding_entity.Termination, error) {
+	now := s.clock.Now()
+	_, err := s.update(ctx, employeeID, func(e *employee_entity.Employee) error {
+		for _, c := range e.EmploymentContracts() {
+			if p := c.Probation(); c.ID() == contractID && p != nil && (lastWorkingDay.IsZero() || lastWorkingDay.After(p.EndDate())) {
+				return errors.New("final working day must fall within the probation period")
+			}
+		}
+		return e.FailProbation(contractID, reviewerID, note, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	termination, err := s.terminator.Initiate(ctx, offboarding_service.InitiateRequest{
+		EmployeeID:     employeeID,
+		Reason:         string(enum.TerminationFailedProbation),
+		NoticeDate:     now,
+		LastWorkingDay: lastWorkingDay,
+		Note:           note,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("probation failed: %w", err)
+	}
+	return termination, nil
+}
+
+func (s *ProbationService) update(ctx context.Context, employeeID uuid.UUID, change func(*employee_entity.Employee) error) (*employee_entity.Employee, error) {
+	employee, err := s.employees.FindByID(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("find employee: %w", err)
+	}
+	if err := change(employee); err != nil {
+		return nil, err
+	}
+	if err := s.employees.Save(ctx, employee); err != nil {
+		return nil, fmt.Errorf("save employee: %w", err)
+	}
+	return employee, nil
+}
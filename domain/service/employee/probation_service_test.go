@@ -0,0 +1,95 @@
+package employee_service_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	employee_service "github.com/rfanazhari/hris/domain/service/employee"
+	offboarding_service "github.com/rfanazhari/hris/domain/service/offboarding"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// hireOnProbation hires an employee on a PKWTT contract starting on start with a probation of
+// the given months.
+func hireOnProbation(t *testing.T, employees *porttest.Employees, start time.Time, months int) (*employee_entity.Employee, uuid.UUID) {
+	personalInfo, _ := employee_entity.PersonalInfoFactory{
+		FirstName: "Putri", LastName: "Anggraini", PlaceOfBirth: "padang",
+		Gender: "F", Nationality: "wni", MaritalStatus: "single", Religion: "islam",
+	}.Create()
+	probationEnd := start.AddDate(0, months, -1)
+	contractID := uuid.NewString()
+	employee, err := employee_service.NewHiringService(employees, &clock.Fixed{At: start}).Hire(context.Background(),
+		employee_entity.EmployeeFactory{ID: uuid.NewString(), PersonalInfo: personalInfo, Status: "active"},
+		employee_entity.EmploymentContractFactory{ID: contractID, ContractType: "pkwtt", StartDate: start, ProbationEnd: &probationEnd, Status: "active"},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	record, _ := employee_entity.SalaryRecordFactory{ID: uuid.NewString(), Amount: 7_000_000, Currency: "IDR", EffectiveDate: start}.Create()
+	_ = employee.AddSalaryRecord(*record, time.Time{})
+	return employee, uuid.MustParse(contractID)
+}
+
+func TestProbationService(t *testing.T) {
+	ctx := context.Background()
+	clk := &clock.Fixed{At: date(2025, 3, 20)}
+	employees := porttest.NewEmployees()
+	terminations := &porttest.Terminations{}
+	service := employee_service.NewProbationService(employees,
+		offboarding_service.NewOffboardingService(terminations, employees, nil, nil, clk), clk)
+	reviewer := uuid.New()
+
+	first, firstContract := hireOnProbation(t, employees, date(2025, 1, 1), 3)
+	second, secondContract := hireOnProbation(t, employees, date(2025, 3, 1), 2)
+	assert.Equal(t, enum.EmploymentProbation, first.Status())
+
+	t.Run("Reminders", func(t *testing.T) {
+		reminders, err := service.Reminders(ctx, 0)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []employee_service.ProbationReminder{
+			{EmployeeID: first.ID(), ContractID: firstContract, EndDate: date(2025, 3, 31), DaysLeft: 11},
+		}, reminders)
+
+		reminders, _ = service.Reminders(ctx, 60)
+		assert.Len(t, reminders, 2)
+		assert.Equal(t, second.ID(), reminders[1].EmployeeID)
+	})
+	t.Run("Confirm", func(t *testing.T) {
+		employee, err := service.Confirm(ctx, first.ID(), firstContract, reviewer, "passed")
+
+		assert.Nil(t, err)
+		assert.Equal(t, enum.EmploymentActive, employee.Status())
+		reminders, _ := service.Reminders(ctx, 0)
+		assert.Empty(t, reminders)
+	})
+	t.Run("ExtendAndTerminate", func(t *testing.T) {
+		_, err := service.Extend(ctx, second.ID(), secondContract, date(2025, 6, 1), reviewer, "")
+		assert.EqualError(t, err, "probation cannot exceed 3 months")
+		employee, err := service.Extend(ctx, second.ID(), secondContract, date(2025, 5, 31), reviewer, "another month")
+		assert.Nil(t, err)
+		contracts := employee.EmploymentContracts()
+		assert.Equal(t, date(2025, 5, 31), contracts[0].Probation().EndDate())
+
+		_, err = service.Terminate(ctx, second.ID(), secondContract, date(2025, 6, 30), reviewer, "missed targets")
+		assert.EqualError(t, err, "final working day must fall within the probation period")
+		termination, err := service.Terminate(ctx, second.ID(), secondContract, date(2025, 4, 30), reviewer, "missed targets")
+
+		assert.Nil(t, err)
+		assert.Equal(t, enum.TerminationFailedProbation, termination.Reason())
+		assert.Equal(t, int64(0), termination.Severance().Pesangon())
+		contracts = second.EmploymentContracts()
+		assert.Equal(t, enum.ProbationFailed, contracts[0].Probation().Status())
+		assert.Equal(t, date(2025, 4, 30), *contracts[0].EndDate())
+		assert.Equal(t, enum.EmploymentProbation, second.Status())
+	})
+}
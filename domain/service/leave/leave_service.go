@@ -0,0 +1,379 @@
+package leave_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	leave_entity "github.com/rfanazhari/hris/domain/entity/leave"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"time"
+)
+
+// SubmitRequest holds the data of a new leave request.
+type SubmitRequest struct {
+	EmployeeID uuid.UUID
+	LeaveType  enum.LeaveType
+	StartDate  time.Time
+	EndDate    time.Time
+	Reason     string
+	Attachment *valueobject.FileReference
+}
+
+// LeaveService manages leave requests, the leave balance ledger and the employment
+// status of employees during approved leave.
+type LeaveService struct {
+	employees port.EmployeeRepository
+	requests  port.LeaveRequestRepository
+	ledgers   port.LeaveLedgerRepository
+	days      port.WorkingDayCounter
+	policies  map[enum.LeaveType]leave_entity.LeaveTypePolicy
+	accrual   leave_entity.AccrualPolicy
+	clock     clock.Clock
+}
+
+// NewLeaveService returns a LeaveService using the statutory leave type and accrual policies.
+// A nil day counter counts Monday to Friday as working days and a nil clock falls back to
+// the system clock.
+func NewLeaveService(employees port.EmployeeRepository, requests port.LeaveRequestRepository, ledgers port.LeaveLedgerRepository, days port.WorkingDayCounter, clk clock.Clock) *LeaveService {
+	if days == nil {
+		days = weekdays{}
+	}
+	if clk == nil {
+		clk = clock.System{}
+	}
+	s := &LeaveService{
+		employees: employees,
+		requests:  requests,
+		ledgers:   ledgers,
+		days:      days,
+		policies:  map[enum.LeaveType]leave_entity.LeaveTypePolicy{},
+		accrual:   leave_entity.DefaultAccrualPolicy(),
+		clock:     clk,
+	}
+	for _, p := range leave_entity.DefaultLeaveTypePolicies() {
+		s.policies[p.LeaveType()] = p
+	}
+	return s
+}
+
+// SetPolicy replaces the rules of one leave type, e.g. with a company regulation (peraturan perusahaan).
+func (s *LeaveService) SetPolicy(policy leave_entity.LeaveTypePolicy) {
+	s.policies[policy.LeaveType()] = policy
+}
+
+// SetAccrualPolicy replaces the annual leave accrual policy.
+func (s *LeaveService) SetAccrualPolicy(policy leave_entity.AccrualPolicy) {
+	s.accrual = policy
+}
+
+// Submit validates and stores a pending leave request. Days are counted in working days,
+// or calendar days when the leave type says so; balance tracked leave must be covered by
+// the balance on the first day of leave.
+func (s *LeaveService) Submit(ctx context.Context, req SubmitRequest) (*leave_entity.LeaveRequest, error) {
+	policy, ok := s.policies[req.LeaveType]
+	if !ok {
+		return nil, fmt.Errorf("no policy for %s leave", req.LeaveType)
+	}
+	employee, err := s.employees.FindByID(ctx, req.EmployeeID)
+	if err != nil {
+		return nil, fmt.Errorf("find employee: %w", err)
+	}
+	if employee.HasLeft() {
+		return nil, errors.New("employee has left")
+	}
+
+	days := 0
+	if !req.StartDate.IsZero() && !req.EndDate.Before(req.StartDate) {
+		if policy.CalendarDays() {
+			days = calendarDaysBetween(req.StartDate, req.EndDate)
+		} else {
+			days = s.days.WorkingDaysBetween(req.StartDate, req.EndDate)
+		}
+	}
+	if !req.StartDate.IsZero() && !req.EndDate.IsZero() && days == 0 {
+		return nil, errors.New("leave period has no working days")
+	}
+
+	request, err := leave_entity.LeaveRequestFactory{
+		ID:         uuid.NewString(),
+		EmployeeID: req.EmployeeID.String(),
+		LeaveType:  string(req.LeaveType),
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+		Days:       days,
+		Reason:     req.Reason,
+		Attachment: req.Attachment,
+		CreatedAt:  s.clock.Now(),
+	}.Create()
+	if err != nil {
+		return nil, err
+	}
+	personalInfo := employee.PersonalInfo()
+	if err := policy.Check(request, personalInfo.Gender()); err != nil {
+		return nil, err
+	}
+
+	if policy.BalanceTracked() {
+		ledger, err := s.ledger(ctx, req.EmployeeID)
+		if err != nil {
+			return nil, err
+		}
+		if ledger.Balance(request.LeaveType(), request.StartDate()) < request.Days() {
+			return nil, errors.New("insufficient leave balance")
+		}
+	}
+
+	if err := s.requests.Save(ctx, request); err != nil {
+		return nil, fmt.Errorf("save leave request: %w", err)
+	}
+	return request, nil
+}
+
+// Approve approves a pending request, deducts balance tracked leave from the ledger and
+// updates the employee's employment status.
+func (s *LeaveService) Approve(ctx context.Context, requestID, approverID uuid.UUID) (*leave_entity.LeaveRequest, error) {
+	request, err := s.requests.FindByID(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("find leave request: %w", err)
+	}
+	now := s.clock.Now()
+	if err := request.Approve(approverID, now); err != nil {
+		return nil, err
+	}
+
+	if s.policies[request.LeaveType()].BalanceTracked() {
+		ledger, err := s.ledger(ctx, request.EmployeeID())
+		if err != nil {
+			return nil, err
+		}
+		if err := ledger.Consume(request.LeaveType(), request.Days(), request.StartDate(), request.ID().String()); err != nil {
+			return nil, err
+		}
+		if err := s.ledgers.Save(ctx, ledger); err != nil {
+			return nil, fmt.Errorf("save leave ledger: %w", err)
+		}
+	}
+
+	if err := s.requests.Save(ctx, request); err != nil {
+		return nil, fmt.Errorf("save leave request: %w", err)
+	}
+	if err := s.SyncEmploymentStatus(ctx, request.EmployeeID()); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// Reject rejects a pending request with a note.
+func (s *LeaveService) Reject(ctx context.Context, requestID, approverID uuid.UUID, note string) (*leave_entity.LeaveRequest, error) {
+	request, err := s.requests.FindByID(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("find leave request: %w", err)
+	}
+	if err := request.Reject(approverID, note, s.clock.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.requests.Save(ctx, request); err != nil {
+		return nil, fmt.Errorf("save leave request: %w", err)
+	}
+	return request, nil
+}
+
+// Cancel withdraws a request. Days of cancelled approved leave are restored to the ledger.
+func (s *LeaveService) Cancel(ctx context.Context, requestID uuid.UUID) (*leave_entity.LeaveRequest, error) {
+	request, err := s.requests.FindByID(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("find leave request: %w", err)
+	}
+	wasApproved := request.Status() == enum.LeaveRequestApproved
+	now := s.clock.Now()
+	if err := request.Cancel(now); err != nil {
+		return nil, err
+	}
+
+	if wasApproved && s.policies[request.LeaveType()].BalanceTracked() {
+		ledger, err := s.ledger(ctx, request.EmployeeID())
+		if err != nil {
+			return nil, err
+		}
+		if err := ledger.Reverse(request.ID().String(), now); err != nil {
+			return nil, err
+		}
+		if err := s.ledgers.Save(ctx, ledger); err != nil {
+			return nil, fmt.Errorf("save leave ledger: %w", err)
+		}
+	}
+
+	if err := s.requests.Save(ctx, request); err != nil {
+		return nil, fmt.Errorf("save leave request: %w", err)
+	}
+	return request, nil
+}
+
+// AccrueAnnual grants the employee's annual leave for the given year on their work
+// anniversary. It is a no-op before the first anniversary and when already granted.
+func (s *LeaveService) AccrueAnnual(ctx context.Context, employeeID uuid.UUID, year int) error {
+	employee, err := s.employees.FindByID(ctx, employeeID)
+	if err != nil {
+		return fmt.Errorf("find employee: %w", err)
+	}
+	grantDate, days := s.accrual.AnnualGrant(employee.HireDate(), year)
+	if days == 0 {
+		return nil
+	}
+
+	ledger, err := s.ledger(ctx, employeeID)
+	if err != nil {
+		return err
+	}
+	reference := fmt.Sprintf("annual-%d", year)
+	for _, e := range ledger.Entries() {
+		if e.EntryType() == enum.LeaveEntryAccrual && e.Reference() == reference {
+			return nil
+		}
+	}
+	if err := ledger.Accrue(enum.LeaveAnnual, days, grantDate, reference); err != nil {
+		return err
+	}
+	if err := s.ledgers.Save(ctx, ledger); err != nil {
+		return fmt.Errorf("save leave ledger: %w", err)
+	}
+	return nil
+}
+
+// CloseYear forfeits or carries over the employee's unused annual leave of the given year
+// and lapses carried over days that expired by now.
+func (s *LeaveService) CloseYear(ctx context.Context, employeeID uuid.UUID, year int) error {
+	ledger, err := s.ledger(ctx, employeeID)
+	if err != nil {
+		return err
+	}
+	if err := ledger.CloseYear(year, s.accrual, s.clock.Now().Location()); err != nil {
+		return err
+	}
+	ledger.ExpireCarryOver(s.clock.Now())
+	if err := s.ledgers.Save(ctx, ledger); err != nil {
+		return fmt.Errorf("save leave ledger: %w", err)
+	}
+	return nil
+}
+
+// Balance returns the employee's balance of the leave type on the given date.
+func (s *LeaveService) Balance(ctx context.Context, employeeID uuid.UUID, leaveType enum.LeaveType, at time.Time) (int, error) {
+	ledger, err := s.ledger(ctx, employeeID)
+	if err != nil {
+		return 0, err
+	}
+	return ledger.Balance(leaveType, at), nil
+}
+
+// IsOnLeave reports whether the employee has approved leave covering the date.
+// It implements port.LeaveChecker.
+func (s *LeaveService) IsOnLeave(ctx context.Context, employeeID uuid.UUID, date time.Time) (bool, error) {
+	requests, err := s.requests.ListByEmployee(ctx, employeeID, date, date)
+	if err != nil {
+		return false, fmt.Errorf("list leave requests: %w", err)
+	}
+	for i := range requests {
+		if requests[i].Covers(date) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// SyncEmploymentStatus moves an active employee to on leave while approved leave covers
+// today, and back to active once it no longer does. Other statuses are left untouched.
+func (s *LeaveService) SyncEmploymentStatus(ctx context.Context, employeeID uuid.UUID) error {
+	employee, err := s.employees.FindByID(ctx, employeeID)
+	if err != nil {
+		return fmt.Errorf("find employee: %w", err)
+	}
+	_, err = s.syncStatus(ctx, employee)
+	return err
+}
+
+// SyncEmploymentStatuses runs SyncEmploymentStatus for every employee employed today. It
+// is meant to run daily, shortly after midnight, so leave approved in advance moves the
+// employee to on leave on its first day and back to active after its last. It returns the
+// number of employees whose status changed.
+func (s *LeaveService) SyncEmploymentStatuses(ctx context.Context) (int, error) {
+	now := s.clock.Now()
+	employees, err := s.employees.ListByEmploymentPeriod(ctx, now, now)
+	if err != nil {
+		return 0, fmt.Errorf("list employees: %w", err)
+	}
+	changed := 0
+	for i := range employees {
+		ok, err := s.syncStatus(ctx, &employees[i])
+		if err != nil {
+			return changed, err
+		}
+		if ok {
+			changed++
+		}
+	}
+	return changed, nil
+}
+
+func (s *LeaveService) syncStatus(ctx context.Context, employee *employee_entity.Employee) (bool, error) {
+	now := s.clock.Now()
+	onLeave, err := s.IsOnLeave(ctx, employee.ID(), now)
+	if err != nil {
+		return false, err
+	}
+
+	var status enum.EmploymentStatus
+	var reason string
+	switch {
+	case onLeave && employee.Status() == enum.EmploymentActive:
+		status, reason = enum.EmploymentOnLeave, "approved leave started"
+	case !onLeave && employee.Status() == enum.EmploymentOnLeave:
+		status, reason = enum.EmploymentActive, "approved leave ended"
+	default:
+		return false, nil
+	}
+	if err := employee.ChangeStatus(status, now, reason, now); err != nil {
+		return false, err
+	}
+	if err := s.employees.Save(ctx, employee); err != nil {
+		return false, fmt.Errorf("save employee: %w", err)
+	}
+	return true, nil
+}
+
+func (s *LeaveService) ledger(ctx context.Context, employeeID uuid.UUID) (*leave_entity.LeaveLedger, error) {
+	ledger, err := s.ledgers.FindByEmployee(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("find leave ledger: %w", err)
+	}
+	if ledger == nil {
+		return leave_entity.NewLeaveLedger(employeeID)
+	}
+	return ledger, nil
+}
+
+// weekdays counts Monday to Friday as working days.
+type weekdays struct{}
+
+func (weekdays) WorkingDaysBetween(from, to time.Time) int {
+	count := 0
+	for d := dateOf(from); !d.After(dateOf(to)); d = d.AddDate(0, 0, 1) {
+		if d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
+			count++
+		}
+	}
+	return count
+}
+
+func calendarDaysBetween(from, to time.Time) int {
+	return int(dateOf(to).Sub(dateOf(from)).Hours()/24) + 1
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
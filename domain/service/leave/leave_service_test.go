@@ -0,0 +1,201 @@
+package leave_service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	leave_entity "github.com/rfanazhari/hris/domain/entity/leave"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	leave_service "github.com/rfanazhari/hris/domain/service/leave"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type memoryRequests struct {
+	requests map[uuid.UUID]*leave_entity.LeaveRequest
+}
+
+func (m *memoryRequests) Save(_ context.Context, request *leave_entity.LeaveRequest) error {
+	m.requests[request.ID()] = request
+	return nil
+}
+
+func (m *memoryRequests) FindByID(_ context.Context, id uuid.UUID) (*leave_entity.LeaveRequest, error) {
+	if r, ok := m.requests[id]; ok {
+		return r, nil
+	}
+	return nil, errors.New("leave request not found")
+}
+
+func (m *memoryRequests) ListByEmployee(_ context.Context, employeeID uuid.UUID, from, to time.Time) ([]leave_entity.LeaveRequest, error) {
+	var out []leave_entity.LeaveRequest
+	for _, r := range m.requests {
+		if r.EmployeeID() == employeeID && !r.StartDate().After(to) && !r.EndDate().Before(from.Truncate(24*time.Hour)) {
+			out = append(out, *r)
+		}
+	}
+	return out, nil
+}
+
+type memoryLedgers map[uuid.UUID]*leave_entity.LeaveLedger
+
+func (m memoryLedgers) Save(_ context.Context, ledger *leave_entity.LeaveLedger) error {
+	m[ledger.EmployeeID()] = ledger
+	return nil
+}
+
+func (m memoryLedgers) FindByEmployee(_ context.Context, employeeID uuid.UUID) (*leave_entity.LeaveLedger, error) {
+	return m[employeeID], nil
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+type fixture struct {
+	service   *leave_service.LeaveService
+	employees *porttest.Employees
+	ledgers   memoryLedgers
+	clock     *clock.Fixed
+	employee  *employee_entity.Employee
+}
+
+func newFixture(t *testing.T, gender string) fixture {
+	employee := porttest.NewEmployee(t, porttest.Hire{Gender: gender, MaritalStatus: "married", Start: date(2023, 2, 1)})
+	f := fixture{
+		employees: porttest.NewEmployees(employee),
+		ledgers:   memoryLedgers{},
+		clock:     &clock.Fixed{At: time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)},
+		employee:  employee,
+	}
+	requests := &memoryRequests{requests: map[uuid.UUID]*leave_entity.LeaveRequest{}}
+	f.service = leave_service.NewLeaveService(f.employees, requests, f.ledgers, nil, f.clock)
+	return f
+}
+
+func TestLeaveService_AnnualLeave(t *testing.T) {
+	ctx := context.Background()
+	approver := uuid.New()
+
+	t.Run("SubmitApproveAndSyncStatus", func(t *testing.T) {
+		f := newFixture(t, "F")
+		assert.Nil(t, f.service.AccrueAnnual(ctx, f.employee.ID(), 2025))
+		assert.Nil(t, f.service.AccrueAnnual(ctx, f.employee.ID(), 2025))
+		balance, _ := f.service.Balance(ctx, f.employee.ID(), enum.LeaveAnnual, date(2025, 2, 1))
+		assert.Equal(t, 12, balance)
+
+		// Friday to Tuesday spans a weekend: 3 working days.
+		req, err := f.service.Submit(ctx, leave_service.SubmitRequest{
+			EmployeeID: f.employee.ID(), LeaveType: enum.LeaveAnnual, StartDate: date(2025, 3, 7), EndDate: date(2025, 3, 11),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 3, req.Days())
+
+		_, err = f.service.Approve(ctx, req.ID(), approver)
+		assert.Nil(t, err)
+		balance, _ = f.service.Balance(ctx, f.employee.ID(), enum.LeaveAnnual, date(2025, 3, 31))
+		assert.Equal(t, 9, balance)
+		assert.Equal(t, enum.EmploymentActive, f.employee.Status())
+
+		f.clock.At = date(2025, 3, 10)
+		assert.Nil(t, f.service.SyncEmploymentStatus(ctx, f.employee.ID()))
+		assert.Equal(t, enum.EmploymentOnLeave, f.employee.Status())
+		onLeave, _ := f.service.IsOnLeave(ctx, f.employee.ID(), date(2025, 3, 11))
+		assert.True(t, onLeave)
+
+		f.clock.At = date(2025, 3, 12)
+		assert.Nil(t, f.service.SyncEmploymentStatus(ctx, f.employee.ID()))
+		assert.Equal(t, enum.EmploymentActive, f.employee.Status())
+	})
+	t.Run("DailySyncOfLeaveApprovedInAdvance", func(t *testing.T) {
+		f := newFixture(t, "F")
+		_ = f.service.AccrueAnnual(ctx, f.employee.ID(), 2025)
+		req, _ := f.service.Submit(ctx, leave_service.SubmitRequest{
+			EmployeeID: f.employee.ID(), LeaveType: enum.LeaveAnnual, StartDate: date(2025, 3, 10), EndDate: date(2025, 3, 11),
+		})
+		_, err := f.service.Approve(ctx, req.ID(), approver)
+		assert.Nil(t, err)
+		assert.Equal(t, enum.EmploymentActive, f.employee.Status())
+
+		f.clock.At = date(2025, 3, 10)
+		changed, err := f.service.SyncEmploymentStatuses(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, changed)
+		employee, _ := f.employees.FindByID(ctx, f.employee.ID())
+		assert.Equal(t, enum.EmploymentOnLeave, employee.Status())
+
+		f.clock.At = date(2025, 3, 11)
+		changed, _ = f.service.SyncEmploymentStatuses(ctx)
+		assert.Equal(t, 0, changed)
+
+		f.clock.At = date(2025, 3, 12)
+		changed, _ = f.service.SyncEmploymentStatuses(ctx)
+		assert.Equal(t, 1, changed)
+		employee, _ = f.employees.FindByID(ctx, f.employee.ID())
+		assert.Equal(t, enum.EmploymentActive, employee.Status())
+	})
+	t.Run("InsufficientBalance", func(t *testing.T) {
+		f := newFixture(t, "M")
+
+		_, err := f.service.Submit(ctx, leave_service.SubmitRequest{
+			EmployeeID: f.employee.ID(), LeaveType: enum.LeaveAnnual, StartDate: date(2025, 3, 10), EndDate: date(2025, 3, 10),
+		})
+		assert.EqualError(t, err, "insufficient leave balance")
+	})
+	t.Run("CancelRestoresBalance", func(t *testing.T) {
+		f := newFixture(t, "M")
+		_ = f.service.AccrueAnnual(ctx, f.employee.ID(), 2025)
+		req, _ := f.service.Submit(ctx, leave_service.SubmitRequest{
+			EmployeeID: f.employee.ID(), LeaveType: enum.LeaveAnnual, StartDate: date(2025, 4, 14), EndDate: date(2025, 4, 15),
+		})
+		_, _ = f.service.Approve(ctx, req.ID(), approver)
+
+		cancelled, err := f.service.Cancel(ctx, req.ID())
+		assert.Nil(t, err)
+		assert.Equal(t, enum.LeaveRequestCancelled, cancelled.Status())
+		balance, _ := f.service.Balance(ctx, f.employee.ID(), enum.LeaveAnnual, date(2025, 4, 30))
+		assert.Equal(t, 12, balance)
+	})
+}
+
+func TestLeaveService_StatutoryLeave(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("MaternityCountsCalendarDays", func(t *testing.T) {
+		f := newFixture(t, "F")
+
+		req, err := f.service.Submit(ctx, leave_service.SubmitRequest{
+			EmployeeID: f.employee.ID(), LeaveType: enum.LeaveMaternity, StartDate: date(2025, 5, 1), EndDate: date(2025, 7, 29),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 90, req.Days())
+	})
+	t.Run("MaternityNotForMale", func(t *testing.T) {
+		f := newFixture(t, "M")
+
+		_, err := f.service.Submit(ctx, leave_service.SubmitRequest{
+			EmployeeID: f.employee.ID(), LeaveType: enum.LeaveMaternity, StartDate: date(2025, 5, 1), EndDate: date(2025, 5, 30),
+		})
+		assert.EqualError(t, err, "maternity leave is not available for this employee")
+	})
+	t.Run("MarriageLimit", func(t *testing.T) {
+		f := newFixture(t, "M")
+
+		_, err := f.service.Submit(ctx, leave_service.SubmitRequest{
+			EmployeeID: f.employee.ID(), LeaveType: enum.LeaveMarriage, StartDate: date(2025, 5, 5), EndDate: date(2025, 5, 8),
+		})
+		assert.EqualError(t, err, "marriage leave cannot exceed 3 days")
+	})
+	t.Run("WeekendOnly", func(t *testing.T) {
+		f := newFixture(t, "M")
+
+		_, err := f.service.Submit(ctx, leave_service.SubmitRequest{
+			EmployeeID: f.employee.ID(), LeaveType: enum.LeaveUnpaid, StartDate: date(2025, 5, 3), EndDate: date(2025, 5, 4),
+		})
+		assert.EqualError(t, err, "leave period has no working days")
+	})
+}
@@ -0,0 +1,179 @@
+package loan_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	loan_entity "github.com/rfanazhari/hris/domain/entity/loan"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"sort"
+	"time"
+)
+
+// lineCodes maps loan types to the payslip lines their deductions appear on.
+var lineCodes = map[enum.LoanType]string{
+	enum.LoanCompany:       payroll_entity.LineLoan,
+	enum.LoanSalaryAdvance: payroll_entity.LineSalaryAdvance,
+}
+
+// LoanService grants loans and salary advances, pauses and settles them, and records the
+// installments deducted by payroll.
+type LoanService struct {
+	loans     port.LoanRepository
+	employees port.EmployeeRepository
+	runs      port.PayrollRunRepository
+	clock     clock.Clock
+}
+
+// NewLoanService returns a LoanService. A nil clock falls back to the system clock.
+func NewLoanService(loans port.LoanRepository, employees port.EmployeeRepository, runs port.PayrollRunRepository, clk clock.Clock) *LoanService {
+	if clk == nil {
+		clk = clock.System{}
+	}
+	return &LoanService{loans: loans, employees: employees, runs: runs, clock: clk}
+}
+
+// Grant creates a loan for an employee who is employed when the first installment is due.
+func (s *LoanService) Grant(ctx context.Context, f loan_entity.LoanFactory) (*loan_entity.Loan, error) {
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = s.clock.Now()
+	}
+	loan, err := f.Create()
+	if err != nil {
+		return nil, err
+	}
+	employee, err := s.employees.FindByID(ctx, loan.EmployeeID())
+	if err != nil {
+		return nil, fmt.Errorf("find employee: %w", err)
+	}
+	if !employee.IsEmployedOn(loan.FirstMonth().AddDate(0, 1, -1)) {
+		return nil, errors.New("employee is not employed when the first installment is due")
+	}
+	if err := s.loans.Save(ctx, loan); err != nil {
+		return nil, fmt.Errorf("save loan: %w", err)
+	}
+	return loan, nil
+}
+
+// Pause suspends the loan's deductions from the month of from.
+func (s *LoanService) Pause(ctx context.Context, loanID uuid.UUID, from time.Time) (*loan_entity.Loan, error) {
+	return s.update(ctx, loanID, func(loan *loan_entity.Loan) error {
+		return loan.Pause(from, s.clock.Now())
+	})
+}
+
+// Resume restarts the loan's deductions from the month of from.
+func (s *LoanService) Resume(ctx context.Context, loanID uuid.UUID, from time.Time) (*loan_entity.Loan, error) {
+	return s.update(ctx, loanID, func(loan *loan_entity.Loan) error {
+		return loan.Resume(from, s.clock.Now())
+	})
+}
+
+// Settle records the early repayment of the remaining principal on the given date.
+func (s *LoanService) Settle(ctx context.Context, loanID uuid.UUID, date time.Time) (*loan_entity.Loan, error) {
+	return s.update(ctx, loanID, func(loan *loan_entity.Loan) error {
+		_, err := loan.Settle(date, s.clock.Now())
+		return err
+	})
+}
+
+// OpenLoans returns the employee's unsettled loans, oldest first; it is used by the payroll
+// LoanComponent.
+func (s *LoanService) OpenLoans(ctx context.Context, employeeID uuid.UUID) ([]loan_entity.Loan, error) {
+	loans, err := s.loans.ListByEmployee(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("list loans: %w", err)
+	}
+	var out []loan_entity.Loan
+	for _, l := range loans {
+		if l.Status() != enum.LoanSettled {
+			out = append(out, l)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].CreatedAt().Before(out[j].CreatedAt()) })
+	return out, nil
+}
+
+// RecordPayroll records the loan and salary advance deductions of an approved payroll run
+// as repayments, allocating each payslip's deduction to the employee's loans oldest first
+// just as the LoanComponent computed it. Every open loan is settled in the employee's last
+// period, and the principal its deduction does not cover is recorded as a receivable.
+// Recording a run twice has no effect.
+func (s *LoanService) RecordPayroll(ctx context.Context, runID uuid.UUID) error {
+	run, err := s.runs.FindByID(ctx, runID)
+	if err != nil {
+		return fmt.Errorf("find payroll run: %w", err)
+	}
+	if !run.IsLocked() {
+		return errors.New("payroll run is not approved")
+	}
+	period := run.Period()
+	now := s.clock.Now()
+
+	for _, slip := range run.Payslips() {
+		loans, err := s.OpenLoans(ctx, slip.EmployeeID())
+		if err != nil {
+			return err
+		}
+		if len(loans) == 0 || recorded(loans, run.ID()) {
+			continue
+		}
+		employee, err := s.employees.FindByID(ctx, slip.EmployeeID())
+		if err != nil {
+			return fmt.Errorf("find employee: %w", err)
+		}
+		final := !employee.IsEmployedOn(period.End().AddDate(0, 0, 1))
+
+		remaining := map[enum.LoanType]int64{}
+		for loanType, code := range lineCodes {
+			if line, ok := slip.Line(code); ok {
+				remaining[loanType] = line.Amount()
+			}
+		}
+		for i := range loans {
+			loan := &loans[i]
+			amount := min(loan.Deduction(period, final), remaining[loan.Type()])
+			if amount <= 0 && !final {
+				continue
+			}
+			if err := loan.RecordPayrollRepayment(run.ID(), amount, period.End(), final, now); err != nil {
+				return err
+			}
+			remaining[loan.Type()] -= amount
+			if err := s.loans.Save(ctx, loan); err != nil {
+				return fmt.Errorf("save loan: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *LoanService) update(ctx context.Context, loanID uuid.UUID, change func(*loan_entity.Loan) error) (*loan_entity.Loan, error) {
+	loan, err := s.loans.FindByID(ctx, loanID)
+	if err != nil {
+		return nil, fmt.Errorf("find loan: %w", err)
+	}
+	if err := change(loan); err != nil {
+		return nil, err
+	}
+	if err := s.loans.Save(ctx, loan); err != nil {
+		return nil, fmt.Errorf("save loan: %w", err)
+	}
+	return loan, nil
+}
+
+// recorded reports whether the run's deductions were already recorded on any of the loans.
+func recorded(loans []loan_entity.Loan, runID uuid.UUID) bool {
+	for _, l := range loans {
+		for _, r := range l.Repayments() {
+			if r.RunID() != nil && *r.RunID() == runID {
+				return true
+			}
+		}
+	}
+	return false
+}
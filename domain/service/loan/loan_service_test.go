@@ -0,0 +1,198 @@
+package loan_service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	loan_entity "github.com/rfanazhari/hris/domain/entity/loan"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	loan_service "github.com/rfanazhari/hris/domain/service/loan"
+	payroll_service "github.com/rfanazhari/hris/domain/service/payroll"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type memoryLoans struct {
+	loans []*loan_entity.Loan
+}
+
+func (m *memoryLoans) Save(_ context.Context, loan *loan_entity.Loan) error {
+	for i, l := range m.loans {
+		if l.ID() == loan.ID() {
+			m.loans[i] = loan
+			return nil
+		}
+	}
+	m.loans = append(m.loans, loan)
+	return nil
+}
+
+func (m *memoryLoans) FindByID(_ context.Context, id uuid.UUID) (*loan_entity.Loan, error) {
+	for _, l := range m.loans {
+		if l.ID() == id {
+			return l, nil
+		}
+	}
+	return nil, errors.New("loan not found")
+}
+
+func (m *memoryLoans) ListByEmployee(_ context.Context, employeeID uuid.UUID) ([]loan_entity.Loan, error) {
+	var out []loan_entity.Loan
+	for _, l := range m.loans {
+		if l.EmployeeID() == employeeID {
+			out = append(out, *l)
+		}
+	}
+	return out, nil
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newEmployee(t *testing.T, salary int64, end *time.Time) *employee_entity.Employee {
+	return porttest.NewEmployee(t, porttest.Hire{Start: date(2024, 1, 1), End: end, Salary: salary})
+}
+
+type fixture struct {
+	store   *memoryLoans
+	loans   *loan_service.LoanService
+	payroll *payroll_service.PayrollService
+}
+
+func newFixture(employee *employee_entity.Employee) fixture {
+	employees := porttest.NewEmployees(employee)
+	runs := porttest.NewPayrollRuns()
+	store := &memoryLoans{}
+	loans := loan_service.NewLoanService(store, employees, runs, clock.Fixed{At: date(2025, 3, 20)})
+	payroll := payroll_service.NewPayrollService(employees, runs, nil, clock.Fixed{At: date(2025, 3, 25)},
+		payroll_service.NewLoanComponent(loans, 70),
+	)
+	return fixture{store: store, loans: loans, payroll: payroll}
+}
+
+// pay calculates, approves and records the run of the month and returns the payslip.
+func (f fixture) pay(t *testing.T, employeeID uuid.UUID, month time.Month) payroll_entity.Payslip {
+	ctx := context.Background()
+	run, err := f.payroll.CreateRun(ctx, payroll_entity.MonthlyPayPeriod(2025, month), "IDR")
+	assert.Nil(t, err)
+	run, err = f.payroll.Calculate(ctx, run.ID())
+	assert.Nil(t, err)
+	_, err = f.payroll.Approve(ctx, run.ID(), uuid.New())
+	assert.Nil(t, err)
+	assert.Nil(t, f.loans.RecordPayroll(ctx, run.ID()))
+	assert.Nil(t, f.loans.RecordPayroll(ctx, run.ID()))
+	slip, _ := run.Payslip(employeeID)
+	return slip
+}
+
+func amountOf(slip payroll_entity.Payslip, code string) int64 {
+	line, _ := slip.Line(code)
+	return line.Amount()
+}
+
+func TestLoanService_Grant(t *testing.T) {
+	ctx := context.Background()
+	end := date(2025, 3, 31)
+	employee := newEmployee(t, 10_000_000, &end)
+	f := newFixture(employee)
+
+	loan, err := f.loans.Grant(ctx, loan_entity.LoanFactory{ID: uuid.NewString(), EmployeeID: employee.ID().String(), Type: "loan", Principal: 1_000_000, FirstMonth: date(2025, 3, 1)})
+	assert.Nil(t, err)
+	assert.Equal(t, date(2025, 3, 20), loan.CreatedAt())
+
+	_, err = f.loans.Grant(ctx, loan_entity.LoanFactory{ID: uuid.NewString(), EmployeeID: employee.ID().String(), Type: "loan", Principal: 1_000_000, FirstMonth: date(2025, 4, 1)})
+	assert.EqualError(t, err, "employee is not employed when the first installment is due")
+	_, err = f.loans.Grant(ctx, loan_entity.LoanFactory{ID: uuid.NewString(), EmployeeID: uuid.NewString(), Type: "loan", Principal: 1_000_000, FirstMonth: date(2025, 3, 1)})
+	assert.EqualError(t, err, "find employee: employee not found")
+}
+
+func TestLoanService_Payroll(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("TakeHomeCap", func(t *testing.T) {
+		// 70% of 10.000.000 must be taken home, so at most 3.000.000 is deducted a month.
+		employee := newEmployee(t, 10_000_000, nil)
+		f := newFixture(employee)
+		loan, _ := f.loans.Grant(ctx, loan_entity.LoanFactory{ID: uuid.NewString(), EmployeeID: employee.ID().String(), Type: "loan", Principal: 12_000_000, InterestRate: 1200, Installments: 12, FirstMonth: date(2025, 4, 1)})
+		advance, _ := f.loans.Grant(ctx, loan_entity.LoanFactory{ID: uuid.NewString(), EmployeeID: employee.ID().String(), Type: "salary_advance", Principal: 3_000_000, FirstMonth: date(2025, 4, 1)})
+
+		april := f.pay(t, employee.ID(), time.April)
+		assert.Equal(t, int64(1_120_000), amountOf(april, payroll_entity.LineLoan))
+		assert.Equal(t, int64(1_880_000), amountOf(april, payroll_entity.LineSalaryAdvance))
+		assert.Equal(t, int64(7_000_000), april.NetPay())
+
+		open, _ := f.loans.OpenLoans(ctx, employee.ID())
+		assert.Equal(t, []uuid.UUID{loan.ID(), advance.ID()}, []uuid.UUID{open[0].ID(), open[1].ID()})
+		assert.Equal(t, int64(1_120_000), open[1].Outstanding())
+
+		_, err := f.loans.Pause(ctx, loan.ID(), date(2025, 5, 1))
+		assert.Nil(t, err)
+		may := f.pay(t, employee.ID(), time.May)
+		assert.Equal(t, int64(0), amountOf(may, payroll_entity.LineLoan))
+		assert.Equal(t, int64(1_120_000), amountOf(may, payroll_entity.LineSalaryAdvance))
+
+		open, _ = f.loans.OpenLoans(ctx, employee.ID())
+		assert.Len(t, open, 1)
+		assert.Equal(t, enum.LoanPaused, open[0].Status())
+
+		settled, err := f.loans.Settle(ctx, loan.ID(), date(2025, 6, 5))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(11_000_000), settled.Repayments()[1].Amount())
+		june := f.pay(t, employee.ID(), time.June)
+		assert.Equal(t, int64(0), june.TotalDeductions())
+	})
+	t.Run("TerminationSettlesBalance", func(t *testing.T) {
+		end := date(2025, 5, 31)
+		employee := newEmployee(t, 10_000_000, &end)
+		f := newFixture(employee)
+		loan, _ := f.loans.Grant(ctx, loan_entity.LoanFactory{ID: uuid.NewString(), EmployeeID: employee.ID().String(), Type: "loan", Principal: 3_000_000, Installments: 3, FirstMonth: date(2025, 4, 1)})
+		advance, _ := f.loans.Grant(ctx, loan_entity.LoanFactory{ID: uuid.NewString(), EmployeeID: employee.ID().String(), Type: "salary_advance", Principal: 3_000_000, FirstMonth: date(2025, 4, 1)})
+
+		f.pay(t, employee.ID(), time.April)
+		may := f.pay(t, employee.ID(), time.May)
+
+		// The last pay is not capped: the remaining 2.000.000 and 1.000.000 are deducted.
+		assert.Equal(t, int64(2_000_000), amountOf(may, payroll_entity.LineLoan))
+		assert.Equal(t, int64(1_000_000), amountOf(may, payroll_entity.LineSalaryAdvance))
+		assert.Equal(t, int64(7_000_000), may.NetPay())
+		open, _ := f.loans.OpenLoans(ctx, employee.ID())
+		assert.Empty(t, open)
+		_, err := f.loans.Settle(ctx, loan.ID(), end)
+		assert.EqualError(t, err, "loan is settled")
+		_, err = f.loans.Settle(ctx, advance.ID(), end)
+		assert.EqualError(t, err, "loan is settled")
+	})
+	t.Run("TerminationShortfallIsReceivable", func(t *testing.T) {
+		end := date(2025, 5, 31)
+		employee := newEmployee(t, 10_000_000, &end)
+		f := newFixture(employee)
+		loan, _ := f.loans.Grant(ctx, loan_entity.LoanFactory{ID: uuid.NewString(), EmployeeID: employee.ID().String(), Type: "loan", Principal: 15_000_000, Installments: 3, FirstMonth: date(2025, 4, 1)})
+
+		april := f.pay(t, employee.ID(), time.April)
+		assert.Equal(t, int64(3_000_000), amountOf(april, payroll_entity.LineLoan))
+		may := f.pay(t, employee.ID(), time.May)
+
+		// The net pay covers 10.000.000 of the remaining 12.000.000; the rest is owed outside payroll.
+		assert.Equal(t, int64(10_000_000), amountOf(may, payroll_entity.LineLoan))
+		open, _ := f.loans.OpenLoans(ctx, employee.ID())
+		assert.Empty(t, open)
+		_, err := f.loans.Settle(ctx, loan.ID(), end)
+		assert.EqualError(t, err, "loan is settled")
+		loan, _ = f.store.FindByID(ctx, loan.ID())
+		assert.Equal(t, int64(2_000_000), loan.Receivable())
+		assert.Equal(t, int64(13_000_000), loan.Repaid())
+	})
+	t.Run("UnapprovedRun", func(t *testing.T) {
+		employee := newEmployee(t, 10_000_000, nil)
+		f := newFixture(employee)
+		run, _ := f.payroll.CreateRun(ctx, payroll_entity.MonthlyPayPeriod(2025, time.April), "IDR")
+
+		assert.EqualError(t, f.loans.RecordPayroll(ctx, run.ID()), "payroll run is not approved")
+	})
+}
@@ -0,0 +1,230 @@
+package offboarding_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	offboarding_entity "github.com/rfanazhari/hris/domain/entity/offboarding"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"time"
+)
+
+// LeaveBalanceSource returns the leave days an employee has left. It is satisfied by
+// leave_service.LeaveService.
+type LeaveBalanceSource interface {
+	Balance(ctx context.Context, employeeID uuid.UUID, leaveType enum.LeaveType, at time.Time) (int, error)
+}
+
+// InitiateRequest describes the termination of an employee's employment.
+type InitiateRequest struct {
+	EmployeeID     uuid.UUID
+	Reason         string
+	NoticeDate     time.Time
+	NoticeWaived   bool
+	LastWorkingDay time.Time
+	// OtherEntitlements are further rights payable as uang penggantian hak, e.g. the cost
+	// of returning home to where the employee was hired.
+	OtherEntitlements int64
+	Note              string
+}
+
+// OffboardingService processes terminations of employment: it schedules the termination
+// with its severance, ends the employment contract on the final working day, tracks the
+// return of company assets and finally closes the contract and sets the employee's status.
+type OffboardingService struct {
+	terminations port.TerminationRepository
+	employees    port.EmployeeRepository
+	leave        LeaveBalanceSource
+	calendars    port.WorkCalendarProvider
+	clock        clock.Clock
+}
+
+// NewOffboardingService returns an OffboardingService. Without a leave source no unused
+// leave is paid out; without calendars the five-day work week is assumed. A nil clock
+// falls back to the system clock.
+func NewOffboardingService(terminations port.TerminationRepository, employees port.EmployeeRepository, leave LeaveBalanceSource, calendars port.WorkCalendarProvider, clk clock.Clock) *OffboardingService {
+	if clk == nil {
+		clk = clock.System{}
+	}
+	return &OffboardingService{terminations: terminations, employees: employees, leave: leave, calendars: calendars, clock: clk}
+}
+
+// Initiate schedules the termination of the employee's contract in effect on the final
+// working day. The severance is based on the salary in effect on that day and the annual
+// leave left over, and the contract's end date is moved to the final working day.
+func (s *OffboardingService) Initiate(ctx context.Context, req InitiateRequest) (*offboarding_entity.Termination, error) {
+	now := s.clock.Now()
+	terminations, err := s.terminations.ListByEmployee(ctx, req.EmployeeID)
+	if err != nil {
+		return nil, fmt.Errorf("list terminations: %w", err)
+	}
+	for _, t := range terminations {
+		if t.Status() == enum.TerminationScheduled {
+			return nil, errors.New("employee already has a scheduled termination")
+		}
+	}
+
+	employee, err := s.employees.FindByID(ctx, req.EmployeeID)
+	if err != nil {
+		return nil, fmt.Errorf("find employee: %w", err)
+	}
+	if employee.HasLeft() {
+		return nil, errors.New("employee has left")
+	}
+	if req.LastWorkingDay.IsZero() {
+		return nil, errors.New("final working day cannot be empty")
+	}
+	contract, ok := employee.ActiveContract(req.LastWorkingDay)
+	if !ok {
+		return nil, errors.New("no employment contract in effect on the final working day")
+	}
+	salary, ok := employee.SalaryAt(req.LastWorkingDay)
+	if !ok {
+		return nil, fmt.Errorf("no salary record in effect on %s", req.LastWorkingDay.Format(time.DateOnly))
+	}
+
+	var unusedLeave int
+	if s.leave != nil {
+		unusedLeave, err = s.leave.Balance(ctx, employee.ID(), enum.LeaveAnnual, req.LastWorkingDay)
+		if err != nil {
+			return nil, fmt.Errorf("find leave balance: %w", err)
+		}
+	}
+	workWeek := enum.WorkWeekFiveDay
+	if s.calendars != nil {
+		cal, err := s.calendars.WorkCalendar(ctx, employee.OrganizationUnitID(), req.LastWorkingDay.Year())
+		if err != nil {
+			return nil, fmt.Errorf("find work calendar: %w", err)
+		}
+		workWeek = cal.WorkWeek()
+	}
+
+	termination, err := offboarding_entity.TerminationFactory{
+		ID:                uuid.NewString(),
+		EmployeeID:        employee.ID().String(),
+		ContractID:        contract.ID().String(),
+		Reason:            req.Reason,
+		NoticeDate:        req.NoticeDate,
+		NoticeWaived:      req.NoticeWaived,
+		LastWorkingDay:    req.LastWorkingDay,
+		HireDate:          employee.HireDate(),
+		ContractType:      string(contract.ContractType()),
+		ContractStart:     contract.StartDate(),
+		ContractEnd:       contract.EndDate(),
+		MonthlyWage:       salary.Amount(),
+		UnusedLeaveDays:   max(unusedLeave, 0),
+		WorkWeek:          string(workWeek),
+		OtherEntitlements: req.OtherEntitlements,
+		Note:              req.Note,
+		CreatedAt:         now,
+	}.Create()
+	if err != nil {
+		return nil, err
+	}
+	if !employee_entity.CanChangeStatus(employee.Status(), termination.EmploymentStatus()) {
+		return nil, fmt.Errorf("employee cannot change from %s to %s", employee.Status(), termination.EmploymentStatus())
+	}
+
+	lastDay := termination.LastWorkingDay()
+	if err := employee.SetContractEnd(contract.ID(), &lastDay, now); err != nil {
+		return nil, err
+	}
+	if err := s.save(ctx, termination, employee); err != nil {
+		return nil, err
+	}
+	return termination, nil
+}
+
+// AddAsset lists a company asset the employee has to hand back.
+func (s *OffboardingService) AddAsset(ctx context.Context, terminationID uuid.UUID, tag, name string) (*offboarding_entity.Termination, error) {
+	return s.update(ctx, terminationID, func(t *offboarding_entity.Termination) error {
+		return t.AddAsset(tag, name, s.clock.Now())
+	})
+}
+
+// ReturnAsset records that the asset was handed back to the receiver.
+func (s *OffboardingService) ReturnAsset(ctx context.Context, terminationID uuid.UUID, tag string, receivedBy uuid.UUID) (*offboarding_entity.Termination, error) {
+	return s.update(ctx, terminationID, func(t *offboarding_entity.Termination) error {
+		return t.ReturnAsset(tag, receivedBy, s.clock.Now())
+	})
+}
+
+// WaiveAsset closes an asset that will not be returned, e.g. because it was lost.
+func (s *OffboardingService) WaiveAsset(ctx context.Context, terminationID uuid.UUID, tag string, actorID uuid.UUID, note string) (*offboarding_entity.Termination, error) {
+	return s.update(ctx, terminationID, func(t *offboarding_entity.Termination) error {
+		return t.WaiveAsset(tag, actorID, note, s.clock.Now())
+	})
+}
+
+// Complete finishes a termination once the final working day has passed and all assets
+// are returned: the contract is closed and the employee's status set by the reason from
+// the day after the final working day.
+func (s *OffboardingService) Complete(ctx context.Context, terminationID uuid.UUID) (*offboarding_entity.Termination, error) {
+	now := s.clock.Now()
+	return s.updateWithEmployee(ctx, terminationID, func(t *offboarding_entity.Termination, employee *employee_entity.Employee) error {
+		if err := t.Complete(now); err != nil {
+			return err
+		}
+		if err := employee.CloseContract(t.ContractID(), t.ContractStatus(), now); err != nil {
+			return err
+		}
+		return employee.ChangeStatus(t.EmploymentStatus(), t.LastWorkingDay().AddDate(0, 0, 1), string(t.Reason()), now)
+	})
+}
+
+// Cancel withdraws a scheduled termination and restores the contract's end date.
+func (s *OffboardingService) Cancel(ctx context.Context, terminationID uuid.UUID) (*offboarding_entity.Termination, error) {
+	now := s.clock.Now()
+	return s.updateWithEmployee(ctx, terminationID, func(t *offboarding_entity.Termination, employee *employee_entity.Employee) error {
+		if err := t.Cancel(now); err != nil {
+			return err
+		}
+		return employee.SetContractEnd(t.ContractID(), t.PreviousEndDate(), now)
+	})
+}
+
+func (s *OffboardingService) update(ctx context.Context, id uuid.UUID, change func(*offboarding_entity.Termination) error) (*offboarding_entity.Termination, error) {
+	termination, err := s.terminations.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find termination: %w", err)
+	}
+	if err := change(termination); err != nil {
+		return nil, err
+	}
+	if err := s.terminations.Save(ctx, termination); err != nil {
+		return nil, fmt.Errorf("save termination: %w", err)
+	}
+	return termination, nil
+}
+
+func (s *OffboardingService) updateWithEmployee(ctx context.Context, id uuid.UUID, change func(*offboarding_entity.Termination, *employee_entity.Employee) error) (*offboarding_entity.Termination, error) {
+	termination, err := s.terminations.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find termination: %w", err)
+	}
+	employee, err := s.employees.FindByID(ctx, termination.EmployeeID())
+	if err != nil {
+		return nil, fmt.Errorf("find employee: %w", err)
+	}
+	if err := change(termination, employee); err != nil {
+		return nil, err
+	}
+	if err := s.save(ctx, termination, employee); err != nil {
+		return nil, err
+	}
+	return termination, nil
+}
+
+func (s *OffboardingService) save(ctx context.Context, termination *offboarding_entity.Termination, employee *employee_entity.Employee) error {
+	if err := s.employees.Save(ctx, employee); err != nil {
+		return fmt.Errorf("save employee: %w", err)
+	}
+	if err := s.terminations.Save(ctx, termination); err != nil {
+		return fmt.Errorf("save termination: %w", err)
+	}
+	return nil
+}
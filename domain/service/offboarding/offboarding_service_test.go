@@ -0,0 +1,134 @@
+package offboarding_service_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	offboarding_service "github.com/rfanazhari/hris/domain/service/offboarding"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type leaveBalance int
+
+func (l leaveBalance) Balance(context.Context, uuid.UUID, enum.LeaveType, time.Time) (int, error) {
+	return int(l), nil
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newEmployee(t *testing.T, salary int64, start time.Time, end *time.Time) *employee_entity.Employee {
+	return porttest.NewEmployee(t, porttest.Hire{Start: start, End: end, Salary: salary})
+}
+
+func contractOf(employee *employee_entity.Employee) *employee_entity.EmploymentContract {
+	return &employee.EmploymentContracts()[0]
+}
+
+func TestOffboardingService_Layoff(t *testing.T) {
+	ctx := context.Background()
+	clk := &clock.Fixed{At: date(2025, 3, 1)}
+	employee := newEmployee(t, 10_000_000, date(2017, 4, 1), nil)
+	terminations := &porttest.Terminations{}
+	service := offboarding_service.NewOffboardingService(terminations, porttest.NewEmployees(employee), leaveBalance(5), nil, clk)
+	ga := uuid.New()
+
+	termination, err := service.Initiate(ctx, offboarding_service.InitiateRequest{
+		EmployeeID: employee.ID(), Reason: "layoff", LastWorkingDay: date(2025, 3, 31),
+	})
+	assert.Nil(t, err)
+	// Eight years: 9 months of pesangon, 3 of UPMK and 5/21 of a month for unused leave.
+	severance := termination.Severance()
+	assert.Equal(t, int64(90_000_000), severance.Pesangon())
+	assert.Equal(t, int64(30_000_000), severance.UPMK())
+	assert.Equal(t, int64(2_380_952), severance.UPH())
+	assert.Equal(t, date(2025, 3, 31), *contractOf(employee).EndDate())
+	assert.Equal(t, enum.EmploymentActive, employee.Status())
+
+	_, err = service.Initiate(ctx, offboarding_service.InitiateRequest{
+		EmployeeID: employee.ID(), Reason: "layoff", LastWorkingDay: date(2025, 3, 31),
+	})
+	assert.EqualError(t, err, "employee already has a scheduled termination")
+
+	_, err = service.AddAsset(ctx, termination.ID(), "LPT-0042", "Laptop")
+	assert.Nil(t, err)
+	clk.At = date(2025, 4, 1)
+	_, err = service.Complete(ctx, termination.ID())
+	assert.EqualError(t, err, "1 assets are not returned yet")
+
+	_, err = service.ReturnAsset(ctx, termination.ID(), "LPT-0042", ga)
+	assert.Nil(t, err)
+	termination, err = service.Complete(ctx, termination.ID())
+
+	assert.Nil(t, err)
+	assert.Equal(t, enum.TerminationCompleted, termination.Status())
+	assert.Equal(t, enum.EmploymentTerminated, employee.Status())
+	assert.Equal(t, enum.EmploymentActive, employee.StatusOn(date(2025, 3, 31)))
+	assert.Equal(t, "layoff", employee.StatusHistory()[0].Reason())
+	assert.Equal(t, enum.ContractStatusTerminated, contractOf(employee).Status())
+	assert.True(t, employee.IsEmployedOn(date(2025, 3, 31)))
+	assert.False(t, employee.IsEmployedOn(date(2025, 4, 1)))
+}
+
+func TestOffboardingService_Resignation(t *testing.T) {
+	ctx := context.Background()
+	clk := &clock.Fixed{At: date(2025, 5, 1)}
+	end := date(2025, 12, 31)
+	employee := newEmployee(t, 8_000_000, date(2025, 1, 1), &end)
+	service := offboarding_service.NewOffboardingService(&porttest.Terminations{}, porttest.NewEmployees(employee), nil, nil, clk)
+
+	t.Run("ShortNotice", func(t *testing.T) {
+		_, err := service.Initiate(ctx, offboarding_service.InitiateRequest{
+			EmployeeID: employee.ID(), Reason: "resignation", LastWorkingDay: date(2025, 5, 15),
+		})
+
+		assert.EqualError(t, err, "resignation must be submitted at least 30 days before leaving")
+		assert.Equal(t, end, *contractOf(employee).EndDate())
+	})
+	t.Run("AfterContractEnd", func(t *testing.T) {
+		_, err := service.Initiate(ctx, offboarding_service.InitiateRequest{
+			EmployeeID: employee.ID(), Reason: "resignation", LastWorkingDay: date(2026, 1, 31),
+		})
+
+		assert.EqualError(t, err, "no employment contract in effect on the final working day")
+	})
+	t.Run("Cancel", func(t *testing.T) {
+		termination, err := service.Initiate(ctx, offboarding_service.InitiateRequest{
+			EmployeeID: employee.ID(), Reason: "resignation", LastWorkingDay: date(2025, 6, 30),
+		})
+		assert.Nil(t, err)
+		// A PKWT contract ended early still pays compensation for the six months worked.
+		assert.Equal(t, int64(4_000_000), termination.Severance().Compensation())
+		assert.Equal(t, date(2025, 6, 30), *contractOf(employee).EndDate())
+
+		termination, err = service.Cancel(ctx, termination.ID())
+
+		assert.Nil(t, err)
+		assert.Equal(t, enum.TerminationCancelled, termination.Status())
+		assert.Equal(t, end, *contractOf(employee).EndDate())
+		assert.Equal(t, enum.ContractStatusActive, contractOf(employee).Status())
+	})
+}
+
+func TestOffboardingService_Retirement(t *testing.T) {
+	ctx := context.Background()
+	clk := &clock.Fixed{At: date(2025, 3, 1)}
+	employee := newEmployee(t, 10_000_000, date(2025, 1, 1), nil)
+	service := offboarding_service.NewOffboardingService(&porttest.Terminations{}, porttest.NewEmployees(employee), nil, nil, clk)
+	// Back on probation after a suspension; a probationer cannot retire.
+	assert.Nil(t, employee.ChangeStatus(enum.EmploymentSuspended, date(2025, 2, 1), "investigation", clk.At))
+	assert.Nil(t, employee.ChangeStatus(enum.EmploymentProbation, date(2025, 2, 15), "probation resumed", clk.At))
+
+	_, err := service.Initiate(ctx, offboarding_service.InitiateRequest{
+		EmployeeID: employee.ID(), Reason: "retirement", LastWorkingDay: date(2025, 3, 31),
+	})
+
+	assert.EqualError(t, err, "employee cannot change from probation to retired")
+	assert.Nil(t, contractOf(employee).EndDate())
+}
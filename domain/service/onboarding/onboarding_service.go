@@ -0,0 +1,230 @@
+package onboarding_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	onboarding_entity "github.com/rfanazhari/hris/domain/entity/onboarding"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"sort"
+	"time"
+)
+
+// OverdueTask is a pending onboarding task past its due date.
+type OverdueTask struct {
+	ChecklistID uuid.UUID
+	EmployeeID  uuid.UUID
+	Task        onboarding_entity.Task
+}
+
+// OnboardingService keeps the onboarding templates and instantiates a new hire's checklist
+// from the template for their organization unit and contract type. It implements
+// employee_service.HireHandler so checklists start when an employee is hired.
+type OnboardingService struct {
+	templates  port.OnboardingTemplateRepository
+	checklists port.OnboardingChecklistRepository
+	employees  port.EmployeeRepository
+	units      port.OrganizationUnitRepository
+	clock      clock.Clock
+}
+
+// NewOnboardingService returns an OnboardingService. units may be nil, in which case only
+// templates for the employee's own unit apply, not those of its parent units. A nil clock
+// falls back to the system clock.
+func NewOnboardingService(templates port.OnboardingTemplateRepository, checklists port.OnboardingChecklistRepository, employees port.EmployeeRepository, units port.OrganizationUnitRepository, clk clock.Clock) *OnboardingService {
+	if clk == nil {
+		clk = clock.System{}
+	}
+	return &OnboardingService{templates: templates, checklists: checklists, employees: employees, units: units, clock: clk}
+}
+
+// DefineTemplate saves a new template. There is at most one template per combination of
+// organization unit and contract type.
+func (s *OnboardingService) DefineTemplate(ctx context.Context, f onboarding_entity.TemplateFactory) (*onboarding_entity.Template, error) {
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = s.clock.Now()
+	}
+	template, err := f.Create()
+	if err != nil {
+		return nil, err
+	}
+	templates, err := s.templates.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list onboarding templates: %w", err)
+	}
+	for _, t := range templates {
+		if template.SameScope(t) {
+			return nil, errors.New("an onboarding template for this unit and contract type already exists")
+		}
+	}
+	if err := s.templates.Save(ctx, template); err != nil {
+		return nil, fmt.Errorf("save onboarding template: %w", err)
+	}
+	return template, nil
+}
+
+// EmployeeHired implements employee_service.HireHandler. It starts the employee's checklist
+// unless no template applies or the employee already has one.
+func (s *OnboardingService) EmployeeHired(ctx context.Context, employee *employee_entity.Employee) error {
+	existing, err := s.checklists.FindByEmployee(ctx, employee.ID())
+	if err != nil {
+		return fmt.Errorf("find onboarding checklist: %w", err)
+	}
+	if existing != nil {
+		return nil
+	}
+	template, err := s.templateFor(ctx, employee)
+	if err != nil || template == nil {
+		return err
+	}
+	_, err = s.start(ctx, employee, template)
+	return err
+}
+
+// Start instantiates the checklist of an employee hired before onboarding templates were
+// in place.
+func (s *OnboardingService) Start(ctx context.Context, employeeID uuid.UUID) (*onboarding_entity.Checklist, error) {
+	employee, err := s.employees.FindByID(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("find employee: %w", err)
+	}
+	existing, err := s.checklists.FindByEmployee(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("find onboarding checklist: %w", err)
+	}
+	if existing != nil {
+		return nil, errors.New("employee already has an onboarding checklist")
+	}
+	template, err := s.templateFor(ctx, employee)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		return nil, errors.New("no onboarding template applies to the employee")
+	}
+	return s.start(ctx, employee, template)
+}
+
+// CompleteTask marks a task of the checklist as done.
+func (s *OnboardingService) CompleteTask(ctx context.Context, checklistID uuid.UUID, key string, actorID uuid.UUID, note string) (*onboarding_entity.Checklist, error) {
+	return s.update(ctx, checklistID, func(checklist *onboarding_entity.Checklist) error {
+		return checklist.Complete(key, actorID, note, s.clock.Now())
+	})
+}
+
+// SkipTask closes a task that does not apply to the employee.
+func (s *OnboardingService) SkipTask(ctx context.Context, checklistID uuid.UUID, key string, actorID uuid.UUID, note string) (*onboarding_entity.Checklist, error) {
+	return s.update(ctx, checklistID, func(checklist *onboarding_entity.Checklist) error {
+		return checklist.Skip(key, actorID, note, s.clock.Now())
+	})
+}
+
+// Overdue returns the tasks of every open checklist that are past their due date today,
+// earliest due first.
+func (s *OnboardingService) Overdue(ctx context.Context) ([]OverdueTask, error) {
+	checklists, err := s.checklists.ListOpen(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list onboarding checklists: %w", err)
+	}
+	now := s.clock.Now()
+	var out []OverdueTask
+	for i := range checklists {
+		for _, t := range checklists[i].Overdue(now) {
+			out = append(out, OverdueTask{ChecklistID: checklists[i].ID(), EmployeeID: checklists[i].EmployeeID(), Task: t})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Task.DueDate().Before(out[j].Task.DueDate())
+	})
+	return out, nil
+}
+
+func (s *OnboardingService) start(ctx context.Context, employee *employee_entity.Employee, template *onboarding_entity.Template) (*onboarding_entity.Checklist, error) {
+	checklist, err := onboarding_entity.ChecklistFactory{
+		ID:         uuid.NewString(),
+		EmployeeID: employee.ID().String(),
+		Template:   template,
+		StartDate:  employee.HireDate(),
+		CreatedAt:  s.clock.Now(),
+	}.Create()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checklists.Save(ctx, checklist); err != nil {
+		return nil, fmt.Errorf("save onboarding checklist: %w", err)
+	}
+	return checklist, nil
+}
+
+// templateFor returns the template for the employee's unit and contract type, or nil. The
+// nearest unit wins, walking up the unit hierarchy; within a unit a template for the
+// contract type wins over one for every type. Templates for every unit come last.
+func (s *OnboardingService) templateFor(ctx context.Context, employee *employee_entity.Employee) (*onboarding_entity.Template, error) {
+	hired := employee.HireDate()
+	if hired.IsZero() {
+		return nil, errors.New("employee has no employment contract")
+	}
+	contractType := firstContractType(employee, hired)
+	templates, err := s.templates.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list onboarding templates: %w", err)
+	}
+	find := func(unitID *uuid.UUID) *onboarding_entity.Template {
+		for _, ct := range []*enum.ContractType{&contractType, nil} {
+			for i := range templates {
+				if templates[i].Matches(unitID, ct) {
+					return &templates[i]
+				}
+			}
+		}
+		return nil
+	}
+
+	visited := map[uuid.UUID]bool{}
+	for unitID := employee.OrganizationUnitID(); unitID != nil; {
+		if visited[*unitID] {
+			return nil, errors.New("organization unit hierarchy is cyclic")
+		}
+		visited[*unitID] = true
+		if t := find(unitID); t != nil {
+			return t, nil
+		}
+		if s.units == nil {
+			break
+		}
+		unit, err := s.units.FindByID(ctx, *unitID)
+		if err != nil {
+			return nil, fmt.Errorf("find organization unit: %w", err)
+		}
+		unitID = unit.ParentID()
+	}
+	return find(nil), nil
+}
+
+func (s *OnboardingService) update(ctx context.Context, id uuid.UUID, change func(*onboarding_entity.Checklist) error) (*onboarding_entity.Checklist, error) {
+	checklist, err := s.checklists.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find onboarding checklist: %w", err)
+	}
+	if err := change(checklist); err != nil {
+		return nil, err
+	}
+	if err := s.checklists.Save(ctx, checklist); err != nil {
+		return nil, fmt.Errorf("save onboarding checklist: %w", err)
+	}
+	return checklist, nil
+}
+
+// firstContractType returns the type of the contract the employee was hired on.
+func firstContractType(employee *employee_entity.Employee, hired time.Time) enum.ContractType {
+	for _, c := range employee.EmploymentContracts() {
+		if c.StartDate().Equal(hired) {
+			return c.ContractType()
+		}
+	}
+	return ""
+}
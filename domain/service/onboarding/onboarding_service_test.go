@@ -0,0 +1,226 @@
+package onboarding_service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/entity"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	onboarding_entity "github.com/rfanazhari/hris/domain/entity/onboarding"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	employee_service "github.com/rfanazhari/hris/domain/service/employee"
+	onboarding_service "github.com/rfanazhari/hris/domain/service/onboarding"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type memoryTemplates struct {
+	templates []onboarding_entity.Template
+}
+
+func (m *memoryTemplates) Save(_ context.Context, template *onboarding_entity.Template) error {
+	m.templates = append(m.templates, *template)
+	return nil
+}
+
+func (m *memoryTemplates) List(context.Context) ([]onboarding_entity.Template, error) {
+	return m.templates, nil
+}
+
+type memoryChecklists struct {
+	checklists []*onboarding_entity.Checklist
+}
+
+func (m *memoryChecklists) Save(_ context.Context, checklist *onboarding_entity.Checklist) error {
+	for i, c := range m.checklists {
+		if c.ID() == checklist.ID() {
+			m.checklists[i] = checklist
+			return nil
+		}
+	}
+	m.checklists = append(m.checklists, checklist)
+	return nil
+}
+
+func (m *memoryChecklists) FindByID(_ context.Context, id uuid.UUID) (*onboarding_entity.Checklist, error) {
+	for _, c := range m.checklists {
+		if c.ID() == id {
+			return c, nil
+		}
+	}
+	return nil, errors.New("onboarding checklist not found")
+}
+
+func (m *memoryChecklists) FindByEmployee(_ context.Context, employeeID uuid.UUID) (*onboarding_entity.Checklist, error) {
+	for _, c := range m.checklists {
+		if c.EmployeeID() == employeeID {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *memoryChecklists) ListOpen(context.Context) ([]onboarding_entity.Checklist, error) {
+	var out []onboarding_entity.Checklist
+	for _, c := range m.checklists {
+		if !c.IsComplete() {
+			out = append(out, *c)
+		}
+	}
+	return out, nil
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func task(t *testing.T, key string, owner enum.TaskOwner, offset int, dependsOn ...string) onboarding_entity.TaskTemplate {
+	tt, err := onboarding_entity.NewTaskTemplate(key, key, owner, offset, dependsOn...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return *tt
+}
+
+type fixture struct {
+	service     *onboarding_service.OnboardingService
+	hiring      *employee_service.HiringService
+	checklists  *memoryChecklists
+	clock       *clock.Fixed
+	engineering *entity.OrganizationUnit
+	backend     *entity.OrganizationUnit
+	sales       *entity.OrganizationUnit
+}
+
+// newFixture defines a default template, one for interns and one for engineering, whose
+// backend team inherits it.
+func newFixture(t *testing.T) fixture {
+	ctx := context.Background()
+	engineering, _ := entity.OrganizationUnitFactory{ID: uuid.NewString(), Name: "Engineering", Type: "division"}.Create()
+	backend, _ := entity.OrganizationUnitFactory{ID: uuid.NewString(), Name: "Backend", Type: "team", ParentUnitID: engineering.ID().String()}.Create()
+	sales, _ := entity.OrganizationUnitFactory{ID: uuid.NewString(), Name: "Sales", Type: "division"}.Create()
+	units := porttest.OrganizationUnits{engineering.ID(): engineering, backend.ID(): backend, sales.ID(): sales}
+	employees := porttest.NewEmployees()
+	checklists := &memoryChecklists{}
+	clk := &clock.Fixed{At: date(2025, 6, 20)}
+	service := onboarding_service.NewOnboardingService(&memoryTemplates{}, checklists, employees, units, clk)
+
+	for _, f := range []onboarding_entity.TemplateFactory{
+		{ID: uuid.NewString(), Name: "Default", Tasks: []onboarding_entity.TaskTemplate{
+			task(t, "collect_documents", enum.TaskOwnerHR, 0),
+			task(t, "register_bpjs", enum.TaskOwnerHR, 7, "collect_documents"),
+		}},
+		{ID: uuid.NewString(), Name: "Interns", ContractType: "internship", Tasks: []onboarding_entity.TaskTemplate{
+			task(t, "sign_internship_agreement", enum.TaskOwnerHR, 0),
+		}},
+		{ID: uuid.NewString(), Name: "Engineering", OrganizationUnitID: engineering.ID().String(), Tasks: []onboarding_entity.TaskTemplate{
+			task(t, "order_laptop", enum.TaskOwnerIT, -7),
+			task(t, "create_accounts", enum.TaskOwnerIT, 0),
+			task(t, "orientation", enum.TaskOwnerManager, 3, "create_accounts"),
+		}},
+	} {
+		if _, err := service.DefineTemplate(ctx, f); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	return fixture{
+		service:     service,
+		hiring:      employee_service.NewHiringService(employees, clk, service),
+		checklists:  checklists,
+		clock:       clk,
+		engineering: engineering,
+		backend:     backend,
+		sales:       sales,
+	}
+}
+
+func (f fixture) hire(t *testing.T, unit *entity.OrganizationUnit, contractType string) *employee_entity.Employee {
+	personalInfo, _ := employee_entity.PersonalInfoFactory{
+		FirstName: "Bayu", LastName: "Nugroho", PlaceOfBirth: "solo",
+		Gender: "M", Nationality: "wni", MaritalStatus: "single", Religion: "islam",
+	}.Create()
+	factory := employee_entity.EmployeeFactory{ID: uuid.NewString(), PersonalInfo: personalInfo, Status: "active"}
+	if unit != nil {
+		factory.OrganizationUnitID = unit.ID().String()
+	}
+	contract := employee_entity.EmploymentContractFactory{ID: uuid.NewString(), ContractType: contractType, StartDate: date(2025, 7, 1), Status: "active"}
+	if contractType == "pkwt" || contractType == "internship" {
+		end := date(2025, 12, 31)
+		contract.EndDate = &end
+	}
+	employee, err := f.hiring.Hire(context.Background(), factory, contract)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return employee
+}
+
+func (f fixture) checklist(t *testing.T, employee *employee_entity.Employee) *onboarding_entity.Checklist {
+	checklist, _ := f.checklists.FindByEmployee(context.Background(), employee.ID())
+	if checklist == nil {
+		t.Fatalf("no checklist for employee")
+	}
+	return checklist
+}
+
+func TestOnboardingService_EmployeeHired(t *testing.T) {
+	f := newFixture(t)
+
+	backend := f.checklist(t, f.hire(t, f.backend, "pkwtt"))
+	assert.Equal(t, date(2025, 7, 1), backend.StartDate())
+	laptop, ok := backend.Task("order_laptop")
+	assert.True(t, ok)
+	assert.Equal(t, date(2025, 6, 24), laptop.DueDate())
+
+	sales := f.checklist(t, f.hire(t, f.sales, "pkwtt"))
+	_, ok = sales.Task("register_bpjs")
+	assert.True(t, ok)
+
+	intern := f.checklist(t, f.hire(t, f.sales, "internship"))
+	_, ok = intern.Task("sign_internship_agreement")
+	assert.True(t, ok)
+
+	// The engineering template applies to the whole division, whatever the contract type.
+	engineeringIntern := f.checklist(t, f.hire(t, f.engineering, "internship"))
+	_, ok = engineeringIntern.Task("create_accounts")
+	assert.True(t, ok)
+
+	_, err := f.service.DefineTemplate(context.Background(), onboarding_entity.TemplateFactory{
+		ID: uuid.NewString(), Name: "Default 2", Tasks: []onboarding_entity.TaskTemplate{task(t, "welcome", enum.TaskOwnerHR, 0)},
+	})
+	assert.EqualError(t, err, "an onboarding template for this unit and contract type already exists")
+}
+
+func TestOnboardingService_Progress(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	employee := f.hire(t, f.backend, "pkwtt")
+	checklist := f.checklist(t, employee)
+	it := uuid.New()
+
+	overdue, err := f.service.Overdue(ctx)
+	assert.Nil(t, err)
+	assert.Empty(t, overdue)
+
+	f.clock.At = date(2025, 7, 2)
+	overdue, _ = f.service.Overdue(ctx)
+	assert.Len(t, overdue, 2)
+	assert.Equal(t, "order_laptop", overdue[0].Task.Key())
+	assert.Equal(t, employee.ID(), overdue[0].EmployeeID)
+
+	_, err = f.service.CompleteTask(ctx, checklist.ID(), "orientation", it, "")
+	assert.EqualError(t, err, "task orientation is waiting for create_accounts")
+	_, err = f.service.CompleteTask(ctx, checklist.ID(), "create_accounts", it, "")
+	assert.Nil(t, err)
+	checklist, err = f.service.SkipTask(ctx, checklist.ID(), "order_laptop", it, "laptop handed over from predecessor")
+	assert.Nil(t, err)
+	assert.Equal(t, 66, checklist.Percent())
+	overdue, _ = f.service.Overdue(ctx)
+	assert.Empty(t, overdue)
+
+	_, err = f.service.Start(ctx, employee.ID())
+	assert.EqualError(t, err, "employee already has an onboarding checklist")
+}
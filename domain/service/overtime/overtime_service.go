@@ -0,0 +1,248 @@
+package overtime_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	calendar_entity "github.com/rfanazhari/hris/domain/entity/calendar"
+	overtime_entity "github.com/rfanazhari/hris/domain/entity/overtime"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"time"
+)
+
+// SubmitRequest holds the data of a new overtime request.
+type SubmitRequest struct {
+	EmployeeID uuid.UUID
+	Start      time.Time
+	End        time.Time
+	Reason     string
+}
+
+// OvertimeService manages overtime requests, records the time actually worked from
+// attendance and computes overtime pay from the employee's salary record.
+type OvertimeService struct {
+	employees port.EmployeeRepository
+	overtimes port.OvertimeRepository
+	events    port.ClockEventRepository
+	calendars port.WorkCalendarProvider
+	clock     clock.Clock
+}
+
+// NewOvertimeService returns an OvertimeService. A nil clock falls back to the system clock.
+func NewOvertimeService(employees port.EmployeeRepository, overtimes port.OvertimeRepository, events port.ClockEventRepository, calendars port.WorkCalendarProvider, clk clock.Clock) *OvertimeService {
+	if clk == nil {
+		clk = clock.System{}
+	}
+	return &OvertimeService{employees: employees, overtimes: overtimes, events: events, calendars: calendars, clock: clk}
+}
+
+// Submit validates and stores a pending overtime request. The day type is taken from the
+// employee's work calendar; workday overtime must stay within the daily and weekly limits
+// of PP No. 35/2021 together with the employee's other active requests.
+func (s *OvertimeService) Submit(ctx context.Context, req SubmitRequest) (*overtime_entity.OvertimeRequest, error) {
+	employee, err := s.employees.FindByID(ctx, req.EmployeeID)
+	if err != nil {
+		return nil, fmt.Errorf("find employee: %w", err)
+	}
+	if employee.HasLeft() {
+		return nil, errors.New("employee has left")
+	}
+	cal, err := s.calendars.WorkCalendar(ctx, employee.OrganizationUnitID(), req.Start.Year())
+	if err != nil {
+		return nil, fmt.Errorf("find work calendar: %w", err)
+	}
+
+	request, err := overtime_entity.OvertimeRequestFactory{
+		ID:         uuid.NewString(),
+		EmployeeID: req.EmployeeID.String(),
+		DayType:    string(dayTypeOf(cal, req.Start)),
+		WorkWeek:   string(cal.WorkWeek()),
+		Start:      req.Start,
+		End:        req.End,
+		Reason:     req.Reason,
+		CreatedAt:  s.clock.Now(),
+	}.Create()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkLimits(ctx, request); err != nil {
+		return nil, err
+	}
+
+	if err := s.overtimes.Save(ctx, request); err != nil {
+		return nil, fmt.Errorf("save overtime request: %w", err)
+	}
+	return request, nil
+}
+
+// Approve approves a pending overtime request.
+func (s *OvertimeService) Approve(ctx context.Context, requestID, approverID uuid.UUID) (*overtime_entity.OvertimeRequest, error) {
+	return s.update(ctx, requestID, func(r *overtime_entity.OvertimeRequest) error {
+		return r.Approve(approverID, s.clock.Now())
+	})
+}
+
+// Reject rejects a pending overtime request with a note.
+func (s *OvertimeService) Reject(ctx context.Context, requestID, approverID uuid.UUID, note string) (*overtime_entity.OvertimeRequest, error) {
+	return s.update(ctx, requestID, func(r *overtime_entity.OvertimeRequest) error {
+		return r.Reject(approverID, note, s.clock.Now())
+	})
+}
+
+// Cancel withdraws an overtime request that has not been completed.
+func (s *OvertimeService) Cancel(ctx context.Context, requestID uuid.UUID) (*overtime_entity.OvertimeRequest, error) {
+	return s.update(ctx, requestID, func(r *overtime_entity.OvertimeRequest) error {
+		return r.Cancel(s.clock.Now())
+	})
+}
+
+// RecordActual completes an approved request with the overtime actually worked: the part of
+// the planned overtime covered by the employee's clock-in/clock-out session.
+func (s *OvertimeService) RecordActual(ctx context.Context, requestID uuid.UUID) (*overtime_entity.OvertimeRequest, error) {
+	request, err := s.overtimes.FindByID(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("find overtime request: %w", err)
+	}
+	events, err := s.events.ListByEmployee(ctx, request.EmployeeID(), request.Start().Add(-24*time.Hour), request.End().Add(24*time.Hour))
+	if err != nil {
+		return nil, fmt.Errorf("list clock events: %w", err)
+	}
+
+	// The session is the last clock-in before the planned end and the clock-out that closes it.
+	var in, out time.Time
+	for _, e := range events {
+		switch {
+		case e.Type() == enum.ClockIn && e.OccurredAt().Before(request.End()):
+			in, out = e.OccurredAt(), time.Time{}
+		case e.Type() == enum.ClockOut && !in.IsZero() && out.IsZero():
+			out = e.OccurredAt()
+		}
+	}
+
+	var actual time.Duration
+	if !in.IsZero() && !out.IsZero() {
+		from, to := request.Start(), request.End()
+		if in.After(from) {
+			from = in
+		}
+		if out.Before(to) {
+			to = out
+		}
+		if to.After(from) {
+			actual = to.Sub(from)
+		}
+	}
+
+	if err := request.Complete(actual, s.clock.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.overtimes.Save(ctx, request); err != nil {
+		return nil, fmt.Errorf("save overtime request: %w", err)
+	}
+	return request, nil
+}
+
+// Pay computes the pay of a completed request from the salary record in effect on its date.
+func (s *OvertimeService) Pay(ctx context.Context, requestID uuid.UUID) (int64, error) {
+	request, err := s.overtimes.FindByID(ctx, requestID)
+	if err != nil {
+		return 0, fmt.Errorf("find overtime request: %w", err)
+	}
+	employee, err := s.employees.FindByID(ctx, request.EmployeeID())
+	if err != nil {
+		return 0, fmt.Errorf("find employee: %w", err)
+	}
+	salary, ok := employee.SalaryAt(request.Start())
+	if !ok {
+		return 0, errors.New("no salary record in effect")
+	}
+	return request.Pay(salary.Amount())
+}
+
+// PayForPeriod sums the pay of the employee's completed overtime starting within [from, to].
+func (s *OvertimeService) PayForPeriod(ctx context.Context, employeeID uuid.UUID, from, to time.Time) (int64, error) {
+	requests, err := s.overtimes.ListByEmployee(ctx, employeeID, from, to)
+	if err != nil {
+		return 0, fmt.Errorf("list overtime requests: %w", err)
+	}
+	employee, err := s.employees.FindByID(ctx, employeeID)
+	if err != nil {
+		return 0, fmt.Errorf("find employee: %w", err)
+	}
+
+	var total int64
+	for i := range requests {
+		if requests[i].Status() != enum.OvertimeCompleted {
+			continue
+		}
+		salary, ok := employee.SalaryAt(requests[i].Start())
+		if !ok {
+			return 0, errors.New("no salary record in effect")
+		}
+		pay, err := requests[i].Pay(salary.Amount())
+		if err != nil {
+			return 0, err
+		}
+		total += pay
+	}
+	return total, nil
+}
+
+func (s *OvertimeService) checkLimits(ctx context.Context, request *overtime_entity.OvertimeRequest) error {
+	date := request.Date()
+	weekStart := date.AddDate(0, 0, -((int(date.Weekday()) + 6) % 7))
+	existing, err := s.overtimes.ListByEmployee(ctx, request.EmployeeID(), weekStart, weekStart.AddDate(0, 0, 7).Add(-time.Nanosecond))
+	if err != nil {
+		return fmt.Errorf("list overtime requests: %w", err)
+	}
+
+	daily, weekly := request.Planned(), request.Planned()
+	for i := range existing {
+		if !existing[i].IsActive() {
+			continue
+		}
+		if existing[i].Date().Equal(date) {
+			daily += existing[i].Planned()
+		}
+		if existing[i].DayType() == enum.OvertimeWorkday {
+			weekly += existing[i].Planned()
+		}
+	}
+
+	if daily > overtime_entity.MaxOvertime(request.DayType(), request.WorkWeek()) {
+		return errors.New("overtime exceeds the daily limit")
+	}
+	if request.DayType() == enum.OvertimeWorkday && weekly > overtime_entity.MaxWeeklyOvertime {
+		return errors.New("overtime exceeds the weekly limit")
+	}
+	return nil
+}
+
+func (s *OvertimeService) update(ctx context.Context, requestID uuid.UUID, change func(*overtime_entity.OvertimeRequest) error) (*overtime_entity.OvertimeRequest, error) {
+	request, err := s.overtimes.FindByID(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("find overtime request: %w", err)
+	}
+	if err := change(request); err != nil {
+		return nil, err
+	}
+	if err := s.overtimes.Save(ctx, request); err != nil {
+		return nil, fmt.Errorf("save overtime request: %w", err)
+	}
+	return request, nil
+}
+
+// dayTypeOf classifies the calendar date of t. National, regional and company holidays are
+// public holidays; weekly rest days and observed cuti bersama are rest days.
+func dayTypeOf(cal *calendar_entity.WorkCalendar, t time.Time) enum.OvertimeDayType {
+	if cal.IsWorkingDay(t) {
+		return enum.OvertimeWorkday
+	}
+	if h, ok := cal.Holiday(t); ok && h.Type() != enum.HolidayCollectiveLeave {
+		return enum.OvertimePublicHoliday
+	}
+	return enum.OvertimeRestDay
+}
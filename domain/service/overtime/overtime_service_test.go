@@ -0,0 +1,181 @@
+package overtime_service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	attendance_entity "github.com/rfanazhari/hris/domain/entity/attendance"
+	calendar_entity "github.com/rfanazhari/hris/domain/entity/calendar"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	overtime_entity "github.com/rfanazhari/hris/domain/entity/overtime"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	overtime_service "github.com/rfanazhari/hris/domain/service/overtime"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type memoryOvertimes struct {
+	requests []*overtime_entity.OvertimeRequest
+}
+
+func (m *memoryOvertimes) Save(_ context.Context, request *overtime_entity.OvertimeRequest) error {
+	for i := range m.requests {
+		if m.requests[i].ID() == request.ID() {
+			m.requests[i] = request
+			return nil
+		}
+	}
+	m.requests = append(m.requests, request)
+	return nil
+}
+
+func (m *memoryOvertimes) FindByID(_ context.Context, id uuid.UUID) (*overtime_entity.OvertimeRequest, error) {
+	for _, r := range m.requests {
+		if r.ID() == id {
+			return r, nil
+		}
+	}
+	return nil, errors.New("overtime request not found")
+}
+
+func (m *memoryOvertimes) ListByEmployee(_ context.Context, employeeID uuid.UUID, from, to time.Time) ([]overtime_entity.OvertimeRequest, error) {
+	var out []overtime_entity.OvertimeRequest
+	for _, r := range m.requests {
+		if r.EmployeeID() == employeeID && !r.Start().Before(from) && !r.Start().After(to) {
+			out = append(out, *r)
+		}
+	}
+	return out, nil
+}
+
+type fixedCalendar struct {
+	calendar *calendar_entity.WorkCalendar
+}
+
+func (f fixedCalendar) WorkCalendar(context.Context, *uuid.UUID, int) (*calendar_entity.WorkCalendar, error) {
+	return f.calendar, nil
+}
+
+type fixture struct {
+	service  *overtime_service.OvertimeService
+	events   *porttest.ClockEvents
+	employee *employee_entity.Employee
+}
+
+func newFixture(t *testing.T) fixture {
+	employee := porttest.NewEmployee(t, porttest.Hire{Gender: "M", Salary: 3_460_000, SalaryFrom: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	nyepi, _ := calendar_entity.HolidayFactory{Date: time.Date(2025, 3, 29, 0, 0, 0, 0, time.UTC), Name: "Nyepi", Type: "national"}.Create()
+	cutiBersama, _ := calendar_entity.HolidayFactory{Date: time.Date(2025, 3, 28, 0, 0, 0, 0, time.UTC), Name: "Cuti Bersama Nyepi", Type: "collective_leave"}.Create()
+	cal, _ := calendar_entity.WorkCalendarFactory{WorkWeek: "six_day", Holidays: []calendar_entity.Holiday{*nyepi, *cutiBersama}}.Create()
+
+	f := fixture{events: &porttest.ClockEvents{}, employee: employee}
+	f.service = overtime_service.NewOvertimeService(
+		porttest.NewEmployees(employee),
+		&memoryOvertimes{},
+		f.events,
+		fixedCalendar{calendar: cal},
+		clock.Fixed{At: time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)},
+	)
+	return f
+}
+
+func (f fixture) punch(t *testing.T, eventType string, at time.Time) {
+	event, err := attendance_entity.ClockEventFactory{
+		ID: uuid.NewString(), EmployeeID: f.employee.ID().String(), Type: eventType, Source: "fingerprint", OccurredAt: at,
+	}.Create()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = f.events.Save(context.Background(), event)
+}
+
+func at(d, h, m int) time.Time {
+	return time.Date(2025, 3, d, h, m, 0, 0, time.UTC)
+}
+
+func TestOvertimeService_Submit(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("DayTypeFromCalendar", func(t *testing.T) {
+		f := newFixture(t)
+
+		workday, err := f.service.Submit(ctx, overtime_service.SubmitRequest{EmployeeID: f.employee.ID(), Start: at(3, 17, 0), End: at(3, 19, 0), Reason: "closing"})
+		assert.Nil(t, err)
+		assert.Equal(t, enum.OvertimeWorkday, workday.DayType())
+		assert.Equal(t, enum.WorkWeekSixDay, workday.WorkWeek())
+
+		holiday, _ := f.service.Submit(ctx, overtime_service.SubmitRequest{EmployeeID: f.employee.ID(), Start: at(29, 8, 0), End: at(29, 12, 0), Reason: "server migration"})
+		assert.Equal(t, enum.OvertimePublicHoliday, holiday.DayType())
+		restDay, _ := f.service.Submit(ctx, overtime_service.SubmitRequest{EmployeeID: f.employee.ID(), Start: at(28, 8, 0), End: at(28, 12, 0), Reason: "server migration"})
+		assert.Equal(t, enum.OvertimeRestDay, restDay.DayType())
+		sunday, _ := f.service.Submit(ctx, overtime_service.SubmitRequest{EmployeeID: f.employee.ID(), Start: at(30, 8, 0), End: at(30, 12, 0), Reason: "server migration"})
+		assert.Equal(t, enum.OvertimeRestDay, sunday.DayType())
+	})
+	t.Run("DailyLimit", func(t *testing.T) {
+		f := newFixture(t)
+		_, _ = f.service.Submit(ctx, overtime_service.SubmitRequest{EmployeeID: f.employee.ID(), Start: at(3, 17, 0), End: at(3, 20, 0), Reason: "closing"})
+
+		_, err := f.service.Submit(ctx, overtime_service.SubmitRequest{EmployeeID: f.employee.ID(), Start: at(3, 21, 0), End: at(3, 23, 0), Reason: "closing"})
+		assert.EqualError(t, err, "overtime exceeds the daily limit")
+	})
+	t.Run("WeeklyLimit", func(t *testing.T) {
+		f := newFixture(t)
+		// Monday to Thursday, 4 hours each = 16 hours.
+		for d := 3; d <= 6; d++ {
+			_, err := f.service.Submit(ctx, overtime_service.SubmitRequest{EmployeeID: f.employee.ID(), Start: at(d, 17, 0), End: at(d, 21, 0), Reason: "audit"})
+			assert.Nil(t, err)
+		}
+
+		_, err := f.service.Submit(ctx, overtime_service.SubmitRequest{EmployeeID: f.employee.ID(), Start: at(7, 17, 0), End: at(7, 20, 0), Reason: "audit"})
+		assert.EqualError(t, err, "overtime exceeds the weekly limit")
+		_, err = f.service.Submit(ctx, overtime_service.SubmitRequest{EmployeeID: f.employee.ID(), Start: at(7, 17, 0), End: at(7, 19, 0), Reason: "audit"})
+		assert.Nil(t, err)
+		// Rest day overtime does not count towards the weekly limit.
+		_, err = f.service.Submit(ctx, overtime_service.SubmitRequest{EmployeeID: f.employee.ID(), Start: at(9, 8, 0), End: at(9, 16, 0), Reason: "audit"})
+		assert.Nil(t, err)
+		// The next week starts over.
+		_, err = f.service.Submit(ctx, overtime_service.SubmitRequest{EmployeeID: f.employee.ID(), Start: at(10, 17, 0), End: at(10, 21, 0), Reason: "audit"})
+		assert.Nil(t, err)
+	})
+}
+
+func TestOvertimeService_RecordActualAndPay(t *testing.T) {
+	ctx := context.Background()
+	approver := uuid.New()
+
+	f := newFixture(t)
+	workday, _ := f.service.Submit(ctx, overtime_service.SubmitRequest{EmployeeID: f.employee.ID(), Start: at(3, 17, 0), End: at(3, 20, 0), Reason: "closing"})
+	holiday, _ := f.service.Submit(ctx, overtime_service.SubmitRequest{EmployeeID: f.employee.ID(), Start: at(29, 8, 0), End: at(29, 18, 0), Reason: "migration"})
+	_, _ = f.service.Approve(ctx, workday.ID(), approver)
+	_, _ = f.service.Approve(ctx, holiday.ID(), approver)
+
+	f.punch(t, "in", at(3, 7, 55))
+	f.punch(t, "out", at(3, 19, 30))
+	f.punch(t, "in", at(29, 8, 0))
+	f.punch(t, "out", at(29, 19, 0))
+
+	completed, err := f.service.RecordActual(ctx, workday.ID())
+	assert.Nil(t, err)
+	assert.Equal(t, 150*time.Minute, completed.Actual())
+
+	// 1.5 x 20.000 + 1.5 x 2 x 20.000
+	pay, err := f.service.Pay(ctx, workday.ID())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(90_000), pay)
+
+	_, _ = f.service.RecordActual(ctx, holiday.ID())
+	// Six day week: 7 x 2 + 1 x 3 + 2 x 4 = 25 hours of wage.
+	pay, _ = f.service.Pay(ctx, holiday.ID())
+	assert.Equal(t, int64(500_000), pay)
+
+	total, err := f.service.PayForPeriod(ctx, f.employee.ID(), at(1, 0, 0), at(31, 23, 59))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(590_000), total)
+
+	_, err = f.service.RecordActual(ctx, workday.ID())
+	assert.EqualError(t, err, "overtime request is not approved")
+}
@@ -0,0 +1,103 @@
+package payroll_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	bpjs_entity "github.com/rfanazhari/hris/domain/entity/bpjs"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+)
+
+// bpjsLine maps the part of a programme's contribution to a payslip line. JKK, JKM and the
+// employer's BPJS Kesehatan premium are taxable income of the employee; the employer's JHT
+// and JP contributions are not.
+type bpjsLine struct {
+	program  enum.BPJSProgram
+	employer bool
+	code     string
+	name     string
+	taxable  bool
+}
+
+var bpjsLines = []bpjsLine{
+	{enum.BPJSKesehatan, false, payroll_entity.LineBPJSHealth, "BPJS Kesehatan", false},
+	{enum.BPJSJHT, false, payroll_entity.LineBPJSJHT, "BPJS JHT", false},
+	{enum.BPJSJP, false, payroll_entity.LineBPJSJP, "BPJS JP", false},
+	{enum.BPJSKesehatan, true, payroll_entity.LineBPJSHealthEmployer, "BPJS Kesehatan (Perusahaan)", true},
+	{enum.BPJSJHT, true, payroll_entity.LineBPJSJHTEmployer, "BPJS JHT (Perusahaan)", false},
+	{enum.BPJSJP, true, payroll_entity.LineBPJSJPEmployer, "BPJS JP (Perusahaan)", false},
+	{enum.BPJSJKK, true, payroll_entity.LineBPJSJKK, "BPJS JKK", true},
+	{enum.BPJSJKM, true, payroll_entity.LineBPJSJKM, "BPJS JKM", true},
+}
+
+// BPJSComponent adds the BPJS Kesehatan and Ketenagakerjaan contributions on the full
+// monthly wage, using the rates in effect at the end of the pay period. The JKK rate comes
+// from the risk class of the employee's organization unit or its nearest ancestor with one.
+// Run it before the IncomeTaxComponent, passing LineBPJSJHT and LineBPJSJP as its pension codes.
+type BPJSComponent struct {
+	schedule     *bpjs_entity.RateSchedule
+	units        port.OrganizationUnitRepository
+	defaultClass enum.JKKRiskClass
+}
+
+// NewBPJSComponent returns a BPJSComponent. defaultClass applies to employees without an
+// organization unit or whose units have no risk class.
+func NewBPJSComponent(schedule *bpjs_entity.RateSchedule, units port.OrganizationUnitRepository, defaultClass enum.JKKRiskClass) *BPJSComponent {
+	return &BPJSComponent{schedule: schedule, units: units, defaultClass: defaultClass}
+}
+
+// Apply implements PayComponent.
+func (c *BPJSComponent) Apply(ctx context.Context, employee *employee_entity.Employee, draft *payroll_entity.PayslipDraft) error {
+	rates, ok := c.schedule.At(draft.Period().End())
+	if !ok {
+		return errors.New("no bpjs rates in effect")
+	}
+	class, err := c.riskClass(ctx, employee)
+	if err != nil {
+		return err
+	}
+	contributions, err := rates.Calculate(draft.MonthlyPay(), class)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range bpjsLines {
+		contribution := contributions.Of(l.program)
+		kind, amount := enum.PayLineDeduction, contribution.Employee()
+		if l.employer {
+			kind, amount = enum.PayLineEmployerContribution, contribution.Employer()
+		}
+		line, err := payroll_entity.NewPayslipLine(l.code, l.name, kind, amount, l.taxable)
+		if err != nil {
+			return err
+		}
+		if err := draft.AddLine(*line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// riskClass returns the JKK risk class that applies to the employee.
+func (c *BPJSComponent) riskClass(ctx context.Context, employee *employee_entity.Employee) (enum.JKKRiskClass, error) {
+	visited := map[uuid.UUID]bool{}
+	for unitID := employee.OrganizationUnitID(); unitID != nil; {
+		if visited[*unitID] {
+			return "", errors.New("organization unit hierarchy is cyclic")
+		}
+		visited[*unitID] = true
+		unit, err := c.units.FindByID(ctx, *unitID)
+		if err != nil {
+			return "", fmt.Errorf("find organization unit: %w", err)
+		}
+		if class := unit.JKKRiskClass(); class != nil {
+			return *class, nil
+		}
+		unitID = unit.ParentID()
+	}
+	return c.defaultClass, nil
+}
@@ -0,0 +1,83 @@
+package payroll_service_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/rfanazhari/hris/domain/entity"
+	bpjs_entity "github.com/rfanazhari/hris/domain/entity/bpjs"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	payroll_service "github.com/rfanazhari/hris/domain/service/payroll"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestBPJSComponent(t *testing.T) {
+	plant, _ := entity.OrganizationUnitFactory{ID: uuid.NewString(), Name: "Plant Cikarang", Type: "division", JKKRiskClass: "high"}.Create()
+	line, _ := entity.OrganizationUnitFactory{ID: uuid.NewString(), Name: "Assembly Line", Type: "team", ParentUnitID: plant.ID().String()}.Create()
+	units := porttest.OrganizationUnits{plant.ID(): plant, line.ID(): line}
+	component := payroll_service.NewBPJSComponent(bpjs_entity.DefaultRateSchedule(), units, enum.JKKRiskVeryLow)
+	period := payroll_entity.MonthlyPayPeriod(2025, time.June)
+
+	t.Run("InheritedRiskClass", func(t *testing.T) {
+		employee := newPaidEmployee(t, 15_000_000, date(2020, 1, 1), nil)
+		_ = employee.AssignOrganizationUnit(line.ID(), time.Time{})
+		draft, _ := payroll_entity.NewPayslipDraft(employee.ID(), period, "IDR", 15_000_000, 30, 30)
+		basic, _ := payroll_entity.NewPayslipLine(payroll_entity.LineBasic, "Gaji Pokok", enum.PayLineEarning, 15_000_000, true)
+		_ = draft.AddLine(*basic)
+		tax := payroll_service.NewIncomeTaxComponent(porttest.NewPayrollRuns(), nil, payroll_entity.LineBPJSJHT, payroll_entity.LineBPJSJP)
+
+		assert.Nil(t, component.Apply(context.Background(), employee, draft))
+		assert.Nil(t, tax.Apply(context.Background(), employee, draft))
+
+		slip, _ := draft.Finalize(uuid.New(), uuid.New(), time.Time{})
+		amounts := map[string]int64{}
+		for _, l := range slip.Lines() {
+			amounts[l.Code()] = l.Amount()
+		}
+		assert.Equal(t, map[string]int64{
+			payroll_entity.LineBasic:              15_000_000,
+			payroll_entity.LineBPJSHealth:         120_000,
+			payroll_entity.LineBPJSJHT:            300_000,
+			payroll_entity.LineBPJSJP:             105_474,
+			payroll_entity.LineBPJSHealthEmployer: 480_000,
+			payroll_entity.LineBPJSJHTEmployer:    555_000,
+			payroll_entity.LineBPJSJPEmployer:     210_948,
+			payroll_entity.LineBPJSJKK:            190_500,
+			payroll_entity.LineBPJSJKM:            45_000,
+			// TER A 7% of 15.000.000 + 480.000 + 190.500 + 45.000
+			payroll_entity.LineIncomeTax: 1_100_085,
+		}, amounts)
+		assert.Equal(t, int64(15_715_500), slip.TaxableGross())
+		assert.Equal(t, int64(13_374_441), slip.NetPay())
+	})
+	t.Run("DefaultRiskClass", func(t *testing.T) {
+		employee := newPaidEmployee(t, 5_000_000, date(2020, 1, 1), nil)
+		draft, _ := payroll_entity.NewPayslipDraft(employee.ID(), period, "IDR", 5_000_000, 30, 30)
+
+		assert.Nil(t, component.Apply(context.Background(), employee, draft))
+
+		var jkk int64
+		for _, l := range draft.Lines() {
+			if l.Code() == payroll_entity.LineBPJSJKK {
+				jkk = l.Amount()
+			}
+		}
+		assert.Equal(t, int64(12_000), jkk)
+	})
+	t.Run("MissingRates", func(t *testing.T) {
+		employee := newPaidEmployee(t, 5_000_000, date(2020, 1, 1), nil)
+		draft, _ := payroll_entity.NewPayslipDraft(employee.ID(), payroll_entity.MonthlyPayPeriod(2021, time.June), "IDR", 5_000_000, 30, 30)
+
+		assert.EqualError(t, component.Apply(context.Background(), employee, draft), "no bpjs rates in effect")
+	})
+	t.Run("UnknownUnit", func(t *testing.T) {
+		employee := newPaidEmployee(t, 5_000_000, date(2020, 1, 1), nil)
+		_ = employee.AssignOrganizationUnit(uuid.New(), time.Time{})
+		draft, _ := payroll_entity.NewPayslipDraft(employee.ID(), period, "IDR", 5_000_000, 30, 30)
+
+		assert.EqualError(t, component.Apply(context.Background(), employee, draft), "find organization unit: organization unit not found")
+	})
+}
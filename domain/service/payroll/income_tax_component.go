@@ -0,0 +1,132 @@
+package payroll_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	tax_entity "github.com/rfanazhari/hris/domain/entity/tax"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"time"
+)
+
+// TaxDependentSource counts the dependents of an employee that qualify for PTKP.
+type TaxDependentSource interface {
+	PTKPDependents(ctx context.Context, employeeID uuid.UUID, at time.Time) (int, error)
+}
+
+// IncomeTaxComponent withholds PPh 21 for permanent employees under PMK No. 168/2023. It
+// must run after every component that adds taxable earnings or employer contributions.
+// Months before December are withheld at the TER rate; in December, or in the month the
+// employee leaves, the annual tax is calculated and the difference with what was withheld
+// on the approved payslips earlier in the year is withheld, or refunded as a non-taxable
+// earning.
+type IncomeTaxComponent struct {
+	runs         port.PayrollRunRepository
+	dependents   TaxDependentSource
+	pensionCodes map[string]bool
+}
+
+// NewIncomeTaxComponent returns an IncomeTaxComponent reading earlier payslips of the year
+// from runs. dependents may be nil, in which case no dependents are counted. pensionCodes
+// are the codes of the deduction lines holding the employee's JHT and JP contributions,
+// which reduce the annual taxable income.
+func NewIncomeTaxComponent(runs port.PayrollRunRepository, dependents TaxDependentSource, pensionCodes ...string) *IncomeTaxComponent {
+	codes := make(map[string]bool, len(pensionCodes))
+	for _, c := range pensionCodes {
+		codes[c] = true
+	}
+	return &IncomeTaxComponent{runs: runs, dependents: dependents, pensionCodes: codes}
+}
+
+// Apply implements PayComponent.
+func (c *IncomeTaxComponent) Apply(ctx context.Context, employee *employee_entity.Employee, draft *payroll_entity.PayslipDraft) error {
+	period := draft.Period()
+	status, err := c.status(ctx, employee, period.End())
+	if err != nil {
+		return err
+	}
+
+	gross := draft.TaxableGross()
+	leaving := !employee.IsEmployedOn(period.End().AddDate(0, 0, 1))
+	if period.Month() != time.December && !leaving {
+		return c.withhold(draft, tax_entity.MonthlyWithholding(*status, gross))
+	}
+
+	income := tax_entity.AnnualIncome{Gross: gross, PensionContributions: c.pension(draft.Lines()), Months: 1}
+	var withheld int64
+	months := map[time.Month]bool{}
+	runs, err := c.runs.ListByYear(ctx, period.Year())
+	if err != nil {
+		return fmt.Errorf("list payroll runs: %w", err)
+	}
+	for i := range runs {
+		if !runs[i].IsLocked() || !runs[i].Period().Start().Before(period.Start()) {
+			continue
+		}
+		slip, ok := runs[i].Payslip(employee.ID())
+		if !ok {
+			continue
+		}
+		income.Gross += slip.TaxableGross()
+		income.PensionContributions += c.pension(slip.Lines())
+		withheld += amountOf(slip, payroll_entity.LineIncomeTax) - amountOf(slip, payroll_entity.LineIncomeTaxRefund)
+		months[slip.Period().Month()] = true
+	}
+	income.Months += len(months)
+
+	annual, err := tax_entity.AnnualPPh21(*status, income)
+	if err != nil {
+		return err
+	}
+	due := annual - withheld
+	if due >= 0 {
+		return c.withhold(draft, due)
+	}
+	line, err := payroll_entity.NewPayslipLine(payroll_entity.LineIncomeTaxRefund, "Kelebihan PPh 21", enum.PayLineEarning, -due, false)
+	if err != nil {
+		return err
+	}
+	return draft.AddLine(*line)
+}
+
+func (c *IncomeTaxComponent) status(ctx context.Context, employee *employee_entity.Employee, at time.Time) (*tax_entity.PTKPStatus, error) {
+	dependents := 0
+	if c.dependents != nil {
+		n, err := c.dependents.PTKPDependents(ctx, employee.ID(), at)
+		if err != nil {
+			return nil, fmt.Errorf("count dependents: %w", err)
+		}
+		dependents = n
+	}
+	info := employee.PersonalInfo()
+	return tax_entity.PTKPStatusOf(info.MaritalStatus(), info.Gender(), dependents)
+}
+
+func (c *IncomeTaxComponent) pension(lines []payroll_entity.PayslipLine) int64 {
+	var total int64
+	for _, l := range lines {
+		if l.Kind() == enum.PayLineDeduction && c.pensionCodes[l.Code()] {
+			total += l.Amount()
+		}
+	}
+	return total
+}
+
+func (c *IncomeTaxComponent) withhold(draft *payroll_entity.PayslipDraft, amount int64) error {
+	line, err := payroll_entity.NewPayslipLine(payroll_entity.LineIncomeTax, "PPh 21", enum.PayLineDeduction, amount, false)
+	if err != nil {
+		return err
+	}
+	return draft.AddLine(*line)
+}
+
+func amountOf(slip payroll_entity.Payslip, code string) int64 {
+	line, ok := slip.Line(code)
+	if !ok {
+		return 0
+	}
+	return line.Amount()
+}
@@ -0,0 +1,124 @@
+package payroll_service_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	payroll_service "github.com/rfanazhari/hris/domain/service/payroll"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type dependentCount int
+
+func (d dependentCount) PTKPDependents(context.Context, uuid.UUID, time.Time) (int, error) {
+	return int(d), nil
+}
+
+// runMonths calculates and approves the runs of the given months of 2025 and returns the
+// employee's payslips.
+func runMonths(t *testing.T, service *payroll_service.PayrollService, employeeID uuid.UUID, months ...time.Month) []payroll_entity.Payslip {
+	ctx := context.Background()
+	var slips []payroll_entity.Payslip
+	for _, month := range months {
+		run, err := service.CreateRun(ctx, payroll_entity.MonthlyPayPeriod(2025, month), "IDR")
+		assert.Nil(t, err)
+		run, err = service.Calculate(ctx, run.ID())
+		assert.Nil(t, err)
+		_, err = service.Approve(ctx, run.ID(), uuid.New())
+		assert.Nil(t, err)
+		slip, _ := run.Payslip(employeeID)
+		slips = append(slips, slip)
+	}
+	return slips
+}
+
+func taxOf(slip payroll_entity.Payslip, code string) int64 {
+	line, _ := slip.Line(code)
+	return line.Amount()
+}
+
+func TestIncomeTaxComponent(t *testing.T) {
+	year := []time.Month{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+
+	t.Run("DecemberTrueUp", func(t *testing.T) {
+		// TK/0, 10.000.000 a month: TER A 2% = 200.000 for 11 months; annual tax is
+		// (120.000.000 - 6.000.000 - 200.000 JHT - 54.000.000) x 5% = 2.990.000.
+		employee := newPaidEmployee(t, 10_000_000, date(2020, 1, 1), nil)
+		runs := porttest.NewPayrollRuns()
+		adjustments := &memoryAdjustments{}
+		jht, _ := payroll_entity.PayrollAdjustmentFactory{
+			ID: uuid.NewString(), EmployeeID: employee.ID().String(), Date: date(2025, 12, 1),
+			Code: "JHT_EE", Name: "JHT", Kind: "deduction", Amount: 200_000,
+		}.Create()
+		_ = adjustments.Save(context.Background(), jht)
+		service := payroll_service.NewPayrollService(
+			porttest.NewEmployees(employee), runs, nil, clock.Fixed{At: date(2025, 12, 25)},
+			payroll_service.NewAdjustmentComponent(adjustments),
+			payroll_service.NewIncomeTaxComponent(runs, nil, "JHT_EE"),
+		)
+
+		slips := runMonths(t, service, employee.ID(), year...)
+
+		for _, slip := range slips[:11] {
+			assert.Equal(t, int64(200_000), taxOf(slip, payroll_entity.LineIncomeTax))
+		}
+		assert.Equal(t, int64(790_000), taxOf(slips[11], payroll_entity.LineIncomeTax))
+		assert.Equal(t, int64(10_000_000-200_000-790_000), slips[11].NetPay())
+	})
+	t.Run("TerminationRefund", func(t *testing.T) {
+		// Leaving at the end of March: 30.000.000 less 1.500.000 biaya jabatan is below PTKP,
+		// so the 400.000 withheld in January and February is refunded.
+		end := date(2025, 3, 31)
+		employee := newPaidEmployee(t, 10_000_000, date(2024, 4, 1), &end)
+		runs := porttest.NewPayrollRuns()
+		service := payroll_service.NewPayrollService(
+			porttest.NewEmployees(employee), runs, nil, clock.Fixed{At: end},
+			payroll_service.NewIncomeTaxComponent(runs, nil),
+		)
+
+		slips := runMonths(t, service, employee.ID(), time.January, time.February, time.March)
+
+		assert.Equal(t, int64(200_000), taxOf(slips[1], payroll_entity.LineIncomeTax))
+		_, ok := slips[2].Line(payroll_entity.LineIncomeTax)
+		assert.False(t, ok)
+		assert.Equal(t, int64(400_000), taxOf(slips[2], payroll_entity.LineIncomeTaxRefund))
+		assert.Equal(t, int64(10_400_000), slips[2].NetPay())
+	})
+	t.Run("UnapprovedRunsIgnored", func(t *testing.T) {
+		// The February run is calculated but never approved, so only January's 200.000 was
+		// withheld and is refunded in March.
+		end := date(2025, 3, 31)
+		employee := newPaidEmployee(t, 10_000_000, date(2024, 4, 1), &end)
+		runs := porttest.NewPayrollRuns()
+		service := payroll_service.NewPayrollService(
+			porttest.NewEmployees(employee), runs, nil, clock.Fixed{At: end},
+			payroll_service.NewIncomeTaxComponent(runs, nil),
+		)
+		runMonths(t, service, employee.ID(), time.January)
+		february, err := service.CreateRun(context.Background(), payroll_entity.MonthlyPayPeriod(2025, time.February), "IDR")
+		assert.Nil(t, err)
+		_, err = service.Calculate(context.Background(), february.ID())
+		assert.Nil(t, err)
+
+		slips := runMonths(t, service, employee.ID(), time.March)
+
+		assert.Equal(t, int64(200_000), taxOf(slips[0], payroll_entity.LineIncomeTaxRefund))
+	})
+	t.Run("Dependents", func(t *testing.T) {
+		// A married man with one child is K/1, TER B: 10.000.000 x 1,5%.
+		married := porttest.NewEmployee(t, porttest.Hire{Gender: "M", MaritalStatus: "married", Start: date(2020, 1, 1), Salary: 10_000_000})
+		runs := porttest.NewPayrollRuns()
+		service := payroll_service.NewPayrollService(
+			porttest.NewEmployees(married), runs, nil, nil,
+			payroll_service.NewIncomeTaxComponent(runs, dependentCount(1)),
+		)
+
+		slips := runMonths(t, service, married.ID(), time.May)
+
+		assert.Equal(t, int64(150_000), taxOf(slips[0], payroll_entity.LineIncomeTax))
+	})
+}
@@ -0,0 +1,225 @@
+package payroll_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	loan_entity "github.com/rfanazhari/hris/domain/entity/loan"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"time"
+)
+
+// PayComponent adds lines to an employee's payslip during a payroll run. Components run in
+// the order they are given to the PayrollService, after the prorated base salary, and may
+// read the lines added before them.
+type PayComponent interface {
+	Apply(ctx context.Context, employee *employee_entity.Employee, draft *payroll_entity.PayslipDraft) error
+}
+
+// OvertimePaySource sums the pay of completed overtime; it is implemented by the overtime service.
+type OvertimePaySource interface {
+	PayForPeriod(ctx context.Context, employeeID uuid.UUID, from, to time.Time) (int64, error)
+}
+
+// OvertimeComponent adds the overtime worked in the pay period as a taxable earning.
+type OvertimeComponent struct {
+	source OvertimePaySource
+}
+
+// NewOvertimeComponent returns an OvertimeComponent reading from the given source.
+func NewOvertimeComponent(source OvertimePaySource) *OvertimeComponent {
+	return &OvertimeComponent{source: source}
+}
+
+// Apply implements PayComponent.
+func (c *OvertimeComponent) Apply(ctx context.Context, employee *employee_entity.Employee, draft *payroll_entity.PayslipDraft) error {
+	period := draft.Period()
+	pay, err := c.source.PayForPeriod(ctx, employee.ID(), period.Start(), period.End().AddDate(0, 0, 1).Add(-time.Nanosecond))
+	if err != nil {
+		return fmt.Errorf("overtime pay: %w", err)
+	}
+	line, err := payroll_entity.NewPayslipLine(payroll_entity.LineOvertime, "Lembur", enum.PayLineEarning, pay, true)
+	if err != nil {
+		return err
+	}
+	return draft.AddLine(*line)
+}
+
+// THRSource sums the THR due in a period; it is implemented by the THR service.
+type THRSource interface {
+	THRForPeriod(ctx context.Context, employeeID uuid.UUID, from, to time.Time) (int64, error)
+}
+
+// THRComponent adds the THR due in the pay period as a taxable earning.
+type THRComponent struct {
+	source THRSource
+}
+
+// NewTHRComponent returns a THRComponent reading from the given source.
+func NewTHRComponent(source THRSource) *THRComponent {
+	return &THRComponent{source: source}
+}
+
+// Apply implements PayComponent.
+func (c *THRComponent) Apply(ctx context.Context, employee *employee_entity.Employee, draft *payroll_entity.PayslipDraft) error {
+	period := draft.Period()
+	amount, err := c.source.THRForPeriod(ctx, employee.ID(), period.Start(), period.End())
+	if err != nil {
+		return fmt.Errorf("thr: %w", err)
+	}
+	line, err := payroll_entity.NewPayslipLine(payroll_entity.LineTHR, "THR Keagamaan", enum.PayLineEarning, amount, true)
+	if err != nil {
+		return err
+	}
+	return draft.AddLine(*line)
+}
+
+// BenefitSource returns the allowance and insurance lines of an employee for a pay
+// period; it is implemented by the benefit service.
+type BenefitSource interface {
+	BenefitLines(ctx context.Context, employeeID uuid.UUID, from, to time.Time) ([]payroll_entity.PayslipLine, error)
+}
+
+// BenefitComponent adds the allowances and insurance premiums of the employee's benefit
+// enrollments.
+type BenefitComponent struct {
+	source BenefitSource
+}
+
+// NewBenefitComponent returns a BenefitComponent reading from the given source.
+func NewBenefitComponent(source BenefitSource) *BenefitComponent {
+	return &BenefitComponent{source: source}
+}
+
+// Apply implements PayComponent.
+func (c *BenefitComponent) Apply(ctx context.Context, employee *employee_entity.Employee, draft *payroll_entity.PayslipDraft) error {
+	period := draft.Period()
+	lines, err := c.source.BenefitLines(ctx, employee.ID(), period.Start(), period.End())
+	if err != nil {
+		return fmt.Errorf("benefits: %w", err)
+	}
+	for i := range lines {
+		if err := draft.AddLine(lines[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReimbursementSource sums the approved reimbursements to be paid in a period; it is
+// implemented by the reimbursement service.
+type ReimbursementSource interface {
+	ReimbursementForPeriod(ctx context.Context, employeeID uuid.UUID, from, to time.Time) (int64, error)
+}
+
+// ReimbursementComponent adds the approved reimbursement claims as a non-taxable earning.
+type ReimbursementComponent struct {
+	source ReimbursementSource
+}
+
+// NewReimbursementComponent returns a ReimbursementComponent reading from the given source.
+func NewReimbursementComponent(source ReimbursementSource) *ReimbursementComponent {
+	return &ReimbursementComponent{source: source}
+}
+
+// Apply implements PayComponent.
+func (c *ReimbursementComponent) Apply(ctx context.Context, employee *employee_entity.Employee, draft *payroll_entity.PayslipDraft) error {
+	period := draft.Period()
+	amount, err := c.source.ReimbursementForPeriod(ctx, employee.ID(), period.Start(), period.End())
+	if err != nil {
+		return fmt.Errorf("reimbursements: %w", err)
+	}
+	line, err := payroll_entity.NewPayslipLine(payroll_entity.LineReimbursement, "Reimbursement", enum.PayLineEarning, amount, false)
+	if err != nil {
+		return err
+	}
+	return draft.AddLine(*line)
+}
+
+// AdjustmentComponent adds the one-off earnings and deductions dated in the pay period.
+type AdjustmentComponent struct {
+	adjustments port.PayrollAdjustmentRepository
+}
+
+// NewAdjustmentComponent returns an AdjustmentComponent reading from the given repository.
+func NewAdjustmentComponent(adjustments port.PayrollAdjustmentRepository) *AdjustmentComponent {
+	return &AdjustmentComponent{adjustments: adjustments}
+}
+
+// Apply implements PayComponent.
+func (c *AdjustmentComponent) Apply(ctx context.Context, employee *employee_entity.Employee, draft *payroll_entity.PayslipDraft) error {
+	period := draft.Period()
+	adjustments, err := c.adjustments.ListByEmployee(ctx, employee.ID(), period.Start(), period.End())
+	if err != nil {
+		return fmt.Errorf("list payroll adjustments: %w", err)
+	}
+	for i := range adjustments {
+		if err := draft.AddLine(adjustments[i].Line()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoanSource returns the unsettled loans of an employee, oldest first; it is implemented
+// by the loan service.
+type LoanSource interface {
+	OpenLoans(ctx context.Context, employeeID uuid.UUID) ([]loan_entity.Loan, error)
+}
+
+// LoanComponent deducts loan installments and salary advances. Deductions are capped so
+// the take-home pay stays at or above the given percentage of gross pay; what does not fit
+// is collected in later periods. In the employee's last period the remaining principal is
+// deducted in full, capped only at the net pay. The component must be given last so the
+// cap sees every other deduction.
+type LoanComponent struct {
+	source             LoanSource
+	minTakeHomePercent int64
+}
+
+// NewLoanComponent returns a LoanComponent reading from the given source. The percentage
+// is clamped to 0..100.
+func NewLoanComponent(source LoanSource, minTakeHomePercent int) *LoanComponent {
+	return &LoanComponent{source: source, minTakeHomePercent: int64(min(max(minTakeHomePercent, 0), 100))}
+}
+
+// Apply implements PayComponent.
+func (c *LoanComponent) Apply(ctx context.Context, employee *employee_entity.Employee, draft *payroll_entity.PayslipDraft) error {
+	loans, err := c.source.OpenLoans(ctx, employee.ID())
+	if err != nil {
+		return fmt.Errorf("loans: %w", err)
+	}
+	period := draft.Period()
+	final := !employee.IsEmployedOn(period.End().AddDate(0, 0, 1))
+	room := draft.NetPay()
+	if !final {
+		room -= (draft.GrossPay()*c.minTakeHomePercent + 99) / 100
+	}
+
+	totals := map[enum.LoanType]int64{}
+	for i := range loans {
+		amount := min(loans[i].Deduction(period, final), max(room, 0))
+		totals[loans[i].Type()] += amount
+		room -= amount
+	}
+
+	for _, l := range []struct {
+		code, name string
+		loanType   enum.LoanType
+	}{
+		{payroll_entity.LineLoan, "Cicilan Pinjaman", enum.LoanCompany},
+		{payroll_entity.LineSalaryAdvance, "Potongan Kasbon", enum.LoanSalaryAdvance},
+	} {
+		line, err := payroll_entity.NewPayslipLine(l.code, l.name, enum.PayLineDeduction, totals[l.loanType], false)
+		if err != nil {
+			return err
+		}
+		if err := draft.AddLine(*line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package payroll_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"sort"
+)
+
+// PayrollService runs payroll: it selects the employees employed in the pay period,
+// computes their payslips through the configured pay components and moves runs through
+// their draft, calculated, approved and paid states.
+type PayrollService struct {
+	employees  port.EmployeeRepository
+	runs       port.PayrollRunRepository
+	days       port.WorkingDayCounter
+	components []PayComponent
+	clock      clock.Clock
+}
+
+// NewPayrollService returns a PayrollService. Proration counts working days with the given
+// counter, or calendar days when it is nil. A nil clock falls back to the system clock.
+func NewPayrollService(employees port.EmployeeRepository, runs port.PayrollRunRepository, days port.WorkingDayCounter, clk clock.Clock, components ...PayComponent) *PayrollService {
+	if clk == nil {
+		clk = clock.System{}
+	}
+	return &PayrollService{employees: employees, runs: runs, days: days, components: components, clock: clk}
+}
+
+// CreateRun creates a draft run for the pay period. Only one run may exist per period.
+func (s *PayrollService) CreateRun(ctx context.Context, period payroll_entity.PayPeriod, currency string) (*payroll_entity.PayrollRun, error) {
+	existing, err := s.runs.FindByPeriod(ctx, period)
+	if err != nil {
+		return nil, fmt.Errorf("find payroll run: %w", err)
+	}
+	if existing != nil {
+		return nil, errors.New("payroll run already exists for the period")
+	}
+
+	run, err := payroll_entity.PayrollRunFactory{
+		ID:          uuid.NewString(),
+		PeriodStart: period.Start(),
+		PeriodEnd:   period.End(),
+		Currency:    currency,
+		CreatedAt:   s.clock.Now(),
+	}.Create()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.runs.Save(ctx, run); err != nil {
+		return nil, fmt.Errorf("save payroll run: %w", err)
+	}
+	return run, nil
+}
+
+// Calculate computes the payslips of every employee employed in the run's period and
+// records them on the run, replacing those of an earlier calculation. The base salary is
+// the salary record in effect at the end of the period, prorated for employees hired or
+// leaving during the period.
+func (s *PayrollService) Calculate(ctx context.Context, runID uuid.UUID) (*payroll_entity.PayrollRun, error) {
+	run, err := s.runs.FindByID(ctx, runID)
+	if err != nil {
+		return nil, fmt.Errorf("find payroll run: %w", err)
+	}
+	if run.IsLocked() {
+		return nil, errors.New("payroll run is locked")
+	}
+
+	period := run.Period()
+	employees, err := s.employees.ListByEmploymentPeriod(ctx, period.Start(), period.End())
+	if err != nil {
+		return nil, fmt.Errorf("list employees: %w", err)
+	}
+	sort.Slice(employees, func(i, j int) bool { return employees[i].ID().String() < employees[j].ID().String() })
+
+	now := s.clock.Now()
+	var payslips []payroll_entity.Payslip
+	for i := range employees {
+		slip, err := s.payslip(ctx, run, &employees[i])
+		if err != nil {
+			return nil, fmt.Errorf("employee %s: %w", employees[i].ID(), err)
+		}
+		if slip == nil {
+			continue
+		}
+		final, err := slip.Finalize(uuid.New(), run.ID(), now)
+		if err != nil {
+			return nil, fmt.Errorf("employee %s: %w", employees[i].ID(), err)
+		}
+		payslips = append(payslips, *final)
+	}
+
+	if err := run.RecordCalculation(payslips, now); err != nil {
+		return nil, err
+	}
+	if err := s.runs.Save(ctx, run); err != nil {
+		return nil, fmt.Errorf("save payroll run: %w", err)
+	}
+	return run, nil
+}
+
+// Approve locks a calculated run.
+func (s *PayrollService) Approve(ctx context.Context, runID, approverID uuid.UUID) (*payroll_entity.PayrollRun, error) {
+	return s.update(ctx, runID, func(r *payroll_entity.PayrollRun) error {
+		return r.Approve(approverID, s.clock.Now())
+	})
+}
+
+// MarkPaid records that the salaries of an approved run were transferred.
+func (s *PayrollService) MarkPaid(ctx context.Context, runID uuid.UUID) (*payroll_entity.PayrollRun, error) {
+	return s.update(ctx, runID, func(r *payroll_entity.PayrollRun) error {
+		return r.MarkPaid(s.clock.Now())
+	})
+}
+
+// payslip builds the draft payslip of one employee, or nil if the employee was not
+// employed on any day of the period.
+func (s *PayrollService) payslip(ctx context.Context, run *payroll_entity.PayrollRun, employee *employee_entity.Employee) (*payroll_entity.PayslipDraft, error) {
+	period := run.Period()
+	first, last := period.Start(), period.End()
+	for !first.After(period.End()) && !employee.IsEmployedOn(first) {
+		first = first.AddDate(0, 0, 1)
+	}
+	if first.After(period.End()) {
+		return nil, nil
+	}
+	for !employee.IsEmployedOn(last) {
+		last = last.AddDate(0, 0, -1)
+	}
+
+	salary, ok := employee.SalaryAt(last)
+	if !ok {
+		return nil, errors.New("no salary record in effect")
+	}
+	if salary.Currency() != run.Currency() {
+		return nil, fmt.Errorf("salary currency %s does not match payroll currency %s", salary.Currency(), run.Currency())
+	}
+
+	worked, total := int(last.Sub(first).Hours()/24)+1, period.Days()
+	if s.days != nil {
+		worked, total = s.days.WorkingDaysBetween(first, last), s.days.WorkingDaysBetween(period.Start(), period.End())
+	}
+	draft, err := payroll_entity.NewPayslipDraft(employee.ID(), period, run.Currency(), salary.Amount(), worked, total)
+	if err != nil {
+		return nil, err
+	}
+
+	basic, err := payroll_entity.NewPayslipLine(payroll_entity.LineBasic, "Gaji Pokok", enum.PayLineEarning, draft.Prorate(salary.Amount()), true)
+	if err != nil {
+		return nil, err
+	}
+	if err := draft.AddLine(*basic); err != nil {
+		return nil, err
+	}
+	for _, c := range s.components {
+		if err := c.Apply(ctx, employee, draft); err != nil {
+			return nil, err
+		}
+	}
+	return draft, nil
+}
+
+func (s *PayrollService) update(ctx context.Context, runID uuid.UUID, change func(*payroll_entity.PayrollRun) error) (*payroll_entity.PayrollRun, error) {
+	run, err := s.runs.FindByID(ctx, runID)
+	if err != nil {
+		return nil, fmt.Errorf("find payroll run: %w", err)
+	}
+	if err := change(run); err != nil {
+		return nil, err
+	}
+	if err := s.runs.Save(ctx, run); err != nil {
+		return nil, fmt.Errorf("save payroll run: %w", err)
+	}
+	return run, nil
+}
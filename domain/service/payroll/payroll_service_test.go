@@ -0,0 +1,163 @@
+package payroll_service_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	payroll_service "github.com/rfanazhari/hris/domain/service/payroll"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type memoryAdjustments struct {
+	adjustments []payroll_entity.PayrollAdjustment
+}
+
+func (m *memoryAdjustments) Save(_ context.Context, adjustment *payroll_entity.PayrollAdjustment) error {
+	m.adjustments = append(m.adjustments, *adjustment)
+	return nil
+}
+
+func (m *memoryAdjustments) ListByEmployee(_ context.Context, employeeID uuid.UUID, from, to time.Time) ([]payroll_entity.PayrollAdjustment, error) {
+	var out []payroll_entity.PayrollAdjustment
+	for _, a := range m.adjustments {
+		if a.EmployeeID() == employeeID && !a.Date().Before(from) && !a.Date().After(to) {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+type overtimePay map[uuid.UUID]int64
+
+func (o overtimePay) PayForPeriod(_ context.Context, employeeID uuid.UUID, _, _ time.Time) (int64, error) {
+	return o[employeeID], nil
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newPaidEmployee(t *testing.T, salary int64, start time.Time, end *time.Time) *employee_entity.Employee {
+	return porttest.NewEmployee(t, porttest.Hire{Start: start, End: end, Salary: salary})
+}
+
+func TestPayrollService_Run(t *testing.T) {
+	ctx := context.Background()
+	period := payroll_entity.MonthlyPayPeriod(2025, time.April)
+	terminated := date(2025, 4, 10)
+
+	fullMonth := newPaidEmployee(t, 10_000_000, date(2023, 1, 2), nil)
+	newHire := newPaidEmployee(t, 6_000_000, date(2025, 4, 16), nil)
+	leaver := newPaidEmployee(t, 9_000_000, date(2024, 10, 1), &terminated)
+	future := newPaidEmployee(t, 7_000_000, date(2025, 5, 1), nil)
+
+	adjustments := &memoryAdjustments{}
+	deduction, _ := payroll_entity.PayrollAdjustmentFactory{
+		ID: uuid.NewString(), EmployeeID: fullMonth.ID().String(), Date: date(2025, 4, 20),
+		Code: "DAMAGE", Name: "Potongan Kerusakan", Kind: "deduction", Amount: 200_000,
+	}.Create()
+	_ = adjustments.Save(ctx, deduction)
+
+	runs := porttest.NewPayrollRuns()
+	service := payroll_service.NewPayrollService(
+		porttest.NewEmployees(fullMonth, newHire, leaver, future),
+		runs,
+		nil,
+		clock.Fixed{At: date(2025, 4, 25)},
+		payroll_service.NewOvertimeComponent(overtimePay{fullMonth.ID(): 500_000}),
+		payroll_service.NewAdjustmentComponent(adjustments),
+	)
+
+	run, err := service.CreateRun(ctx, period, "")
+	assert.Nil(t, err)
+	_, err = service.CreateRun(ctx, period, "IDR")
+	assert.EqualError(t, err, "payroll run already exists for the period")
+
+	run, err = service.Calculate(ctx, run.ID())
+	assert.Nil(t, err)
+	assert.Equal(t, enum.PayrollCalculated, run.Status())
+	assert.Len(t, run.Payslips(), 3)
+
+	slip, _ := run.Payslip(fullMonth.ID())
+	assert.False(t, slip.IsProrated())
+	assert.Equal(t, int64(10_500_000), slip.GrossPay())
+	assert.Equal(t, int64(10_300_000), slip.NetPay())
+	overtime, _ := slip.Line(payroll_entity.LineOvertime)
+	assert.Equal(t, int64(500_000), overtime.Amount())
+
+	slip, _ = run.Payslip(newHire.ID())
+	assert.Equal(t, 15, slip.WorkedDays())
+	assert.Equal(t, int64(3_000_000), slip.NetPay())
+	_, ok := slip.Line(payroll_entity.LineOvertime)
+	assert.False(t, ok)
+
+	slip, _ = run.Payslip(leaver.ID())
+	assert.Equal(t, int64(3_000_000), slip.NetPay())
+	_, ok = run.Payslip(future.ID())
+	assert.False(t, ok)
+
+	// Re-running a calculated run picks up late adjustments.
+	bonus, _ := payroll_entity.PayrollAdjustmentFactory{
+		ID: uuid.NewString(), EmployeeID: newHire.ID().String(), Date: date(2025, 4, 30),
+		Code: "SIGNING", Name: "Bonus Bergabung", Kind: "earning", Amount: 1_000_000, Taxable: true,
+	}.Create()
+	_ = adjustments.Save(ctx, bonus)
+	run, err = service.Calculate(ctx, run.ID())
+	assert.Nil(t, err)
+	slip, _ = run.Payslip(newHire.ID())
+	assert.Equal(t, int64(4_000_000), slip.NetPay())
+	assert.Equal(t, int64(17_300_000), run.TotalNetPay())
+
+	approver := uuid.New()
+	_, err = service.Approve(ctx, run.ID(), approver)
+	assert.Nil(t, err)
+	_, err = service.Calculate(ctx, run.ID())
+	assert.EqualError(t, err, "payroll run is locked")
+	run, err = service.MarkPaid(ctx, run.ID())
+	assert.Nil(t, err)
+	assert.Equal(t, enum.PayrollPaid, run.Status())
+}
+
+type weekdays struct{}
+
+func (weekdays) WorkingDaysBetween(from, to time.Time) int {
+	count := 0
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		if d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
+			count++
+		}
+	}
+	return count
+}
+
+func TestPayrollService_Proration(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("WorkingDays", func(t *testing.T) {
+		// April 2025 has 22 weekdays; 14 Apr - 30 Apr has 13.
+		employee := newPaidEmployee(t, 8_800_000, date(2025, 4, 14), nil)
+		service := payroll_service.NewPayrollService(porttest.NewEmployees(employee), porttest.NewPayrollRuns(), weekdays{}, clock.Fixed{At: date(2025, 4, 25)})
+		run, _ := service.CreateRun(ctx, payroll_entity.MonthlyPayPeriod(2025, time.April), "IDR")
+
+		run, err := service.Calculate(ctx, run.ID())
+		assert.Nil(t, err)
+		slip, _ := run.Payslip(employee.ID())
+		assert.Equal(t, 13, slip.WorkedDays())
+		assert.Equal(t, 22, slip.PeriodDays())
+		assert.Equal(t, int64(5_200_000), slip.GrossPay())
+	})
+	t.Run("MissingSalary", func(t *testing.T) {
+		employee := newPaidEmployee(t, 0, date(2025, 1, 1), nil)
+		service := payroll_service.NewPayrollService(porttest.NewEmployees(employee), porttest.NewPayrollRuns(), nil, nil)
+		run, _ := service.CreateRun(ctx, payroll_entity.MonthlyPayPeriod(2025, time.April), "IDR")
+
+		_, err := service.Calculate(ctx, run.ID())
+		assert.EqualError(t, err, "employee "+employee.ID().String()+": no salary record in effect")
+	})
+}
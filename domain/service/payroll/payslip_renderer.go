@@ -0,0 +1,330 @@
+package payroll_service
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/pkg/format"
+	"github.com/rfanazhari/hris/pkg/pdf"
+	"html/template"
+	"image/jpeg"
+	"regexp"
+	"strconv"
+)
+
+//go:embed templates/payslip.html
+var payslipHTML string
+
+var payslipTemplate = template.Must(template.New("payslip").Parse(payslipHTML))
+
+var hexColor = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// defaultPayslipColor is used when the branding has no color.
+const defaultPayslipColor = "#1F3A5F"
+
+// PayslipBranding is the company identity printed on payslips.
+//
+// Fields:
+//   - CompanyName: required
+//   - Address: optional, printed below the company name
+//   - Logo: optional JPEG image
+//   - Color: header color as "#RRGGBB", defaults to dark blue
+type PayslipBranding struct {
+	CompanyName string
+	Address     string
+	Logo        []byte
+	Color       string
+}
+
+// PayslipLineView is a payslip line formatted for display.
+type PayslipLineView struct {
+	Name   string
+	Amount string
+}
+
+// PayslipView is a payslip with its amounts formatted for display, as passed to the
+// HTML template.
+type PayslipView struct {
+	CompanyName                string
+	Address                    string
+	Logo                       template.URL
+	Color                      string
+	EmployeeName               string
+	EmployeeID                 string
+	Period                     string
+	PeriodRange                string
+	Prorated                   bool
+	WorkedDays                 int
+	PeriodDays                 int
+	Earnings                   []PayslipLineView
+	Deductions                 []PayslipLineView
+	EmployerContributions      []PayslipLineView
+	GrossPay                   string
+	TotalDeductions            string
+	NetPay                     string
+	TotalEmployerContributions string
+	TaxYearToDate              string
+}
+
+// PayslipRenderer renders payslips of approved or paid payroll runs as HTML or PDF. Rendering
+// happens entirely in process; no external service or font is needed.
+type PayslipRenderer struct {
+	runs      port.PayrollRunRepository
+	employees port.EmployeeRepository
+	branding  PayslipBranding
+}
+
+// NewPayslipRenderer returns a PayslipRenderer printing the given branding.
+func NewPayslipRenderer(runs port.PayrollRunRepository, employees port.EmployeeRepository, branding PayslipBranding) (*PayslipRenderer, error) {
+	if branding.CompanyName == "" {
+		return nil, errors.New("company name cannot be empty")
+	}
+	if branding.Color == "" {
+		branding.Color = defaultPayslipColor
+	}
+	if !hexColor.MatchString(branding.Color) {
+		return nil, fmt.Errorf("invalid branding color: %q", branding.Color)
+	}
+	if len(branding.Logo) > 0 {
+		if _, err := jpeg.DecodeConfig(bytes.NewReader(branding.Logo)); err != nil {
+			return nil, errors.New("logo must be a JPEG image")
+		}
+	}
+	return &PayslipRenderer{runs: runs, employees: employees, branding: branding}, nil
+}
+
+// View returns the employee's payslip of the run formatted for display. Payslips of runs that
+// are not approved yet can still change and are not rendered. The year to date tax is the
+// PPh 21 withheld, less refunds, on the employee's approved payslips of the year up to and
+// including this one.
+func (r *PayslipRenderer) View(ctx context.Context, runID, employeeID uuid.UUID) (*PayslipView, error) {
+	view, _, err := r.view(ctx, runID, employeeID)
+	return view, err
+}
+
+func (r *PayslipRenderer) view(ctx context.Context, runID, employeeID uuid.UUID) (*PayslipView, *employee_entity.Employee, error) {
+	run, err := r.runs.FindByID(ctx, runID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("find payroll run: %w", err)
+	}
+	if !run.IsLocked() {
+		return nil, nil, errors.New("payroll run is not approved")
+	}
+	slip, ok := run.Payslip(employeeID)
+	if !ok {
+		return nil, nil, errors.New("payslip not found")
+	}
+	employee, err := r.employees.FindByID(ctx, employeeID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("find employee: %w", err)
+	}
+	taxYTD, err := r.taxYearToDate(ctx, slip)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	period := slip.Period()
+	info := employee.PersonalInfo()
+	money := func(amount int64) string { return formatMoney(slip.Currency(), amount) }
+	view := &PayslipView{
+		CompanyName:                r.branding.CompanyName,
+		Address:                    r.branding.Address,
+		Color:                      r.branding.Color,
+		EmployeeName:               info.Name().FullName(),
+		EmployeeID:                 employee.ID().String(),
+		Period:                     format.MonthYear(period.Start()),
+		PeriodRange:                format.Date(period.Start()) + " - " + format.Date(period.End()),
+		Prorated:                   slip.IsProrated(),
+		WorkedDays:                 slip.WorkedDays(),
+		PeriodDays:                 slip.PeriodDays(),
+		Earnings:                   lineViews(slip.Earnings(), money),
+		Deductions:                 lineViews(slip.Deductions(), money),
+		EmployerContributions:      lineViews(slip.EmployerContributions(), money),
+		GrossPay:                   money(slip.GrossPay()),
+		TotalDeductions:            money(slip.TotalDeductions()),
+		NetPay:                     money(slip.NetPay()),
+		TotalEmployerContributions: money(sumLines(slip.EmployerContributions())),
+		TaxYearToDate:              money(taxYTD),
+	}
+	if len(r.branding.Logo) > 0 {
+		view.Logo = template.URL("data:image/jpeg;base64," + base64.StdEncoding.EncodeToString(r.branding.Logo))
+	}
+	return view, employee, nil
+}
+
+// HTML renders the employee's payslip of the run as a standalone HTML page.
+func (r *PayslipRenderer) HTML(ctx context.Context, runID, employeeID uuid.UUID) ([]byte, error) {
+	view, err := r.View(ctx, runID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := payslipTemplate.Execute(&buf, view); err != nil {
+		return nil, fmt.Errorf("render payslip: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// PDF renders the employee's payslip of the run as an A4 PDF. When protect is set the
+// document is encrypted with the employee's birth date as password, written as DDMMYYYY.
+func (r *PayslipRenderer) PDF(ctx context.Context, runID, employeeID uuid.UUID, protect bool) ([]byte, error) {
+	view, employee, err := r.view(ctx, runID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := pdf.New()
+	doc.SetInfo("Slip Gaji "+view.Period+" - "+view.EmployeeName, r.branding.CompanyName)
+	if protect {
+		info := employee.PersonalInfo()
+		doc.Encrypt(info.BirthDate().Format("02012006"), "")
+	}
+
+	var logo *pdf.Image
+	if len(r.branding.Logo) > 0 {
+		if logo, err = doc.AddJPEG(r.branding.Logo); err != nil {
+			return nil, err
+		}
+	}
+	layoutPayslip(doc, view, logo)
+	return doc.Bytes()
+}
+
+func (r *PayslipRenderer) taxYearToDate(ctx context.Context, slip payroll_entity.Payslip) (int64, error) {
+	period := slip.Period()
+	runs, err := r.runs.ListByYear(ctx, period.Year())
+	if err != nil {
+		return 0, fmt.Errorf("list payroll runs: %w", err)
+	}
+	var total int64
+	for _, run := range runs {
+		if !run.IsLocked() || run.Period().Start().After(period.Start()) {
+			continue
+		}
+		if s, ok := run.Payslip(slip.EmployeeID()); ok {
+			total += amountOf(s, payroll_entity.LineIncomeTax) - amountOf(s, payroll_entity.LineIncomeTaxRefund)
+		}
+	}
+	return total, nil
+}
+
+func lineViews(lines []payroll_entity.PayslipLine, money func(int64) string) []PayslipLineView {
+	out := make([]PayslipLineView, len(lines))
+	for i, l := range lines {
+		out[i] = PayslipLineView{Name: l.Name(), Amount: money(l.Amount())}
+	}
+	return out
+}
+
+func sumLines(lines []payroll_entity.PayslipLine) int64 {
+	var total int64
+	for _, l := range lines {
+		total += l.Amount()
+	}
+	return total
+}
+
+// formatMoney formats rupiah amounts the Indonesian way and prefixes other currencies
+// with their code.
+func formatMoney(currency string, amount int64) string {
+	if currency == "IDR" {
+		return format.Rupiah(amount)
+	}
+	if amount < 0 {
+		return "-" + currency + " " + format.Thousands(-amount)
+	}
+	return currency + " " + format.Thousands(amount)
+}
+
+// layoutPayslip draws the payslip on A4 pages, starting a new page when a section runs
+// past the bottom margin.
+func layoutPayslip(doc *pdf.Document, view *PayslipView, logo *pdf.Image) {
+	const left, right, bottom = 40.0, pdf.A4Width - 40, pdf.A4Height - 60
+	red, green, blue := rgb(view.Color)
+
+	page := doc.AddPage()
+	page.SetFillColor(red, green, blue)
+	page.Rect(0, 0, pdf.A4Width, 80)
+	x := left
+	if logo != nil {
+		w := 50 * float64(logo.Width()) / float64(logo.Height())
+		page.Image(logo, left, 15, w, 50)
+		x += w + 12
+	}
+	page.SetFillColor(255, 255, 255)
+	page.Text(x, 40, pdf.HelveticaBold, 16, view.CompanyName)
+	page.Text(x, 58, pdf.Helvetica, 9, view.Address)
+	page.TextRight(right, 40, pdf.HelveticaBold, 14, "SLIP GAJI")
+	page.TextRight(right, 58, pdf.Helvetica, 10, view.Period)
+
+	page.SetFillColor(34, 34, 34)
+	y := 110.0
+	details := [][2]string{{"Nama", view.EmployeeName}, {"ID Karyawan", view.EmployeeID}, {"Periode", view.PeriodRange}}
+	if view.Prorated {
+		details = append(details, [2]string{"Hari kerja", strconv.Itoa(view.WorkedDays) + " dari " + strconv.Itoa(view.PeriodDays) + " hari"})
+	}
+	for _, d := range details {
+		page.Text(left, y, pdf.Helvetica, 10, d[0])
+		page.Text(left+110, y, pdf.Helvetica, 10, ": "+d[1])
+		y += 16
+	}
+	y += 10
+
+	table := func(title string, lines []PayslipLineView, totalLabel, total string) {
+		if y+float64(len(lines)+2)*16 > bottom {
+			page = doc.AddPage()
+			y = 60
+		}
+		page.SetFillColor(235, 235, 235)
+		page.Rect(left, y-12, right-left, 18)
+		page.SetFillColor(34, 34, 34)
+		page.Text(left+6, y, pdf.HelveticaBold, 10, title)
+		page.TextRight(right-6, y, pdf.HelveticaBold, 10, "Jumlah")
+		y += 20
+		for _, l := range lines {
+			page.Text(left+6, y, pdf.Helvetica, 10, l.Name)
+			page.TextRight(right-6, y, pdf.Helvetica, 10, l.Amount)
+			y += 16
+		}
+		if totalLabel != "" {
+			page.SetStrokeColor(153, 153, 153)
+			page.Line(left, y-11, right, y-11, 0.5)
+			page.Text(left+6, y, pdf.HelveticaBold, 10, totalLabel)
+			page.TextRight(right-6, y, pdf.HelveticaBold, 10, total)
+			y += 16
+		}
+		y += 12
+	}
+
+	table("Pendapatan", view.Earnings, "Total Pendapatan", view.GrossPay)
+	table("Potongan", view.Deductions, "Total Potongan", view.TotalDeductions)
+
+	page.SetFillColor(red, green, blue)
+	page.Rect(left, y-14, right-left, 24)
+	page.SetFillColor(255, 255, 255)
+	page.Text(left+6, y+2, pdf.HelveticaBold, 12, "Gaji Bersih")
+	page.TextRight(right-6, y+2, pdf.HelveticaBold, 12, view.NetPay)
+	page.SetFillColor(34, 34, 34)
+	y += 36
+
+	if len(view.EmployerContributions) > 0 {
+		table("Iuran Perusahaan (tidak dibayarkan)", view.EmployerContributions, "Total Iuran Perusahaan", view.TotalEmployerContributions)
+	}
+	table("Pajak", []PayslipLineView{{Name: "PPh 21 dipotong s.d. " + view.Period, Amount: view.TaxYearToDate}}, "", "")
+
+	page.SetFillColor(136, 136, 136)
+	page.Text(left, pdf.A4Height-40, pdf.Helvetica, 8, "Dokumen ini dibuat secara elektronik dan tidak memerlukan tanda tangan.")
+}
+
+// rgb parses a "#RRGGBB" color.
+func rgb(hex string) (uint8, uint8, uint8) {
+	v, _ := strconv.ParseUint(hex[1:], 16, 32)
+	return uint8(v >> 16), uint8(v >> 8), uint8(v)
+}
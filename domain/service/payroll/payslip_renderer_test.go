@@ -0,0 +1,102 @@
+package payroll_service_test
+
+import (
+	"bytes"
+	"context"
+	"github.com/google/uuid"
+	bpjs_entity "github.com/rfanazhari/hris/domain/entity/bpjs"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	payroll_service "github.com/rfanazhari/hris/domain/service/payroll"
+	"github.com/rfanazhari/hris/pkg/format"
+	"github.com/stretchr/testify/assert"
+	"image"
+	"image/jpeg"
+	"strings"
+	"testing"
+)
+
+func TestPayslipRenderer(t *testing.T) {
+	ctx := context.Background()
+	employee := newPaidEmployee(t, 10_000_000, date(2020, 1, 1), nil)
+	employees := porttest.NewEmployees(employee)
+	runs := porttest.NewPayrollRuns()
+	service := payroll_service.NewPayrollService(employees, runs, nil, nil,
+		payroll_service.NewBPJSComponent(bpjs_entity.DefaultRateSchedule(), porttest.OrganizationUnits{}, enum.JKKRiskVeryLow),
+		payroll_service.NewIncomeTaxComponent(runs, nil, payroll_entity.LineBPJSJHT, payroll_entity.LineBPJSJP),
+	)
+	runMonths(t, service, employee.ID(), 1)
+	// February stays calculated: it is neither rendered nor counted in the year to date.
+	february, _ := service.CreateRun(ctx, payroll_entity.MonthlyPayPeriod(2025, 2), "IDR")
+	february, _ = service.Calculate(ctx, february.ID())
+	march, _ := service.CreateRun(ctx, payroll_entity.MonthlyPayPeriod(2025, 3), "IDR")
+	march, _ = service.Calculate(ctx, march.ID())
+	march, err := service.Approve(ctx, march.ID(), uuid.New())
+	assert.Nil(t, err)
+
+	var logo bytes.Buffer
+	_ = jpeg.Encode(&logo, image.NewRGBA(image.Rect(0, 0, 20, 10)), nil)
+	renderer, err := payroll_service.NewPayslipRenderer(runs, employees, payroll_service.PayslipBranding{
+		CompanyName: "PT Maju <Bersama>", Address: "Jl. Sudirman No. 1, Jakarta", Logo: logo.Bytes(),
+	})
+	assert.Nil(t, err)
+
+	t.Run("View", func(t *testing.T) {
+		view, err := renderer.View(ctx, march.ID(), employee.ID())
+		assert.Nil(t, err)
+
+		assert.Equal(t, "Rina Wijaya", view.EmployeeName)
+		assert.Equal(t, "Maret 2025", view.Period)
+		assert.Equal(t, "1 Maret 2025 - 31 Maret 2025", view.PeriodRange)
+		assert.Equal(t, "#1F3A5F", view.Color)
+		assert.Equal(t, payroll_service.PayslipLineView{Name: "Gaji Pokok", Amount: "Rp 10.000.000"}, view.Earnings[0])
+		assert.NotEmpty(t, view.Deductions)
+		assert.NotEmpty(t, view.EmployerContributions)
+		// January and March withheld at TER A on the gross including taxable employer contributions.
+		slip, _ := march.Payslip(employee.ID())
+		tax, _ := slip.Line(payroll_entity.LineIncomeTax)
+		assert.Equal(t, format.Rupiah(2*tax.Amount()), view.TaxYearToDate)
+		assert.True(t, strings.HasPrefix(string(view.Logo), "data:image/jpeg;base64,"))
+	})
+	t.Run("HTML", func(t *testing.T) {
+		out, err := renderer.HTML(ctx, march.ID(), employee.ID())
+		assert.Nil(t, err)
+
+		html := string(out)
+		assert.Contains(t, html, "<title>Slip Gaji Maret 2025 - Rina Wijaya</title>")
+		assert.Contains(t, html, "PT Maju &lt;Bersama&gt;")
+		assert.Contains(t, html, "Rp 10.000.000")
+		assert.Contains(t, html, "Iuran Perusahaan")
+	})
+	t.Run("PDF", func(t *testing.T) {
+		out, err := renderer.PDF(ctx, march.ID(), employee.ID(), false)
+		assert.Nil(t, err)
+		assert.True(t, bytes.HasPrefix(out, []byte("%PDF-1.4")))
+		assert.Contains(t, string(out), "(Rp 10.000.000) Tj")
+		assert.Contains(t, string(out), "/Subtype /Image")
+
+		protected, err := renderer.PDF(ctx, march.ID(), employee.ID(), true)
+		assert.Nil(t, err)
+		assert.Contains(t, string(protected), "/Filter /Standard")
+		assert.NotContains(t, string(protected), "Rp 10.000.000")
+	})
+	t.Run("NotApproved", func(t *testing.T) {
+		_, err := renderer.HTML(ctx, february.ID(), employee.ID())
+		assert.EqualError(t, err, "payroll run is not approved")
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := renderer.HTML(ctx, march.ID(), uuid.New())
+		assert.EqualError(t, err, "payslip not found")
+		_, err = renderer.PDF(ctx, uuid.New(), employee.ID(), false)
+		assert.EqualError(t, err, "find payroll run: payroll run not found")
+	})
+	t.Run("InvalidBranding", func(t *testing.T) {
+		_, err := payroll_service.NewPayslipRenderer(runs, employees, payroll_service.PayslipBranding{})
+		assert.EqualError(t, err, "company name cannot be empty")
+		_, err = payroll_service.NewPayslipRenderer(runs, employees, payroll_service.PayslipBranding{CompanyName: "PT Maju", Color: "blue"})
+		assert.EqualError(t, err, `invalid branding color: "blue"`)
+		_, err = payroll_service.NewPayslipRenderer(runs, employees, payroll_service.PayslipBranding{CompanyName: "PT Maju", Logo: []byte("png")})
+		assert.EqualError(t, err, "logo must be a JPEG image")
+	})
+}
@@ -0,0 +1,256 @@
+package reimbursement_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	reimbursement_entity "github.com/rfanazhari/hris/domain/entity/reimbursement"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"sort"
+	"time"
+)
+
+// GradeLevelSource returns the grade level of an employee's position on a date.
+type GradeLevelSource interface {
+	GradeLevel(ctx context.Context, employeeID uuid.UUID, at time.Time) (enum.GradeLevel, error)
+}
+
+// ReimbursementService handles reimbursement claims from submission to payment. Claims
+// are checked against the annual limit of the employee's grade level when submitted and
+// again when approved. Approved claims are paid either by the first payroll run whose
+// period ends on or after the approval, or by a separate payout.
+type ReimbursementService struct {
+	claims port.ReimbursementClaimRepository
+	runs   port.PayrollRunRepository
+	policy *reimbursement_entity.LimitPolicy
+	grades GradeLevelSource
+	clock  clock.Clock
+}
+
+// NewReimbursementService returns a ReimbursementService. A nil clock falls back to the
+// system clock.
+func NewReimbursementService(claims port.ReimbursementClaimRepository, runs port.PayrollRunRepository, policy *reimbursement_entity.LimitPolicy, grades GradeLevelSource, clk clock.Clock) *ReimbursementService {
+	if clk == nil {
+		clk = clock.System{}
+	}
+	return &ReimbursementService{claims: claims, runs: runs, policy: policy, grades: grades, clock: clk}
+}
+
+// CreateClaim creates a draft claim.
+func (s *ReimbursementService) CreateClaim(ctx context.Context, f reimbursement_entity.ClaimFactory) (*reimbursement_entity.Claim, error) {
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = s.clock.Now()
+	}
+	claim, err := f.Create()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.claims.Save(ctx, claim); err != nil {
+		return nil, fmt.Errorf("save claim: %w", err)
+	}
+	return claim, nil
+}
+
+// AddItem adds an expense to a draft claim.
+func (s *ReimbursementService) AddItem(ctx context.Context, claimID uuid.UUID, item reimbursement_entity.ClaimItem) (*reimbursement_entity.Claim, error) {
+	return s.update(ctx, claimID, func(claim *reimbursement_entity.Claim) error {
+		return claim.AddItem(item, s.clock.Now())
+	})
+}
+
+// RemoveItem removes the expense at the given index from a draft claim.
+func (s *ReimbursementService) RemoveItem(ctx context.Context, claimID uuid.UUID, index int) (*reimbursement_entity.Claim, error) {
+	return s.update(ctx, claimID, func(claim *reimbursement_entity.Claim) error {
+		return claim.RemoveItem(index, s.clock.Now())
+	})
+}
+
+// Submit sends a draft claim for approval. The claim may not exceed what is left of the
+// annual limit in any year its expenses are dated in.
+func (s *ReimbursementService) Submit(ctx context.Context, claimID uuid.UUID) (*reimbursement_entity.Claim, error) {
+	return s.update(ctx, claimID, func(claim *reimbursement_entity.Claim) error {
+		if err := s.checkLimit(ctx, claim); err != nil {
+			return err
+		}
+		return claim.Submit(s.clock.Now())
+	})
+}
+
+// Approve approves a submitted claim after checking the annual limit again.
+func (s *ReimbursementService) Approve(ctx context.Context, claimID, approverID uuid.UUID) (*reimbursement_entity.Claim, error) {
+	return s.update(ctx, claimID, func(claim *reimbursement_entity.Claim) error {
+		if err := s.checkLimit(ctx, claim); err != nil {
+			return err
+		}
+		return claim.Approve(approverID, s.clock.Now())
+	})
+}
+
+// Reject rejects a submitted claim with a reason.
+func (s *ReimbursementService) Reject(ctx context.Context, claimID, approverID uuid.UUID, note string) (*reimbursement_entity.Claim, error) {
+	return s.update(ctx, claimID, func(claim *reimbursement_entity.Claim) error {
+		return claim.Reject(approverID, note, s.clock.Now())
+	})
+}
+
+// Cancel withdraws a draft or submitted claim.
+func (s *ReimbursementService) Cancel(ctx context.Context, claimID uuid.UUID) (*reimbursement_entity.Claim, error) {
+	return s.update(ctx, claimID, func(claim *reimbursement_entity.Claim) error {
+		return claim.Cancel(s.clock.Now())
+	})
+}
+
+// PayOut records the separate payout of an approved claim.
+func (s *ReimbursementService) PayOut(ctx context.Context, claimID uuid.UUID) (*reimbursement_entity.Claim, error) {
+	return s.update(ctx, claimID, func(claim *reimbursement_entity.Claim) error {
+		return claim.MarkPaid(nil, s.clock.Now())
+	})
+}
+
+// Used returns the amount of the category the employee has claimed in the year, counting
+// submitted, approved and paid claims.
+func (s *ReimbursementService) Used(ctx context.Context, employeeID uuid.UUID, category enum.ReimbursementCategory, year int) (int64, error) {
+	claims, err := s.claims.ListByEmployee(ctx, employeeID)
+	if err != nil {
+		return 0, fmt.Errorf("list claims: %w", err)
+	}
+	return used(claims, category, year, uuid.Nil), nil
+}
+
+// Remaining returns what the employee may still claim in the category in the year under
+// the limit of their current grade level.
+func (s *ReimbursementService) Remaining(ctx context.Context, employeeID uuid.UUID, category enum.ReimbursementCategory, year int) (int64, error) {
+	grade, err := s.grades.GradeLevel(ctx, employeeID, s.clock.Now())
+	if err != nil {
+		return 0, fmt.Errorf("grade level: %w", err)
+	}
+	annual, ok := s.policy.Limit(category, grade)
+	if !ok {
+		return 0, nil
+	}
+	claimed, err := s.Used(ctx, employeeID, category, year)
+	if err != nil {
+		return 0, err
+	}
+	return max(annual-claimed, 0), nil
+}
+
+// ReimbursementForPeriod sums the unpaid claims to be paid through payroll that were
+// approved by the end of the period [from, to]; it is used by the payroll
+// ReimbursementComponent.
+func (s *ReimbursementService) ReimbursementForPeriod(ctx context.Context, employeeID uuid.UUID, from, to time.Time) (int64, error) {
+	claims, err := s.payable(ctx, employeeID, to)
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for _, c := range claims {
+		total += c.Total()
+	}
+	return total, nil
+}
+
+// RecordPayroll marks the claims paid by an approved payroll run, oldest approval first,
+// up to the reimbursement amount on each payslip.
+func (s *ReimbursementService) RecordPayroll(ctx context.Context, runID uuid.UUID) error {
+	run, err := s.runs.FindByID(ctx, runID)
+	if err != nil {
+		return fmt.Errorf("find payroll run: %w", err)
+	}
+	if !run.IsLocked() {
+		return errors.New("payroll run is not approved")
+	}
+	id := run.ID()
+	for _, slip := range run.Payslips() {
+		line, ok := slip.Line(payroll_entity.LineReimbursement)
+		if !ok {
+			continue
+		}
+		claims, err := s.payable(ctx, slip.EmployeeID(), run.Period().End())
+		if err != nil {
+			return err
+		}
+		remaining := line.Amount()
+		for i := range claims {
+			claim := &claims[i]
+			if claim.Total() > remaining {
+				continue
+			}
+			if err := claim.MarkPaid(&id, s.clock.Now()); err != nil {
+				return err
+			}
+			if err := s.claims.Save(ctx, claim); err != nil {
+				return fmt.Errorf("save claim: %w", err)
+			}
+			remaining -= claim.Total()
+		}
+	}
+	return nil
+}
+
+// payable returns the employee's approved, unpaid payroll claims decided by the end of
+// the given day, oldest approval first.
+func (s *ReimbursementService) payable(ctx context.Context, employeeID uuid.UUID, to time.Time) ([]reimbursement_entity.Claim, error) {
+	claims, err := s.claims.ListByEmployee(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("list claims: %w", err)
+	}
+	cutoff := time.Date(to.Year(), to.Month(), to.Day()+1, 0, 0, 0, 0, time.UTC)
+	var out []reimbursement_entity.Claim
+	for _, c := range claims {
+		if c.Status() == enum.ClaimApproved && c.PayoutMethod() == enum.PayoutPayroll && c.DecidedAt().Before(cutoff) {
+			out = append(out, c)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].DecidedAt().Before(*out[j].DecidedAt()) })
+	return out, nil
+}
+
+// checkLimit checks the claim against the limit of the employee's current grade level in
+// every year its expenses are dated in. The claim itself is not counted as used.
+func (s *ReimbursementService) checkLimit(ctx context.Context, claim *reimbursement_entity.Claim) error {
+	grade, err := s.grades.GradeLevel(ctx, claim.EmployeeID(), s.clock.Now())
+	if err != nil {
+		return fmt.Errorf("grade level: %w", err)
+	}
+	claims, err := s.claims.ListByEmployee(ctx, claim.EmployeeID())
+	if err != nil {
+		return fmt.Errorf("list claims: %w", err)
+	}
+	for _, year := range claim.Years() {
+		if err := s.policy.Check(claim.Category(), grade, year, used(claims, claim.Category(), year, claim.ID()), claim.TotalIn(year)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ReimbursementService) update(ctx context.Context, claimID uuid.UUID, change func(*reimbursement_entity.Claim) error) (*reimbursement_entity.Claim, error) {
+	claim, err := s.claims.FindByID(ctx, claimID)
+	if err != nil {
+		return nil, fmt.Errorf("find claim: %w", err)
+	}
+	if err := change(claim); err != nil {
+		return nil, err
+	}
+	if err := s.claims.Save(ctx, claim); err != nil {
+		return nil, fmt.Errorf("save claim: %w", err)
+	}
+	return claim, nil
+}
+
+// used sums the claims of the category counting towards the limit of the year, except
+// the claim with the given id.
+func used(claims []reimbursement_entity.Claim, category enum.ReimbursementCategory, year int, except uuid.UUID) int64 {
+	var total int64
+	for _, c := range claims {
+		if c.ID() != except && c.Category() == category && c.CountsTowardsLimit() {
+			total += c.TotalIn(year)
+		}
+	}
+	return total
+}
@@ -0,0 +1,198 @@
+package reimbursement_service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	reimbursement_entity "github.com/rfanazhari/hris/domain/entity/reimbursement"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	payroll_service "github.com/rfanazhari/hris/domain/service/payroll"
+	reimbursement_service "github.com/rfanazhari/hris/domain/service/reimbursement"
+	"github.com/rfanazhari/hris/domain/valueobject"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type memoryClaims struct {
+	claims []*reimbursement_entity.Claim
+}
+
+func (m *memoryClaims) Save(_ context.Context, claim *reimbursement_entity.Claim) error {
+	for i, c := range m.claims {
+		if c.ID() == claim.ID() {
+			m.claims[i] = claim
+			return nil
+		}
+	}
+	m.claims = append(m.claims, claim)
+	return nil
+}
+
+func (m *memoryClaims) FindByID(_ context.Context, id uuid.UUID) (*reimbursement_entity.Claim, error) {
+	for _, c := range m.claims {
+		if c.ID() == id {
+			return c, nil
+		}
+	}
+	return nil, errors.New("claim not found")
+}
+
+func (m *memoryClaims) ListByEmployee(_ context.Context, employeeID uuid.UUID) ([]reimbursement_entity.Claim, error) {
+	var out []reimbursement_entity.Claim
+	for _, c := range m.claims {
+		if c.EmployeeID() == employeeID {
+			out = append(out, *c)
+		}
+	}
+	return out, nil
+}
+
+type grades map[uuid.UUID]enum.GradeLevel
+
+func (g grades) GradeLevel(_ context.Context, employeeID uuid.UUID, _ time.Time) (enum.GradeLevel, error) {
+	return g[employeeID], nil
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+type fixture struct {
+	service  *reimbursement_service.ReimbursementService
+	claims   *memoryClaims
+	clock    *clock.Fixed
+	runs     *porttest.PayrollRuns
+	employee *employee_entity.Employee
+	approver uuid.UUID
+}
+
+func newFixture(t *testing.T) fixture {
+	employee := porttest.NewEmployee(t, porttest.Hire{Gender: "M", Start: date(2024, 1, 1), Salary: 8_000_000})
+	medical, _ := reimbursement_entity.NewLimit(enum.ReimbursementMedical, enum.GradeJunior, 3_000_000)
+	travel, _ := reimbursement_entity.NewLimit(enum.ReimbursementTravel, enum.GradeJunior, 10_000_000)
+	policy, err := reimbursement_entity.NewLimitPolicy(*medical, *travel)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clk := &clock.Fixed{At: date(2025, 4, 1)}
+	runs := porttest.NewPayrollRuns()
+	claims := &memoryClaims{}
+	service := reimbursement_service.NewReimbursementService(claims, runs, policy, grades{employee.ID(): enum.GradeJunior}, clk)
+	return fixture{service: service, claims: claims, clock: clk, runs: runs, employee: employee, approver: uuid.New()}
+}
+
+// claim creates a claim of the category with one expense per amount, dated on the given
+// day, and submits it.
+func (f fixture) claim(t *testing.T, category, payout string, day time.Time, amounts ...int64) (*reimbursement_entity.Claim, error) {
+	ctx := context.Background()
+	claim, err := f.service.CreateClaim(ctx, reimbursement_entity.ClaimFactory{ID: uuid.NewString(), EmployeeID: f.employee.ID().String(), Category: category, PayoutMethod: payout})
+	assert.Nil(t, err)
+	receipt, _ := valueobject.NewFileReference("https://storage.example.com/receipts/r.pdf", "r.pdf", "application/pdf")
+	for _, amount := range amounts {
+		item, err := reimbursement_entity.NewClaimItem(day, "Biaya "+category, amount, receipt)
+		assert.Nil(t, err)
+		_, err = f.service.AddItem(ctx, claim.ID(), *item)
+		assert.Nil(t, err)
+	}
+	return f.service.Submit(ctx, claim.ID())
+}
+
+func TestReimbursementService_Limits(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	first, err := f.claim(t, "medical", "", date(2025, 3, 10), 1_200_000, 800_000)
+	assert.Nil(t, err)
+	assert.Equal(t, enum.ClaimSubmitted, first.Status())
+
+	_, err = f.claim(t, "medical", "", date(2025, 3, 20), 1_500_000)
+	assert.EqualError(t, err, "medical reimbursement exceeds the 2025 limit: 1000000 of 3000000 remaining")
+	_, err = f.claim(t, "optical", "", date(2025, 3, 20), 500_000)
+	assert.EqualError(t, err, "optical reimbursement is not available for grade junior")
+	// Last year's expenses count towards last year's limit.
+	_, err = f.claim(t, "medical", "", date(2024, 12, 20), 1_500_000)
+	assert.Nil(t, err)
+
+	remaining, err := f.service.Remaining(ctx, f.employee.ID(), enum.ReimbursementMedical, 2025)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1_000_000), remaining)
+
+	_, err = f.service.Reject(ctx, first.ID(), f.approver, "kuitansi tidak lengkap")
+	assert.Nil(t, err)
+	used, _ := f.service.Used(ctx, f.employee.ID(), enum.ReimbursementMedical, 2025)
+	assert.Equal(t, int64(0), used)
+	_, err = f.claim(t, "medical", "", date(2025, 3, 20), 1_500_000)
+	assert.Nil(t, err)
+
+	_, err = f.service.Approve(ctx, uuid.New(), f.approver)
+	assert.EqualError(t, err, "find claim: claim not found")
+}
+
+func TestReimbursementService_Payout(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Payroll", func(t *testing.T) {
+		f := newFixture(t)
+		medical, _ := f.claim(t, "medical", "payroll", date(2025, 4, 2), 750_000)
+		travel, _ := f.claim(t, "travel", "payroll", date(2025, 4, 3), 2_000_000)
+		late, _ := f.claim(t, "travel", "payroll", date(2025, 4, 28), 400_000)
+		separate, _ := f.claim(t, "travel", "separate", date(2025, 4, 3), 600_000)
+
+		f.clock.At = date(2025, 4, 10)
+		for _, c := range []*reimbursement_entity.Claim{medical, travel, separate} {
+			_, err := f.service.Approve(ctx, c.ID(), f.approver)
+			assert.Nil(t, err)
+		}
+		f.clock.At = time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+		_, err := f.service.Approve(ctx, late.ID(), f.approver)
+		assert.Nil(t, err)
+
+		amount, err := f.service.ReimbursementForPeriod(ctx, f.employee.ID(), date(2025, 4, 1), date(2025, 4, 30))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2_750_000), amount)
+
+		payroll := payroll_service.NewPayrollService(porttest.NewEmployees(f.employee), f.runs, nil, clock.Fixed{At: date(2025, 4, 25)},
+			payroll_service.NewReimbursementComponent(f.service),
+		)
+		run, _ := payroll.CreateRun(ctx, payroll_entity.MonthlyPayPeriod(2025, time.April), "IDR")
+		run, err = payroll.Calculate(ctx, run.ID())
+		assert.Nil(t, err)
+		slip, _ := run.Payslip(f.employee.ID())
+		line, _ := slip.Line(payroll_entity.LineReimbursement)
+		assert.Equal(t, int64(2_750_000), line.Amount())
+		assert.Equal(t, int64(8_000_000), slip.TaxableGross())
+
+		assert.EqualError(t, f.service.RecordPayroll(ctx, run.ID()), "payroll run is not approved")
+		_, _ = payroll.Approve(ctx, run.ID(), f.approver)
+		assert.Nil(t, f.service.RecordPayroll(ctx, run.ID()))
+		assert.Nil(t, f.service.RecordPayroll(ctx, run.ID()))
+
+		for _, c := range []*reimbursement_entity.Claim{medical, travel} {
+			stored, _ := f.claims.FindByID(ctx, c.ID())
+			assert.Equal(t, enum.ClaimPaid, stored.Status())
+			assert.Equal(t, run.ID(), *stored.PayrollRunID())
+		}
+		for _, c := range []*reimbursement_entity.Claim{late, separate} {
+			stored, _ := f.claims.FindByID(ctx, c.ID())
+			assert.Equal(t, enum.ClaimApproved, stored.Status())
+		}
+		amount, _ = f.service.ReimbursementForPeriod(ctx, f.employee.ID(), date(2025, 5, 1), date(2025, 5, 31))
+		assert.Equal(t, int64(400_000), amount)
+	})
+	t.Run("Separate", func(t *testing.T) {
+		f := newFixture(t)
+		claim, _ := f.claim(t, "travel", "separate", date(2025, 3, 3), 600_000)
+		_, err := f.service.PayOut(ctx, claim.ID())
+		assert.EqualError(t, err, "claim is not approved")
+
+		_, _ = f.service.Approve(ctx, claim.ID(), f.approver)
+		paid, err := f.service.PayOut(ctx, claim.ID())
+		assert.Nil(t, err)
+		assert.Equal(t, date(2025, 4, 1), *paid.PaidAt())
+	})
+}
@@ -0,0 +1,103 @@
+package thr_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	calendar_entity "github.com/rfanazhari/hris/domain/entity/calendar"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/port"
+	"time"
+)
+
+// THRService determines the THR of employees under Permenaker No. 6/2016: the religious
+// holiday follows from the employee's religion and the work calendar of their organization
+// unit, and the amount from the salary record in effect on the payment due date.
+type THRService struct {
+	employees port.EmployeeRepository
+	calendars port.WorkCalendarProvider
+}
+
+// NewTHRService returns a THRService.
+func NewTHRService(employees port.EmployeeRepository, calendars port.WorkCalendarProvider) *THRService {
+	return &THRService{employees: employees, calendars: calendars}
+}
+
+// Entitlement returns the employee's THR for the religious holiday in the given year.
+func (s *THRService) Entitlement(ctx context.Context, employeeID uuid.UUID, year int) (*payroll_entity.THR, error) {
+	employee, err := s.employees.FindByID(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("find employee: %w", err)
+	}
+	return s.entitlement(ctx, employee, year)
+}
+
+// THRForPeriod returns the THR due to the employee with a due date within [from, to]. It is
+// zero if the employee is not entitled or no longer employed on the due date, and for years
+// whose religious holiday is not known yet. The payroll run of the period must be paid by
+// the due date.
+func (s *THRService) THRForPeriod(ctx context.Context, employeeID uuid.UUID, from, to time.Time) (int64, error) {
+	employee, err := s.employees.FindByID(ctx, employeeID)
+	if err != nil {
+		return 0, fmt.Errorf("find employee: %w", err)
+	}
+
+	var total int64
+	for year := from.Year(); year <= to.Year(); year++ {
+		holiday, ok, err := s.religiousHoliday(ctx, employee, year)
+		if errors.Is(err, port.ErrNoHolidayData) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			continue
+		}
+		due := holiday.Date().Add(-payroll_entity.THRPaymentNotice)
+		if due.Before(from) || due.After(to) || !employee.IsEmployedOn(due) {
+			continue
+		}
+		thr, err := s.thr(employee, *holiday)
+		if err != nil {
+			return 0, err
+		}
+		total += thr.Amount()
+	}
+	return total, nil
+}
+
+func (s *THRService) entitlement(ctx context.Context, employee *employee_entity.Employee, year int) (*payroll_entity.THR, error) {
+	holiday, ok, err := s.religiousHoliday(ctx, employee, year)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		info := employee.PersonalInfo()
+		return nil, fmt.Errorf("no religious holiday for %s in %d", info.Religion(), year)
+	}
+	return s.thr(employee, *holiday)
+}
+
+// religiousHoliday returns the holiday of the employee's religion in the year; ok is false
+// when the work calendar has none.
+func (s *THRService) religiousHoliday(ctx context.Context, employee *employee_entity.Employee, year int) (*calendar_entity.Holiday, bool, error) {
+	cal, err := s.calendars.WorkCalendar(ctx, employee.OrganizationUnitID(), year)
+	if err != nil {
+		return nil, false, fmt.Errorf("find work calendar: %w", err)
+	}
+	info := employee.PersonalInfo()
+	holiday, ok := payroll_entity.ReligiousHoliday(info.Religion(), cal.Holidays())
+	return holiday, ok, nil
+}
+
+func (s *THRService) thr(employee *employee_entity.Employee, holiday calendar_entity.Holiday) (*payroll_entity.THR, error) {
+	due := holiday.Date().Add(-payroll_entity.THRPaymentNotice)
+	salary, ok := employee.SalaryAt(due)
+	if !ok {
+		return nil, fmt.Errorf("no salary record in effect on %s", due.Format(time.DateOnly))
+	}
+	return payroll_entity.NewTHR(employee.ID(), holiday, employee.HireDate(), salary.Amount(), salary.Currency())
+}
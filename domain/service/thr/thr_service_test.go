@@ -0,0 +1,105 @@
+package thr_service_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	calendar_entity "github.com/rfanazhari/hris/domain/entity/calendar"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	payroll_entity "github.com/rfanazhari/hris/domain/entity/payroll"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	calendar_service "github.com/rfanazhari/hris/domain/service/calendar"
+	payroll_service "github.com/rfanazhari/hris/domain/service/payroll"
+	thr_service "github.com/rfanazhari/hris/domain/service/thr"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// bundledCalendars serves work calendars built from the bundled national holidays.
+type bundledCalendars struct {
+	service *calendar_service.CalendarService
+}
+
+func (b bundledCalendars) WorkCalendar(ctx context.Context, unitID *uuid.UUID, year int) (*calendar_entity.WorkCalendar, error) {
+	return b.service.Calendar(ctx, calendar_service.CalendarRequest{OrganizationUnitID: unitID, WorkWeek: enum.WorkWeekFiveDay, From: year, To: year})
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newEmployee(t *testing.T, religion string, hired time.Time, end *time.Time) *employee_entity.Employee {
+	return porttest.NewEmployee(t, porttest.Hire{Religion: religion, Start: hired, End: end, Salary: 12_000_000})
+}
+
+func TestTHRService(t *testing.T) {
+	ctx := context.Background()
+	calendars, err := calendar_service.NewCalendarService(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	contractEnd := date(2025, 3, 20)
+	muslim := newEmployee(t, "islam", date(2024, 9, 15), nil)
+	catholic := newEmployee(t, "katolik", date(2020, 1, 6), nil)
+	leaver := newEmployee(t, "islam", date(2024, 3, 1), &contractEnd)
+	service := thr_service.NewTHRService(porttest.NewEmployees(muslim, catholic, leaver), bundledCalendars{service: calendars})
+
+	t.Run("Entitlement", func(t *testing.T) {
+		thr, err := service.Entitlement(ctx, muslim.ID(), 2025)
+
+		assert.Nil(t, err)
+		assert.Equal(t, date(2025, 3, 31), thr.Holiday().Date())
+		assert.Equal(t, date(2025, 3, 24), thr.DueDate())
+		assert.Equal(t, 6, thr.ServiceMonths())
+		assert.Equal(t, int64(6_000_000), thr.Amount())
+
+		thr, _ = service.Entitlement(ctx, catholic.ID(), 2025)
+		assert.Equal(t, date(2025, 12, 25), thr.Holiday().Date())
+		assert.Equal(t, int64(12_000_000), thr.Amount())
+	})
+	t.Run("ForPeriod", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			employee *employee_entity.Employee
+			period   payroll_entity.PayPeriod
+			want     int64
+		}{
+			{"IdulFitriMonth", muslim, payroll_entity.MonthlyPayPeriod(2025, time.March), 6_000_000},
+			{"AfterIdulFitri", muslim, payroll_entity.MonthlyPayPeriod(2025, time.April), 0},
+			{"ChristmasMonth", catholic, payroll_entity.MonthlyPayPeriod(2025, time.December), 12_000_000},
+			{"NotChristmasMonth", catholic, payroll_entity.MonthlyPayPeriod(2025, time.March), 0},
+			{"LeftBeforeDueDate", leaver, payroll_entity.MonthlyPayPeriod(2025, time.March), 0},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := service.THRForPeriod(ctx, tt.employee.ID(), tt.period.Start(), tt.period.End())
+
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			})
+		}
+	})
+	t.Run("PayrollComponent", func(t *testing.T) {
+		period := payroll_entity.MonthlyPayPeriod(2025, time.March)
+		draft, _ := payroll_entity.NewPayslipDraft(muslim.ID(), period, "IDR", 12_000_000, 31, 31)
+
+		err := payroll_service.NewTHRComponent(service).Apply(ctx, muslim, draft)
+
+		assert.Nil(t, err)
+		assert.Len(t, draft.Lines(), 1)
+		assert.Equal(t, payroll_entity.LineTHR, draft.Lines()[0].Code())
+		assert.Equal(t, int64(6_000_000), draft.TaxableGross())
+	})
+	t.Run("NoHolidayData", func(t *testing.T) {
+		_, err := service.Entitlement(ctx, muslim.ID(), 2030)
+
+		assert.EqualError(t, err, "find work calendar: no holiday data for year 2030")
+
+		// A payroll run in a year the holiday data does not cover yet owes no THR.
+		period := payroll_entity.MonthlyPayPeriod(2030, time.March)
+		amount, err := service.THRForPeriod(ctx, muslim.ID(), period.Start(), period.End())
+		assert.Nil(t, err)
+		assert.Equal(t, int64(0), amount)
+	})
+}
@@ -0,0 +1,323 @@
+package workflow_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	workflow_entity "github.com/rfanazhari/hris/domain/entity/workflow"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port"
+	"github.com/rfanazhari/hris/pkg/clock"
+)
+
+// ApproverDirectory finds the people approver rules refer to. DirectManager and UnitHead
+// return nil when there is no such person.
+type ApproverDirectory interface {
+	DirectManager(ctx context.Context, employeeID uuid.UUID) (*uuid.UUID, error)
+	UnitHead(ctx context.Context, unitID uuid.UUID) (*uuid.UUID, error)
+	RoleHolders(ctx context.Context, role string) ([]uuid.UUID, error)
+}
+
+// OutcomeHandler is told when an approval of the request type it handles is approved,
+// rejected or cancelled, so it can update the request, e.g. approve the leave request.
+type OutcomeHandler interface {
+	ApprovalCompleted(ctx context.Context, approval *workflow_entity.Approval) error
+}
+
+// WorkflowService runs approval workflows for any kind of request. A request is identified
+// by its request type and subject id only; what approving it means is left to the
+// OutcomeHandler registered for the type.
+type WorkflowService struct {
+	definitions port.WorkflowDefinitionRepository
+	approvals   port.ApprovalRepository
+	delegations port.DelegationRepository
+	employees   port.EmployeeRepository
+	directory   ApproverDirectory
+	handlers    map[string]OutcomeHandler
+	clock       clock.Clock
+}
+
+// NewWorkflowService returns a WorkflowService. A nil clock falls back to the system clock.
+func NewWorkflowService(definitions port.WorkflowDefinitionRepository, approvals port.ApprovalRepository, delegations port.DelegationRepository, employees port.EmployeeRepository, directory ApproverDirectory, clk clock.Clock) *WorkflowService {
+	if clk == nil {
+		clk = clock.System{}
+	}
+	return &WorkflowService{
+		definitions: definitions,
+		approvals:   approvals,
+		delegations: delegations,
+		employees:   employees,
+		directory:   directory,
+		handlers:    map[string]OutcomeHandler{},
+		clock:       clk,
+	}
+}
+
+// Handle registers the handler told about the outcomes of the request type.
+func (s *WorkflowService) Handle(requestType string, handler OutcomeHandler) {
+	s.handlers[requestType] = handler
+}
+
+// Define creates or replaces the workflow of a request type. Approvals already started
+// keep the steps they were started with.
+func (s *WorkflowService) Define(ctx context.Context, f workflow_entity.DefinitionFactory) (*workflow_entity.Definition, error) {
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = s.clock.Now()
+	}
+	definition, err := f.Create()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.definitions.Save(ctx, definition); err != nil {
+		return nil, fmt.Errorf("save workflow definition: %w", err)
+	}
+	return definition, nil
+}
+
+// AddDelegation records that the delegate decides in place of the delegator between the
+// delegation dates. It applies to approvers assigned from then on.
+func (s *WorkflowService) AddDelegation(ctx context.Context, f workflow_entity.DelegationFactory) (*workflow_entity.Delegation, error) {
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = s.clock.Now()
+	}
+	delegation, err := f.Create()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.delegations.Save(ctx, delegation); err != nil {
+		return nil, fmt.Errorf("save delegation: %w", err)
+	}
+	return delegation, nil
+}
+
+// Submit starts the workflow of the request type for a request and assigns the approvers
+// of its first step. A request can have one pending approval at a time.
+func (s *WorkflowService) Submit(ctx context.Context, requestType string, subjectID, requesterID uuid.UUID) (*workflow_entity.Approval, error) {
+	definition, err := s.definitions.FindByRequestType(ctx, requestType)
+	if err != nil {
+		return nil, fmt.Errorf("find workflow definition: %w", err)
+	}
+	if definition == nil {
+		return nil, fmt.Errorf("no workflow defined for %s", requestType)
+	}
+	existing, err := s.approvals.ListBySubject(ctx, definition.RequestType(), subjectID)
+	if err != nil {
+		return nil, fmt.Errorf("list approvals: %w", err)
+	}
+	for _, a := range existing {
+		if a.Status() == enum.ApprovalPending {
+			return nil, errors.New("request already has a pending approval")
+		}
+	}
+
+	approval, err := workflow_entity.ApprovalFactory{
+		ID:          uuid.NewString(),
+		SubjectID:   subjectID.String(),
+		RequesterID: requesterID.String(),
+		Definition:  definition,
+		CreatedAt:   s.clock.Now(),
+	}.Create()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.openNext(ctx, approval); err != nil {
+		return nil, err
+	}
+	if err := s.approvals.Save(ctx, approval); err != nil {
+		return nil, fmt.Errorf("save approval: %w", err)
+	}
+	return approval, nil
+}
+
+// Approve records the approver's approval. When it completes a step the approvers of the
+// next step are assigned; when it completes the last step the request is approved.
+func (s *WorkflowService) Approve(ctx context.Context, approvalID, approverID uuid.UUID, note string) (*workflow_entity.Approval, error) {
+	return s.update(ctx, approvalID, func(approval *workflow_entity.Approval) error {
+		completed, err := approval.Approve(approverID, note, s.clock.Now())
+		if err != nil || !completed || approval.Status() != enum.ApprovalPending {
+			return err
+		}
+		return s.openNext(ctx, approval)
+	})
+}
+
+// Reject rejects the request on behalf of the approver.
+func (s *WorkflowService) Reject(ctx context.Context, approvalID, approverID uuid.UUID, note string) (*workflow_entity.Approval, error) {
+	return s.update(ctx, approvalID, func(approval *workflow_entity.Approval) error {
+		return approval.Reject(approverID, note, s.clock.Now())
+	})
+}
+
+// Delegate hands the approver's pending decision on the approval to someone else.
+func (s *WorkflowService) Delegate(ctx context.Context, approvalID, approverID, delegateID uuid.UUID, note string) (*workflow_entity.Approval, error) {
+	return s.update(ctx, approvalID, func(approval *workflow_entity.Approval) error {
+		return approval.Delegate(approverID, delegateID, note, s.clock.Now())
+	})
+}
+
+// Cancel withdraws the request on behalf of the requester.
+func (s *WorkflowService) Cancel(ctx context.Context, approvalID, requesterID uuid.UUID, note string) (*workflow_entity.Approval, error) {
+	return s.update(ctx, approvalID, func(approval *workflow_entity.Approval) error {
+		return approval.Cancel(requesterID, note, s.clock.Now())
+	})
+}
+
+// Inbox returns the pending approvals waiting for the approver's decision.
+func (s *WorkflowService) Inbox(ctx context.Context, approverID uuid.UUID) ([]workflow_entity.Approval, error) {
+	pending, err := s.approvals.ListPending(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list pending approvals: %w", err)
+	}
+	var out []workflow_entity.Approval
+	for _, a := range pending {
+		if a.IsAwaiting(approverID) {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+// EscalateOverdue moves every decision pending past its step's timeout to the direct
+// manager of the approver, or to the manager's delegate. Decisions of approvers without
+// a manager stay where they are. It returns the number of decisions escalated.
+func (s *WorkflowService) EscalateOverdue(ctx context.Context) (int, error) {
+	pending, err := s.approvals.ListPending(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list pending approvals: %w", err)
+	}
+	now := s.clock.Now()
+	escalated := 0
+	for i := range pending {
+		approval := &pending[i]
+		changed := false
+		for _, as := range approval.Overdue(now) {
+			if !approval.IsAwaiting(as.ApproverID()) {
+				continue
+			}
+			manager, err := s.directory.DirectManager(ctx, as.ApproverID())
+			if err != nil {
+				return escalated, fmt.Errorf("direct manager: %w", err)
+			}
+			if manager == nil {
+				continue
+			}
+			to, err := s.delegate(ctx, approval.RequestType(), *manager)
+			if err != nil {
+				return escalated, err
+			}
+			if to == approval.RequesterID() || to == as.ApproverID() {
+				continue
+			}
+			if err := approval.Escalate(as.ApproverID(), to, now); err != nil {
+				return escalated, err
+			}
+			changed = true
+			escalated++
+		}
+		if changed {
+			if err := s.approvals.Save(ctx, approval); err != nil {
+				return escalated, fmt.Errorf("save approval: %w", err)
+			}
+		}
+	}
+	return escalated, nil
+}
+
+// openNext assigns the approvers of the approval's next step.
+func (s *WorkflowService) openNext(ctx context.Context, approval *workflow_entity.Approval) error {
+	step, ok := approval.NextStep()
+	if !ok {
+		return nil
+	}
+	candidates := make([][]uuid.UUID, 0, len(step.Approvers()))
+	for _, approver := range step.Approvers() {
+		ids, err := s.resolve(ctx, approval, approver)
+		if err != nil {
+			return err
+		}
+		candidates = append(candidates, ids)
+	}
+	return approval.Open(candidates, s.clock.Now())
+}
+
+// resolve finds the people who may decide for an approver rule, replaced by their
+// delegates where a delegation covers the request. The requester is left out.
+func (s *WorkflowService) resolve(ctx context.Context, approval *workflow_entity.Approval, approver workflow_entity.Approver) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	switch approver.Rule() {
+	case enum.ApproverDirectManager:
+		manager, err := s.directory.DirectManager(ctx, approval.RequesterID())
+		if err != nil {
+			return nil, fmt.Errorf("direct manager: %w", err)
+		}
+		if manager != nil {
+			ids = append(ids, *manager)
+		}
+	case enum.ApproverUnitHead:
+		requester, err := s.employees.FindByID(ctx, approval.RequesterID())
+		if err != nil {
+			return nil, fmt.Errorf("find employee: %w", err)
+		}
+		if unitID := requester.OrganizationUnitID(); unitID != nil {
+			head, err := s.directory.UnitHead(ctx, *unitID)
+			if err != nil {
+				return nil, fmt.Errorf("unit head: %w", err)
+			}
+			if head != nil {
+				ids = append(ids, *head)
+			}
+		}
+	case enum.ApproverRole:
+		holders, err := s.directory.RoleHolders(ctx, approver.Role())
+		if err != nil {
+			return nil, fmt.Errorf("role holders: %w", err)
+		}
+		ids = append(ids, holders...)
+	}
+
+	out := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		id, err := s.delegate(ctx, approval.RequestType(), id)
+		if err != nil {
+			return nil, err
+		}
+		if id != approval.RequesterID() {
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
+
+// delegate returns the approver's delegate for the request type today, or the approver.
+func (s *WorkflowService) delegate(ctx context.Context, requestType string, approverID uuid.UUID) (uuid.UUID, error) {
+	delegations, err := s.delegations.ListByDelegator(ctx, approverID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("list delegations: %w", err)
+	}
+	for _, d := range delegations {
+		if d.Covers(requestType, s.clock.Now()) {
+			return d.DelegateID(), nil
+		}
+	}
+	return approverID, nil
+}
+
+func (s *WorkflowService) update(ctx context.Context, approvalID uuid.UUID, change func(*workflow_entity.Approval) error) (*workflow_entity.Approval, error) {
+	approval, err := s.approvals.FindByID(ctx, approvalID)
+	if err != nil {
+		return nil, fmt.Errorf("find approval: %w", err)
+	}
+	if err := change(approval); err != nil {
+		return nil, err
+	}
+	if err := s.approvals.Save(ctx, approval); err != nil {
+		return nil, fmt.Errorf("save approval: %w", err)
+	}
+	if handler, ok := s.handlers[approval.RequestType()]; ok && approval.Status() != enum.ApprovalPending {
+		if err := handler.ApprovalCompleted(ctx, approval); err != nil {
+			return nil, fmt.Errorf("%s outcome: %w", approval.RequestType(), err)
+		}
+	}
+	return approval, nil
+}
@@ -0,0 +1,259 @@
+package workflow_service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	employee_entity "github.com/rfanazhari/hris/domain/entity/employee"
+	workflow_entity "github.com/rfanazhari/hris/domain/entity/workflow"
+	"github.com/rfanazhari/hris/domain/enum"
+	"github.com/rfanazhari/hris/domain/port/porttest"
+	workflow_service "github.com/rfanazhari/hris/domain/service/workflow"
+	"github.com/rfanazhari/hris/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type memoryDefinitions map[string]*workflow_entity.Definition
+
+func (m memoryDefinitions) Save(_ context.Context, definition *workflow_entity.Definition) error {
+	m[definition.RequestType()] = definition
+	return nil
+}
+
+func (m memoryDefinitions) FindByRequestType(_ context.Context, requestType string) (*workflow_entity.Definition, error) {
+	return m[requestType], nil
+}
+
+type memoryApprovals struct {
+	approvals []*workflow_entity.Approval
+}
+
+func (m *memoryApprovals) Save(_ context.Context, approval *workflow_entity.Approval) error {
+	for i, a := range m.approvals {
+		if a.ID() == approval.ID() {
+			m.approvals[i] = approval
+			return nil
+		}
+	}
+	m.approvals = append(m.approvals, approval)
+	return nil
+}
+
+func (m *memoryApprovals) FindByID(_ context.Context, id uuid.UUID) (*workflow_entity.Approval, error) {
+	for _, a := range m.approvals {
+		if a.ID() == id {
+			return a, nil
+		}
+	}
+	return nil, errors.New("approval not found")
+}
+
+func (m *memoryApprovals) ListBySubject(_ context.Context, requestType string, subjectID uuid.UUID) ([]workflow_entity.Approval, error) {
+	var out []workflow_entity.Approval
+	for _, a := range m.approvals {
+		if a.RequestType() == requestType && a.SubjectID() == subjectID {
+			out = append(out, *a)
+		}
+	}
+	return out, nil
+}
+
+func (m *memoryApprovals) ListPending(context.Context) ([]workflow_entity.Approval, error) {
+	var out []workflow_entity.Approval
+	for _, a := range m.approvals {
+		if a.Status() == enum.ApprovalPending {
+			out = append(out, *a)
+		}
+	}
+	return out, nil
+}
+
+type memoryDelegations struct {
+	delegations []workflow_entity.Delegation
+}
+
+func (m *memoryDelegations) Save(_ context.Context, delegation *workflow_entity.Delegation) error {
+	m.delegations = append(m.delegations, *delegation)
+	return nil
+}
+
+func (m *memoryDelegations) ListByDelegator(_ context.Context, delegatorID uuid.UUID) ([]workflow_entity.Delegation, error) {
+	var out []workflow_entity.Delegation
+	for _, d := range m.delegations {
+		if d.DelegatorID() == delegatorID {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+type directory struct {
+	managers map[uuid.UUID]uuid.UUID
+	heads    map[uuid.UUID]uuid.UUID
+	roles    map[string][]uuid.UUID
+}
+
+func (d directory) DirectManager(_ context.Context, employeeID uuid.UUID) (*uuid.UUID, error) {
+	if id, ok := d.managers[employeeID]; ok {
+		return &id, nil
+	}
+	return nil, nil
+}
+
+func (d directory) UnitHead(_ context.Context, unitID uuid.UUID) (*uuid.UUID, error) {
+	if id, ok := d.heads[unitID]; ok {
+		return &id, nil
+	}
+	return nil, nil
+}
+
+func (d directory) RoleHolders(_ context.Context, role string) ([]uuid.UUID, error) {
+	return d.roles[role], nil
+}
+
+type outcomes []enum.ApprovalStatus
+
+func (o *outcomes) ApprovalCompleted(_ context.Context, approval *workflow_entity.Approval) error {
+	*o = append(*o, approval.Status())
+	return nil
+}
+
+type fixture struct {
+	service   *workflow_service.WorkflowService
+	clock     *clock.Fixed
+	outcomes  *outcomes
+	staff     *employee_entity.Employee
+	head      *employee_entity.Employee
+	manager   uuid.UUID
+	director  uuid.UUID
+	hr1, hr2  uuid.UUID
+	approvals *memoryApprovals
+}
+
+// newFixture defines the "leave" workflow: the direct manager within two days, then the
+// unit head together with any HR admin.
+func newFixture(t *testing.T) fixture {
+	ctx := context.Background()
+	unitID := uuid.New()
+	staff, head := porttest.NewEmployee(t, porttest.Hire{UnitID: &unitID}), porttest.NewEmployee(t, porttest.Hire{UnitID: &unitID})
+	manager, director, hr1, hr2 := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	clk := &clock.Fixed{At: time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)}
+	approvals := &memoryApprovals{}
+	service := workflow_service.NewWorkflowService(memoryDefinitions{}, approvals, &memoryDelegations{},
+		porttest.NewEmployees(staff, head),
+		directory{
+			managers: map[uuid.UUID]uuid.UUID{staff.ID(): manager, head.ID(): director, manager: director},
+			heads:    map[uuid.UUID]uuid.UUID{unitID: head.ID()},
+			roles:    map[string][]uuid.UUID{"hr_admin": {hr1, hr2}},
+		}, clk)
+	results := &outcomes{}
+	service.Handle("leave", results)
+
+	managerRule, _ := workflow_entity.NewApprover(enum.ApproverDirectManager, "")
+	headRule, _ := workflow_entity.NewApprover(enum.ApproverUnitHead, "")
+	hrRule, _ := workflow_entity.NewApprover(enum.ApproverRole, "hr_admin")
+	first, _ := workflow_entity.NewStep("Manager", enum.ApprovalAll, 48*time.Hour, *managerRule)
+	second, _ := workflow_entity.NewStep("Unit & HR", enum.ApprovalAll, 0, *headRule, *hrRule)
+	_, err := service.Define(ctx, workflow_entity.DefinitionFactory{RequestType: "leave", Steps: []workflow_entity.Step{*first, *second}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return fixture{service: service, clock: clk, outcomes: results, staff: staff, head: head, manager: manager, director: director, hr1: hr1, hr2: hr2, approvals: approvals}
+}
+
+func TestWorkflowService_Submit(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	subject := uuid.New()
+
+	_, err := f.service.Submit(ctx, "transfer", subject, f.staff.ID())
+	assert.EqualError(t, err, "no workflow defined for transfer")
+
+	approval, err := f.service.Submit(ctx, "leave", subject, f.staff.ID())
+	assert.Nil(t, err)
+	inbox, _ := f.service.Inbox(ctx, f.manager)
+	assert.Len(t, inbox, 1)
+	assert.Equal(t, approval.ID(), inbox[0].ID())
+
+	_, err = f.service.Submit(ctx, "leave", subject, f.staff.ID())
+	assert.EqualError(t, err, "request already has a pending approval")
+
+	// The unit head cannot approve their own request in the second step.
+	own, err := f.service.Submit(ctx, "leave", uuid.New(), f.head.ID())
+	assert.Nil(t, err)
+	_, err = f.service.Approve(ctx, own.ID(), f.director, "")
+	assert.EqualError(t, err, "no approver for unit_head in step Unit & HR")
+}
+
+func TestWorkflowService_Decisions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ApproveAllSteps", func(t *testing.T) {
+		f := newFixture(t)
+		approval, _ := f.service.Submit(ctx, "leave", uuid.New(), f.staff.ID())
+
+		approval, err := f.service.Approve(ctx, approval.ID(), f.manager, "")
+		assert.Nil(t, err)
+		assert.True(t, approval.IsAwaiting(f.head.ID()))
+		assert.True(t, approval.IsAwaiting(f.hr1))
+		assert.True(t, approval.IsAwaiting(f.hr2))
+
+		_, _ = f.service.Approve(ctx, approval.ID(), f.hr2, "")
+		assert.Empty(t, *f.outcomes)
+		approval, err = f.service.Approve(ctx, approval.ID(), f.head.ID(), "")
+		assert.Nil(t, err)
+		assert.Equal(t, enum.ApprovalApproved, approval.Status())
+		assert.Equal(t, outcomes{enum.ApprovalApproved}, *f.outcomes)
+	})
+	t.Run("RejectAndCancel", func(t *testing.T) {
+		f := newFixture(t)
+		approval, _ := f.service.Submit(ctx, "leave", uuid.New(), f.staff.ID())
+		_, err := f.service.Reject(ctx, approval.ID(), f.manager, "periode sibuk")
+		assert.Nil(t, err)
+
+		other, _ := f.service.Submit(ctx, "leave", uuid.New(), f.staff.ID())
+		_, err = f.service.Cancel(ctx, other.ID(), f.staff.ID(), "")
+		assert.Nil(t, err)
+		assert.Equal(t, outcomes{enum.ApprovalRejected, enum.ApprovalCancelled}, *f.outcomes)
+	})
+	t.Run("Delegation", func(t *testing.T) {
+		f := newFixture(t)
+		deputy := uuid.New()
+		_, err := f.service.AddDelegation(ctx, workflow_entity.DelegationFactory{
+			ID: uuid.NewString(), DelegatorID: f.manager.String(), DelegateID: deputy.String(),
+			StartDate: time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 4, 5, 0, 0, 0, 0, time.UTC),
+		})
+		assert.Nil(t, err)
+
+		approval, _ := f.service.Submit(ctx, "leave", uuid.New(), f.staff.ID())
+		assert.True(t, approval.IsAwaiting(deputy))
+		assert.False(t, approval.IsAwaiting(f.manager))
+
+		approval, err = f.service.Delegate(ctx, approval.ID(), deputy, f.hr1, "")
+		assert.Nil(t, err)
+		assert.True(t, approval.IsAwaiting(f.hr1))
+	})
+	t.Run("Escalation", func(t *testing.T) {
+		f := newFixture(t)
+		approval, _ := f.service.Submit(ctx, "leave", uuid.New(), f.staff.ID())
+
+		f.clock.At = f.clock.At.Add(47 * time.Hour)
+		escalated, err := f.service.EscalateOverdue(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, escalated)
+
+		f.clock.At = f.clock.At.Add(2 * time.Hour)
+		escalated, err = f.service.EscalateOverdue(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, escalated)
+
+		stored, _ := f.approvals.FindByID(ctx, approval.ID())
+		assert.True(t, stored.IsAwaiting(f.director))
+		assert.Equal(t, f.manager, *stored.Pending()[0].OnBehalfOf())
+		history := stored.History()
+		assert.Equal(t, enum.WorkflowEscalated, history[len(history)-1].Action())
+		assert.Equal(t, uuid.Nil, history[len(history)-1].ActorID())
+	})
+}
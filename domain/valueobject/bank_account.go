@@ -0,0 +1,77 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rfanazhari/hris/domain/enum"
+	"strings"
+)
+
+// accountLengths holds the allowed number of digits of account numbers per bank.
+var accountLengths = map[enum.Bank][2]int{
+	enum.BankBCA:       {10, 10},
+	enum.BankMandiri:   {13, 13},
+	enum.BankBNI:       {10, 10},
+	enum.BankBRI:       {15, 15},
+	enum.BankBSI:       {10, 10},
+	enum.BankCIMBNiaga: {12, 14},
+	enum.BankPermata:   {10, 10},
+}
+
+// BankAccount represents an account that salary is transferred to.
+// Example:
+//
+//	bank:       "bca"
+//	number:     "1234567890"
+//	holderName: "ARFAN AZHARI"
+//
+// Basic normalization & validation are performed:
+// - Spaces, dots and dashes are removed from the number, which must then contain digits only
+// - The number must have the length used by the bank (e.g. 10 digits for BCA, 13 for Mandiri)
+// - The holder name is trimmed, uppercased and must be non-empty
+type BankAccount struct {
+	bank       enum.Bank
+	number     string
+	holderName string
+}
+
+// NewBankAccount constructs a BankAccount with normalization and validation.
+func NewBankAccount(bank enum.Bank, number, holderName string) (*BankAccount, error) {
+	lengths, ok := accountLengths[bank]
+	if !ok {
+		return nil, fmt.Errorf("invalid Bank: %q", bank)
+	}
+
+	num := strings.NewReplacer(" ", "", ".", "", "-", "").Replace(number)
+	if num == "" {
+		return nil, errors.New("account number cannot be empty")
+	}
+	if !isDigits(num) {
+		return nil, errors.New("account number must contain digits only")
+	}
+	if len(num) < lengths[0] || len(num) > lengths[1] {
+		if lengths[0] == lengths[1] {
+			return nil, fmt.Errorf("account number for %s must be %d digits", bank, lengths[0])
+		}
+		return nil, fmt.Errorf("account number for %s must be %d to %d digits", bank, lengths[0], lengths[1])
+	}
+
+	holder := strings.ToUpper(strings.Join(strings.Fields(holderName), " "))
+	if holder == "" {
+		return nil, errors.New("account holder name cannot be empty")
+	}
+
+	return &BankAccount{bank: bank, number: num, holderName: holder}, nil
+}
+
+// Bank returns the bank holding the account.
+func (b BankAccount) Bank() enum.Bank { return b.bank }
+
+// Number returns the account number, digits only.
+func (b BankAccount) Number() string { return b.number }
+
+// HolderName returns the uppercased name of the account holder.
+func (b BankAccount) HolderName() string { return b.holderName }
+
+// IsZero reports whether the account is the zero value.
+func (b BankAccount) IsZero() bool { return b.number == "" }
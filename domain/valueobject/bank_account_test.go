@@ -0,0 +1,69 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/rfanazhari/hris/domain/enum"
+	vo "github.com/rfanazhari/hris/domain/valueobject"
+)
+
+func TestNewBankAccount_Valid(t *testing.T) {
+	a, err := vo.NewBankAccount(enum.BankBCA, "123-456 789.0", "  arfan   azhari ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.Number() != "1234567890" {
+		t.Fatalf("Number mismatch: got %s", a.Number())
+	}
+	if a.HolderName() != "ARFAN AZHARI" {
+		t.Fatalf("HolderName mismatch: got %s", a.HolderName())
+	}
+	if a.Bank() != enum.BankBCA || a.IsZero() {
+		t.Fatalf("unexpected account: %+v", a)
+	}
+}
+
+func TestNewBankAccount_LengthPerBank(t *testing.T) {
+	cases := []struct {
+		bank   enum.Bank
+		number string
+		err    string
+	}{
+		{enum.BankMandiri, "1234567890123", ""},
+		{enum.BankMandiri, "1234567890", "account number for mandiri must be 13 digits"},
+		{enum.BankBNI, "0123456789", ""},
+		{enum.BankBRI, "123456789012345", ""},
+		{enum.BankBRI, "1234567890123", "account number for bri must be 15 digits"},
+		{enum.BankCIMBNiaga, "123456789012", ""},
+		{enum.BankCIMBNiaga, "12345678901", "account number for cimb_niaga must be 12 to 14 digits"},
+		{enum.BankBCA, "12345678901", "account number for bca must be 10 digits"},
+	}
+	for _, c := range cases {
+		_, err := vo.NewBankAccount(c.bank, c.number, "Rina")
+		if c.err == "" && err != nil {
+			t.Fatalf("%s %s: unexpected error: %v", c.bank, c.number, err)
+		}
+		if c.err != "" && (err == nil || err.Error() != c.err) {
+			t.Fatalf("%s %s: expected %q, got %v", c.bank, c.number, c.err, err)
+		}
+	}
+}
+
+func TestNewBankAccount_Invalid(t *testing.T) {
+	cases := []struct {
+		bank   enum.Bank
+		number string
+		holder string
+		err    string
+	}{
+		{"jago", "1234567890", "Rina", `invalid Bank: "jago"`},
+		{enum.BankBCA, " ", "Rina", "account number cannot be empty"},
+		{enum.BankBCA, "12345A7890", "Rina", "account number must contain digits only"},
+		{enum.BankBCA, "1234567890", "  ", "account holder name cannot be empty"},
+	}
+	for _, c := range cases {
+		if _, err := vo.NewBankAccount(c.bank, c.number, c.holder); err == nil || err.Error() != c.err {
+			t.Fatalf("expected %q, got %v", c.err, err)
+		}
+	}
+}
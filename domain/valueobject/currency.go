@@ -0,0 +1,86 @@
+package valueobject
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Currency is an ISO 4217 currency with the number of digits of its minor unit,
+// e.g. 2 for USD cents and 0 for JPY.
+type Currency struct {
+	code       string
+	name       string
+	minorUnits int
+}
+
+// currencies is the registry of supported ISO 4217 currencies.
+var currencies = map[string]Currency{
+	"AED": {"AED", "UAE Dirham", 2},
+	"AUD": {"AUD", "Australian Dollar", 2},
+	"BND": {"BND", "Brunei Dollar", 2},
+	"CAD": {"CAD", "Canadian Dollar", 2},
+	"CHF": {"CHF", "Swiss Franc", 2},
+	"CNY": {"CNY", "Yuan Renminbi", 2},
+	"EUR": {"EUR", "Euro", 2},
+	"GBP": {"GBP", "Pound Sterling", 2},
+	"HKD": {"HKD", "Hong Kong Dollar", 2},
+	"IDR": {"IDR", "Rupiah", 2},
+	"INR": {"INR", "Indian Rupee", 2},
+	"JPY": {"JPY", "Yen", 0},
+	"KRW": {"KRW", "Won", 0},
+	"KWD": {"KWD", "Kuwaiti Dinar", 3},
+	"MYR": {"MYR", "Malaysian Ringgit", 2},
+	"NZD": {"NZD", "New Zealand Dollar", 2},
+	"PHP": {"PHP", "Philippine Peso", 2},
+	"SAR": {"SAR", "Saudi Riyal", 2},
+	"SGD": {"SGD", "Singapore Dollar", 2},
+	"THB": {"THB", "Baht", 2},
+	"TWD": {"TWD", "New Taiwan Dollar", 2},
+	"USD": {"USD", "US Dollar", 2},
+	"VND": {"VND", "Dong", 0},
+}
+
+// ParseCurrency returns the registered currency with the given code (case-insensitive,
+// trims spaces).
+func ParseCurrency(code string) (Currency, error) {
+	c, ok := currencies[strings.ToUpper(strings.TrimSpace(code))]
+	if !ok {
+		return Currency{}, fmt.Errorf("unsupported currency: %s", code)
+	}
+	return c, nil
+}
+
+// Currencies returns the registered currencies ordered by code.
+func Currencies() []Currency {
+	out := make([]Currency, 0, len(currencies))
+	for _, c := range currencies {
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].code < out[j].code })
+	return out
+}
+
+// Code returns the three letter ISO 4217 code.
+func (c Currency) Code() string { return c.code }
+
+// Name returns the ISO 4217 currency name.
+func (c Currency) Name() string { return c.name }
+
+// MinorUnits returns the number of decimal digits of the minor unit.
+func (c Currency) MinorUnits() int { return c.minorUnits }
+
+// MinorUnitFactor returns the number of minor units in one major unit, e.g. 100 for USD.
+func (c Currency) MinorUnitFactor() int64 {
+	f := int64(1)
+	for i := 0; i < c.minorUnits; i++ {
+		f *= 10
+	}
+	return f
+}
+
+// IsZero reports whether the currency is the zero value.
+func (c Currency) IsZero() bool { return c.code == "" }
+
+// String returns the currency code.
+func (c Currency) String() string { return c.code }
@@ -0,0 +1,47 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	vo "github.com/rfanazhari/hris/domain/valueobject"
+)
+
+func TestParseCurrency(t *testing.T) {
+	cases := []struct {
+		code       string
+		want       string
+		minorUnits int
+		factor     int64
+	}{
+		{" idr ", "IDR", 2, 100},
+		{"USD", "USD", 2, 100},
+		{"jpy", "JPY", 0, 1},
+		{"KWD", "KWD", 3, 1000},
+	}
+	for _, c := range cases {
+		got, err := vo.ParseCurrency(c.code)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Code() != c.want || got.MinorUnits() != c.minorUnits || got.MinorUnitFactor() != c.factor {
+			t.Fatalf("ParseCurrency(%q) = %s/%d/%d", c.code, got.Code(), got.MinorUnits(), got.MinorUnitFactor())
+		}
+	}
+
+	if _, err := vo.ParseCurrency("XYZ"); err == nil || err.Error() != "unsupported currency: XYZ" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCurrencies(t *testing.T) {
+	all := vo.Currencies()
+	for i := 1; i < len(all); i++ {
+		if all[i-1].Code() >= all[i].Code() {
+			t.Fatalf("currencies not ordered: %s before %s", all[i-1], all[i])
+		}
+	}
+	usd, _ := vo.ParseCurrency("USD")
+	if usd.Name() != "US Dollar" || usd.IsZero() {
+		t.Fatalf("unexpected currency: %+v", usd)
+	}
+}
@@ -0,0 +1,123 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ExchangeRate is the price of one unit of the base currency in the quote currency,
+// effective from a date until the next rate of the same pair.
+// Example:
+//
+//	base:          "USD"
+//	quote:         "IDR"
+//	rate:          "16250.5"
+//	effectiveDate: 2025-04-01
+//
+// Rates are kept as exact decimals so conversions do not accumulate floating point errors.
+type ExchangeRate struct {
+	base          Currency
+	quote         Currency
+	rate          *big.Rat
+	effectiveDate time.Time
+}
+
+// NewExchangeRate constructs an ExchangeRate from a decimal rate such as "16250.50".
+func NewExchangeRate(base, quote, rate string, effectiveDate time.Time) (*ExchangeRate, error) {
+	b, err := ParseCurrency(base)
+	if err != nil {
+		return nil, err
+	}
+	q, err := ParseCurrency(quote)
+	if err != nil {
+		return nil, err
+	}
+	if b == q {
+		return nil, errors.New("exchange rate currencies must differ")
+	}
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(rate))
+	if !ok || strings.ContainsAny(rate, "/eE") {
+		return nil, fmt.Errorf("invalid exchange rate: %q", rate)
+	}
+	if r.Sign() <= 0 {
+		return nil, errors.New("exchange rate must be positive")
+	}
+	if effectiveDate.IsZero() {
+		return nil, errors.New("effective date cannot be empty")
+	}
+	return &ExchangeRate{base: b, quote: q, rate: r, effectiveDate: dateOnly(effectiveDate)}, nil
+}
+
+// Base returns the currency being priced.
+func (e ExchangeRate) Base() Currency { return e.base }
+
+// Quote returns the currency the price is expressed in.
+func (e ExchangeRate) Quote() Currency { return e.quote }
+
+// Rate returns the rate as a decimal string with up to 10 fractional digits.
+func (e ExchangeRate) Rate() string {
+	s := e.rate.FloatString(10)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// EffectiveDate returns the date the rate applies from.
+func (e ExchangeRate) EffectiveDate() time.Time { return e.effectiveDate }
+
+// Inverse returns the rate of the quote currency in the base currency.
+func (e ExchangeRate) Inverse() ExchangeRate {
+	return ExchangeRate{base: e.quote, quote: e.base, rate: new(big.Rat).Inv(e.rate), effectiveDate: e.effectiveDate}
+}
+
+// Cross chains e with a rate from e's quote currency, e.g. USD/IDR and IDR/SGD give
+// USD/SGD. The cross rate is effective from the later of the two dates.
+func (e ExchangeRate) Cross(next ExchangeRate) (*ExchangeRate, error) {
+	if next.base != e.quote {
+		return nil, fmt.Errorf("cannot cross %s/%s with %s/%s", e.base, e.quote, next.base, next.quote)
+	}
+	if next.quote == e.base {
+		return nil, errors.New("exchange rate currencies must differ")
+	}
+	effective := e.effectiveDate
+	if next.effectiveDate.After(effective) {
+		effective = next.effectiveDate
+	}
+	return &ExchangeRate{base: e.base, quote: next.quote, rate: new(big.Rat).Mul(e.rate, next.rate), effectiveDate: effective}, nil
+}
+
+// Convert converts an amount in whole units of the base currency to whole units of the
+// quote currency, rounding half away from zero.
+func (e ExchangeRate) Convert(amount int64) int64 {
+	return roundRat(new(big.Rat).Mul(new(big.Rat).SetInt64(amount), e.rate))
+}
+
+// ConvertMinor converts an amount in minor units of the base currency (e.g. cents) to
+// minor units of the quote currency, rounding half away from zero.
+func (e ExchangeRate) ConvertMinor(amount int64) int64 {
+	v := new(big.Rat).Mul(new(big.Rat).SetInt64(amount), e.rate)
+	v.Mul(v, new(big.Rat).SetFrac64(e.quote.MinorUnitFactor(), e.base.MinorUnitFactor()))
+	return roundRat(v)
+}
+
+// IsZero reports whether the rate is the zero value.
+func (e ExchangeRate) IsZero() bool { return e.rate == nil }
+
+// roundRat rounds v to the nearest integer, halves away from zero.
+func roundRat(v *big.Rat) int64 {
+	num := new(big.Int).Abs(v.Num())
+	q, r := new(big.Int).QuoRem(num, v.Denom(), new(big.Int))
+	if r.Mul(r, big.NewInt(2)).Cmp(v.Denom()) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if v.Sign() < 0 {
+		q.Neg(q)
+	}
+	return q.Int64()
+}
+
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,85 @@
+package valueobject_test
+
+import (
+	"testing"
+	"time"
+
+	vo "github.com/rfanazhari/hris/domain/valueobject"
+)
+
+func TestNewExchangeRate(t *testing.T) {
+	at := time.Date(2025, 4, 1, 15, 30, 0, 0, time.UTC)
+	rate, err := vo.NewExchangeRate("usd", "IDR", " 16250.50 ", at)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rate.Base().Code() != "USD" || rate.Quote().Code() != "IDR" || rate.Rate() != "16250.5" {
+		t.Fatalf("unexpected rate: %s/%s %s", rate.Base(), rate.Quote(), rate.Rate())
+	}
+	if !rate.EffectiveDate().Equal(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("EffectiveDate not truncated: %v", rate.EffectiveDate())
+	}
+
+	cases := []struct {
+		base, quote, rate string
+		at                time.Time
+		err               string
+	}{
+		{"USD", "USD", "1", at, "exchange rate currencies must differ"},
+		{"USD", "XYZ", "1", at, "unsupported currency: XYZ"},
+		{"USD", "IDR", "abc", at, `invalid exchange rate: "abc"`},
+		{"USD", "IDR", "1/3", at, `invalid exchange rate: "1/3"`},
+		{"USD", "IDR", "0", at, "exchange rate must be positive"},
+		{"USD", "IDR", "-1", at, "exchange rate must be positive"},
+		{"USD", "IDR", "1", time.Time{}, "effective date cannot be empty"},
+	}
+	for _, c := range cases {
+		if _, err := vo.NewExchangeRate(c.base, c.quote, c.rate, c.at); err == nil || err.Error() != c.err {
+			t.Fatalf("NewExchangeRate(%s, %s, %s): got %v, want %s", c.base, c.quote, c.rate, err, c.err)
+		}
+	}
+}
+
+func TestExchangeRate_Convert(t *testing.T) {
+	at := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	usdIDR, _ := vo.NewExchangeRate("USD", "IDR", "16250.5", at)
+	idrSGD, _ := vo.NewExchangeRate("IDR", "SGD", "0.0000825", at.AddDate(0, 0, 3))
+	usdJPY, _ := vo.NewExchangeRate("USD", "JPY", "149.333", at)
+
+	if got := usdIDR.Convert(3); got != 48_752 {
+		t.Fatalf("Convert = %d", got)
+	}
+	if got := usdIDR.Convert(-3); got != -48_752 {
+		t.Fatalf("Convert negative = %d", got)
+	}
+	// 12,34 USD in cents to IDR in sen: 12,34 x 16.250,5 = 200.531,17.
+	if got := usdIDR.ConvertMinor(1_234); got != 20_053_117 {
+		t.Fatalf("ConvertMinor = %d", got)
+	}
+	// JPY has no minor unit: 0,50 USD x 149,333 = 74,6665 yen.
+	if got := usdJPY.ConvertMinor(50); got != 75 {
+		t.Fatalf("ConvertMinor to JPY = %d", got)
+	}
+
+	inverse := usdIDR.Inverse()
+	if inverse.Base().Code() != "IDR" || inverse.Convert(16_250_500) != 1_000 {
+		t.Fatalf("unexpected inverse: %s %d", inverse.Rate(), inverse.Convert(16_250_500))
+	}
+
+	cross, err := usdIDR.Cross(*idrSGD)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cross.Base().Code() != "USD" || cross.Quote().Code() != "SGD" || cross.Rate() != "1.34066625" {
+		t.Fatalf("unexpected cross: %s/%s %s", cross.Base(), cross.Quote(), cross.Rate())
+	}
+	if !cross.EffectiveDate().Equal(idrSGD.EffectiveDate()) {
+		t.Fatalf("cross effective date = %v", cross.EffectiveDate())
+	}
+	if _, err := usdIDR.Cross(*usdJPY); err == nil || err.Error() != "cannot cross USD/IDR with USD/JPY" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := usdIDR.Cross(inverse); err == nil {
+		t.Fatalf("expected error crossing back to the base currency")
+	}
+}
@@ -0,0 +1,53 @@
+package valueobject
+
+import (
+	"errors"
+	"math"
+)
+
+// GeoLocation represents a WGS84 coordinate captured with an attendance punch.
+//
+// Fields:
+//   - latitude:  degrees in [-90, 90]
+//   - longitude: degrees in [-180, 180]
+//   - accuracy:  reported accuracy radius in meters (>= 0, 0 means unknown)
+type GeoLocation struct {
+	latitude  float64
+	longitude float64
+	accuracy  float64
+}
+
+// NewGeoLocation constructs a GeoLocation after validating coordinate ranges.
+func NewGeoLocation(latitude, longitude, accuracy float64) (*GeoLocation, error) {
+	if math.IsNaN(latitude) || latitude < -90 || latitude > 90 {
+		return nil, errors.New("latitude must be between -90 and 90")
+	}
+	if math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		return nil, errors.New("longitude must be between -180 and 180")
+	}
+	if math.IsNaN(accuracy) || accuracy < 0 {
+		return nil, errors.New("accuracy cannot be negative")
+	}
+	return &GeoLocation{latitude: latitude, longitude: longitude, accuracy: accuracy}, nil
+}
+
+// Latitude returns the latitude in degrees.
+func (g GeoLocation) Latitude() float64 { return g.latitude }
+
+// Longitude returns the longitude in degrees.
+func (g GeoLocation) Longitude() float64 { return g.longitude }
+
+// Accuracy returns the accuracy radius in meters (0 means unknown).
+func (g GeoLocation) Accuracy() float64 { return g.accuracy }
+
+// DistanceTo returns the great-circle distance in meters to other (haversine formula).
+func (g GeoLocation) DistanceTo(other GeoLocation) float64 {
+	const earthRadius = 6371000.0
+	lat1 := g.latitude * math.Pi / 180
+	lat2 := other.latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (other.longitude - g.longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
@@ -0,0 +1,55 @@
+package valueobject_test
+
+import (
+	"math"
+	"testing"
+
+	vo "github.com/rfanazhari/hris/domain/valueobject"
+)
+
+func TestNewGeoLocation_Valid(t *testing.T) {
+	g, err := vo.NewGeoLocation(-6.175392, 106.827153, 12.5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.Latitude() != -6.175392 || g.Longitude() != 106.827153 || g.Accuracy() != 12.5 {
+		t.Fatalf("unexpected values: %v %v %v", g.Latitude(), g.Longitude(), g.Accuracy())
+	}
+}
+
+func TestNewGeoLocation_Invalid(t *testing.T) {
+	cases := []struct {
+		name     string
+		lat, lng float64
+		accuracy float64
+	}{
+		{"latitude too low", -90.1, 0, 0},
+		{"latitude too high", 90.1, 0, 0},
+		{"longitude too low", 0, -180.1, 0},
+		{"longitude too high", 0, 180.1, 0},
+		{"latitude NaN", math.NaN(), 0, 0},
+		{"negative accuracy", 0, 0, -1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := vo.NewGeoLocation(c.lat, c.lng, c.accuracy); err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestGeoLocation_DistanceTo(t *testing.T) {
+	// Monas to Bundaran HI is roughly 2.3 km.
+	monas, _ := vo.NewGeoLocation(-6.175392, 106.827153, 0)
+	hi, _ := vo.NewGeoLocation(-6.194898, 106.823043, 0)
+
+	d := monas.DistanceTo(*hi)
+	if d < 2100 || d > 2400 {
+		t.Fatalf("unexpected distance: %f", d)
+	}
+	if monas.DistanceTo(*monas) != 0 {
+		t.Fatalf("distance to self must be zero")
+	}
+}
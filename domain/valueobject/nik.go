@@ -0,0 +1,49 @@
+package valueobject
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// NIK is the 16-digit Nomor Induk Kependudukan printed on the KTP and Kartu Keluarga.
+// Example:
+//
+//	"3273014507950001" (Kota Bandung, born 5 July 1995, female)
+//
+// Basic normalization & validation are performed:
+// - Spaces, dots and dashes are removed, leaving exactly 16 digits
+// - Digits 1-6 (the region code) cannot be all zeros
+// - Digits 7-12 encode the birth date as DDMMYY, with 40 added to the day for women
+type NIK struct {
+	value string
+}
+
+// NewNIK constructs a NIK with normalization and validation.
+func NewNIK(value string) (*NIK, error) {
+	v := strings.NewReplacer(" ", "", ".", "", "-", "").Replace(value)
+	if v == "" {
+		return nil, errors.New("nik cannot be empty")
+	}
+	if len(v) != 16 || !isDigits(v) {
+		return nil, errors.New("nik must be 16 digits")
+	}
+	if v[:6] == "000000" {
+		return nil, errors.New("nik has an invalid region code")
+	}
+	day, _ := strconv.Atoi(v[6:8])
+	month, _ := strconv.Atoi(v[8:10])
+	if day > 40 {
+		day -= 40
+	}
+	if day < 1 || day > 31 || month < 1 || month > 12 {
+		return nil, errors.New("nik has an invalid birth date")
+	}
+	return &NIK{value: v}, nil
+}
+
+// String returns the 16 digits.
+func (n NIK) String() string { return n.value }
+
+// IsZero reports whether the NIK is the zero value.
+func (n NIK) IsZero() bool { return n.value == "" }
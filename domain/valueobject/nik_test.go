@@ -0,0 +1,39 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	vo "github.com/rfanazhari/hris/domain/valueobject"
+)
+
+func TestNewNIK_Valid(t *testing.T) {
+	for _, value := range []string{"3273014507950001", "3273.010507.95.0001", "3273 0131 1299 0002"} {
+		n, err := vo.NewNIK(value)
+		if err != nil {
+			t.Fatalf("NewNIK(%q) unexpected error: %v", value, err)
+		}
+		if len(n.String()) != 16 || n.IsZero() {
+			t.Fatalf("NewNIK(%q) got %q", value, n.String())
+		}
+	}
+}
+
+func TestNewNIK_Invalid(t *testing.T) {
+	cases := []struct {
+		value string
+		err   string
+	}{
+		{" ", "nik cannot be empty"},
+		{"327301450795000", "nik must be 16 digits"},
+		{"32730145079500a1", "nik must be 16 digits"},
+		{"0000004507950001", "nik has an invalid region code"},
+		{"3273013207950001", "nik has an invalid birth date"},
+		{"3273014513950001", "nik has an invalid birth date"},
+	}
+	for _, c := range cases {
+		_, err := vo.NewNIK(c.value)
+		if err == nil || err.Error() != c.err {
+			t.Fatalf("NewNIK(%q) error got %v, want %q", c.value, err, c.err)
+		}
+	}
+}
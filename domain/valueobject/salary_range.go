@@ -8,7 +8,7 @@ import (
 )
 
 // SalaryRange represents a salary band for a role or grade.
-// Currency is a 3-letter ISO-like code, currently limited to IDR and USD.
+// Currency is an ISO 4217 code from the currency registry, amounts are in whole units.
 // Values are non-negative and Max must be >= Min.
 type SalaryRange struct {
 	Min      int64  `json:"min"`
@@ -16,11 +16,6 @@ type SalaryRange struct {
 	Currency string `json:"currency"`
 }
 
-var allowedCurrencies = map[string]struct{}{
-	"IDR": {},
-	"USD": {},
-}
-
 // NewSalaryRange constructs a SalaryRange with validation and normalization.
 func NewSalaryRange(min, max int64, currency string) (*SalaryRange, error) {
 	sr := &SalaryRange{Min: min, Max: max, Currency: currency}
@@ -44,12 +39,25 @@ func (s *SalaryRange) Validate() error {
 	if s.Currency == "" {
 		return fmt.Errorf("currency cannot be empty")
 	}
-	if _, ok := allowedCurrencies[s.Currency]; !ok {
-		return fmt.Errorf("unsupported currency: %s", s.Currency)
+	if _, err := ParseCurrency(s.Currency); err != nil {
+		return err
 	}
 	return nil
 }
 
+// Contains reports whether amount, in the range's currency, lies within the range.
+func (s SalaryRange) Contains(amount int64) bool {
+	return amount >= s.Min && amount <= s.Max
+}
+
+// Convert returns the range converted with rate, whose base must be the range's currency.
+func (s SalaryRange) Convert(rate ExchangeRate) (*SalaryRange, error) {
+	if rate.Base().Code() != s.Currency {
+		return nil, fmt.Errorf("exchange rate %s/%s does not convert from %s", rate.Base(), rate.Quote(), s.Currency)
+	}
+	return NewSalaryRange(rate.Convert(s.Min), rate.Convert(s.Max), rate.Quote().Code())
+}
+
 // normalizeAndValidate uppercases currency then validates.
 func (s *SalaryRange) normalizeAndValidate() error {
 	if s == nil {
@@ -2,7 +2,9 @@ package valueobject_test
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
+	"time"
 
 	vo "github.com/rfanazhari/hris/domain/valueobject"
 )
@@ -17,6 +19,7 @@ func TestNewSalaryRange_ValidCurrencies(t *testing.T) {
 		{"IDR exact", 0, 10000000, "IDR"},
 		{"USD lowercase normalized", 50000, 150000, "usd"},
 		{"IDR spaced", 1, 2, " idr "},
+		{"EUR", 4000, 6000, "eur"},
 	}
 
 	for _, tt := range tests {
@@ -28,7 +31,7 @@ func TestNewSalaryRange_ValidCurrencies(t *testing.T) {
 			if s.Min != tt.min || s.Max != tt.max {
 				t.Fatalf("unexpected min/max: got %d-%d", s.Min, s.Max)
 			}
-			if s.Currency != "IDR" && s.Currency != "USD" {
+			if s.Currency != strings.ToUpper(strings.TrimSpace(tt.currency)) {
 				t.Fatalf("unexpected currency normalization: %s", s.Currency)
 			}
 		})
@@ -45,7 +48,7 @@ func TestNewSalaryRange_InvalidValues(t *testing.T) {
 		{"negative min", -1, 0, "IDR"},
 		{"max less than min", 10, 5, "USD"},
 		{"empty currency", 0, 0, ""},
-		{"unsupported currency", 0, 0, "EUR"},
+		{"unsupported currency", 0, 0, "XYZ"},
 	}
 
 	for _, c := range cases {
@@ -88,3 +91,22 @@ func TestSalaryRange_JSON(t *testing.T) {
 		t.Fatalf("expected error for invalid range, got nil")
 	}
 }
+
+func TestSalaryRange_Convert(t *testing.T) {
+	s, _ := vo.NewSalaryRange(4_000, 6_000, "USD")
+	rate, _ := vo.NewExchangeRate("USD", "IDR", "16250.5", time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+
+	idr, err := s.Convert(*rate)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if idr.Min != 65_002_000 || idr.Max != 97_503_000 || idr.Currency != "IDR" {
+		t.Fatalf("unexpected range: %+v", idr)
+	}
+	if !idr.Contains(80_000_000) || idr.Contains(100_000_000) {
+		t.Fatalf("Contains mismatch for %+v", idr)
+	}
+	if _, err := idr.Convert(*rate); err == nil || err.Error() != "exchange rate USD/IDR does not convert from IDR" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -2,12 +2,15 @@ module github.com/rfanazhari/hris
 
 go 1.24
 
+require (
+	github.com/go-faker/faker/v4 v4.6.1
+	github.com/google/uuid v1.6.0
+	github.com/stretchr/testify v1.10.0
+)
+
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/go-faker/faker/v4 v4.6.1 // indirect
-	github.com/google/uuid v1.6.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	github.com/stretchr/testify v1.10.0 // indirect
 	golang.org/x/text v0.28.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
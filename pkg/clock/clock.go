@@ -0,0 +1,23 @@
+package clock
+
+import "time"
+
+// Clock provides the current time. Services depend on Clock instead of calling
+// time.Now directly so that time-sensitive rules can be tested deterministically.
+type Clock interface {
+	Now() time.Time
+}
+
+// System is a Clock backed by time.Now.
+type System struct{}
+
+// Now returns the current system time.
+func (System) Now() time.Time { return time.Now() }
+
+// Fixed is a Clock that always returns the same instant.
+type Fixed struct {
+	At time.Time
+}
+
+// Now returns the fixed instant.
+func (f Fixed) Now() time.Time { return f.At }
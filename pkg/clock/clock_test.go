@@ -0,0 +1,24 @@
+package clock_test
+
+import (
+	"github.com/rfanazhari/hris/pkg/clock"
+	"testing"
+	"time"
+)
+
+func TestFixed_Now(t *testing.T) {
+	at := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	var c clock.Clock = clock.Fixed{At: at}
+	if !c.Now().Equal(at) {
+		t.Fatalf("expected %v, got %v", at, c.Now())
+	}
+}
+
+func TestSystem_Now(t *testing.T) {
+	var c clock.Clock = clock.System{}
+	before := time.Now()
+	got := c.Now()
+	if got.Before(before) || got.After(time.Now()) {
+		t.Fatalf("system clock returned %v outside of [%v, now]", got, before)
+	}
+}
@@ -0,0 +1,38 @@
+package format
+
+import (
+	"fmt"
+	"time"
+)
+
+var indonesianMonths = [...]string{
+	"Januari", "Februari", "Maret", "April", "Mei", "Juni",
+	"Juli", "Agustus", "September", "Oktober", "November", "Desember",
+}
+
+var indonesianDays = [...]string{
+	"Minggu", "Senin", "Selasa", "Rabu", "Kamis", "Jumat", "Sabtu",
+}
+
+// Date formats t as an Indonesian date, e.g. "17 Agustus 2025".
+// The zero time yields an empty string.
+func Date(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%d %s %d", t.Day(), indonesianMonths[t.Month()-1], t.Year())
+}
+
+// LongDate formats t as an Indonesian date with weekday, e.g. "Minggu, 17 Agustus 2025".
+// The zero time yields an empty string.
+func LongDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return indonesianDays[t.Weekday()] + ", " + Date(t)
+}
+
+// MonthYear formats t as an Indonesian month and year, e.g. "Agustus 2025".
+func MonthYear(t time.Time) string {
+	return fmt.Sprintf("%s %d", indonesianMonths[t.Month()-1], t.Year())
+}
@@ -0,0 +1,53 @@
+package format_test
+
+import (
+	"github.com/rfanazhari/hris/pkg/format"
+	"testing"
+	"time"
+)
+
+func TestDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       time.Time
+		date     string
+		longDate string
+	}{
+		{
+			name:     "independence_day",
+			in:       time.Date(2025, 8, 17, 10, 0, 0, 0, time.UTC),
+			date:     "17 Agustus 2025",
+			longDate: "Minggu, 17 Agustus 2025",
+		},
+		{
+			name:     "new_year",
+			in:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			date:     "1 Januari 2024",
+			longDate: "Senin, 1 Januari 2024",
+		},
+		{
+			name:     "zero_time",
+			in:       time.Time{},
+			date:     "",
+			longDate: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := format.Date(tt.in); got != tt.date {
+				t.Errorf("Date: expected %q, got %q", tt.date, got)
+			}
+			if got := format.LongDate(tt.in); got != tt.longDate {
+				t.Errorf("LongDate: expected %q, got %q", tt.longDate, got)
+			}
+		})
+	}
+}
+
+func TestMonthYear(t *testing.T) {
+	got := format.MonthYear(time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC))
+	if got != "Desember 2025" {
+		t.Errorf("expected %q, got %q", "Desember 2025", got)
+	}
+}
@@ -0,0 +1,36 @@
+package format
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Rupiah formats an amount in whole rupiah using Indonesian conventions,
+// e.g. 12500000 -> "Rp 12.500.000" and -7500 -> "-Rp 7.500".
+func Rupiah(amount int64) string {
+	if amount < 0 {
+		return "-Rp " + Thousands(-amount)
+	}
+	return "Rp " + Thousands(amount)
+}
+
+// Thousands formats a non-negative integer with '.' as thousands separator,
+// e.g. 1500000 -> "1.500.000".
+func Thousands(n int64) string {
+	digits := strconv.FormatInt(n, 10)
+	if len(digits) <= 3 {
+		return digits
+	}
+	var b strings.Builder
+	head := len(digits) % 3
+	if head > 0 {
+		b.WriteString(digits[:head])
+	}
+	for i := head; i < len(digits); i += 3 {
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
@@ -0,0 +1,30 @@
+package format_test
+
+import (
+	"github.com/rfanazhari/hris/pkg/format"
+	"testing"
+)
+
+func TestRupiah(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		expected string
+	}{
+		{name: "zero", amount: 0, expected: "Rp 0"},
+		{name: "hundreds", amount: 750, expected: "Rp 750"},
+		{name: "thousands", amount: 7500, expected: "Rp 7.500"},
+		{name: "exact_group", amount: 150000, expected: "Rp 150.000"},
+		{name: "millions", amount: 12500000, expected: "Rp 12.500.000"},
+		{name: "billions", amount: 1234567890, expected: "Rp 1.234.567.890"},
+		{name: "negative", amount: -7500, expected: "-Rp 7.500"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := format.Rupiah(tt.amount); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+// Package pdf writes simple single-font PDF documents such as payslips and letters
+// without external dependencies. It supports text in the standard Helvetica fonts,
+// lines, filled rectangles, JPEG images and password protection.
+//
+// Coordinates are in points (1/72 inch) measured from the top-left corner of the page.
+package pdf
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image/color"
+	"image/jpeg"
+	"math"
+	"strconv"
+)
+
+// A4 page size in points.
+const (
+	A4Width  = 595.28
+	A4Height = 841.89
+)
+
+// Document is a PDF document under construction.
+type Document struct {
+	width         float64
+	height        float64
+	pages         []*Page
+	images        []*Image
+	title         string
+	author        string
+	userPassword  string
+	ownerPassword string
+	encrypted     bool
+}
+
+// Image is a JPEG image added to a document, drawn with Page.Image.
+type Image struct {
+	name   string
+	data   []byte
+	width  int
+	height int
+	gray   bool
+}
+
+// Page is a page of a document.
+type Page struct {
+	doc     *Document
+	content bytes.Buffer
+}
+
+// New returns an empty A4 portrait document.
+func New() *Document {
+	return &Document{width: A4Width, height: A4Height}
+}
+
+// SetInfo sets the title and author shown in the reader's document properties.
+func (d *Document) SetInfo(title, author string) {
+	d.title = title
+	d.author = author
+}
+
+// Encrypt protects the document with RC4 128-bit encryption. The user password is asked
+// when opening the document. Without an owner password a random one is used, so nobody
+// can lift the permissions.
+func (d *Document) Encrypt(userPassword, ownerPassword string) {
+	if ownerPassword == "" {
+		ownerPassword = rand.Text()
+	}
+	d.userPassword = userPassword
+	d.ownerPassword = ownerPassword
+	d.encrypted = true
+}
+
+// AddPage appends a blank page.
+func (d *Document) AddPage() *Page {
+	p := &Page{doc: d}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+// AddJPEG adds a baseline JPEG image for use on any page.
+func (d *Document) AddJPEG(data []byte) (*Image, error) {
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decode jpeg: %w", err)
+	}
+	img := &Image{
+		name:   "Im" + strconv.Itoa(len(d.images)+1),
+		data:   data,
+		width:  cfg.Width,
+		height: cfg.Height,
+		gray:   cfg.ColorModel == color.GrayModel,
+	}
+	d.images = append(d.images, img)
+	return img, nil
+}
+
+// Width returns the width of the image in pixels.
+func (i *Image) Width() int {
+	return i.width
+}
+
+// Height returns the height of the image in pixels.
+func (i *Image) Height() int {
+	return i.height
+}
+
+// SetFillColor sets the color of subsequent text and rectangles.
+func (p *Page) SetFillColor(r, g, b uint8) {
+	fmt.Fprintf(&p.content, "%s %s %s rg\n", component(r), component(g), component(b))
+}
+
+// SetStrokeColor sets the color of subsequent lines.
+func (p *Page) SetStrokeColor(r, g, b uint8) {
+	fmt.Fprintf(&p.content, "%s %s %s RG\n", component(r), component(g), component(b))
+}
+
+// Text draws s with its baseline starting at x, y.
+func (p *Page) Text(x, y float64, font Font, size float64, s string) {
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td (", font.resource(), num(size), num(x), num(p.doc.height-y))
+	for _, c := range winAnsi(s) {
+		if c == '(' || c == ')' || c == '\\' {
+			p.content.WriteByte('\\')
+		}
+		p.content.WriteByte(c)
+	}
+	p.content.WriteString(") Tj ET\n")
+}
+
+// TextRight draws s with its baseline ending at x, y.
+func (p *Page) TextRight(x, y float64, font Font, size float64, s string) {
+	p.Text(x-TextWidth(font, size, s), y, font, size, s)
+}
+
+// Line draws a line from x1, y1 to x2, y2.
+func (p *Page) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s m %s %s l S\n", num(width), num(x1), num(p.doc.height-y1), num(x2), num(p.doc.height-y2))
+}
+
+// Rect fills the rectangle with top-left corner x, y.
+func (p *Page) Rect(x, y, w, h float64) {
+	fmt.Fprintf(&p.content, "%s %s %s %s re f\n", num(x), num(p.doc.height-y-h), num(w), num(h))
+}
+
+// Image draws img scaled to w by h with its top-left corner at x, y.
+func (p *Page) Image(img *Image, x, y, w, h float64) {
+	fmt.Fprintf(&p.content, "q %s 0 0 %s %s %s cm /%s Do Q\n", num(w), num(h), num(x), num(p.doc.height-y-h), img.name)
+}
+
+// Bytes renders the document.
+func (d *Document) Bytes() ([]byte, error) {
+	if len(d.pages) == 0 {
+		return nil, errors.New("document has no pages")
+	}
+
+	w := &writer{}
+	// Objects 1 to 4 are fixed; pages, their contents and images follow.
+	const catalog, pages, regular, bold = 1, 2, 3, 4
+	next := 5
+	pageIDs := make([]int, len(d.pages))
+	for i := range d.pages {
+		pageIDs[i] = next
+		next += 2
+	}
+	imageIDs := make([]int, len(d.images))
+	for i := range d.images {
+		imageIDs[i] = next
+		next++
+	}
+	info := next
+	encrypt := next + 1
+
+	id := d.fileID()
+	if d.encrypted {
+		w.security = newSecurity(d.userPassword, d.ownerPassword, id)
+	}
+
+	w.object(catalog, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pages))
+	kids := ""
+	for _, pid := range pageIDs {
+		kids += fmt.Sprintf("%d 0 R ", pid)
+	}
+	w.object(pages, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d /MediaBox [0 0 %s %s] >>", kids, len(pageIDs), num(d.width), num(d.height)))
+	w.object(regular, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	w.object(bold, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	xobjects := ""
+	for i, img := range d.images {
+		xobjects += fmt.Sprintf("/%s %d 0 R ", img.name, imageIDs[i])
+	}
+	resources := fmt.Sprintf("<< /Font << /F1 %d 0 R /F2 %d 0 R >> /XObject << %s>> >>", regular, bold, xobjects)
+	for i, p := range d.pages {
+		w.object(pageIDs[i], fmt.Sprintf("<< /Type /Page /Parent %d 0 R /Resources %s /Contents %d 0 R >>", pages, resources, pageIDs[i]+1))
+		w.stream(pageIDs[i]+1, "", p.content.Bytes())
+	}
+	for i, img := range d.images {
+		space := "/DeviceRGB"
+		if img.gray {
+			space = "/DeviceGray"
+		}
+		w.stream(imageIDs[i], fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace %s /BitsPerComponent 8 /Filter /DCTDecode ", img.width, img.height, space), img.data)
+	}
+	w.object(info, fmt.Sprintf("<< /Title %s /Author %s /Producer %s >>", w.text(info, d.title), w.text(info, d.author), w.text(info, "hris")))
+
+	trailer := fmt.Sprintf("/Root %d 0 R /Info %d 0 R /ID [<%x> <%x>]", catalog, info, id, id)
+	size := info + 1
+	if w.security != nil {
+		w.object(encrypt, fmt.Sprintf("<< /Filter /Standard /V 2 /R 3 /Length 128 /O <%x> /U <%x> /P %d >>", w.security.owner, w.security.user, permissions))
+		trailer += fmt.Sprintf(" /Encrypt %d 0 R", encrypt)
+		size = encrypt + 1
+	}
+	return w.finish(size, trailer), nil
+}
+
+// fileID derives the document identifier from its content so identical documents render
+// identically.
+func (d *Document) fileID() []byte {
+	h := md5.New()
+	h.Write([]byte(d.title))
+	h.Write([]byte(d.author))
+	for _, p := range d.pages {
+		h.Write(p.content.Bytes())
+	}
+	return h.Sum(nil)
+}
+
+// writer assembles numbered objects and the cross-reference table.
+type writer struct {
+	buf      bytes.Buffer
+	offsets  map[int]int
+	security *security
+}
+
+func (w *writer) object(id int, body string) {
+	w.begin(id)
+	w.buf.WriteString(body)
+	w.buf.WriteString("\nendobj\n")
+}
+
+func (w *writer) stream(id int, dict string, data []byte) {
+	if w.security != nil {
+		data = w.security.encrypt(id, data)
+	}
+	w.begin(id)
+	fmt.Fprintf(&w.buf, "<< %s/Length %d >>\nstream\n", dict, len(data))
+	w.buf.Write(data)
+	w.buf.WriteString("\nendstream\nendobj\n")
+}
+
+// text returns s as a hex string, encrypted for object id when the document is protected.
+func (w *writer) text(id int, s string) string {
+	data := winAnsi(s)
+	if w.security != nil {
+		data = w.security.encrypt(id, data)
+	}
+	return "<" + hex.EncodeToString(data) + ">"
+}
+
+func (w *writer) begin(id int) {
+	if w.offsets == nil {
+		w.buf.WriteString("%PDF-1.4\n%\xE2\xE3\xCF\xD3\n")
+		w.offsets = map[int]int{}
+	}
+	w.offsets[id] = w.buf.Len()
+	fmt.Fprintf(&w.buf, "%d 0 obj\n", id)
+}
+
+func (w *writer) finish(size int, trailer string) []byte {
+	xref := w.buf.Len()
+	fmt.Fprintf(&w.buf, "xref\n0 %d\n0000000000 65535 f \n", size)
+	for id := 1; id < size; id++ {
+		fmt.Fprintf(&w.buf, "%010d 00000 n \n", w.offsets[id])
+	}
+	fmt.Fprintf(&w.buf, "trailer\n<< /Size %d %s >>\nstartxref\n%d\n%%%%EOF\n", size, trailer, xref)
+	return w.buf.Bytes()
+}
+
+func component(c uint8) string {
+	return num(float64(c) / 255)
+}
+
+func num(f float64) string {
+	return strconv.FormatFloat(math.Round(f*1000)/1000, 'f', -1, 64)
+}
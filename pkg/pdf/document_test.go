@@ -0,0 +1,103 @@
+package pdf_test
+
+import (
+	"bytes"
+	"github.com/rfanazhari/hris/pkg/pdf"
+	"image"
+	"image/jpeg"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestDocument_Bytes(t *testing.T) {
+	doc := pdf.New()
+	doc.SetInfo("Slip Gaji", "PT Contoh")
+	page := doc.AddPage()
+	page.SetFillColor(0, 51, 102)
+	page.Rect(40, 40, 515, 30)
+	page.Text(50, 60, pdf.HelveticaBold, 14, "Slip Gaji (April 2025)")
+	page.TextRight(555, 100, pdf.Helvetica, 10, "Rp5.000.000")
+	page.Line(40, 110, 555, 110, 0.5)
+
+	out, err := doc.Bytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatalf("missing PDF header or trailer")
+	}
+	if !bytes.Contains(out, []byte(`(Slip Gaji \(April 2025\)) Tj`)) {
+		t.Fatalf("text is not escaped:\n%s", out)
+	}
+	if !bytes.Contains(out, []byte("BT /F1 10 Tf 497.74 741.89 Td (Rp5.000.000) Tj ET")) {
+		t.Fatalf("right aligned text is misplaced:\n%s", out)
+	}
+
+	// Every cross-reference entry must point at its object.
+	start := bytes.LastIndex(out, []byte("startxref\n"))
+	xref, _ := strconv.Atoi(string(bytes.Fields(out[start+len("startxref\n"):])[0]))
+	entries := regexp.MustCompile(`(\d{10}) 00000 n`).FindAllSubmatch(out[xref:], -1)
+	if len(entries) != 7 {
+		t.Fatalf("expected 7 objects, got %d", len(entries))
+	}
+	for i, e := range entries {
+		offset, _ := strconv.Atoi(string(e[1]))
+		if want := strconv.Itoa(i+1) + " 0 obj"; !bytes.HasPrefix(out[offset:], []byte(want)) {
+			t.Fatalf("xref entry %d points at %q", i+1, out[offset:offset+10])
+		}
+	}
+}
+
+func TestDocument_Encrypt(t *testing.T) {
+	doc := pdf.New()
+	doc.AddPage().Text(50, 50, pdf.Helvetica, 10, "Gaji Pokok")
+	doc.Encrypt("17081990", "")
+
+	out, err := doc.Bytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Contains(out, []byte("/Filter /Standard /V 2 /R 3 /Length 128")) || !bytes.Contains(out, []byte("/Encrypt 8 0 R")) {
+		t.Fatalf("missing encryption dictionary:\n%s", out)
+	}
+	if bytes.Contains(out, []byte("Gaji Pokok")) {
+		t.Fatalf("content is not encrypted")
+	}
+}
+
+func TestDocument_AddJPEG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 4, 2)), nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	doc := pdf.New()
+	img, err := doc.AddJPEG(buf.Bytes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if img.Width() != 4 || img.Height() != 2 {
+		t.Fatalf("unexpected size %dx%d", img.Width(), img.Height())
+	}
+	doc.AddPage().Image(img, 40, 40, 80, 40)
+	out, _ := doc.Bytes()
+	if !bytes.Contains(out, []byte("/Width 4 /Height 2 /ColorSpace /DeviceRGB /BitsPerComponent 8 /Filter /DCTDecode")) {
+		t.Fatalf("missing image object:\n%s", out)
+	}
+
+	if _, err := doc.AddJPEG([]byte("not a jpeg")); err == nil {
+		t.Fatalf("expected error for invalid jpeg")
+	}
+	if _, err := pdf.New().Bytes(); err == nil || err.Error() != "document has no pages" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTextWidth(t *testing.T) {
+	if got := pdf.TextWidth(pdf.Helvetica, 10, "Rp1"); got != 18.34 {
+		t.Fatalf("TextWidth = %v", got)
+	}
+	if got := pdf.TextWidth(pdf.HelveticaBold, 10, "Rp1"); got != 18.89 {
+		t.Fatalf("TextWidth = %v", got)
+	}
+}
@@ -0,0 +1,88 @@
+package pdf
+
+import (
+	"crypto/md5"
+	"crypto/rc4"
+	"encoding/binary"
+)
+
+// permissions allows printing, copying and accessibility extraction but not modification,
+// as the P entry of the standard security handler.
+const permissions int32 = -1324
+
+// passwordPadding pads passwords to 32 bytes, PDF 1.7 section 7.6.3.3.
+var passwordPadding = []byte{
+	0x28, 0xBF, 0x4E, 0x5E, 0x4E, 0x75, 0x8A, 0x41, 0x64, 0x00, 0x4E, 0x56, 0xFF, 0xFA, 0x01, 0x08,
+	0x2E, 0x2E, 0x00, 0xB6, 0xD0, 0x68, 0x3E, 0x80, 0x2F, 0x0C, 0xA9, 0xFE, 0x64, 0x53, 0x69, 0x7A,
+}
+
+// security implements the standard security handler revision 3: RC4 with a 128-bit key.
+type security struct {
+	key   []byte
+	owner []byte
+	user  []byte
+}
+
+func newSecurity(userPassword, ownerPassword string, id []byte) *security {
+	// Algorithm 3: the O entry.
+	h := md5.Sum(pad(ownerPassword))
+	for i := 0; i < 50; i++ {
+		h = md5.Sum(h[:])
+	}
+	owner := rc4Rounds(h[:], pad(userPassword))
+
+	// Algorithm 2: the file key.
+	p := make([]byte, 4)
+	binary.LittleEndian.PutUint32(p, uint32(int64(permissions)&0xFFFFFFFF))
+	m := md5.New()
+	m.Write(pad(userPassword))
+	m.Write(owner)
+	m.Write(p)
+	m.Write(id)
+	key := m.Sum(nil)
+	for i := 0; i < 50; i++ {
+		sum := md5.Sum(key)
+		key = sum[:]
+	}
+
+	// Algorithm 5: the U entry.
+	u := md5.Sum(append(append([]byte{}, passwordPadding...), id...))
+	user := append(rc4Rounds(key, u[:]), make([]byte, 16)...)
+
+	return &security{key: key, owner: owner, user: user}
+}
+
+// encrypt encrypts the data of a string or stream of the given object, Algorithm 1.
+func (s *security) encrypt(object int, data []byte) []byte {
+	k := append(append([]byte{}, s.key...), byte(object), byte(object>>8), byte(object>>16), 0, 0)
+	sum := md5.Sum(k)
+	return rc4Once(sum[:], data)
+}
+
+func pad(password string) []byte {
+	b := []byte(password)
+	if len(b) > 32 {
+		b = b[:32]
+	}
+	return append(b, passwordPadding[:32-len(b)]...)
+}
+
+// rc4Rounds encrypts data with key and then 19 more times with key XOR the round number.
+func rc4Rounds(key, data []byte) []byte {
+	out := rc4Once(key, data)
+	round := make([]byte, len(key))
+	for i := 1; i <= 19; i++ {
+		for j := range key {
+			round[j] = key[j] ^ byte(i)
+		}
+		out = rc4Once(round, out)
+	}
+	return out
+}
+
+func rc4Once(key, data []byte) []byte {
+	c, _ := rc4.NewCipher(key)
+	out := make([]byte, len(data))
+	c.XORKeyStream(out, data)
+	return out
+}
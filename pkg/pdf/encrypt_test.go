@@ -0,0 +1,57 @@
+package pdf
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"regexp"
+	"testing"
+)
+
+func TestSecurity_RoundTrip(t *testing.T) {
+	doc := New()
+	doc.AddPage().Text(50, 50, Helvetica, 10, "Gaji Pokok")
+	doc.Encrypt("17081990", "owner")
+	out, err := doc.Bytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	id := regexp.MustCompile(`/ID \[<([0-9a-f]+)>`).FindSubmatch(out)[1]
+	fileID, _ := hex.DecodeString(string(id))
+
+	// Algorithm 6: the user password is correct when U starts with the expected hash.
+	s := newSecurity("17081990", "owner", fileID)
+	expected := md5.Sum(append(append([]byte{}, passwordPadding...), fileID...))
+	if !bytes.Equal(rc4Rounds(s.key, expected[:]), s.user[:16]) {
+		t.Fatalf("user password does not authenticate")
+	}
+	if len(s.owner) != 32 || len(s.user) != 32 || len(s.key) != 16 {
+		t.Fatalf("unexpected key sizes")
+	}
+
+	// The content stream of the first page is object 6.
+	m := regexp.MustCompile(`(?s)6 0 obj\n<< /Length (\d+) >>\nstream\n`).FindIndex(out)
+	if m == nil {
+		t.Fatalf("content stream not found")
+	}
+	end := bytes.Index(out[m[1]:], []byte("\nendstream"))
+	plain := s.encrypt(6, out[m[1]:m[1]+end])
+	if !bytes.Contains(plain, []byte("(Gaji Pokok) Tj")) {
+		t.Fatalf("decrypted content = %q", plain)
+	}
+}
+
+func TestDocument_EncryptRandomOwnerPassword(t *testing.T) {
+	doc := New()
+	doc.Encrypt("17081990", "")
+	other := New()
+	other.Encrypt("17081990", "")
+
+	if doc.ownerPassword == "" || doc.ownerPassword == "17081990" {
+		t.Fatalf("owner password = %q, want a random password", doc.ownerPassword)
+	}
+	if doc.ownerPassword == other.ownerPassword {
+		t.Fatalf("owner passwords repeat")
+	}
+}